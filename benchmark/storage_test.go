@@ -1,6 +1,7 @@
 package benchmark
 
 import (
+	"context"
 	"os"
 	"testing"
 
@@ -21,6 +22,11 @@ func newStorage() (*storage_json.Storage, error) {
 	return storage_json.NewStorage(ohp, rp, up, StoragePath)
 }
 
+func removeStorage() {
+	os.Remove(StoragePath)
+	os.Remove(StoragePath + ".wal")
+}
+
 func BenchmarkAddOrder(b *testing.B) {
 	benches := []struct {
 		name   string
@@ -58,19 +64,21 @@ func BenchmarkAddOrder(b *testing.B) {
 		b.Run(bc.name, func(b *testing.B) {
 			for i := 0; i < b.N; i++ {
 				b.StopTimer()
-				os.Remove(StoragePath)
+				removeStorage()
 				st, err := newStorage()
 				require.NoError(b, err)
+				ctx := context.Background()
 				b.StartTimer()
 
 				for i := 0; i < bc.orders; i++ {
-					st.AddOrder(uint64(i), uint64(i), order)
+					st.AddOrder(ctx, uint64(i), uint64(i), order)
 				}
+				st.Close()
 			}
 		})
 	}
 
-	os.Remove(StoragePath)
+	removeStorage()
 }
 
 func BenchmarkAddOrderSingleUser(b *testing.B) {
@@ -109,17 +117,19 @@ func BenchmarkAddOrderSingleUser(b *testing.B) {
 		b.Run(bc.name, func(b *testing.B) {
 			for i := 0; i < b.N; i++ {
 				b.StopTimer()
-				os.Remove(StoragePath)
+				removeStorage()
 				st, err := newStorage()
 				require.NoError(b, err)
+				ctx := context.Background()
 				b.StartTimer()
 
 				for i := 0; i < bc.orders; i++ {
-					st.AddOrder(1, uint64(i), order)
+					st.AddOrder(ctx, 1, uint64(i), order)
 				}
+				st.Close()
 			}
 		})
 	}
 
-	os.Remove(StoragePath)
+	removeStorage()
 }
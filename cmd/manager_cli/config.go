@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/spf13/viper"
+	"gitlab.ozon.dev/chppppr/homework/internal/infra/certs"
 )
 
 type (
@@ -12,7 +13,8 @@ type (
 	}
 
 	Config struct {
-		GRPC Address `mapstructure:"grpc"`
+		GRPC Address            `mapstructure:"grpc"`
+		TLS  certs.ClientConfig `mapstructure:"tls"`
 	}
 )
 
@@ -7,14 +7,23 @@ import (
 	"os/signal"
 	"syscall"
 
+	"github.com/joho/godotenv"
 	"gitlab.ozon.dev/chppppr/homework/internal/app/manager_cli"
+	"gitlab.ozon.dev/chppppr/homework/internal/auth"
 	"gitlab.ozon.dev/chppppr/homework/internal/clients/manager"
 	"gitlab.ozon.dev/chppppr/homework/internal/cmd"
+	"gitlab.ozon.dev/chppppr/homework/internal/infra/certs"
 	manager_service "gitlab.ozon.dev/chppppr/homework/pkg/manager-service/v1"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
+// .env.dev с ключами make dev-keys читается первым: godotenv не перезаписывает
+// уже заданные переменные, а в .env секреты пустые
+func init() {
+	_ = godotenv.Load(".env.dev")
+	_ = godotenv.Load()
+}
+
 func main() {
 	cfg, err := LoadConfig()
 	if err != nil {
@@ -25,7 +34,17 @@ func main() {
 	ctxWichCancel, cancel := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
 	defer cancel()
 
-	conn, err := grpc.NewClient(cfg.GRPC.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	creds, err := certs.TransportCredentials(cfg.TLS)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if creds, ok := auth.CredentialsFromEnv(); ok {
+		opts = append(opts, grpc.WithPerRPCCredentials(creds))
+	}
+
+	conn, err := grpc.NewClient(cfg.GRPC.Address, opts...)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -2,9 +2,15 @@ package main
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/viper"
+	"gitlab.ozon.dev/chppppr/homework/internal/health"
+	"gitlab.ozon.dev/chppppr/homework/internal/infra/certs"
 	"gitlab.ozon.dev/chppppr/homework/internal/infra/kafka"
+	"gitlab.ozon.dev/chppppr/homework/internal/ratelimit"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage/cache"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage/postgres"
 )
 
 type (
@@ -17,18 +23,95 @@ type (
 		Topic  string       `mapstructure:"topic"`
 	}
 
+	Sweeper struct {
+		Enabled  bool          `mapstructure:"enabled"`
+		Interval time.Duration `mapstructure:"interval"`
+		Grace    time.Duration `mapstructure:"grace"`
+	}
+
+	Reminder struct {
+		Enabled    bool          `mapstructure:"enabled"`
+		Interval   time.Duration `mapstructure:"interval"`
+		DaysBefore []uint64      `mapstructure:"days_before"`
+	}
+
+	// Archiver переносит в архив заказы, выданные больше Retention назад.
+	// Retention должен быть больше срока, в который клиент может вернуть заказ.
+	// Части архива старше DropAfter удаляются, 0 - хранить бессрочно
+	Archiver struct {
+		Enabled   bool          `mapstructure:"enabled"`
+		Interval  time.Duration `mapstructure:"interval"`
+		Retention time.Duration `mapstructure:"retention"`
+		DropAfter time.Duration `mapstructure:"drop_after"`
+		BatchSize uint64        `mapstructure:"batch_size"`
+	}
+
+	Scheduler struct {
+		Sweeper  Sweeper  `mapstructure:"sweeper"`
+		Reminder Reminder `mapstructure:"reminder"`
+		Archiver Archiver `mapstructure:"archiver"`
+	}
+
+	Watch struct {
+		HistorySize int `mapstructure:"history_size"`
+		BufferSize  int `mapstructure:"buffer_size"`
+	}
+
+	Postgres struct {
+		// AutoMigrate применяет недостающие миграции при старте,
+		// иначе сервис на устаревшей схеме не запускается
+		AutoMigrate bool                   `mapstructure:"auto_migrate"`
+		Retry       postgres.RetryConfig   `mapstructure:"retry"`
+		Replicas    postgres.ReplicaConfig `mapstructure:"replicas"`
+	}
+
+	// Auth: API-ключи берутся из AUTH_API_KEYS (key:subject:role[:pickup_point] через запятую),
+	// секрет подписи JWT - из AUTH_JWT_SECRET
+	Auth struct {
+		Enabled bool `mapstructure:"enabled"`
+	}
+
+	TLS struct {
+		Server certs.ServerConfig `mapstructure:"server"`
+		// Gateway - TLS шлюза HTTP как клиента gRPC сервера
+		Gateway certs.ClientConfig `mapstructure:"gateway"`
+	}
+
+	RateLimit struct {
+		// Peer ограничивает вызовы gRPC по адресу клиента до проверки учётных данных
+		Peer ratelimit.Config `mapstructure:"peer"`
+		GRPC ratelimit.Config `mapstructure:"grpc"`
+		HTTP ratelimit.Config `mapstructure:"http"`
+	}
+
 	Config struct {
-		GRPC    Address `mapstructure:"grpc"`
-		HTPP    Address `mapstructure:"http"`
-		Swagger Address `mapstructure:"swagger"`
-		Kafka   Kafka   `mapstructure:"kafka"`
+		// Storage - бэкенд хранилища: postgres (по умолчанию) или memory
+		Storage   string        `mapstructure:"storage"`
+		GRPC      Address       `mapstructure:"grpc"`
+		HTPP      Address       `mapstructure:"http"`
+		Swagger   Address       `mapstructure:"swagger"`
+		Kafka     Kafka         `mapstructure:"kafka"`
+		Scheduler Scheduler     `mapstructure:"scheduler"`
+		Watch     Watch         `mapstructure:"watch"`
+		Postgres  Postgres      `mapstructure:"postgres"`
+		Cache     cache.Config  `mapstructure:"cache"`
+		Auth      Auth          `mapstructure:"auth"`
+		TLS       TLS           `mapstructure:"tls"`
+		RateLimit RateLimit     `mapstructure:"rate_limit"`
+		Health    health.Config `mapstructure:"health"`
 	}
 )
 
+const (
+	StoragePostgres = "postgres"
+	StorageMemory   = "memory"
+)
+
 func LoadConfig() (*Config, error) {
 	viper.AddConfigPath("./configs")
 	viper.SetConfigName("manager_service")
 	viper.SetConfigType("yaml")
+	viper.SetDefault("storage", StoragePostgres)
 
 	if err := viper.ReadInConfig(); err != nil {
 		return nil, fmt.Errorf("LoadConfig ReadInConfig: %w", err)
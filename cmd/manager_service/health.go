@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM/sarama"
+	"gitlab.ozon.dev/chppppr/homework/internal/health"
+	desc "gitlab.ozon.dev/chppppr/homework/pkg/manager-service/v1"
+	grpchealth "google.golang.org/grpc/health"
+)
+
+// newKafkaCheck запрашивает у брокеров метаданные топика событий.
+// sarama не учитывает ctx, поэтому проверку ограничивает таймаут Checker
+func newKafkaCheck(client sarama.Client, topic string) health.Check {
+	return func(ctx context.Context) error {
+		if err := client.RefreshMetadata(topic); err != nil {
+			return err
+		}
+
+		partitions, err := client.Partitions(topic)
+		if err != nil {
+			return err
+		}
+		if len(partitions) == 0 {
+			return fmt.Errorf("topic %q has no partitions", topic)
+		}
+		return nil
+	}
+}
+
+// newHealthChecker проверяет хранилище и Kafka, статус выставляется
+// для всего сервера и для ManagerService
+func newHealthChecker(cfg *Config, b *Backend, client sarama.Client, srv *grpchealth.Server) *health.Checker {
+	checker := health.NewChecker(cfg.Health, srv, desc.ManagerService_ServiceDesc.ServiceName)
+	for name, check := range b.Checks {
+		checker.Add(name, check)
+	}
+	checker.Add("kafka", newKafkaCheck(client, cfg.Kafka.Topic))
+	return checker
+}
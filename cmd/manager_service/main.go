@@ -2,15 +2,19 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net"
 	"net/http"
 	"os"
 	"os/signal"
+	"slices"
+	"strings"
+	"sync"
 	"syscall"
+	"time"
 
-	"github.com/IBM/sarama"
 	"github.com/go-chi/chi/v5"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
@@ -18,35 +22,391 @@ import (
 	"github.com/joho/godotenv"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	httpSwagger "github.com/swaggo/http-swagger/v2"
+	"gitlab.ozon.dev/chppppr/homework/internal/app/jobs"
 	"gitlab.ozon.dev/chppppr/homework/internal/app/manager_service"
+	"gitlab.ozon.dev/chppppr/homework/internal/auth"
+	"gitlab.ozon.dev/chppppr/homework/internal/broadcast"
+	"gitlab.ozon.dev/chppppr/homework/internal/clients"
 	kafka_client "gitlab.ozon.dev/chppppr/homework/internal/clients/kafka"
+	"gitlab.ozon.dev/chppppr/homework/internal/health"
+	"gitlab.ozon.dev/chppppr/homework/internal/infra/certs"
 	"gitlab.ozon.dev/chppppr/homework/internal/infra/kafka/producer"
+	"gitlab.ozon.dev/chppppr/homework/internal/ratelimit"
+	"gitlab.ozon.dev/chppppr/homework/internal/scheduler"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage/cache"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage/memory"
 	"gitlab.ozon.dev/chppppr/homework/internal/storage/postgres"
 	"gitlab.ozon.dev/chppppr/homework/internal/usecase"
 	desc "gitlab.ozon.dev/chppppr/homework/pkg/manager-service/v1"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
+	grpchealth "google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/grpc/reflection"
 )
 
+// .env.dev с ключами make dev-keys читается первым: godotenv не перезаписывает
+// уже заданные переменные, а в .env секреты пустые
 func init() {
+	_ = godotenv.Load(".env.dev")
 	_ = godotenv.Load()
 }
 
-func newManagerService(ctx context.Context, pool *pgxpool.Pool, pr sarama.SyncProducer, cfg *Config) (*manager_service.ManagerService, error) {
-	txManager := postgres.NewTxManager(pool)
-	pgPepo := postgres.NewRepoPG(txManager)
-	st := postgres.NewStorageDB(ctx, txManager, pgPepo)
+// withCache оборачивает хранилище кэшем, если он включён.
+// Возвращаемая функция дожидается остановки подписки на инвалидации
+func withCache(ctx context.Context, cfg cache.Config, st storage.Storage, inv cache.Invalidator) (storage.Storage, func()) {
+	if !cfg.Enabled {
+		return st, func() {}
+	}
+
+	cached := cache.NewStorage(st, cfg, inv)
+	wg := &sync.WaitGroup{}
+	cached.Run(ctx, wg)
+	return cached, wg.Wait
+}
+
+// newReplicas подключается к репликам из POSTGRESQL_REPLICA_DSNS (через запятую).
+// Без реплик возвращает nil, и все транзакции идут на основной сервер
+func newReplicas(ctx context.Context, cfg postgres.ReplicaConfig) (*postgres.Replicas, error) {
+	dsns := os.Getenv("POSTGRESQL_REPLICA_DSNS")
+	if dsns == "" {
+		return nil, nil
+	}
+
+	pools := make([]*pgxpool.Pool, 0)
+	for _, dsn := range strings.Split(dsns, ",") {
+		pool, err := pgxpool.New(ctx, strings.TrimSpace(dsn))
+		if err != nil {
+			for _, p := range pools {
+				p.Close()
+			}
+			return nil, fmt.Errorf("pgxpool.New replica: %w", err)
+		}
+		pools = append(pools, pool)
+	}
+
+	return postgres.NewReplicas(cfg, pools...), nil
+}
+
+// Backend - хранилище с сопутствующими ему ресурсами
+type Backend struct {
+	Storage storage.Storage
+	// Locker не даёт задачам планировщика выполняться одновременно на нескольких репликах
+	Locker scheduler.Locker
+	// Archiver - nil, если хранилище не поддерживает архив
+	Archiver storage.Archiver
+	// UserData - nil, если хранилище не поддерживает запросы о данных клиента
+	UserData storage.UserData
+	// Audit - nil, если хранилище не поддерживает журнал действий
+	Audit storage.AuditLog
+	// Checks - проверки готовности хранилища по имени зависимости
+	Checks map[string]health.Check
+	// Close освобождает ресурсы хранилища
+	Close func()
+}
+
+// openPostgres подключается к базе из POSTGRESQL_DSN, если её схема подходит сервису.
+// Migrator остаётся открытым, чтобы проверять версию схемы при проверке готовности
+func openPostgres(ctx context.Context, cfg *Config) (*pgxpool.Pool, *postgres.Migrator, error) {
+	dsn := os.Getenv("POSTGRESQL_DSN")
+	m, err := postgres.NewMigrator(dsn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err = checkSchema(ctx, m, cfg.Postgres.AutoMigrate); err != nil {
+		m.Close()
+		return nil, nil, fmt.Errorf("checkSchema: %w", err)
+	}
+
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		m.Close()
+		return nil, nil, fmt.Errorf("pgxpool.New: %w", err)
+	}
+
+	return pool, m, nil
+}
+
+func newPostgresBackend(ctx context.Context, cfg *Config) (*Backend, error) {
+	pool, m, err := openPostgres(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	replicas, err := newReplicas(ctx, cfg.Postgres.Replicas)
+	if err != nil {
+		pool.Close()
+		m.Close()
+		return nil, err
+	}
+
+	wg := &sync.WaitGroup{}
+	if replicas != nil {
+		replicas.Run(ctx, wg)
+	}
+
+	txManager := postgres.NewTxManagerWithReplicas(pool, cfg.Postgres.Retry, replicas)
+	db := postgres.NewStorageDB(txManager, postgres.NewRepoPG(txManager))
+	st, stop := withCache(ctx, cfg.Cache, db, postgres.NewNotifier(pool, txManager, postgres.CacheChannel))
+	return &Backend{
+		Storage: st,
+		Locker:  postgres.NewAdvisoryLocker(pool),
+		// через кэш, чтобы удалённые и обезличенные заказы не читались из него
+		Archiver: st.(storage.Archiver),
+		UserData: st.(storage.UserData),
+		Audit:    db,
+		Checks: map[string]health.Check{
+			"postgres": pool.Ping,
+			"schema":   m.Check,
+		},
+		Close: func() {
+			stop()
+			wg.Wait()
+			if replicas != nil {
+				replicas.Close()
+			}
+			pool.Close()
+			m.Close()
+		},
+	}, nil
+}
+
+func newBackend(ctx context.Context, cfg *Config) (*Backend, error) {
+	switch cfg.Storage {
+	case StorageMemory:
+		st, stop := withCache(ctx, cfg.Cache, memory.NewStorage(), nil)
+		return &Backend{Storage: st, Locker: scheduler.NewLocalLocker(), Close: stop}, nil
+	case StoragePostgres:
+		return newPostgresBackend(ctx, cfg)
+	default:
+		return nil, fmt.Errorf("unknown storage %q", cfg.Storage)
+	}
+}
+
+// newUserDataUsecase использует ключ псевдонимов из USER_PSEUDONYM_KEY. Запросы о данных
+// клиента записываются только в журнал действий, поэтому без него они выключены
+func newUserDataUsecase(ud storage.UserData, al storage.AuditLog, br *broadcast.Broadcaster) manager_service.UserDataUsecase {
+	if ud == nil || al == nil {
+		return nil
+	}
+	return usecase.NewUserDataUsecase(broadcast.NewUserData(ud, br), []byte(os.Getenv("USER_PSEUDONYM_KEY")))
+}
+
+func newAuditUsecase(al storage.AuditLog) manager_service.AuditUsecase {
+	if al == nil {
+		return nil
+	}
+	return usecase.NewAuditUsecase(al)
+}
+
+func newManagerService(b *Backend, st storage.Storage, br *broadcast.Broadcaster, pr clients.KafkaProducer) (*manager_service.ManagerService, error) {
 	au := usecase.NewAcceptUsecase(st)
 	gu := usecase.NewGiveUsecase(st)
 	ru := usecase.NewReturnUsecase(st)
 	vu := usecase.NewViewUsecase(st)
+	su := usecase.NewSearchUsecase(st)
+	wu := usecase.NewWatchUsecase(br)
+	du := newUserDataUsecase(b.UserData, b.Audit, br)
+	qu := newAuditUsecase(b.Audit)
 
-	pr_client := kafka_client.NewProducerClient(pr, cfg.Kafka.Topic)
-	return manager_service.NewManagerService(au, gu, ru, vu, pr_client), nil
+	return manager_service.NewManagerService(au, gu, ru, vu, su, wu, du, qu, pr), nil
+}
+
+// newAuthInterceptor возвращает nil, если проверка учётных данных выключена
+func newAuthInterceptor(cfg *Config) (*manager_service.AuthInterceptor, error) {
+	if !cfg.Auth.Enabled {
+		log.Println("auth is disabled: any client may call any method")
+		return nil, nil
+	}
+
+	keys, secret := os.Getenv("AUTH_API_KEYS"), os.Getenv("AUTH_JWT_SECRET")
+	if err := rejectDevSecrets(append(apiKeys(keys), secret)...); err != nil {
+		return nil, err
+	}
+
+	authenticator, err := auth.NewAuthenticator(keys, []byte(secret))
+	if err != nil {
+		return nil, err
+	}
+	return manager_service.NewAuthInterceptor(authenticator), nil
+}
+
+// devSecrets раньше лежали в .env репозитория, поэтому известны всем
+var devSecrets = []string{"dev-staff-key", "dev-courier-key", "dev-admin-key", "dev-jwt-secret", "dev-audit-digest-key"}
+
+// rejectDevSecrets не даёт запустить сервис с известными ключами вне профиля dev
+func rejectDevSecrets(values ...string) error {
+	if os.Getenv("APP_PROFILE") == "dev" {
+		return nil
+	}
+
+	for _, v := range values {
+		if slices.Contains(devSecrets, v) {
+			return fmt.Errorf("well-known dev secret %q is configured outside APP_PROFILE=dev: run make dev-keys or set your own", v)
+		}
+	}
+	return nil
+}
+
+// apiKeys достаёт ключи из AUTH_API_KEYS
+func apiKeys(list string) []string {
+	var keys []string
+	for _, entry := range strings.Split(list, ",") {
+		key, _, _ := strings.Cut(strings.TrimSpace(entry), ":")
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// newAuditInterceptor возвращает nil, если хранилище не поддерживает журнал.
+// Запросы в журнале подписываются ключом из AUDIT_DIGEST_KEY
+func newAuditInterceptor(al storage.AuditLog) (*manager_service.AuditInterceptor, error) {
+	if al == nil {
+		return nil, nil
+	}
+
+	key := os.Getenv("AUDIT_DIGEST_KEY")
+	if key == "" {
+		return nil, errors.New("AUDIT_DIGEST_KEY is not set")
+	}
+	if err := rejectDevSecrets(key); err != nil {
+		return nil, err
+	}
+	return manager_service.NewAuditInterceptor(al, []byte(key)), nil
+}
+
+// newInterceptors выстраивает перехватчики по порядку: ограничение частоты по адресу
+// клиента, журнал действий, проверка учётных данных, ограничение частоты по личности
+// вызывающего. Журнал стоит перед проверкой, чтобы записывать запрещённые вызовы;
+// отказы без учётных данных и сверх лимита он только считает метрикой
+func newInterceptors(cfg *Config, al storage.AuditLog) ([]grpc.UnaryServerInterceptor, []grpc.StreamServerInterceptor, error) {
+	var (
+		unary  []grpc.UnaryServerInterceptor
+		stream []grpc.StreamServerInterceptor
+	)
+
+	if cfg.RateLimit.Peer.Enabled {
+		limiter, err := ratelimit.NewLimiter("peer", cfg.RateLimit.Peer)
+		if err != nil {
+			return nil, nil, err
+		}
+		unary = append(unary, limiter.Unary)
+		stream = append(stream, limiter.Stream)
+	}
+
+	auditInterceptor, err := newAuditInterceptor(al)
+	if err != nil {
+		return nil, nil, err
+	}
+	if auditInterceptor != nil {
+		unary = append(unary, auditInterceptor.Unary)
+		stream = append(stream, auditInterceptor.Stream)
+	}
+
+	authInterceptor, err := newAuthInterceptor(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+	if authInterceptor != nil {
+		unary = append(unary, authInterceptor.Unary)
+		stream = append(stream, authInterceptor.Stream)
+	}
+
+	if cfg.RateLimit.GRPC.Enabled {
+		limiter, err := ratelimit.NewLimiter("grpc", cfg.RateLimit.GRPC)
+		if err != nil {
+			return nil, nil, err
+		}
+		unary = append(unary, limiter.Unary)
+		stream = append(stream, limiter.Stream)
+	}
+
+	unary = append(unary, manager_service.ReadYourWritesUnaryInterceptor)
+	stream = append(stream, manager_service.ReadYourWritesStreamInterceptor)
+	return unary, stream, nil
+}
+
+// newServerOptions включает TLS, если он включен в конфиге, и перехватчики
+func newServerOptions(cfg *Config, al storage.AuditLog) ([]grpc.ServerOption, error) {
+	opts, err := newServerCreds(cfg.TLS.Server)
+	if err != nil {
+		return nil, err
+	}
+
+	unary, stream, err := newInterceptors(cfg, al)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(opts,
+		grpc.ChainUnaryInterceptor(unary...),
+		grpc.ChainStreamInterceptor(stream...),
+	), nil
+}
+
+func newServerCreds(cfg certs.ServerConfig) ([]grpc.ServerOption, error) {
+	if !cfg.Enabled {
+		return nil, nil
+	}
+
+	srv, err := certs.NewServer(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return []grpc.ServerOption{grpc.Creds(credentials.NewTLS(srv.Config()))}, nil
+}
+
+// gatewayHeaderMatcher передаёт в gRPC X-Api-Key. Authorization шлюз передаёт сам
+func gatewayHeaderMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, auth.APIKeyHeader) {
+		return auth.APIKeyHeader, true
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+// gatewayOutgoingHeaderMatcher отдаёт время до повторной попытки
+// стандартным заголовком Retry-After, остальные метаданные - как обычно
+func gatewayOutgoingHeaderMatcher(key string) (string, bool) {
+	if key == ratelimit.RetryAfterHeader {
+		return "Retry-After", true
+	}
+	return runtime.MetadataHeaderPrefix + key, true
+}
+
+func newScheduler(b *Backend, st storage.Storage, pr clients.KafkaProducer, cfg *Config) *scheduler.Scheduler {
+	sched := scheduler.NewScheduler(b.Locker)
+
+	sweeper := cfg.Scheduler.Sweeper
+	if sweeper.Enabled {
+		eu := usecase.NewExpireUsecase(st)
+		sched.Add(jobs.NewSweeperJob(eu, pr, sweeper.Grace), sweeper.Interval)
+	}
+
+	reminder := cfg.Scheduler.Reminder
+	if reminder.Enabled {
+		ru := usecase.NewRemindUsecase(st)
+		sched.Add(jobs.NewReminderJob(ru, pr, reminder.DaysBefore), reminder.Interval)
+	}
+
+	archiver := cfg.Scheduler.Archiver
+	if archiver.Enabled && b.Archiver != nil {
+		au := usecase.NewArchiveUsecase(b.Archiver)
+		sched.Add(jobs.NewArchiverJob(au, archiver.Retention, archiver.DropAfter, archiver.BatchSize), archiver.Interval)
+	}
+
+	return sched
 }
 
 func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(context.Background(), os.Args[2:]); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
 	cfg, err := LoadConfig()
 	if err != nil {
 		log.Fatal(err)
@@ -56,36 +416,66 @@ func main() {
 	ctxWichCancel, cancel := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
 	defer cancel()
 
-	pool, err := pgxpool.New(ctxWichCancel, os.Getenv("POSTGRESQL_DSN"))
+	backend, err := newBackend(ctxWichCancel, cfg)
 	if err != nil {
-		log.Fatal("pgxpool.New:", err)
+		log.Fatal("newBackend:", err)
 	}
-	defer pool.Close()
+	defer backend.Close()
 
-	pr, err := producer.NewSyncProducer(cfg.Kafka.Config)
+	kafkaClient, err := producer.NewClient(cfg.Kafka.Config)
 	if err != nil {
-		log.Fatal("producer.NewSyncProducer:", err)
+		log.Fatal("producer.NewClient:", err)
+	}
+	defer kafkaClient.Close()
+
+	pr, err := producer.NewSyncProducerFromClient(kafkaClient)
+	if err != nil {
+		log.Fatal("producer.NewSyncProducerFromClient:", err)
 	}
 	defer pr.Close()
 
-	mng_service, err := newManagerService(ctxWichCancel, pool, pr, cfg)
+	br := broadcast.NewBroadcaster(cfg.Watch.HistorySize, cfg.Watch.BufferSize)
+	st := broadcast.NewStorage(backend.Storage, br)
+	pr_client := kafka_client.NewProducerClient(pr, cfg.Kafka.Topic)
+	mng_service, err := newManagerService(backend, st, br, pr_client)
 	if err != nil {
 		log.Fatal("newManagerService:", err)
 	}
 
+	wg := &sync.WaitGroup{}
+	newScheduler(backend, st, pr_client, cfg).Run(ctxWichCancel, wg)
+
 	lis, err := net.Listen("tcp", cfg.GRPC.Address)
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 	defer lis.Close()
 
-	grpcServer := grpc.NewServer()
+	opts, err := newServerOptions(cfg, backend.Audit)
+	if err != nil {
+		log.Fatal("newServerOptions:", err)
+	}
+
+	grpcServer := grpc.NewServer(opts...)
 	reflection.Register(grpcServer)
 	desc.RegisterManagerServiceServer(grpcServer, mng_service)
 
-	mux := runtime.NewServeMux()
+	healthServer := grpchealth.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	checker := newHealthChecker(cfg, backend, kafkaClient, healthServer)
+	checker.Run(ctxWichCancel, wg)
+
+	gatewayCreds, err := certs.TransportCredentials(cfg.TLS.Gateway)
+	if err != nil {
+		log.Fatal("gateway TLS:", err)
+	}
+
+	mux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(gatewayHeaderMatcher),
+		runtime.WithOutgoingHeaderMatcher(gatewayOutgoingHeaderMatcher),
+	)
 	err = desc.RegisterManagerServiceHandlerFromEndpoint(ctxWichCancel, mux, cfg.GRPC.Address, []grpc.DialOption{
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(gatewayCreds),
 	})
 	if err != nil {
 		log.Fatalf("failed to register manager service handler: %v", err)
@@ -97,15 +487,32 @@ func main() {
 		}
 	}()
 
+	var httpLimiter *ratelimit.Limiter
+	if cfg.RateLimit.HTTP.Enabled {
+		if httpLimiter, err = ratelimit.NewLimiter("http", cfg.RateLimit.HTTP); err != nil {
+			log.Fatalf("failed to create http rate limiter: %v", err)
+		}
+	}
+
 	r := chi.NewRouter()
 	r.Use(middleware.Recoverer)
 
-	r.Mount("/api/v1/", mux)
-	r.Mount("/metrics", promhttp.Handler())
-	r.Get("/swagger.json", func(w http.ResponseWriter, r *http.Request) {
-		http.ServeFile(w, r, "pkg/manager-service/v1/manager-service.swagger.json")
+	// пробы не ограничиваются, чтобы нагрузка не выдавала себя за неготовность
+	r.Get("/healthz", checker.Liveness)
+	r.Get("/readyz", checker.Readiness)
+
+	r.Group(func(r chi.Router) {
+		if httpLimiter != nil {
+			r.Use(httpLimiter.Middleware)
+		}
+
+		r.Mount("/api/v1/", mux)
+		r.Mount("/metrics", promhttp.Handler())
+		r.Get("/swagger.json", func(w http.ResponseWriter, r *http.Request) {
+			http.ServeFile(w, r, "pkg/manager-service/v1/manager-service.swagger.json")
+		})
+		r.Get("/swagger/*", httpSwagger.Handler(httpSwagger.URL("https://"+cfg.Swagger.Address+"/swagger.json")))
 	})
-	r.Get("/swagger/*", httpSwagger.Handler(httpSwagger.URL("https://"+cfg.Swagger.Address+"/swagger.json")))
 
 	httpServer := http.Server{Addr: cfg.HTPP.Address, Handler: r}
 	go httpServer.ListenAndServe()
@@ -113,7 +520,11 @@ func main() {
 	<-ctxWichCancel.Done()
 	fmt.Println()
 	log.Println("Receive os signal")
+	checker.Shutdown()
+	time.Sleep(checker.ShutdownDelay())
+	br.Close()
 	grpcServer.GracefulStop()
 	httpServer.Shutdown(context.Background())
+	wg.Wait()
 	log.Println("all done")
 }
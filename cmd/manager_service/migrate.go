@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/storage/postgres"
+)
+
+const migrateUsage = "usage: manager_service migrate up|down|status"
+
+// runMigrate выполняет подкоманду migrate над базой из POSTGRESQL_DSN
+func runMigrate(ctx context.Context, args []string) error {
+	if len(args) != 1 {
+		return errors.New(migrateUsage)
+	}
+
+	m, err := postgres.NewMigrator(os.Getenv("POSTGRESQL_DSN"))
+	if err != nil {
+		return err
+	}
+	defer m.Close()
+
+	switch args[0] {
+	case "up":
+		return migrateUp(ctx, m)
+	case "down":
+		return migrateDown(ctx, m)
+	case "status":
+		return migrateStatus(ctx, m)
+	default:
+		return errors.New(migrateUsage)
+	}
+}
+
+func migrateUp(ctx context.Context, m *postgres.Migrator) error {
+	results, err := m.Up(ctx)
+	for _, res := range results {
+		fmt.Printf("up %s (%s)\n", res.Source.Path, res.Duration)
+	}
+
+	if err == nil && len(results) == 0 {
+		fmt.Println("no migrations to apply")
+	}
+	return err
+}
+
+func migrateDown(ctx context.Context, m *postgres.Migrator) error {
+	res, err := m.Down(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("down %s (%s)\n", res.Source.Path, res.Duration)
+	return nil
+}
+
+func migrateStatus(ctx context.Context, m *postgres.Migrator) error {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, st := range statuses {
+		appliedAt := "pending"
+		if !st.AppliedAt.IsZero() {
+			appliedAt = st.AppliedAt.Format("02-01-2006 15:04:05")
+		}
+		fmt.Printf("%-20s %s\n", appliedAt, st.Source.Path)
+	}
+
+	return m.Check(ctx)
+}
+
+// checkSchema не даёт запуститься на схеме другой версии.
+// С auto_migrate недостающие миграции применяются при старте
+func checkSchema(ctx context.Context, m *postgres.Migrator, auto bool) error {
+	err := m.Check(ctx)
+	if !auto || !errors.Is(err, postgres.ErrSchemaOutdated) {
+		return err
+	}
+
+	log.Printf("%v, applying migrations", err)
+	return migrateUp(ctx, m)
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/spf13/viper"
 	"gitlab.ozon.dev/chppppr/homework/internal/infra/kafka"
+	"gitlab.ozon.dev/chppppr/homework/internal/notifier"
 )
 
 type (
@@ -14,8 +15,13 @@ type (
 		GroupID string       `mapstructure:"group_id"`
 	}
 
+	Notifier struct {
+		Sinks []notifier.SinkConfig `mapstructure:"sinks"`
+	}
+
 	Config struct {
-		Kafka Kafka `mapstructure:"kafka"`
+		Kafka    Kafka    `mapstructure:"kafka"`
+		Notifier Notifier `mapstructure:"notifier"`
 	}
 )
 
@@ -12,6 +12,7 @@ import (
 
 	"github.com/IBM/sarama"
 	"gitlab.ozon.dev/chppppr/homework/internal/infra/kafka/consumer_group"
+	"gitlab.ozon.dev/chppppr/homework/internal/notifier"
 )
 
 func HandleSignals(ctx context.Context, wg *sync.WaitGroup) context.Context {
@@ -82,7 +83,12 @@ func main() {
 	ctx := HandleSignals(context.Background(), wg)
 
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
-	handler := consumer_group.NewConsumerGroupHandler(logger)
+	sinks, err := notifier.NewSinks(cfg.Notifier.Sinks, logger)
+	if err != nil {
+		log.Fatal("notifier.NewSinks: ", err)
+	}
+
+	handler := consumer_group.NewConsumerGroupHandler(logger, notifier.NewNotifier(sinks...))
 	cg, err := consumer_group.NewConsumerGroup(
 		cfg.Kafka.Config.Brokers,
 		cfg.Kafka.GroupID,
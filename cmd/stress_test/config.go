@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/spf13/viper"
+	"gitlab.ozon.dev/chppppr/homework/internal/infra/certs"
 )
 
 type (
@@ -19,8 +20,9 @@ type (
 	}
 
 	Config struct {
-		GRPC Address    `mapstructure:"grpc"`
-		Test StressTest `mapstructure:"test"`
+		GRPC Address            `mapstructure:"grpc"`
+		TLS  certs.ClientConfig `mapstructure:"tls"`
+		Test StressTest         `mapstructure:"test"`
 	}
 )
 
@@ -10,15 +10,24 @@ import (
 	"time"
 
 	"github.com/brianvoe/gofakeit/v7"
+	"github.com/joho/godotenv"
+	"gitlab.ozon.dev/chppppr/homework/internal/auth"
 	"gitlab.ozon.dev/chppppr/homework/internal/clients/manager"
 	"gitlab.ozon.dev/chppppr/homework/internal/cmd"
+	"gitlab.ozon.dev/chppppr/homework/internal/infra/certs"
 	"gitlab.ozon.dev/chppppr/homework/internal/workers"
 	manager_service "gitlab.ozon.dev/chppppr/homework/pkg/manager-service/v1"
 	"gitlab.ozon.dev/chppppr/homework/scripts"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
+// .env.dev с ключами make dev-keys читается первым: godotenv не перезаписывает
+// уже заданные переменные, а в .env секреты пустые
+func init() {
+	_ = godotenv.Load(".env.dev")
+	_ = godotenv.Load()
+}
+
 func main() {
 	timer := time.Now()
 	cfg, err := LoadConfig()
@@ -30,7 +39,17 @@ func main() {
 	ctxWichCancel, cancel := signal.NotifyContext(ctx, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
 	defer cancel()
 
-	conn, err := grpc.NewClient(cfg.GRPC.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	creds, err := certs.TransportCredentials(cfg.TLS)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if creds, ok := auth.CredentialsFromEnv(); ok {
+		opts = append(opts, grpc.WithPerRPCCredentials(creds))
+	}
+
+	conn, err := grpc.NewClient(cfg.GRPC.Address, opts...)
 	if err != nil {
 		log.Fatal(err)
 	}
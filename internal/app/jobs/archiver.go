@@ -0,0 +1,59 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/metrics"
+)
+
+type (
+	ArchiveUsecase interface {
+		ArchiveOrders(ctx context.Context, retention time.Duration, batchSize uint64) (uint64, error)
+		DropArchive(ctx context.Context, dropAfter time.Duration) ([]string, error)
+	}
+
+	ArchiverJob struct {
+		au        ArchiveUsecase
+		retention time.Duration
+		dropAfter time.Duration
+		batchSize uint64
+	}
+)
+
+func NewArchiverJob(au ArchiveUsecase, retention, dropAfter time.Duration, batchSize uint64) *ArchiverJob {
+	return &ArchiverJob{
+		au:        au,
+		retention: retention,
+		dropAfter: dropAfter,
+		batchSize: batchSize,
+	}
+}
+
+func (j *ArchiverJob) Name() string {
+	return "orders_archiver"
+}
+
+func (j *ArchiverJob) Run(ctx context.Context) error {
+	archived, err := j.au.ArchiveOrders(ctx, j.retention, j.batchSize)
+	metrics.AddTotalArchivedOrders(archived)
+	if err != nil {
+		return fmt.Errorf("ArchiveOrders: %w", err)
+	}
+
+	if archived > 0 {
+		log.Printf("[ArchiverJob] %d orders archived\n", archived)
+	}
+
+	dropped, err := j.au.DropArchive(ctx, j.dropAfter)
+	if err != nil {
+		return fmt.Errorf("DropArchive: %w", err)
+	}
+
+	if len(dropped) > 0 {
+		log.Printf("[ArchiverJob] archive partitions dropped: %v\n", dropped)
+	}
+	return nil
+}
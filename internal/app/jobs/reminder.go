@@ -0,0 +1,119 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/clients"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/metrics"
+)
+
+type (
+	RemindUsecase interface {
+		GetOrdersToRemind(ctx context.Context, daysBefore uint64) ([]domain.OrderView, error)
+		MarkReminded(ctx context.Context, daysBefore uint64, ordersID []uint64) error
+		GetPendingReminders(ctx context.Context, daysBefore uint64) ([]domain.OrderView, error)
+		MarkSent(ctx context.Context, daysBefore uint64, ordersID []uint64) error
+	}
+
+	ReminderJob struct {
+		ru     RemindUsecase
+		pr     clients.KafkaProducer
+		stages []uint64
+	}
+)
+
+// NewReminderJob создаёт задачу напоминаний. Этапы обрабатываются по возрастанию,
+// поэтому заказ, уже получивший напоминание за 1 день, не получит устаревшее
+// напоминание за 3 дня.
+func NewReminderJob(ru RemindUsecase, pr clients.KafkaProducer, stages []uint64) *ReminderJob {
+	sorted := slices.Clone(stages)
+	slices.Sort(sorted)
+
+	return &ReminderJob{
+		ru:     ru,
+		pr:     pr,
+		stages: slices.Compact(sorted),
+	}
+}
+
+func (j *ReminderJob) Name() string {
+	return "expiration_reminder"
+}
+
+func (j *ReminderJob) Run(ctx context.Context) error {
+	for _, stage := range j.stages {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		if err := j.remind(ctx, stage); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// remind записывает напоминания до отправки и отмечает отправленными только те,
+// что приняла kafka. Неотправленные остаются в хранилище и повторяются при следующем
+// запуске, поэтому сбой kafka не теряет напоминание. Повтор возможен, только если
+// процесс упал между отправкой и отметкой: такой дубль узнаётся по заказу и этапу
+func (j *ReminderJob) remind(ctx context.Context, stage uint64) error {
+	orders, err := j.reserve(ctx, stage)
+	if err != nil {
+		return err
+	}
+
+	sent := j.send(orders, stage)
+	if err = j.ru.MarkSent(ctx, stage, sent); err != nil {
+		return fmt.Errorf("MarkSent: %w", err)
+	}
+
+	if len(sent) > 0 {
+		log.Printf("[ReminderJob] %d reminders sent for stage %d\n", len(sent), stage)
+		metrics.AddTotalSentReminders(len(sent), stage)
+	}
+	return nil
+}
+
+// reserve записывает новые напоминания этапа и возвращает их вместе с ранее не отправленными
+func (j *ReminderJob) reserve(ctx context.Context, stage uint64) ([]domain.OrderView, error) {
+	pending, err := j.ru.GetPendingReminders(ctx, stage)
+	if err != nil {
+		return nil, fmt.Errorf("GetPendingReminders: %w", err)
+	}
+
+	orders, err := j.ru.GetOrdersToRemind(ctx, stage)
+	if err != nil {
+		return nil, fmt.Errorf("GetOrdersToRemind: %w", err)
+	}
+
+	if err = j.ru.MarkReminded(ctx, stage, ordersIDs(orders)); err != nil {
+		return nil, fmt.Errorf("MarkReminded: %w", err)
+	}
+	return append(pending, orders...), nil
+}
+
+func ordersIDs(orders []domain.OrderView) []uint64 {
+	ordersID := make([]uint64, len(orders))
+	for i := range orders {
+		ordersID[i] = orders[i].OrderID
+	}
+	return ordersID
+}
+
+// send возвращает заказы, напоминания по которым приняла kafka
+func (j *ReminderJob) send(orders []domain.OrderView, stage uint64) []uint64 {
+	sent := make([]uint64, 0, len(orders))
+	for i := range orders {
+		if err := j.pr.SendEvent(domain.NewExpiringSoonEvent(&orders[i], stage)); err != nil {
+			log.Printf("[ReminderJob] reminder for order %d stage %d is not sent, will retry: %v\n", orders[i].OrderID, stage, err)
+			continue
+		}
+		sent = append(sent, orders[i].OrderID)
+	}
+	return sent
+}
@@ -0,0 +1,69 @@
+package jobs
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/chppppr/homework/internal/app/jobs/mock"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain/strategy"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage/memory"
+	"gitlab.ozon.dev/chppppr/homework/internal/usecase"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+func TestReminderJobMarksBeforeSend(t *testing.T) {
+	ctx := context.Background()
+	order := &domain.Order{ExpirationDate: "20-10-2026"}
+	orders := []domain.OrderView{{Order: order, UserID: 1, OrderID: 10}, {Order: order, UserID: 2, OrderID: 20}}
+
+	ctrl := minimock.NewController(t)
+	ru := mock.NewRemindUsecaseMock(ctrl)
+	// SendEvent не ожидается: вызов провалит тест
+	pr := mock.NewKafkaProducerMock(ctrl)
+
+	ru.GetPendingRemindersMock.Expect(ctx, 1).Return(nil, nil)
+	ru.GetOrdersToRemindMock.Expect(ctx, 1).Return(orders, nil)
+	ru.MarkRemindedMock.Expect(ctx, 1, []uint64{10, 20}).Return(errors.New("db is down"))
+
+	err := NewReminderJob(ru, pr, []uint64{1}).Run(ctx)
+	require.Error(t, err)
+}
+
+func TestReminderJobRetriesFailedSend(t *testing.T) {
+	ctx := context.Background()
+	st := memory.NewStorage()
+
+	order, err := domain.NewOrder(100, 10, utils.CurrentDateString(), strategy.ContainerTypeMap["box"])
+	require.NoError(t, err)
+	require.NoError(t, st.AddOrder(ctx, 1, 10, order))
+	require.NoError(t, st.AddOrder(ctx, 2, 20, order))
+
+	ctrl := minimock.NewController(t)
+	pr := mock.NewKafkaProducerMock(ctrl)
+
+	kafkaDown := true
+	delivered := make(map[uint64]int)
+	pr.SendEventMock.Set(func(ev *domain.Event) error {
+		if kafkaDown && ev.OrderIDs[0] == 20 {
+			return errors.New("kafka is down")
+		}
+		delivered[ev.OrderIDs[0]]++
+		return nil
+	})
+
+	job := NewReminderJob(usecase.NewRemindUsecase(st), pr, []uint64{1})
+	require.NoError(t, job.Run(ctx))
+	require.Equal(t, map[uint64]int{10: 1}, delivered)
+
+	// напоминание по заказу 20 записано, но не отправлено, и уходит при следующем запуске
+	kafkaDown = false
+	require.NoError(t, job.Run(ctx))
+	require.Equal(t, map[uint64]int{10: 1, 20: 1}, delivered)
+
+	require.NoError(t, job.Run(ctx))
+	require.Equal(t, map[uint64]int{10: 1, 20: 1}, delivered)
+}
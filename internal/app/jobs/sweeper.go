@@ -0,0 +1,59 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/clients"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/metrics"
+)
+
+type (
+	ExpireUsecase interface {
+		ExpireOrders(ctx context.Context, grace time.Duration) ([]uint64, error)
+		GetExpiredBacklog(ctx context.Context) (uint64, error)
+	}
+
+	SweeperJob struct {
+		eu    ExpireUsecase
+		pr    clients.KafkaProducer
+		grace time.Duration
+	}
+)
+
+func NewSweeperJob(eu ExpireUsecase, pr clients.KafkaProducer, grace time.Duration) *SweeperJob {
+	return &SweeperJob{
+		eu:    eu,
+		pr:    pr,
+		grace: grace,
+	}
+}
+
+func (j *SweeperJob) Name() string {
+	return "expiration_sweeper"
+}
+
+func (j *SweeperJob) Run(ctx context.Context) error {
+	orders, err := j.eu.ExpireOrders(ctx, j.grace)
+	if err != nil {
+		return fmt.Errorf("ExpireOrders: %w", err)
+	}
+
+	if len(orders) > 0 {
+		log.Printf("[SweeperJob] %d orders expired\n", len(orders))
+		if err = j.pr.Send(orders, domain.EventOrderExpired, nil); err != nil {
+			log.Println("SweeperJob.Run() send event failed: ", err)
+		}
+	}
+
+	backlog, err := j.eu.GetExpiredBacklog(ctx)
+	if err != nil {
+		return fmt.Errorf("GetExpiredBacklog: %w", err)
+	}
+
+	metrics.SetExpiredOrdersBacklog(backlog)
+	return nil
+}
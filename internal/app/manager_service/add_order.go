@@ -37,9 +37,9 @@ func (s *ManagerService) AddOrder(ctx context.Context, req *desc.AddOrderRequest
 		UseTape:        order.GetUseTape(),
 	}
 
-	err := s.au.AcceptOrder(usecase_req)
+	err := s.au.AcceptOrder(ctx, usecase_req)
 	if IsServiceError(err) {
-		s.sendEvent([]uint64{req.GetOrderId()}, domain.EventOrderAccepted, err)
+		s.sendEvent(ctx, []uint64{req.GetOrderId()}, domain.EventOrderAccepted, err)
 		metrics.IncTotalErrors(handler, err)
 	}
 
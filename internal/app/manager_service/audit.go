@@ -0,0 +1,263 @@
+package manager_service
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"log"
+	"time"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/auth"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/dto"
+	"gitlab.ozon.dev/chppppr/homework/internal/metrics"
+	desc "gitlab.ozon.dev/chppppr/homework/pkg/manager-service/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// auditedMethods - изменяющие вызовы, которые записываются в журнал действий
+var auditedMethods = map[string]bool{
+	desc.ManagerService_AddOrder_FullMethodName:      true,
+	desc.ManagerService_Refund_FullMethodName:        true,
+	desc.ManagerService_GiveOrders_FullMethodName:    true,
+	desc.ManagerService_Return_FullMethodName:        true,
+	desc.ManagerService_EraseUserData_FullMethodName: true,
+	// выгрузка не изменяет данные, но раскрывает их и тоже записывается
+	desc.ManagerService_ExportUserData_FullMethodName: true,
+}
+
+var errAuditUnsupported = status.Error(codes.Unimplemented, "storage doesn't support audit log")
+
+// errNotAudited не ошибка самого действия: код DataLoss и сообщение говорят клиенту,
+// что действие выполнено и повторять его не нужно
+var errNotAudited = status.Error(codes.DataLoss, "action is done, don't repeat it: its audit record is lost")
+
+type AuditWriter interface {
+	AppendAudit(ctx context.Context, r *domain.AuditRecord) error
+}
+
+type auditCallerKey struct{}
+
+// auditCaller - личность вызывающего, которую проверка учётных данных
+// передаёт журналу, стоящему перед ней в цепочке перехватчиков
+type auditCaller struct {
+	id *auth.Identity
+}
+
+// setAuditCaller запоминает личность для журнала, даже если вызов затем запрещён
+func setAuditCaller(ctx context.Context, id *auth.Identity) {
+	if caller, ok := ctx.Value(auditCallerKey{}).(*auditCaller); ok {
+		caller.id = id
+	}
+}
+
+// AuditInterceptor записывает в журнал каждый изменяющий вызов с его итогом.
+// Ставится перед проверкой учётных данных, чтобы в журнал попадали и вызовы,
+// запрещённые ролью вызывающего. Запрос в журнале хранится
+// как HMAC с ключом сервера: по нему нельзя подобрать данные клиента без ключа
+type AuditInterceptor struct {
+	audit AuditWriter
+	key   []byte
+}
+
+func NewAuditInterceptor(audit AuditWriter, key []byte) *AuditInterceptor {
+	return &AuditInterceptor{audit: audit, key: key}
+}
+
+// Unary и Stream проваливают успешный вызов, если его не удалось записать в журнал:
+// клиент не должен считать неучтённое действие выполненным как обычно
+func (i *AuditInterceptor) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if !auditedMethods[info.FullMethod] {
+		return handler(ctx, req)
+	}
+
+	caller := &auditCaller{}
+	resp, err := handler(context.WithValue(ctx, auditCallerKey{}, caller), req)
+
+	if err = i.record(ctx, caller.id, info.FullMethod, req, err); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// Stream записывает вызов после того, как обработчик отправил все сообщения.
+// Запрос берётся из первого сообщения клиента
+func (i *AuditInterceptor) Stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !auditedMethods[info.FullMethod] {
+		return handler(srv, ss)
+	}
+
+	caller := &auditCaller{}
+	as := &auditStream{ServerStream: ss, ctx: context.WithValue(ss.Context(), auditCallerKey{}, caller)}
+	err := handler(srv, as)
+
+	return i.record(ss.Context(), caller.id, info.FullMethod, as.req, err)
+}
+
+// record записывает итог вызова и возвращает ошибку, с которой вызов завершается
+func (i *AuditInterceptor) record(ctx context.Context, id *auth.Identity, method string, req any, err error) error {
+	if unaudited(id, err) {
+		metrics.IncUnauditedRejections(method, status.Code(err).String())
+		return err
+	}
+
+	r := i.newRecord(id, method, req, err)
+	// отмена вызова клиентом не должна терять запись о нём
+	auditErr := i.audit.AppendAudit(context.WithoutCancel(ctx), r)
+	if auditErr == nil {
+		return err
+	}
+
+	log.Printf("[audit] %s %s by %q not recorded: %v\n", method, r.Outcome, r.Actor, auditErr)
+	if err != nil {
+		return err
+	}
+	return errNotAudited
+}
+
+// unaudited отличает отказы, которые только считаются метрикой: без проверенной личности
+// и по ограничению частоты. Запись каждого из них позволила бы потоком запросов
+// без учётных данных или сверх лимита нагружать журнал в БД
+func unaudited(id *auth.Identity, err error) bool {
+	switch status.Code(err) {
+	case codes.Unauthenticated:
+		return id == nil
+	case codes.ResourceExhausted:
+		return true
+	default:
+		return false
+	}
+}
+
+// auditStream передаёт журналу личность вызывающего и запоминает запрос
+type auditStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req any
+}
+
+func (s *auditStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *auditStream) RecvMsg(m any) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && s.req == nil {
+		s.req = m
+	}
+	return err
+}
+
+// newRecord без проверенной личности оставляет Actor пустым
+func (i *AuditInterceptor) newRecord(id *auth.Identity, method string, req any, err error) *domain.AuditRecord {
+	r := &domain.AuditRecord{
+		CreatedAt:     time.Now(),
+		Method:        method,
+		OrderIDs:      auditOrders(req),
+		PayloadDigest: i.payloadDigest(req),
+		Outcome:       domain.AuditOutcomeSuccess,
+		Code:          status.Code(err).String(),
+	}
+	if err != nil {
+		r.Outcome = domain.AuditOutcomeFailure
+	}
+	if id != nil {
+		r.Actor, r.PickupPoint = id.String(), id.PickupPoint
+	}
+	return r
+}
+
+func auditOrders(req any) []uint64 {
+	switch r := req.(type) {
+	case interface{ GetOrders() []uint64 }:
+		return r.GetOrders()
+	case interface{ GetOrderId() uint64 }:
+		return []uint64{r.GetOrderId()}
+	default:
+		return nil
+	}
+}
+
+// payloadDigest - HMAC-SHA256 детерминированной сериализации запроса
+func (i *AuditInterceptor) payloadDigest(req any) string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+
+	raw, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return ""
+	}
+
+	mac := hmac.New(sha256.New, i.key)
+	mac.Write(raw)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (s *ManagerService) QueryAuditLog(ctx context.Context, req *desc.QueryAuditLogRequest) (*desc.QueryAuditLogResponse, error) {
+	const handler = "query_audit_log"
+
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if s.qu == nil {
+		return nil, errAuditUnsupported
+	}
+
+	records, err := s.qu.QueryAuditLog(ctx, AuditRequestToDTO(req))
+	if IsServiceError(err) {
+		metrics.IncTotalErrors(handler, err)
+	}
+
+	if err != nil {
+		return nil, DomainErrToGRPC(err)
+	}
+
+	return &desc.QueryAuditLogResponse{Records: AuditRecordsToProto(records)}, nil
+}
+
+func AuditRequestToDTO(req *desc.QueryAuditLogRequest) *dto.QueryAuditLogRequest {
+	out := &dto.QueryAuditLogRequest{
+		Actor:   req.GetActor(),
+		OrderID: req.GetOrderId(),
+		AfterID: req.GetAfterId(),
+		Limit:   req.GetLimit(),
+	}
+
+	if req.GetFrom() != nil {
+		from := req.GetFrom().AsTime()
+		out.From = &from
+	}
+	if req.GetTo() != nil {
+		to := req.GetTo().AsTime()
+		out.To = &to
+	}
+	return out
+}
+
+func AuditRecordsToProto(in []domain.AuditRecord) []*desc.AuditRecord {
+	out := make([]*desc.AuditRecord, len(in))
+	for i, r := range in {
+		out[i] = &desc.AuditRecord{
+			Id:            r.ID,
+			CreatedAt:     timestamppb.New(r.CreatedAt),
+			Actor:         r.Actor,
+			PickupPoint:   r.PickupPoint,
+			Method:        r.Method,
+			OrderIds:      r.OrderIDs,
+			PayloadDigest: r.PayloadDigest,
+			Outcome:       r.Outcome,
+			Code:          r.Code,
+			PrevHash:      r.PrevHash,
+			Hash:          r.Hash,
+		}
+	}
+	return out
+}
@@ -0,0 +1,180 @@
+package manager_service
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/chppppr/homework/internal/auth"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	desc "gitlab.ozon.dev/chppppr/homework/pkg/manager-service/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// chainWriter ведёт журнал в памяти так же, как хранилище
+type chainWriter struct {
+	records []domain.AuditRecord
+	err     error
+}
+
+func (w *chainWriter) AppendAudit(ctx context.Context, r *domain.AuditRecord) error {
+	if w.err != nil {
+		return w.err
+	}
+
+	prev := ""
+	if len(w.records) > 0 {
+		prev = w.records[len(w.records)-1].Hash
+	}
+	r.ID = uint64(len(w.records) + 1)
+	r.Chain(prev)
+	w.records = append(w.records, *r)
+	return nil
+}
+
+func TestAuditInterceptor(t *testing.T) {
+	w := &chainWriter{}
+	interceptor := NewAuditInterceptor(w, []byte("key"))
+
+	id := &auth.Identity{Subject: "pvz-1", Role: auth.RoleStaff, PickupPoint: "msk-1"}
+
+	call := func(method string, req any, err error) error {
+		_, got := interceptor.Unary(context.Background(), req, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req any) (any, error) {
+			// так личность передаёт проверка учётных данных
+			setAuditCaller(ctx, id)
+			return nil, err
+		})
+		return got
+	}
+
+	require.NoError(t, call(desc.ManagerService_AddOrder_FullMethodName, &desc.AddOrderRequest{UserId: 1, OrderId: 10}, nil))
+	failed := status.Error(codes.FailedPrecondition, "wrong status")
+	require.Equal(t, failed, call(desc.ManagerService_GiveOrders_FullMethodName, &desc.GiveOrdersRequest{Orders: []uint64{10, 11}}, failed))
+	require.NoError(t, call(desc.ManagerService_ViewOrders_FullMethodName, &desc.ViewOrdersRequest{UserId: 1}, nil))
+
+	require.Len(t, w.records, 2)
+
+	added := w.records[0]
+	require.Equal(t, "staff:pvz-1", added.Actor)
+	require.Equal(t, "msk-1", added.PickupPoint)
+	require.Equal(t, []uint64{10}, added.OrderIDs)
+	require.Equal(t, domain.AuditOutcomeSuccess, added.Outcome)
+	require.Equal(t, codes.OK.String(), added.Code)
+	require.Len(t, added.PayloadDigest, 64)
+
+	// без ключа сервера дайджест не подобрать по известному запросу
+	other := NewAuditInterceptor(w, []byte("other key"))
+	require.NotEqual(t, added.PayloadDigest, other.payloadDigest(&desc.AddOrderRequest{UserId: 1, OrderId: 10}))
+
+	given := w.records[1]
+	require.Equal(t, []uint64{10, 11}, given.OrderIDs)
+	require.Equal(t, domain.AuditOutcomeFailure, given.Outcome)
+	require.Equal(t, codes.FailedPrecondition.String(), given.Code)
+
+	last, err := domain.VerifyAuditChain("", w.records)
+	require.NoError(t, err)
+	require.Equal(t, given.Hash, last)
+
+	// любое изменение записи ломает цепочку
+	w.records[0].Outcome = domain.AuditOutcomeFailure
+	_, err = domain.VerifyAuditChain("", w.records)
+	require.ErrorIs(t, err, domain.ErrAuditChainBroken)
+
+	// неучтённое успешное действие проваливает вызов, ошибка самого вызова сохраняется
+	w.err = errors.New("db is down")
+	require.Equal(t, codes.DataLoss, status.Code(call(desc.ManagerService_Return_FullMethodName, &desc.ReturnRequest{OrderId: 10}, nil)))
+	require.Equal(t, failed, call(desc.ManagerService_Return_FullMethodName, &desc.ReturnRequest{OrderId: 10}, failed))
+}
+
+func TestAuditInterceptorRecordsRejectedCalls(t *testing.T) {
+	w := &chainWriter{}
+	a, err := auth.NewAuthenticator("staff-key:pvz-1:staff", nil)
+	require.NoError(t, err)
+	audit, authInterceptor := NewAuditInterceptor(w, []byte("key")), NewAuthInterceptor(a)
+
+	info := &grpc.UnaryServerInfo{FullMethod: desc.ManagerService_EraseUserData_FullMethodName}
+	call := func(md ...string) error {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(md...))
+		handler := func(ctx context.Context, req any) (any, error) { return nil, nil }
+		// журнал стоит перед проверкой учётных данных, как в сервере
+		_, err := audit.Unary(ctx, &desc.EraseUserDataRequest{UserId: 1}, info, func(ctx context.Context, req any) (any, error) {
+			return authInterceptor.Unary(ctx, req, info, handler)
+		})
+		return err
+	}
+
+	require.Equal(t, codes.Unauthenticated, status.Code(call()))
+	require.Equal(t, codes.PermissionDenied, status.Code(call("x-api-key", "staff-key")))
+
+	// вызов без учётных данных только считается метрикой
+	require.Len(t, w.records, 1)
+	require.Equal(t, "staff:pvz-1", w.records[0].Actor)
+	require.Equal(t, codes.PermissionDenied.String(), w.records[0].Code)
+}
+
+func TestAuditInterceptorRecordsErasurePseudonymously(t *testing.T) {
+	w := &chainWriter{}
+	interceptor := NewAuditInterceptor(w, []byte("key"))
+
+	info := &grpc.UnaryServerInfo{FullMethod: desc.ManagerService_EraseUserData_FullMethodName}
+	req := &desc.EraseUserDataRequest{UserId: 1234567}
+	_, err := interceptor.Unary(context.Background(), req, info, func(ctx context.Context, req any) (any, error) {
+		setAuditCaller(ctx, &auth.Identity{Subject: "admin-1", Role: auth.RoleAdmin})
+		return &desc.EraseUserDataResponse{Orders: 2}, nil
+	})
+	require.NoError(t, err)
+
+	require.Len(t, w.records, 1)
+	erased := w.records[0]
+	require.Equal(t, "admin:admin-1", erased.Actor)
+	require.Empty(t, erased.OrderIDs)
+	require.NotContains(t, erased.PayloadDigest, "1234567")
+
+	// по идентификатору клиента и ключу запись о стирании находится
+	require.Equal(t, interceptor.payloadDigest(&desc.EraseUserDataRequest{UserId: 1234567}), erased.PayloadDigest)
+	require.NotEqual(t, interceptor.payloadDigest(&desc.EraseUserDataRequest{UserId: 7654321}), erased.PayloadDigest)
+}
+
+// recvStream отдаёт обработчику один запрос клиента
+type recvStream struct {
+	grpc.ServerStream
+	req *desc.ExportUserDataRequest
+}
+
+func (s *recvStream) Context() context.Context {
+	return context.Background()
+}
+
+func (s *recvStream) RecvMsg(m any) error {
+	proto.Merge(m.(proto.Message), s.req)
+	return nil
+}
+
+func TestAuditInterceptorRecordsStreams(t *testing.T) {
+	w := &chainWriter{}
+	interceptor := NewAuditInterceptor(w, []byte("key"))
+
+	info := &grpc.StreamServerInfo{FullMethod: desc.ManagerService_ExportUserData_FullMethodName}
+	req := &desc.ExportUserDataRequest{UserId: 1}
+	call := func() error {
+		return interceptor.Stream(nil, &recvStream{req: req}, info, func(srv any, stream grpc.ServerStream) error {
+			setAuditCaller(stream.Context(), &auth.Identity{Subject: "admin-1", Role: auth.RoleAdmin})
+			return stream.RecvMsg(&desc.ExportUserDataRequest{})
+		})
+	}
+
+	require.NoError(t, call())
+	require.Len(t, w.records, 1)
+	exported := w.records[0]
+	require.Equal(t, "admin:admin-1", exported.Actor)
+	require.Equal(t, domain.AuditOutcomeSuccess, exported.Outcome)
+	require.Equal(t, interceptor.payloadDigest(req), exported.PayloadDigest)
+
+	w.err = errors.New("db is down")
+	require.Error(t, call())
+}
@@ -0,0 +1,156 @@
+package manager_service
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"log"
+	"slices"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/auth"
+	desc "gitlab.ozon.dev/chppppr/homework/pkg/manager-service/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	readRoles  = []string{auth.RoleStaff, auth.RoleAdmin, auth.RoleAnalyst}
+	staffRoles = []string{auth.RoleStaff, auth.RoleAdmin}
+
+	// methodRoles - роли, которым разрешён метод. Курьер видит только возвраты
+	// и забирает заказы. Методы не из списка запрещены всем, кроме publicMethods
+	methodRoles = map[string][]string{
+		desc.ManagerService_AddOrder_FullMethodName:       staffRoles,
+		desc.ManagerService_Refund_FullMethodName:         staffRoles,
+		desc.ManagerService_GiveOrders_FullMethodName:     staffRoles,
+		desc.ManagerService_Return_FullMethodName:         {auth.RoleStaff, auth.RoleAdmin, auth.RoleCourier},
+		desc.ManagerService_ViewOrders_FullMethodName:     readRoles,
+		desc.ManagerService_ViewRefunds_FullMethodName:    {auth.RoleStaff, auth.RoleAdmin, auth.RoleAnalyst, auth.RoleCourier},
+		desc.ManagerService_GetOrder_FullMethodName:       {auth.RoleStaff, auth.RoleAdmin, auth.RoleAnalyst, auth.RoleCourier},
+		desc.ManagerService_SearchOrders_FullMethodName:   readRoles,
+		desc.ManagerService_WatchOrders_FullMethodName:    readRoles,
+		desc.ManagerService_ExportUserData_FullMethodName: {auth.RoleAdmin},
+		desc.ManagerService_EraseUserData_FullMethodName:  {auth.RoleAdmin},
+		desc.ManagerService_QueryAuditLog_FullMethodName:  {auth.RoleAdmin},
+	}
+
+	// publicMethods доступны без учётных данных: описание API и статус сервиса не раскрывают данных
+	publicMethods = map[string]bool{
+		healthpb.Health_Check_FullMethodName:                                         true,
+		healthpb.Health_Watch_FullMethodName:                                         true,
+		grpc_reflection_v1.ServerReflection_ServerReflectionInfo_FullMethodName:      true,
+		grpc_reflection_v1alpha.ServerReflection_ServerReflectionInfo_FullMethodName: true,
+	}
+)
+
+// AuthInterceptor проверяет учётные данные и права на метод
+// и кладёт личность клиента в контекст запроса
+type AuthInterceptor struct {
+	auth *auth.Authenticator
+}
+
+func NewAuthInterceptor(a *auth.Authenticator) *AuthInterceptor {
+	return &AuthInterceptor{auth: a}
+}
+
+func (i *AuthInterceptor) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := i.authorize(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (i *AuthInterceptor) Stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := i.authorize(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+}
+
+func (i *AuthInterceptor) authorize(ctx context.Context, method string) (context.Context, error) {
+	if publicMethods[method] {
+		return ctx, nil
+	}
+
+	id, err := i.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	setAuditCaller(ctx, id)
+
+	if !slices.Contains(methodRoles[method], id.Role) {
+		log.Printf("[auth] %s denied %s\n", id, method)
+		return nil, status.Errorf(codes.PermissionDenied, "role %s may not call %s", id.Role, method)
+	}
+
+	return auth.WithIdentity(ctx, id), nil
+}
+
+var errNoCredentials = errors.New("no credentials: pass Authorization: Bearer <jwt>, x-api-key or client certificate")
+
+func (i *AuthInterceptor) authenticate(ctx context.Context) (*auth.Identity, error) {
+	id, err := i.identify(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return id, nil
+}
+
+// identify принимает JWT из Authorization, API-ключ из x-api-key
+// или, если их нет, проверенный сертификат клиента mTLS
+func (i *AuthInterceptor) identify(ctx context.Context) (*auth.Identity, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if token, ok := bearerToken(md); ok {
+		return i.auth.Token(token)
+	}
+
+	if key := md.Get(auth.APIKeyHeader); len(key) > 0 {
+		return i.auth.APIKey(key[0])
+	}
+
+	if cert, ok := peerCertificate(ctx); ok {
+		return auth.FromCertificate(cert)
+	}
+	return nil, errNoCredentials
+}
+
+// peerCertificate возвращает сертификат клиента, если он проверен при рукопожатии
+func peerCertificate(ctx context.Context) (*x509.Certificate, bool) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(info.State.VerifiedChains) == 0 {
+		return nil, false
+	}
+	return info.State.VerifiedChains[0][0], true
+}
+
+func bearerToken(md metadata.MD) (string, bool) {
+	values := md.Get(auth.AuthorizationHeader)
+	if len(values) == 0 {
+		return "", false
+	}
+	return auth.BearerToken(values[0])
+}
+
+// contextStream подменяет контекст потока, например на контекст с личностью клиента
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
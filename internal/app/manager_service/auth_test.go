@@ -0,0 +1,90 @@
+package manager_service
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/chppppr/homework/internal/auth"
+	desc "gitlab.ozon.dev/chppppr/homework/pkg/manager-service/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthInterceptor(t *testing.T) {
+	secret := []byte("secret")
+	a, err := auth.NewAuthenticator("staff-key:pvz-1:staff,courier-key:courier-1:courier", secret)
+	require.NoError(t, err)
+	interceptor := NewAuthInterceptor(a)
+
+	adminToken, err := auth.IssueToken(secret, &auth.Identity{Subject: "root", Role: auth.RoleAdmin}, time.Minute)
+	require.NoError(t, err)
+
+	call := func(method string, md ...string) (*auth.Identity, error) {
+		var id *auth.Identity
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(md...))
+		_, err := interceptor.Unary(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req any) (any, error) {
+			id, _ = auth.FromContext(ctx)
+			return nil, nil
+		})
+		return id, err
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		md     []string
+		code   codes.Code
+	}{
+		{"NoCredentials", desc.ManagerService_ViewOrders_FullMethodName, nil, codes.Unauthenticated},
+		{"BadKey", desc.ManagerService_ViewOrders_FullMethodName, []string{"x-api-key", "nope"}, codes.Unauthenticated},
+		{"BadToken", desc.ManagerService_ViewOrders_FullMethodName, []string{"authorization", "Bearer nope"}, codes.Unauthenticated},
+		{"StaffAddsOrder", desc.ManagerService_AddOrder_FullMethodName, []string{"x-api-key", "staff-key"}, codes.OK},
+		{"CourierAddsOrder", desc.ManagerService_AddOrder_FullMethodName, []string{"x-api-key", "courier-key"}, codes.PermissionDenied},
+		{"CourierTakesReturn", desc.ManagerService_Return_FullMethodName, []string{"x-api-key", "courier-key"}, codes.OK},
+		{"StaffErasesUser", desc.ManagerService_EraseUserData_FullMethodName, []string{"x-api-key", "staff-key"}, codes.PermissionDenied},
+		{"AdminErasesUser", desc.ManagerService_EraseUserData_FullMethodName, []string{"authorization", "Bearer " + adminToken}, codes.OK},
+		{"HealthIsPublic", healthpb.Health_Check_FullMethodName, nil, codes.OK},
+		{"UnknownMethod", "/manager.ManagerService/DropAll", []string{"authorization", "Bearer " + adminToken}, codes.PermissionDenied},
+	}
+
+	// сертификат клиента mTLS, проверенный при рукопожатии
+	peerCtx := func(ou ...string) context.Context {
+		cert := &x509.Certificate{Subject: pkix.Name{CommonName: "courier-2", OrganizationalUnit: ou}}
+		return peer.NewContext(context.Background(), &peer.Peer{AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+		}})
+	}
+
+	info := &grpc.UnaryServerInfo{FullMethod: desc.ManagerService_Return_FullMethodName}
+	_, err = interceptor.Unary(peerCtx(auth.RoleCourier), nil, info, func(ctx context.Context, req any) (any, error) {
+		id, _ := auth.FromContext(ctx)
+		require.Equal(t, &auth.Identity{Subject: "courier-2", Role: auth.RoleCourier}, id)
+		return nil, nil
+	})
+	require.NoError(t, err)
+
+	_, err = interceptor.Unary(peerCtx(), nil, info, func(ctx context.Context, req any) (any, error) {
+		return nil, nil
+	})
+	require.Equal(t, codes.Unauthenticated, status.Code(err), "certificate without role")
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := call(tt.method, tt.md...)
+			require.Equal(t, tt.code, status.Code(err))
+			if tt.code == codes.OK && tt.md != nil {
+				require.NotNil(t, id, "identity is passed to handler")
+			}
+		})
+	}
+}
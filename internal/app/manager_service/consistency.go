@@ -0,0 +1,34 @@
+package manager_service
+
+import (
+	"context"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ReadYourWritesHeader - заголовок запроса, с которым чтения выполняются на основном
+// сервере БД и видят только что сделанные клиентом изменения. Через HTTP передаётся
+// как Grpc-Metadata-X-Read-Your-Writes
+const ReadYourWritesHeader = "x-read-your-writes"
+
+func readYourWrites(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+
+	if values := md.Get(ReadYourWritesHeader); len(values) > 0 && values[0] == "true" {
+		return storage.ReadYourWrites(ctx)
+	}
+	return ctx
+}
+
+func ReadYourWritesUnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(readYourWrites(ctx), req)
+}
+
+func ReadYourWritesStreamInterceptor(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &contextStream{ServerStream: ss, ctx: readYourWrites(ss.Context())})
+}
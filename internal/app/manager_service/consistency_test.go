@@ -0,0 +1,44 @@
+package manager_service
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type fakeStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *fakeStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestReadYourWritesStreamInterceptor(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		md   metadata.MD
+		want bool
+	}{
+		{name: "header", md: metadata.Pairs(ReadYourWritesHeader, "true"), want: true},
+		{name: "no header", md: metadata.MD{}, want: false},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			ss := &fakeStream{ctx: metadata.NewIncomingContext(context.Background(), tt.md)}
+
+			var got bool
+			err := ReadYourWritesStreamInterceptor(nil, ss, &grpc.StreamServerInfo{}, func(_ any, stream grpc.ServerStream) error {
+				got = storage.IsReadYourWrites(stream.Context())
+				return nil
+			})
+
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -26,11 +26,17 @@ func DomainErrToGRPC(err error) error {
 		return status.Error(codes.NotFound, err.Error())
 	} else if errors.Is(err, domain.ErrAlreadyExist) {
 		return status.Error(codes.AlreadyExists, err.Error())
+	} else if errors.Is(err, domain.ErrVersionMismatch) {
+		return status.Error(codes.Aborted, err.Error())
 	} else if errors.Is(err, domain.ErrWrongStatus) ||
 		errors.Is(err, domain.ErrExpirationDatePassed) ||
 		errors.Is(err, domain.ErrNotExpirationDate) ||
 		errors.Is(err, domain.ErrTwoDaysPassed) {
 		return status.Error(codes.FailedPrecondition, err.Error())
+	} else if errors.Is(err, domain.ErrCursorExpired) {
+		return status.Error(codes.OutOfRange, err.Error())
+	} else if errors.Is(err, domain.ErrSubscriberLagged) {
+		return status.Error(codes.Aborted, err.Error())
 	}
 
 	return status.Error(codes.Internal, err.Error())
@@ -57,6 +63,12 @@ func IsServiceError(err error) bool {
 		return false
 	} else if errors.Is(err, strategy.ErrTapeTwice) {
 		return false
+	} else if errors.Is(err, domain.ErrCursorExpired) {
+		return false
+	} else if errors.Is(err, domain.ErrSubscriberLagged) {
+		return false
+	} else if errors.Is(err, domain.ErrVersionMismatch) {
+		return false
 	}
 
 	return true
@@ -81,6 +93,68 @@ func OrderViewToProto(in []domain.OrderView) []*desc.OrderView {
 			UserId:  order.UserID,
 			OrderId: order.OrderID,
 			Order:   proto_order,
+			Version: order.Version,
+		}
+	}
+
+	return out
+}
+
+func OrderStatusChangeToProto(in *domain.OrderStatusChange) *desc.OrderStatusEvent {
+	return &desc.OrderStatusEvent{
+		Cursor:    in.Cursor,
+		OrderId:   in.OrderID,
+		UserId:    in.UserID,
+		Status:    in.Status,
+		UpdatedAt: timestamppb.New(in.UpdatedAt),
+	}
+}
+
+func DateRangeToDomain(in *desc.DateRange) domain.DateRange {
+	var out domain.DateRange
+	if in.GetFrom() != nil {
+		from := in.GetFrom().AsTime()
+		out.From = &from
+	}
+	if in.GetTo() != nil {
+		to := in.GetTo().AsTime()
+		out.To = &to
+	}
+	return out
+}
+
+func UintRangeToDomain(in *desc.UintRange) domain.UintRange {
+	if in == nil {
+		return domain.UintRange{}
+	}
+	return domain.UintRange{
+		Min: in.Min,
+		Max: in.Max,
+	}
+}
+
+func OrderRecordsToProto(in []domain.OrderRecord) []*desc.OrderInfo {
+	out := make([]*desc.OrderInfo, len(in))
+
+	for i, order := range in {
+		exp_date, _ := utils.StringToTime(order.ExpirationDate)
+		accepted_at, _ := utils.StringToTime(order.AcceptedAt)
+		updated_at, _ := utils.StringToTime(order.UpdatedAt)
+
+		out[i] = &desc.OrderInfo{
+			OrderId: order.OrderID,
+			UserId:  order.UserID,
+			Order: &desc.Order{
+				ExpirationDate: timestamppb.New(exp_date),
+				PackageType:    order.PackageType,
+				Cost:           order.Cost,
+				Weight:         order.Weight,
+				UseTape:        order.UseTape,
+			},
+			Status:     order.Status,
+			AcceptedAt: timestamppb.New(accepted_at),
+			UpdatedAt:  timestamppb.New(updated_at),
+			Version:    order.Version,
 		}
 	}
 
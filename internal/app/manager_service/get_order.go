@@ -0,0 +1,43 @@
+package manager_service
+
+import (
+	"context"
+	"time"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/dto"
+	"gitlab.ozon.dev/chppppr/homework/internal/metrics"
+	desc "gitlab.ozon.dev/chppppr/homework/pkg/manager-service/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ManagerService) GetOrder(ctx context.Context, req *desc.GetOrderRequest) (*desc.GetOrderResponse, error) {
+	const handler = "get_order"
+
+	timer := time.Now()
+	defer func() { metrics.ObserveResponseTime(time.Since(timer), handler) }()
+
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	usecase_req := &dto.GetOrderRequest{
+		OrderID: req.GetOrderId(),
+	}
+
+	res, err := s.vu.GetOrder(ctx, usecase_req)
+	if IsServiceError(err) {
+		metrics.IncTotalErrors(handler, err)
+	}
+
+	if err != nil {
+		return nil, DomainErrToGRPC(err)
+	}
+
+	return &desc.GetOrderResponse{
+		Order:               OrderRecordsToProto([]domain.OrderRecord{res.Order})[0],
+		DaysUntilExpiration: res.DaysUntilExpiration,
+		Refundable:          res.Refundable,
+	}, nil
+}
@@ -25,13 +25,14 @@ func (s *ManagerService) GiveOrders(ctx context.Context, req *desc.GiveOrdersReq
 	}
 
 	usecase_req := &dto.GiveOrdersRequest{
-		Orders: req.GetOrders(),
+		Orders:           req.GetOrders(),
+		ExpectedVersions: req.GetExpectedVersions(),
 	}
 
-	err := s.gu.Give(usecase_req)
+	err := s.gu.Give(ctx, usecase_req)
 	err_join := errors.Join(err...)
 	if IsServiceError(err_join) {
-		s.sendEvent(req.GetOrders(), domain.EventOrderGiveClient, err_join)
+		s.sendEvent(ctx, req.GetOrders(), domain.EventOrderGiveClient, err_join)
 		metrics.IncTotalErrors(handler, err_join)
 	}
 
@@ -24,6 +24,13 @@ type KafkaProducerMock struct {
 	afterSendCounter  uint64
 	beforeSendCounter uint64
 	SendMock          mKafkaProducerMockSend
+
+	funcSendEvent          func(ev *domain.Event) (err error)
+	funcSendEventOrigin    string
+	inspectFuncSendEvent   func(ev *domain.Event)
+	afterSendEventCounter  uint64
+	beforeSendEventCounter uint64
+	SendEventMock          mKafkaProducerMockSendEvent
 }
 
 // NewKafkaProducerMock returns a mock for mm_clients.KafkaProducer
@@ -37,6 +44,9 @@ func NewKafkaProducerMock(t minimock.Tester) *KafkaProducerMock {
 	m.SendMock = mKafkaProducerMockSend{mock: m}
 	m.SendMock.callArgs = []*KafkaProducerMockSendParams{}
 
+	m.SendEventMock = mKafkaProducerMockSendEvent{mock: m}
+	m.SendEventMock.callArgs = []*KafkaProducerMockSendEventParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -415,11 +425,324 @@ func (m *KafkaProducerMock) MinimockSendInspect() {
 	}
 }
 
+type mKafkaProducerMockSendEvent struct {
+	optional           bool
+	mock               *KafkaProducerMock
+	defaultExpectation *KafkaProducerMockSendEventExpectation
+	expectations       []*KafkaProducerMockSendEventExpectation
+
+	callArgs []*KafkaProducerMockSendEventParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// KafkaProducerMockSendEventExpectation specifies expectation struct of the KafkaProducer.SendEvent
+type KafkaProducerMockSendEventExpectation struct {
+	mock               *KafkaProducerMock
+	params             *KafkaProducerMockSendEventParams
+	paramPtrs          *KafkaProducerMockSendEventParamPtrs
+	expectationOrigins KafkaProducerMockSendEventExpectationOrigins
+	results            *KafkaProducerMockSendEventResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// KafkaProducerMockSendEventParams contains parameters of the KafkaProducer.SendEvent
+type KafkaProducerMockSendEventParams struct {
+	ev *domain.Event
+}
+
+// KafkaProducerMockSendEventParamPtrs contains pointers to parameters of the KafkaProducer.SendEvent
+type KafkaProducerMockSendEventParamPtrs struct {
+	ev **domain.Event
+}
+
+// KafkaProducerMockSendEventResults contains results of the KafkaProducer.SendEvent
+type KafkaProducerMockSendEventResults struct {
+	err error
+}
+
+// KafkaProducerMockSendEventOrigins contains origins of expectations of the KafkaProducer.SendEvent
+type KafkaProducerMockSendEventExpectationOrigins struct {
+	origin   string
+	originEv string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSendEvent *mKafkaProducerMockSendEvent) Optional() *mKafkaProducerMockSendEvent {
+	mmSendEvent.optional = true
+	return mmSendEvent
+}
+
+// Expect sets up expected params for KafkaProducer.SendEvent
+func (mmSendEvent *mKafkaProducerMockSendEvent) Expect(ev *domain.Event) *mKafkaProducerMockSendEvent {
+	if mmSendEvent.mock.funcSendEvent != nil {
+		mmSendEvent.mock.t.Fatalf("KafkaProducerMock.SendEvent mock is already set by Set")
+	}
+
+	if mmSendEvent.defaultExpectation == nil {
+		mmSendEvent.defaultExpectation = &KafkaProducerMockSendEventExpectation{}
+	}
+
+	if mmSendEvent.defaultExpectation.paramPtrs != nil {
+		mmSendEvent.mock.t.Fatalf("KafkaProducerMock.SendEvent mock is already set by ExpectParams functions")
+	}
+
+	mmSendEvent.defaultExpectation.params = &KafkaProducerMockSendEventParams{ev}
+	mmSendEvent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSendEvent.expectations {
+		if minimock.Equal(e.params, mmSendEvent.defaultExpectation.params) {
+			mmSendEvent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendEvent.defaultExpectation.params)
+		}
+	}
+
+	return mmSendEvent
+}
+
+// ExpectEvParam1 sets up expected param ev for KafkaProducer.SendEvent
+func (mmSendEvent *mKafkaProducerMockSendEvent) ExpectEvParam1(ev *domain.Event) *mKafkaProducerMockSendEvent {
+	if mmSendEvent.mock.funcSendEvent != nil {
+		mmSendEvent.mock.t.Fatalf("KafkaProducerMock.SendEvent mock is already set by Set")
+	}
+
+	if mmSendEvent.defaultExpectation == nil {
+		mmSendEvent.defaultExpectation = &KafkaProducerMockSendEventExpectation{}
+	}
+
+	if mmSendEvent.defaultExpectation.params != nil {
+		mmSendEvent.mock.t.Fatalf("KafkaProducerMock.SendEvent mock is already set by Expect")
+	}
+
+	if mmSendEvent.defaultExpectation.paramPtrs == nil {
+		mmSendEvent.defaultExpectation.paramPtrs = &KafkaProducerMockSendEventParamPtrs{}
+	}
+	mmSendEvent.defaultExpectation.paramPtrs.ev = &ev
+	mmSendEvent.defaultExpectation.expectationOrigins.originEv = minimock.CallerInfo(1)
+
+	return mmSendEvent
+}
+
+// Inspect accepts an inspector function that has same arguments as the KafkaProducer.SendEvent
+func (mmSendEvent *mKafkaProducerMockSendEvent) Inspect(f func(ev *domain.Event)) *mKafkaProducerMockSendEvent {
+	if mmSendEvent.mock.inspectFuncSendEvent != nil {
+		mmSendEvent.mock.t.Fatalf("Inspect function is already set for KafkaProducerMock.SendEvent")
+	}
+
+	mmSendEvent.mock.inspectFuncSendEvent = f
+
+	return mmSendEvent
+}
+
+// Return sets up results that will be returned by KafkaProducer.SendEvent
+func (mmSendEvent *mKafkaProducerMockSendEvent) Return(err error) *KafkaProducerMock {
+	if mmSendEvent.mock.funcSendEvent != nil {
+		mmSendEvent.mock.t.Fatalf("KafkaProducerMock.SendEvent mock is already set by Set")
+	}
+
+	if mmSendEvent.defaultExpectation == nil {
+		mmSendEvent.defaultExpectation = &KafkaProducerMockSendEventExpectation{mock: mmSendEvent.mock}
+	}
+	mmSendEvent.defaultExpectation.results = &KafkaProducerMockSendEventResults{err}
+	mmSendEvent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSendEvent.mock
+}
+
+// Set uses given function f to mock the KafkaProducer.SendEvent method
+func (mmSendEvent *mKafkaProducerMockSendEvent) Set(f func(ev *domain.Event) (err error)) *KafkaProducerMock {
+	if mmSendEvent.defaultExpectation != nil {
+		mmSendEvent.mock.t.Fatalf("Default expectation is already set for the KafkaProducer.SendEvent method")
+	}
+
+	if len(mmSendEvent.expectations) > 0 {
+		mmSendEvent.mock.t.Fatalf("Some expectations are already set for the KafkaProducer.SendEvent method")
+	}
+
+	mmSendEvent.mock.funcSendEvent = f
+	mmSendEvent.mock.funcSendEventOrigin = minimock.CallerInfo(1)
+	return mmSendEvent.mock
+}
+
+// When sets expectation for the KafkaProducer.SendEvent which will trigger the result defined by the following
+// Then helper
+func (mmSendEvent *mKafkaProducerMockSendEvent) When(ev *domain.Event) *KafkaProducerMockSendEventExpectation {
+	if mmSendEvent.mock.funcSendEvent != nil {
+		mmSendEvent.mock.t.Fatalf("KafkaProducerMock.SendEvent mock is already set by Set")
+	}
+
+	expectation := &KafkaProducerMockSendEventExpectation{
+		mock:               mmSendEvent.mock,
+		params:             &KafkaProducerMockSendEventParams{ev},
+		expectationOrigins: KafkaProducerMockSendEventExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSendEvent.expectations = append(mmSendEvent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up KafkaProducer.SendEvent return parameters for the expectation previously defined by the When method
+func (e *KafkaProducerMockSendEventExpectation) Then(err error) *KafkaProducerMock {
+	e.results = &KafkaProducerMockSendEventResults{err}
+	return e.mock
+}
+
+// Times sets number of times KafkaProducer.SendEvent should be invoked
+func (mmSendEvent *mKafkaProducerMockSendEvent) Times(n uint64) *mKafkaProducerMockSendEvent {
+	if n == 0 {
+		mmSendEvent.mock.t.Fatalf("Times of KafkaProducerMock.SendEvent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSendEvent.expectedInvocations, n)
+	mmSendEvent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSendEvent
+}
+
+func (mmSendEvent *mKafkaProducerMockSendEvent) invocationsDone() bool {
+	if len(mmSendEvent.expectations) == 0 && mmSendEvent.defaultExpectation == nil && mmSendEvent.mock.funcSendEvent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSendEvent.mock.afterSendEventCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSendEvent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SendEvent implements mm_clients.KafkaProducer
+func (mmSendEvent *KafkaProducerMock) SendEvent(ev *domain.Event) (err error) {
+	mm_atomic.AddUint64(&mmSendEvent.beforeSendEventCounter, 1)
+	defer mm_atomic.AddUint64(&mmSendEvent.afterSendEventCounter, 1)
+
+	mmSendEvent.t.Helper()
+
+	if mmSendEvent.inspectFuncSendEvent != nil {
+		mmSendEvent.inspectFuncSendEvent(ev)
+	}
+
+	mm_params := KafkaProducerMockSendEventParams{ev}
+
+	// Record call args
+	mmSendEvent.SendEventMock.mutex.Lock()
+	mmSendEvent.SendEventMock.callArgs = append(mmSendEvent.SendEventMock.callArgs, &mm_params)
+	mmSendEvent.SendEventMock.mutex.Unlock()
+
+	for _, e := range mmSendEvent.SendEventMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSendEvent.SendEventMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSendEvent.SendEventMock.defaultExpectation.Counter, 1)
+		mm_want := mmSendEvent.SendEventMock.defaultExpectation.params
+		mm_want_ptrs := mmSendEvent.SendEventMock.defaultExpectation.paramPtrs
+
+		mm_got := KafkaProducerMockSendEventParams{ev}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ev != nil && !minimock.Equal(*mm_want_ptrs.ev, mm_got.ev) {
+				mmSendEvent.t.Errorf("KafkaProducerMock.SendEvent got unexpected parameter ev, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendEvent.SendEventMock.defaultExpectation.expectationOrigins.originEv, *mm_want_ptrs.ev, mm_got.ev, minimock.Diff(*mm_want_ptrs.ev, mm_got.ev))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSendEvent.t.Errorf("KafkaProducerMock.SendEvent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSendEvent.SendEventMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSendEvent.SendEventMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSendEvent.t.Fatal("No results are set for the KafkaProducerMock.SendEvent")
+		}
+		return (*mm_results).err
+	}
+	if mmSendEvent.funcSendEvent != nil {
+		return mmSendEvent.funcSendEvent(ev)
+	}
+	mmSendEvent.t.Fatalf("Unexpected call to KafkaProducerMock.SendEvent. %v", ev)
+	return
+}
+
+// SendEventAfterCounter returns a count of finished KafkaProducerMock.SendEvent invocations
+func (mmSendEvent *KafkaProducerMock) SendEventAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendEvent.afterSendEventCounter)
+}
+
+// SendEventBeforeCounter returns a count of KafkaProducerMock.SendEvent invocations
+func (mmSendEvent *KafkaProducerMock) SendEventBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendEvent.beforeSendEventCounter)
+}
+
+// Calls returns a list of arguments used in each call to KafkaProducerMock.SendEvent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSendEvent *mKafkaProducerMockSendEvent) Calls() []*KafkaProducerMockSendEventParams {
+	mmSendEvent.mutex.RLock()
+
+	argCopy := make([]*KafkaProducerMockSendEventParams, len(mmSendEvent.callArgs))
+	copy(argCopy, mmSendEvent.callArgs)
+
+	mmSendEvent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSendEventDone returns true if the count of the SendEvent invocations corresponds
+// the number of defined expectations
+func (m *KafkaProducerMock) MinimockSendEventDone() bool {
+	if m.SendEventMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SendEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SendEventMock.invocationsDone()
+}
+
+// MinimockSendEventInspect logs each unmet expectation
+func (m *KafkaProducerMock) MinimockSendEventInspect() {
+	for _, e := range m.SendEventMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to KafkaProducerMock.SendEvent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSendEventCounter := mm_atomic.LoadUint64(&m.afterSendEventCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SendEventMock.defaultExpectation != nil && afterSendEventCounter < 1 {
+		if m.SendEventMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to KafkaProducerMock.SendEvent at\n%s", m.SendEventMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to KafkaProducerMock.SendEvent at\n%s with params: %#v", m.SendEventMock.defaultExpectation.expectationOrigins.origin, *m.SendEventMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSendEvent != nil && afterSendEventCounter < 1 {
+		m.t.Errorf("Expected call to KafkaProducerMock.SendEvent at\n%s", m.funcSendEventOrigin)
+	}
+
+	if !m.SendEventMock.invocationsDone() && afterSendEventCounter > 0 {
+		m.t.Errorf("Expected %d calls to KafkaProducerMock.SendEvent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SendEventMock.expectedInvocations), m.SendEventMock.expectedInvocationsOrigin, afterSendEventCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *KafkaProducerMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
 			m.MinimockSendInspect()
+
+			m.MinimockSendEventInspect()
 		}
 	})
 }
@@ -443,5 +766,6 @@ func (m *KafkaProducerMock) MinimockWait(timeout mm_time.Duration) {
 func (m *KafkaProducerMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockSendDone()
+		m.MinimockSendDone() &&
+		m.MinimockSendEventDone()
 }
@@ -5,11 +5,13 @@ package mock
 //go:generate minimock -i gitlab.ozon.dev/chppppr/homework/internal/app/manager_service.Usecases -o usecases_mock.go -n UsecasesMock -p mock
 
 import (
+	"context"
 	"sync"
 	mm_atomic "sync/atomic"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
+	"gitlab.ozon.dev/chppppr/homework/internal/broadcast"
 	"gitlab.ozon.dev/chppppr/homework/internal/domain"
 	"gitlab.ozon.dev/chppppr/homework/internal/dto"
 )
@@ -19,47 +21,89 @@ type UsecasesMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcAcceptOrder          func(req *dto.AddOrderRequest) (err error)
+	funcAcceptOrder          func(ctx context.Context, req *dto.AddOrderRequest) (err error)
 	funcAcceptOrderOrigin    string
-	inspectFuncAcceptOrder   func(req *dto.AddOrderRequest)
+	inspectFuncAcceptOrder   func(ctx context.Context, req *dto.AddOrderRequest)
 	afterAcceptOrderCounter  uint64
 	beforeAcceptOrderCounter uint64
 	AcceptOrderMock          mUsecasesMockAcceptOrder
 
-	funcAcceptRefund          func(req *dto.RefundRequest) (err error)
+	funcAcceptRefund          func(ctx context.Context, req *dto.RefundRequest) (err error)
 	funcAcceptRefundOrigin    string
-	inspectFuncAcceptRefund   func(req *dto.RefundRequest)
+	inspectFuncAcceptRefund   func(ctx context.Context, req *dto.RefundRequest)
 	afterAcceptRefundCounter  uint64
 	beforeAcceptRefundCounter uint64
 	AcceptRefundMock          mUsecasesMockAcceptRefund
 
-	funcGetOrders          func(req *dto.ViewOrdersRequest) (oa1 []domain.OrderView, err error)
+	funcEraseUserData          func(ctx context.Context, req *dto.EraseUserDataRequest) (ep1 *dto.EraseUserDataResponse, err error)
+	funcEraseUserDataOrigin    string
+	inspectFuncEraseUserData   func(ctx context.Context, req *dto.EraseUserDataRequest)
+	afterEraseUserDataCounter  uint64
+	beforeEraseUserDataCounter uint64
+	EraseUserDataMock          mUsecasesMockEraseUserData
+
+	funcExportUserData          func(ctx context.Context, req *dto.ExportUserDataRequest) (up1 *domain.UserData, err error)
+	funcExportUserDataOrigin    string
+	inspectFuncExportUserData   func(ctx context.Context, req *dto.ExportUserDataRequest)
+	afterExportUserDataCounter  uint64
+	beforeExportUserDataCounter uint64
+	ExportUserDataMock          mUsecasesMockExportUserData
+
+	funcGetOrder          func(ctx context.Context, req *dto.GetOrderRequest) (gp1 *dto.GetOrderResponse, err error)
+	funcGetOrderOrigin    string
+	inspectFuncGetOrder   func(ctx context.Context, req *dto.GetOrderRequest)
+	afterGetOrderCounter  uint64
+	beforeGetOrderCounter uint64
+	GetOrderMock          mUsecasesMockGetOrder
+
+	funcGetOrders          func(ctx context.Context, req *dto.ViewOrdersRequest) (oa1 []domain.OrderView, err error)
 	funcGetOrdersOrigin    string
-	inspectFuncGetOrders   func(req *dto.ViewOrdersRequest)
+	inspectFuncGetOrders   func(ctx context.Context, req *dto.ViewOrdersRequest)
 	afterGetOrdersCounter  uint64
 	beforeGetOrdersCounter uint64
 	GetOrdersMock          mUsecasesMockGetOrders
 
-	funcGetRefunds          func(req *dto.ViewRefundsRequest) (oa1 []domain.OrderView, err error)
+	funcGetRefunds          func(ctx context.Context, req *dto.ViewRefundsRequest) (oa1 []domain.OrderView, err error)
 	funcGetRefundsOrigin    string
-	inspectFuncGetRefunds   func(req *dto.ViewRefundsRequest)
+	inspectFuncGetRefunds   func(ctx context.Context, req *dto.ViewRefundsRequest)
 	afterGetRefundsCounter  uint64
 	beforeGetRefundsCounter uint64
 	GetRefundsMock          mUsecasesMockGetRefunds
 
-	funcGive          func(req *dto.GiveOrdersRequest) (ea1 []error)
+	funcGive          func(ctx context.Context, req *dto.GiveOrdersRequest) (ea1 []error)
 	funcGiveOrigin    string
-	inspectFuncGive   func(req *dto.GiveOrdersRequest)
+	inspectFuncGive   func(ctx context.Context, req *dto.GiveOrdersRequest)
 	afterGiveCounter  uint64
 	beforeGiveCounter uint64
 	GiveMock          mUsecasesMockGive
 
-	funcReturn          func(req *dto.ReturnRequest) (err error)
+	funcQueryAuditLog          func(ctx context.Context, req *dto.QueryAuditLogRequest) (aa1 []domain.AuditRecord, err error)
+	funcQueryAuditLogOrigin    string
+	inspectFuncQueryAuditLog   func(ctx context.Context, req *dto.QueryAuditLogRequest)
+	afterQueryAuditLogCounter  uint64
+	beforeQueryAuditLogCounter uint64
+	QueryAuditLogMock          mUsecasesMockQueryAuditLog
+
+	funcReturn          func(ctx context.Context, req *dto.ReturnRequest) (err error)
 	funcReturnOrigin    string
-	inspectFuncReturn   func(req *dto.ReturnRequest)
+	inspectFuncReturn   func(ctx context.Context, req *dto.ReturnRequest)
 	afterReturnCounter  uint64
 	beforeReturnCounter uint64
 	ReturnMock          mUsecasesMockReturn
+
+	funcSearchOrders          func(ctx context.Context, req *dto.SearchOrdersRequest) (sp1 *dto.SearchOrdersResponse, err error)
+	funcSearchOrdersOrigin    string
+	inspectFuncSearchOrders   func(ctx context.Context, req *dto.SearchOrdersRequest)
+	afterSearchOrdersCounter  uint64
+	beforeSearchOrdersCounter uint64
+	SearchOrdersMock          mUsecasesMockSearchOrders
+
+	funcWatch          func(ctx context.Context, req *dto.WatchOrdersRequest) (sp1 *broadcast.Subscription, err error)
+	funcWatchOrigin    string
+	inspectFuncWatch   func(ctx context.Context, req *dto.WatchOrdersRequest)
+	afterWatchCounter  uint64
+	beforeWatchCounter uint64
+	WatchMock          mUsecasesMockWatch
 }
 
 // NewUsecasesMock returns a mock for mm_manager_service.Usecases
@@ -76,6 +120,15 @@ func NewUsecasesMock(t minimock.Tester) *UsecasesMock {
 	m.AcceptRefundMock = mUsecasesMockAcceptRefund{mock: m}
 	m.AcceptRefundMock.callArgs = []*UsecasesMockAcceptRefundParams{}
 
+	m.EraseUserDataMock = mUsecasesMockEraseUserData{mock: m}
+	m.EraseUserDataMock.callArgs = []*UsecasesMockEraseUserDataParams{}
+
+	m.ExportUserDataMock = mUsecasesMockExportUserData{mock: m}
+	m.ExportUserDataMock.callArgs = []*UsecasesMockExportUserDataParams{}
+
+	m.GetOrderMock = mUsecasesMockGetOrder{mock: m}
+	m.GetOrderMock.callArgs = []*UsecasesMockGetOrderParams{}
+
 	m.GetOrdersMock = mUsecasesMockGetOrders{mock: m}
 	m.GetOrdersMock.callArgs = []*UsecasesMockGetOrdersParams{}
 
@@ -85,9 +138,18 @@ func NewUsecasesMock(t minimock.Tester) *UsecasesMock {
 	m.GiveMock = mUsecasesMockGive{mock: m}
 	m.GiveMock.callArgs = []*UsecasesMockGiveParams{}
 
+	m.QueryAuditLogMock = mUsecasesMockQueryAuditLog{mock: m}
+	m.QueryAuditLogMock.callArgs = []*UsecasesMockQueryAuditLogParams{}
+
 	m.ReturnMock = mUsecasesMockReturn{mock: m}
 	m.ReturnMock.callArgs = []*UsecasesMockReturnParams{}
 
+	m.SearchOrdersMock = mUsecasesMockSearchOrders{mock: m}
+	m.SearchOrdersMock.callArgs = []*UsecasesMockSearchOrdersParams{}
+
+	m.WatchMock = mUsecasesMockWatch{mock: m}
+	m.WatchMock.callArgs = []*UsecasesMockWatchParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -119,11 +181,13 @@ type UsecasesMockAcceptOrderExpectation struct {
 
 // UsecasesMockAcceptOrderParams contains parameters of the Usecases.AcceptOrder
 type UsecasesMockAcceptOrderParams struct {
+	ctx context.Context
 	req *dto.AddOrderRequest
 }
 
 // UsecasesMockAcceptOrderParamPtrs contains pointers to parameters of the Usecases.AcceptOrder
 type UsecasesMockAcceptOrderParamPtrs struct {
+	ctx *context.Context
 	req **dto.AddOrderRequest
 }
 
@@ -135,6 +199,7 @@ type UsecasesMockAcceptOrderResults struct {
 // UsecasesMockAcceptOrderOrigins contains origins of expectations of the Usecases.AcceptOrder
 type UsecasesMockAcceptOrderExpectationOrigins struct {
 	origin    string
+	originCtx string
 	originReq string
 }
 
@@ -149,7 +214,7 @@ func (mmAcceptOrder *mUsecasesMockAcceptOrder) Optional() *mUsecasesMockAcceptOr
 }
 
 // Expect sets up expected params for Usecases.AcceptOrder
-func (mmAcceptOrder *mUsecasesMockAcceptOrder) Expect(req *dto.AddOrderRequest) *mUsecasesMockAcceptOrder {
+func (mmAcceptOrder *mUsecasesMockAcceptOrder) Expect(ctx context.Context, req *dto.AddOrderRequest) *mUsecasesMockAcceptOrder {
 	if mmAcceptOrder.mock.funcAcceptOrder != nil {
 		mmAcceptOrder.mock.t.Fatalf("UsecasesMock.AcceptOrder mock is already set by Set")
 	}
@@ -162,7 +227,7 @@ func (mmAcceptOrder *mUsecasesMockAcceptOrder) Expect(req *dto.AddOrderRequest)
 		mmAcceptOrder.mock.t.Fatalf("UsecasesMock.AcceptOrder mock is already set by ExpectParams functions")
 	}
 
-	mmAcceptOrder.defaultExpectation.params = &UsecasesMockAcceptOrderParams{req}
+	mmAcceptOrder.defaultExpectation.params = &UsecasesMockAcceptOrderParams{ctx, req}
 	mmAcceptOrder.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmAcceptOrder.expectations {
 		if minimock.Equal(e.params, mmAcceptOrder.defaultExpectation.params) {
@@ -173,8 +238,31 @@ func (mmAcceptOrder *mUsecasesMockAcceptOrder) Expect(req *dto.AddOrderRequest)
 	return mmAcceptOrder
 }
 
-// ExpectReqParam1 sets up expected param req for Usecases.AcceptOrder
-func (mmAcceptOrder *mUsecasesMockAcceptOrder) ExpectReqParam1(req *dto.AddOrderRequest) *mUsecasesMockAcceptOrder {
+// ExpectCtxParam1 sets up expected param ctx for Usecases.AcceptOrder
+func (mmAcceptOrder *mUsecasesMockAcceptOrder) ExpectCtxParam1(ctx context.Context) *mUsecasesMockAcceptOrder {
+	if mmAcceptOrder.mock.funcAcceptOrder != nil {
+		mmAcceptOrder.mock.t.Fatalf("UsecasesMock.AcceptOrder mock is already set by Set")
+	}
+
+	if mmAcceptOrder.defaultExpectation == nil {
+		mmAcceptOrder.defaultExpectation = &UsecasesMockAcceptOrderExpectation{}
+	}
+
+	if mmAcceptOrder.defaultExpectation.params != nil {
+		mmAcceptOrder.mock.t.Fatalf("UsecasesMock.AcceptOrder mock is already set by Expect")
+	}
+
+	if mmAcceptOrder.defaultExpectation.paramPtrs == nil {
+		mmAcceptOrder.defaultExpectation.paramPtrs = &UsecasesMockAcceptOrderParamPtrs{}
+	}
+	mmAcceptOrder.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAcceptOrder.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAcceptOrder
+}
+
+// ExpectReqParam2 sets up expected param req for Usecases.AcceptOrder
+func (mmAcceptOrder *mUsecasesMockAcceptOrder) ExpectReqParam2(req *dto.AddOrderRequest) *mUsecasesMockAcceptOrder {
 	if mmAcceptOrder.mock.funcAcceptOrder != nil {
 		mmAcceptOrder.mock.t.Fatalf("UsecasesMock.AcceptOrder mock is already set by Set")
 	}
@@ -197,7 +285,7 @@ func (mmAcceptOrder *mUsecasesMockAcceptOrder) ExpectReqParam1(req *dto.AddOrder
 }
 
 // Inspect accepts an inspector function that has same arguments as the Usecases.AcceptOrder
-func (mmAcceptOrder *mUsecasesMockAcceptOrder) Inspect(f func(req *dto.AddOrderRequest)) *mUsecasesMockAcceptOrder {
+func (mmAcceptOrder *mUsecasesMockAcceptOrder) Inspect(f func(ctx context.Context, req *dto.AddOrderRequest)) *mUsecasesMockAcceptOrder {
 	if mmAcceptOrder.mock.inspectFuncAcceptOrder != nil {
 		mmAcceptOrder.mock.t.Fatalf("Inspect function is already set for UsecasesMock.AcceptOrder")
 	}
@@ -222,7 +310,7 @@ func (mmAcceptOrder *mUsecasesMockAcceptOrder) Return(err error) *UsecasesMock {
 }
 
 // Set uses given function f to mock the Usecases.AcceptOrder method
-func (mmAcceptOrder *mUsecasesMockAcceptOrder) Set(f func(req *dto.AddOrderRequest) (err error)) *UsecasesMock {
+func (mmAcceptOrder *mUsecasesMockAcceptOrder) Set(f func(ctx context.Context, req *dto.AddOrderRequest) (err error)) *UsecasesMock {
 	if mmAcceptOrder.defaultExpectation != nil {
 		mmAcceptOrder.mock.t.Fatalf("Default expectation is already set for the Usecases.AcceptOrder method")
 	}
@@ -238,14 +326,14 @@ func (mmAcceptOrder *mUsecasesMockAcceptOrder) Set(f func(req *dto.AddOrderReque
 
 // When sets expectation for the Usecases.AcceptOrder which will trigger the result defined by the following
 // Then helper
-func (mmAcceptOrder *mUsecasesMockAcceptOrder) When(req *dto.AddOrderRequest) *UsecasesMockAcceptOrderExpectation {
+func (mmAcceptOrder *mUsecasesMockAcceptOrder) When(ctx context.Context, req *dto.AddOrderRequest) *UsecasesMockAcceptOrderExpectation {
 	if mmAcceptOrder.mock.funcAcceptOrder != nil {
 		mmAcceptOrder.mock.t.Fatalf("UsecasesMock.AcceptOrder mock is already set by Set")
 	}
 
 	expectation := &UsecasesMockAcceptOrderExpectation{
 		mock:               mmAcceptOrder.mock,
-		params:             &UsecasesMockAcceptOrderParams{req},
+		params:             &UsecasesMockAcceptOrderParams{ctx, req},
 		expectationOrigins: UsecasesMockAcceptOrderExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmAcceptOrder.expectations = append(mmAcceptOrder.expectations, expectation)
@@ -280,17 +368,17 @@ func (mmAcceptOrder *mUsecasesMockAcceptOrder) invocationsDone() bool {
 }
 
 // AcceptOrder implements mm_manager_service.Usecases
-func (mmAcceptOrder *UsecasesMock) AcceptOrder(req *dto.AddOrderRequest) (err error) {
+func (mmAcceptOrder *UsecasesMock) AcceptOrder(ctx context.Context, req *dto.AddOrderRequest) (err error) {
 	mm_atomic.AddUint64(&mmAcceptOrder.beforeAcceptOrderCounter, 1)
 	defer mm_atomic.AddUint64(&mmAcceptOrder.afterAcceptOrderCounter, 1)
 
 	mmAcceptOrder.t.Helper()
 
 	if mmAcceptOrder.inspectFuncAcceptOrder != nil {
-		mmAcceptOrder.inspectFuncAcceptOrder(req)
+		mmAcceptOrder.inspectFuncAcceptOrder(ctx, req)
 	}
 
-	mm_params := UsecasesMockAcceptOrderParams{req}
+	mm_params := UsecasesMockAcceptOrderParams{ctx, req}
 
 	// Record call args
 	mmAcceptOrder.AcceptOrderMock.mutex.Lock()
@@ -309,10 +397,15 @@ func (mmAcceptOrder *UsecasesMock) AcceptOrder(req *dto.AddOrderRequest) (err er
 		mm_want := mmAcceptOrder.AcceptOrderMock.defaultExpectation.params
 		mm_want_ptrs := mmAcceptOrder.AcceptOrderMock.defaultExpectation.paramPtrs
 
-		mm_got := UsecasesMockAcceptOrderParams{req}
+		mm_got := UsecasesMockAcceptOrderParams{ctx, req}
 
 		if mm_want_ptrs != nil {
 
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAcceptOrder.t.Errorf("UsecasesMock.AcceptOrder got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAcceptOrder.AcceptOrderMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
 			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
 				mmAcceptOrder.t.Errorf("UsecasesMock.AcceptOrder got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 					mmAcceptOrder.AcceptOrderMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
@@ -330,9 +423,9 @@ func (mmAcceptOrder *UsecasesMock) AcceptOrder(req *dto.AddOrderRequest) (err er
 		return (*mm_results).err
 	}
 	if mmAcceptOrder.funcAcceptOrder != nil {
-		return mmAcceptOrder.funcAcceptOrder(req)
+		return mmAcceptOrder.funcAcceptOrder(ctx, req)
 	}
-	mmAcceptOrder.t.Fatalf("Unexpected call to UsecasesMock.AcceptOrder. %v", req)
+	mmAcceptOrder.t.Fatalf("Unexpected call to UsecasesMock.AcceptOrder. %v %v", ctx, req)
 	return
 }
 
@@ -430,11 +523,13 @@ type UsecasesMockAcceptRefundExpectation struct {
 
 // UsecasesMockAcceptRefundParams contains parameters of the Usecases.AcceptRefund
 type UsecasesMockAcceptRefundParams struct {
+	ctx context.Context
 	req *dto.RefundRequest
 }
 
 // UsecasesMockAcceptRefundParamPtrs contains pointers to parameters of the Usecases.AcceptRefund
 type UsecasesMockAcceptRefundParamPtrs struct {
+	ctx *context.Context
 	req **dto.RefundRequest
 }
 
@@ -446,6 +541,7 @@ type UsecasesMockAcceptRefundResults struct {
 // UsecasesMockAcceptRefundOrigins contains origins of expectations of the Usecases.AcceptRefund
 type UsecasesMockAcceptRefundExpectationOrigins struct {
 	origin    string
+	originCtx string
 	originReq string
 }
 
@@ -460,7 +556,7 @@ func (mmAcceptRefund *mUsecasesMockAcceptRefund) Optional() *mUsecasesMockAccept
 }
 
 // Expect sets up expected params for Usecases.AcceptRefund
-func (mmAcceptRefund *mUsecasesMockAcceptRefund) Expect(req *dto.RefundRequest) *mUsecasesMockAcceptRefund {
+func (mmAcceptRefund *mUsecasesMockAcceptRefund) Expect(ctx context.Context, req *dto.RefundRequest) *mUsecasesMockAcceptRefund {
 	if mmAcceptRefund.mock.funcAcceptRefund != nil {
 		mmAcceptRefund.mock.t.Fatalf("UsecasesMock.AcceptRefund mock is already set by Set")
 	}
@@ -473,7 +569,7 @@ func (mmAcceptRefund *mUsecasesMockAcceptRefund) Expect(req *dto.RefundRequest)
 		mmAcceptRefund.mock.t.Fatalf("UsecasesMock.AcceptRefund mock is already set by ExpectParams functions")
 	}
 
-	mmAcceptRefund.defaultExpectation.params = &UsecasesMockAcceptRefundParams{req}
+	mmAcceptRefund.defaultExpectation.params = &UsecasesMockAcceptRefundParams{ctx, req}
 	mmAcceptRefund.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmAcceptRefund.expectations {
 		if minimock.Equal(e.params, mmAcceptRefund.defaultExpectation.params) {
@@ -484,8 +580,31 @@ func (mmAcceptRefund *mUsecasesMockAcceptRefund) Expect(req *dto.RefundRequest)
 	return mmAcceptRefund
 }
 
-// ExpectReqParam1 sets up expected param req for Usecases.AcceptRefund
-func (mmAcceptRefund *mUsecasesMockAcceptRefund) ExpectReqParam1(req *dto.RefundRequest) *mUsecasesMockAcceptRefund {
+// ExpectCtxParam1 sets up expected param ctx for Usecases.AcceptRefund
+func (mmAcceptRefund *mUsecasesMockAcceptRefund) ExpectCtxParam1(ctx context.Context) *mUsecasesMockAcceptRefund {
+	if mmAcceptRefund.mock.funcAcceptRefund != nil {
+		mmAcceptRefund.mock.t.Fatalf("UsecasesMock.AcceptRefund mock is already set by Set")
+	}
+
+	if mmAcceptRefund.defaultExpectation == nil {
+		mmAcceptRefund.defaultExpectation = &UsecasesMockAcceptRefundExpectation{}
+	}
+
+	if mmAcceptRefund.defaultExpectation.params != nil {
+		mmAcceptRefund.mock.t.Fatalf("UsecasesMock.AcceptRefund mock is already set by Expect")
+	}
+
+	if mmAcceptRefund.defaultExpectation.paramPtrs == nil {
+		mmAcceptRefund.defaultExpectation.paramPtrs = &UsecasesMockAcceptRefundParamPtrs{}
+	}
+	mmAcceptRefund.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAcceptRefund.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAcceptRefund
+}
+
+// ExpectReqParam2 sets up expected param req for Usecases.AcceptRefund
+func (mmAcceptRefund *mUsecasesMockAcceptRefund) ExpectReqParam2(req *dto.RefundRequest) *mUsecasesMockAcceptRefund {
 	if mmAcceptRefund.mock.funcAcceptRefund != nil {
 		mmAcceptRefund.mock.t.Fatalf("UsecasesMock.AcceptRefund mock is already set by Set")
 	}
@@ -508,7 +627,7 @@ func (mmAcceptRefund *mUsecasesMockAcceptRefund) ExpectReqParam1(req *dto.Refund
 }
 
 // Inspect accepts an inspector function that has same arguments as the Usecases.AcceptRefund
-func (mmAcceptRefund *mUsecasesMockAcceptRefund) Inspect(f func(req *dto.RefundRequest)) *mUsecasesMockAcceptRefund {
+func (mmAcceptRefund *mUsecasesMockAcceptRefund) Inspect(f func(ctx context.Context, req *dto.RefundRequest)) *mUsecasesMockAcceptRefund {
 	if mmAcceptRefund.mock.inspectFuncAcceptRefund != nil {
 		mmAcceptRefund.mock.t.Fatalf("Inspect function is already set for UsecasesMock.AcceptRefund")
 	}
@@ -533,7 +652,7 @@ func (mmAcceptRefund *mUsecasesMockAcceptRefund) Return(err error) *UsecasesMock
 }
 
 // Set uses given function f to mock the Usecases.AcceptRefund method
-func (mmAcceptRefund *mUsecasesMockAcceptRefund) Set(f func(req *dto.RefundRequest) (err error)) *UsecasesMock {
+func (mmAcceptRefund *mUsecasesMockAcceptRefund) Set(f func(ctx context.Context, req *dto.RefundRequest) (err error)) *UsecasesMock {
 	if mmAcceptRefund.defaultExpectation != nil {
 		mmAcceptRefund.mock.t.Fatalf("Default expectation is already set for the Usecases.AcceptRefund method")
 	}
@@ -549,14 +668,14 @@ func (mmAcceptRefund *mUsecasesMockAcceptRefund) Set(f func(req *dto.RefundReque
 
 // When sets expectation for the Usecases.AcceptRefund which will trigger the result defined by the following
 // Then helper
-func (mmAcceptRefund *mUsecasesMockAcceptRefund) When(req *dto.RefundRequest) *UsecasesMockAcceptRefundExpectation {
+func (mmAcceptRefund *mUsecasesMockAcceptRefund) When(ctx context.Context, req *dto.RefundRequest) *UsecasesMockAcceptRefundExpectation {
 	if mmAcceptRefund.mock.funcAcceptRefund != nil {
 		mmAcceptRefund.mock.t.Fatalf("UsecasesMock.AcceptRefund mock is already set by Set")
 	}
 
 	expectation := &UsecasesMockAcceptRefundExpectation{
 		mock:               mmAcceptRefund.mock,
-		params:             &UsecasesMockAcceptRefundParams{req},
+		params:             &UsecasesMockAcceptRefundParams{ctx, req},
 		expectationOrigins: UsecasesMockAcceptRefundExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmAcceptRefund.expectations = append(mmAcceptRefund.expectations, expectation)
@@ -591,17 +710,17 @@ func (mmAcceptRefund *mUsecasesMockAcceptRefund) invocationsDone() bool {
 }
 
 // AcceptRefund implements mm_manager_service.Usecases
-func (mmAcceptRefund *UsecasesMock) AcceptRefund(req *dto.RefundRequest) (err error) {
+func (mmAcceptRefund *UsecasesMock) AcceptRefund(ctx context.Context, req *dto.RefundRequest) (err error) {
 	mm_atomic.AddUint64(&mmAcceptRefund.beforeAcceptRefundCounter, 1)
 	defer mm_atomic.AddUint64(&mmAcceptRefund.afterAcceptRefundCounter, 1)
 
 	mmAcceptRefund.t.Helper()
 
 	if mmAcceptRefund.inspectFuncAcceptRefund != nil {
-		mmAcceptRefund.inspectFuncAcceptRefund(req)
+		mmAcceptRefund.inspectFuncAcceptRefund(ctx, req)
 	}
 
-	mm_params := UsecasesMockAcceptRefundParams{req}
+	mm_params := UsecasesMockAcceptRefundParams{ctx, req}
 
 	// Record call args
 	mmAcceptRefund.AcceptRefundMock.mutex.Lock()
@@ -620,10 +739,15 @@ func (mmAcceptRefund *UsecasesMock) AcceptRefund(req *dto.RefundRequest) (err er
 		mm_want := mmAcceptRefund.AcceptRefundMock.defaultExpectation.params
 		mm_want_ptrs := mmAcceptRefund.AcceptRefundMock.defaultExpectation.paramPtrs
 
-		mm_got := UsecasesMockAcceptRefundParams{req}
+		mm_got := UsecasesMockAcceptRefundParams{ctx, req}
 
 		if mm_want_ptrs != nil {
 
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAcceptRefund.t.Errorf("UsecasesMock.AcceptRefund got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAcceptRefund.AcceptRefundMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
 			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
 				mmAcceptRefund.t.Errorf("UsecasesMock.AcceptRefund got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 					mmAcceptRefund.AcceptRefundMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
@@ -641,9 +765,9 @@ func (mmAcceptRefund *UsecasesMock) AcceptRefund(req *dto.RefundRequest) (err er
 		return (*mm_results).err
 	}
 	if mmAcceptRefund.funcAcceptRefund != nil {
-		return mmAcceptRefund.funcAcceptRefund(req)
+		return mmAcceptRefund.funcAcceptRefund(ctx, req)
 	}
-	mmAcceptRefund.t.Fatalf("Unexpected call to UsecasesMock.AcceptRefund. %v", req)
+	mmAcceptRefund.t.Fatalf("Unexpected call to UsecasesMock.AcceptRefund. %v %v", ctx, req)
 	return
 }
 
@@ -715,49 +839,52 @@ func (m *UsecasesMock) MinimockAcceptRefundInspect() {
 	}
 }
 
-type mUsecasesMockGetOrders struct {
+type mUsecasesMockEraseUserData struct {
 	optional           bool
 	mock               *UsecasesMock
-	defaultExpectation *UsecasesMockGetOrdersExpectation
-	expectations       []*UsecasesMockGetOrdersExpectation
+	defaultExpectation *UsecasesMockEraseUserDataExpectation
+	expectations       []*UsecasesMockEraseUserDataExpectation
 
-	callArgs []*UsecasesMockGetOrdersParams
+	callArgs []*UsecasesMockEraseUserDataParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UsecasesMockGetOrdersExpectation specifies expectation struct of the Usecases.GetOrders
-type UsecasesMockGetOrdersExpectation struct {
+// UsecasesMockEraseUserDataExpectation specifies expectation struct of the Usecases.EraseUserData
+type UsecasesMockEraseUserDataExpectation struct {
 	mock               *UsecasesMock
-	params             *UsecasesMockGetOrdersParams
-	paramPtrs          *UsecasesMockGetOrdersParamPtrs
-	expectationOrigins UsecasesMockGetOrdersExpectationOrigins
-	results            *UsecasesMockGetOrdersResults
+	params             *UsecasesMockEraseUserDataParams
+	paramPtrs          *UsecasesMockEraseUserDataParamPtrs
+	expectationOrigins UsecasesMockEraseUserDataExpectationOrigins
+	results            *UsecasesMockEraseUserDataResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UsecasesMockGetOrdersParams contains parameters of the Usecases.GetOrders
-type UsecasesMockGetOrdersParams struct {
-	req *dto.ViewOrdersRequest
+// UsecasesMockEraseUserDataParams contains parameters of the Usecases.EraseUserData
+type UsecasesMockEraseUserDataParams struct {
+	ctx context.Context
+	req *dto.EraseUserDataRequest
 }
 
-// UsecasesMockGetOrdersParamPtrs contains pointers to parameters of the Usecases.GetOrders
-type UsecasesMockGetOrdersParamPtrs struct {
-	req **dto.ViewOrdersRequest
+// UsecasesMockEraseUserDataParamPtrs contains pointers to parameters of the Usecases.EraseUserData
+type UsecasesMockEraseUserDataParamPtrs struct {
+	ctx *context.Context
+	req **dto.EraseUserDataRequest
 }
 
-// UsecasesMockGetOrdersResults contains results of the Usecases.GetOrders
-type UsecasesMockGetOrdersResults struct {
-	oa1 []domain.OrderView
+// UsecasesMockEraseUserDataResults contains results of the Usecases.EraseUserData
+type UsecasesMockEraseUserDataResults struct {
+	ep1 *dto.EraseUserDataResponse
 	err error
 }
 
-// UsecasesMockGetOrdersOrigins contains origins of expectations of the Usecases.GetOrders
-type UsecasesMockGetOrdersExpectationOrigins struct {
+// UsecasesMockEraseUserDataOrigins contains origins of expectations of the Usecases.EraseUserData
+type UsecasesMockEraseUserDataExpectationOrigins struct {
 	origin    string
+	originCtx string
 	originReq string
 }
 
@@ -766,310 +893,341 @@ type UsecasesMockGetOrdersExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetOrders *mUsecasesMockGetOrders) Optional() *mUsecasesMockGetOrders {
-	mmGetOrders.optional = true
-	return mmGetOrders
+func (mmEraseUserData *mUsecasesMockEraseUserData) Optional() *mUsecasesMockEraseUserData {
+	mmEraseUserData.optional = true
+	return mmEraseUserData
 }
 
-// Expect sets up expected params for Usecases.GetOrders
-func (mmGetOrders *mUsecasesMockGetOrders) Expect(req *dto.ViewOrdersRequest) *mUsecasesMockGetOrders {
-	if mmGetOrders.mock.funcGetOrders != nil {
-		mmGetOrders.mock.t.Fatalf("UsecasesMock.GetOrders mock is already set by Set")
+// Expect sets up expected params for Usecases.EraseUserData
+func (mmEraseUserData *mUsecasesMockEraseUserData) Expect(ctx context.Context, req *dto.EraseUserDataRequest) *mUsecasesMockEraseUserData {
+	if mmEraseUserData.mock.funcEraseUserData != nil {
+		mmEraseUserData.mock.t.Fatalf("UsecasesMock.EraseUserData mock is already set by Set")
 	}
 
-	if mmGetOrders.defaultExpectation == nil {
-		mmGetOrders.defaultExpectation = &UsecasesMockGetOrdersExpectation{}
+	if mmEraseUserData.defaultExpectation == nil {
+		mmEraseUserData.defaultExpectation = &UsecasesMockEraseUserDataExpectation{}
 	}
 
-	if mmGetOrders.defaultExpectation.paramPtrs != nil {
-		mmGetOrders.mock.t.Fatalf("UsecasesMock.GetOrders mock is already set by ExpectParams functions")
+	if mmEraseUserData.defaultExpectation.paramPtrs != nil {
+		mmEraseUserData.mock.t.Fatalf("UsecasesMock.EraseUserData mock is already set by ExpectParams functions")
 	}
 
-	mmGetOrders.defaultExpectation.params = &UsecasesMockGetOrdersParams{req}
-	mmGetOrders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetOrders.expectations {
-		if minimock.Equal(e.params, mmGetOrders.defaultExpectation.params) {
-			mmGetOrders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrders.defaultExpectation.params)
+	mmEraseUserData.defaultExpectation.params = &UsecasesMockEraseUserDataParams{ctx, req}
+	mmEraseUserData.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmEraseUserData.expectations {
+		if minimock.Equal(e.params, mmEraseUserData.defaultExpectation.params) {
+			mmEraseUserData.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmEraseUserData.defaultExpectation.params)
 		}
 	}
 
-	return mmGetOrders
+	return mmEraseUserData
 }
 
-// ExpectReqParam1 sets up expected param req for Usecases.GetOrders
-func (mmGetOrders *mUsecasesMockGetOrders) ExpectReqParam1(req *dto.ViewOrdersRequest) *mUsecasesMockGetOrders {
-	if mmGetOrders.mock.funcGetOrders != nil {
-		mmGetOrders.mock.t.Fatalf("UsecasesMock.GetOrders mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Usecases.EraseUserData
+func (mmEraseUserData *mUsecasesMockEraseUserData) ExpectCtxParam1(ctx context.Context) *mUsecasesMockEraseUserData {
+	if mmEraseUserData.mock.funcEraseUserData != nil {
+		mmEraseUserData.mock.t.Fatalf("UsecasesMock.EraseUserData mock is already set by Set")
 	}
 
-	if mmGetOrders.defaultExpectation == nil {
-		mmGetOrders.defaultExpectation = &UsecasesMockGetOrdersExpectation{}
+	if mmEraseUserData.defaultExpectation == nil {
+		mmEraseUserData.defaultExpectation = &UsecasesMockEraseUserDataExpectation{}
 	}
 
-	if mmGetOrders.defaultExpectation.params != nil {
-		mmGetOrders.mock.t.Fatalf("UsecasesMock.GetOrders mock is already set by Expect")
+	if mmEraseUserData.defaultExpectation.params != nil {
+		mmEraseUserData.mock.t.Fatalf("UsecasesMock.EraseUserData mock is already set by Expect")
 	}
 
-	if mmGetOrders.defaultExpectation.paramPtrs == nil {
-		mmGetOrders.defaultExpectation.paramPtrs = &UsecasesMockGetOrdersParamPtrs{}
+	if mmEraseUserData.defaultExpectation.paramPtrs == nil {
+		mmEraseUserData.defaultExpectation.paramPtrs = &UsecasesMockEraseUserDataParamPtrs{}
 	}
-	mmGetOrders.defaultExpectation.paramPtrs.req = &req
-	mmGetOrders.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+	mmEraseUserData.defaultExpectation.paramPtrs.ctx = &ctx
+	mmEraseUserData.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetOrders
+	return mmEraseUserData
 }
 
-// Inspect accepts an inspector function that has same arguments as the Usecases.GetOrders
-func (mmGetOrders *mUsecasesMockGetOrders) Inspect(f func(req *dto.ViewOrdersRequest)) *mUsecasesMockGetOrders {
-	if mmGetOrders.mock.inspectFuncGetOrders != nil {
-		mmGetOrders.mock.t.Fatalf("Inspect function is already set for UsecasesMock.GetOrders")
+// ExpectReqParam2 sets up expected param req for Usecases.EraseUserData
+func (mmEraseUserData *mUsecasesMockEraseUserData) ExpectReqParam2(req *dto.EraseUserDataRequest) *mUsecasesMockEraseUserData {
+	if mmEraseUserData.mock.funcEraseUserData != nil {
+		mmEraseUserData.mock.t.Fatalf("UsecasesMock.EraseUserData mock is already set by Set")
 	}
 
-	mmGetOrders.mock.inspectFuncGetOrders = f
+	if mmEraseUserData.defaultExpectation == nil {
+		mmEraseUserData.defaultExpectation = &UsecasesMockEraseUserDataExpectation{}
+	}
 
-	return mmGetOrders
+	if mmEraseUserData.defaultExpectation.params != nil {
+		mmEraseUserData.mock.t.Fatalf("UsecasesMock.EraseUserData mock is already set by Expect")
+	}
+
+	if mmEraseUserData.defaultExpectation.paramPtrs == nil {
+		mmEraseUserData.defaultExpectation.paramPtrs = &UsecasesMockEraseUserDataParamPtrs{}
+	}
+	mmEraseUserData.defaultExpectation.paramPtrs.req = &req
+	mmEraseUserData.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmEraseUserData
 }
 
-// Return sets up results that will be returned by Usecases.GetOrders
-func (mmGetOrders *mUsecasesMockGetOrders) Return(oa1 []domain.OrderView, err error) *UsecasesMock {
-	if mmGetOrders.mock.funcGetOrders != nil {
-		mmGetOrders.mock.t.Fatalf("UsecasesMock.GetOrders mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the Usecases.EraseUserData
+func (mmEraseUserData *mUsecasesMockEraseUserData) Inspect(f func(ctx context.Context, req *dto.EraseUserDataRequest)) *mUsecasesMockEraseUserData {
+	if mmEraseUserData.mock.inspectFuncEraseUserData != nil {
+		mmEraseUserData.mock.t.Fatalf("Inspect function is already set for UsecasesMock.EraseUserData")
 	}
 
-	if mmGetOrders.defaultExpectation == nil {
-		mmGetOrders.defaultExpectation = &UsecasesMockGetOrdersExpectation{mock: mmGetOrders.mock}
+	mmEraseUserData.mock.inspectFuncEraseUserData = f
+
+	return mmEraseUserData
+}
+
+// Return sets up results that will be returned by Usecases.EraseUserData
+func (mmEraseUserData *mUsecasesMockEraseUserData) Return(ep1 *dto.EraseUserDataResponse, err error) *UsecasesMock {
+	if mmEraseUserData.mock.funcEraseUserData != nil {
+		mmEraseUserData.mock.t.Fatalf("UsecasesMock.EraseUserData mock is already set by Set")
 	}
-	mmGetOrders.defaultExpectation.results = &UsecasesMockGetOrdersResults{oa1, err}
-	mmGetOrders.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetOrders.mock
+
+	if mmEraseUserData.defaultExpectation == nil {
+		mmEraseUserData.defaultExpectation = &UsecasesMockEraseUserDataExpectation{mock: mmEraseUserData.mock}
+	}
+	mmEraseUserData.defaultExpectation.results = &UsecasesMockEraseUserDataResults{ep1, err}
+	mmEraseUserData.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmEraseUserData.mock
 }
 
-// Set uses given function f to mock the Usecases.GetOrders method
-func (mmGetOrders *mUsecasesMockGetOrders) Set(f func(req *dto.ViewOrdersRequest) (oa1 []domain.OrderView, err error)) *UsecasesMock {
-	if mmGetOrders.defaultExpectation != nil {
-		mmGetOrders.mock.t.Fatalf("Default expectation is already set for the Usecases.GetOrders method")
+// Set uses given function f to mock the Usecases.EraseUserData method
+func (mmEraseUserData *mUsecasesMockEraseUserData) Set(f func(ctx context.Context, req *dto.EraseUserDataRequest) (ep1 *dto.EraseUserDataResponse, err error)) *UsecasesMock {
+	if mmEraseUserData.defaultExpectation != nil {
+		mmEraseUserData.mock.t.Fatalf("Default expectation is already set for the Usecases.EraseUserData method")
 	}
 
-	if len(mmGetOrders.expectations) > 0 {
-		mmGetOrders.mock.t.Fatalf("Some expectations are already set for the Usecases.GetOrders method")
+	if len(mmEraseUserData.expectations) > 0 {
+		mmEraseUserData.mock.t.Fatalf("Some expectations are already set for the Usecases.EraseUserData method")
 	}
 
-	mmGetOrders.mock.funcGetOrders = f
-	mmGetOrders.mock.funcGetOrdersOrigin = minimock.CallerInfo(1)
-	return mmGetOrders.mock
+	mmEraseUserData.mock.funcEraseUserData = f
+	mmEraseUserData.mock.funcEraseUserDataOrigin = minimock.CallerInfo(1)
+	return mmEraseUserData.mock
 }
 
-// When sets expectation for the Usecases.GetOrders which will trigger the result defined by the following
+// When sets expectation for the Usecases.EraseUserData which will trigger the result defined by the following
 // Then helper
-func (mmGetOrders *mUsecasesMockGetOrders) When(req *dto.ViewOrdersRequest) *UsecasesMockGetOrdersExpectation {
-	if mmGetOrders.mock.funcGetOrders != nil {
-		mmGetOrders.mock.t.Fatalf("UsecasesMock.GetOrders mock is already set by Set")
+func (mmEraseUserData *mUsecasesMockEraseUserData) When(ctx context.Context, req *dto.EraseUserDataRequest) *UsecasesMockEraseUserDataExpectation {
+	if mmEraseUserData.mock.funcEraseUserData != nil {
+		mmEraseUserData.mock.t.Fatalf("UsecasesMock.EraseUserData mock is already set by Set")
 	}
 
-	expectation := &UsecasesMockGetOrdersExpectation{
-		mock:               mmGetOrders.mock,
-		params:             &UsecasesMockGetOrdersParams{req},
-		expectationOrigins: UsecasesMockGetOrdersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UsecasesMockEraseUserDataExpectation{
+		mock:               mmEraseUserData.mock,
+		params:             &UsecasesMockEraseUserDataParams{ctx, req},
+		expectationOrigins: UsecasesMockEraseUserDataExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetOrders.expectations = append(mmGetOrders.expectations, expectation)
+	mmEraseUserData.expectations = append(mmEraseUserData.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Usecases.GetOrders return parameters for the expectation previously defined by the When method
-func (e *UsecasesMockGetOrdersExpectation) Then(oa1 []domain.OrderView, err error) *UsecasesMock {
-	e.results = &UsecasesMockGetOrdersResults{oa1, err}
+// Then sets up Usecases.EraseUserData return parameters for the expectation previously defined by the When method
+func (e *UsecasesMockEraseUserDataExpectation) Then(ep1 *dto.EraseUserDataResponse, err error) *UsecasesMock {
+	e.results = &UsecasesMockEraseUserDataResults{ep1, err}
 	return e.mock
 }
 
-// Times sets number of times Usecases.GetOrders should be invoked
-func (mmGetOrders *mUsecasesMockGetOrders) Times(n uint64) *mUsecasesMockGetOrders {
+// Times sets number of times Usecases.EraseUserData should be invoked
+func (mmEraseUserData *mUsecasesMockEraseUserData) Times(n uint64) *mUsecasesMockEraseUserData {
 	if n == 0 {
-		mmGetOrders.mock.t.Fatalf("Times of UsecasesMock.GetOrders mock can not be zero")
+		mmEraseUserData.mock.t.Fatalf("Times of UsecasesMock.EraseUserData mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetOrders.expectedInvocations, n)
-	mmGetOrders.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetOrders
+	mm_atomic.StoreUint64(&mmEraseUserData.expectedInvocations, n)
+	mmEraseUserData.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmEraseUserData
 }
 
-func (mmGetOrders *mUsecasesMockGetOrders) invocationsDone() bool {
-	if len(mmGetOrders.expectations) == 0 && mmGetOrders.defaultExpectation == nil && mmGetOrders.mock.funcGetOrders == nil {
+func (mmEraseUserData *mUsecasesMockEraseUserData) invocationsDone() bool {
+	if len(mmEraseUserData.expectations) == 0 && mmEraseUserData.defaultExpectation == nil && mmEraseUserData.mock.funcEraseUserData == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetOrders.mock.afterGetOrdersCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetOrders.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmEraseUserData.mock.afterEraseUserDataCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmEraseUserData.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetOrders implements mm_manager_service.Usecases
-func (mmGetOrders *UsecasesMock) GetOrders(req *dto.ViewOrdersRequest) (oa1 []domain.OrderView, err error) {
-	mm_atomic.AddUint64(&mmGetOrders.beforeGetOrdersCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetOrders.afterGetOrdersCounter, 1)
+// EraseUserData implements mm_manager_service.Usecases
+func (mmEraseUserData *UsecasesMock) EraseUserData(ctx context.Context, req *dto.EraseUserDataRequest) (ep1 *dto.EraseUserDataResponse, err error) {
+	mm_atomic.AddUint64(&mmEraseUserData.beforeEraseUserDataCounter, 1)
+	defer mm_atomic.AddUint64(&mmEraseUserData.afterEraseUserDataCounter, 1)
 
-	mmGetOrders.t.Helper()
+	mmEraseUserData.t.Helper()
 
-	if mmGetOrders.inspectFuncGetOrders != nil {
-		mmGetOrders.inspectFuncGetOrders(req)
+	if mmEraseUserData.inspectFuncEraseUserData != nil {
+		mmEraseUserData.inspectFuncEraseUserData(ctx, req)
 	}
 
-	mm_params := UsecasesMockGetOrdersParams{req}
+	mm_params := UsecasesMockEraseUserDataParams{ctx, req}
 
 	// Record call args
-	mmGetOrders.GetOrdersMock.mutex.Lock()
-	mmGetOrders.GetOrdersMock.callArgs = append(mmGetOrders.GetOrdersMock.callArgs, &mm_params)
-	mmGetOrders.GetOrdersMock.mutex.Unlock()
+	mmEraseUserData.EraseUserDataMock.mutex.Lock()
+	mmEraseUserData.EraseUserDataMock.callArgs = append(mmEraseUserData.EraseUserDataMock.callArgs, &mm_params)
+	mmEraseUserData.EraseUserDataMock.mutex.Unlock()
 
-	for _, e := range mmGetOrders.GetOrdersMock.expectations {
+	for _, e := range mmEraseUserData.EraseUserDataMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.oa1, e.results.err
+			return e.results.ep1, e.results.err
 		}
 	}
 
-	if mmGetOrders.GetOrdersMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetOrders.GetOrdersMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetOrders.GetOrdersMock.defaultExpectation.params
-		mm_want_ptrs := mmGetOrders.GetOrdersMock.defaultExpectation.paramPtrs
+	if mmEraseUserData.EraseUserDataMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmEraseUserData.EraseUserDataMock.defaultExpectation.Counter, 1)
+		mm_want := mmEraseUserData.EraseUserDataMock.defaultExpectation.params
+		mm_want_ptrs := mmEraseUserData.EraseUserDataMock.defaultExpectation.paramPtrs
 
-		mm_got := UsecasesMockGetOrdersParams{req}
+		mm_got := UsecasesMockEraseUserDataParams{ctx, req}
 
 		if mm_want_ptrs != nil {
 
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmEraseUserData.t.Errorf("UsecasesMock.EraseUserData got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEraseUserData.EraseUserDataMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
 			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
-				mmGetOrders.t.Errorf("UsecasesMock.GetOrders got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetOrders.GetOrdersMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+				mmEraseUserData.t.Errorf("UsecasesMock.EraseUserData got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmEraseUserData.EraseUserDataMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetOrders.t.Errorf("UsecasesMock.GetOrders got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetOrders.GetOrdersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmEraseUserData.t.Errorf("UsecasesMock.EraseUserData got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmEraseUserData.EraseUserDataMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetOrders.GetOrdersMock.defaultExpectation.results
+		mm_results := mmEraseUserData.EraseUserDataMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetOrders.t.Fatal("No results are set for the UsecasesMock.GetOrders")
+			mmEraseUserData.t.Fatal("No results are set for the UsecasesMock.EraseUserData")
 		}
-		return (*mm_results).oa1, (*mm_results).err
+		return (*mm_results).ep1, (*mm_results).err
 	}
-	if mmGetOrders.funcGetOrders != nil {
-		return mmGetOrders.funcGetOrders(req)
+	if mmEraseUserData.funcEraseUserData != nil {
+		return mmEraseUserData.funcEraseUserData(ctx, req)
 	}
-	mmGetOrders.t.Fatalf("Unexpected call to UsecasesMock.GetOrders. %v", req)
+	mmEraseUserData.t.Fatalf("Unexpected call to UsecasesMock.EraseUserData. %v %v", ctx, req)
 	return
 }
 
-// GetOrdersAfterCounter returns a count of finished UsecasesMock.GetOrders invocations
-func (mmGetOrders *UsecasesMock) GetOrdersAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetOrders.afterGetOrdersCounter)
+// EraseUserDataAfterCounter returns a count of finished UsecasesMock.EraseUserData invocations
+func (mmEraseUserData *UsecasesMock) EraseUserDataAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEraseUserData.afterEraseUserDataCounter)
 }
 
-// GetOrdersBeforeCounter returns a count of UsecasesMock.GetOrders invocations
-func (mmGetOrders *UsecasesMock) GetOrdersBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetOrders.beforeGetOrdersCounter)
+// EraseUserDataBeforeCounter returns a count of UsecasesMock.EraseUserData invocations
+func (mmEraseUserData *UsecasesMock) EraseUserDataBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmEraseUserData.beforeEraseUserDataCounter)
 }
 
-// Calls returns a list of arguments used in each call to UsecasesMock.GetOrders.
+// Calls returns a list of arguments used in each call to UsecasesMock.EraseUserData.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetOrders *mUsecasesMockGetOrders) Calls() []*UsecasesMockGetOrdersParams {
-	mmGetOrders.mutex.RLock()
+func (mmEraseUserData *mUsecasesMockEraseUserData) Calls() []*UsecasesMockEraseUserDataParams {
+	mmEraseUserData.mutex.RLock()
 
-	argCopy := make([]*UsecasesMockGetOrdersParams, len(mmGetOrders.callArgs))
-	copy(argCopy, mmGetOrders.callArgs)
+	argCopy := make([]*UsecasesMockEraseUserDataParams, len(mmEraseUserData.callArgs))
+	copy(argCopy, mmEraseUserData.callArgs)
 
-	mmGetOrders.mutex.RUnlock()
+	mmEraseUserData.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetOrdersDone returns true if the count of the GetOrders invocations corresponds
+// MinimockEraseUserDataDone returns true if the count of the EraseUserData invocations corresponds
 // the number of defined expectations
-func (m *UsecasesMock) MinimockGetOrdersDone() bool {
-	if m.GetOrdersMock.optional {
+func (m *UsecasesMock) MinimockEraseUserDataDone() bool {
+	if m.EraseUserDataMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetOrdersMock.expectations {
+	for _, e := range m.EraseUserDataMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetOrdersMock.invocationsDone()
+	return m.EraseUserDataMock.invocationsDone()
 }
 
-// MinimockGetOrdersInspect logs each unmet expectation
-func (m *UsecasesMock) MinimockGetOrdersInspect() {
-	for _, e := range m.GetOrdersMock.expectations {
+// MinimockEraseUserDataInspect logs each unmet expectation
+func (m *UsecasesMock) MinimockEraseUserDataInspect() {
+	for _, e := range m.EraseUserDataMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UsecasesMock.GetOrders at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UsecasesMock.EraseUserData at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetOrdersCounter := mm_atomic.LoadUint64(&m.afterGetOrdersCounter)
+	afterEraseUserDataCounter := mm_atomic.LoadUint64(&m.afterEraseUserDataCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetOrdersMock.defaultExpectation != nil && afterGetOrdersCounter < 1 {
-		if m.GetOrdersMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UsecasesMock.GetOrders at\n%s", m.GetOrdersMock.defaultExpectation.returnOrigin)
+	if m.EraseUserDataMock.defaultExpectation != nil && afterEraseUserDataCounter < 1 {
+		if m.EraseUserDataMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecasesMock.EraseUserData at\n%s", m.EraseUserDataMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UsecasesMock.GetOrders at\n%s with params: %#v", m.GetOrdersMock.defaultExpectation.expectationOrigins.origin, *m.GetOrdersMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UsecasesMock.EraseUserData at\n%s with params: %#v", m.EraseUserDataMock.defaultExpectation.expectationOrigins.origin, *m.EraseUserDataMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetOrders != nil && afterGetOrdersCounter < 1 {
-		m.t.Errorf("Expected call to UsecasesMock.GetOrders at\n%s", m.funcGetOrdersOrigin)
+	if m.funcEraseUserData != nil && afterEraseUserDataCounter < 1 {
+		m.t.Errorf("Expected call to UsecasesMock.EraseUserData at\n%s", m.funcEraseUserDataOrigin)
 	}
 
-	if !m.GetOrdersMock.invocationsDone() && afterGetOrdersCounter > 0 {
-		m.t.Errorf("Expected %d calls to UsecasesMock.GetOrders at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetOrdersMock.expectedInvocations), m.GetOrdersMock.expectedInvocationsOrigin, afterGetOrdersCounter)
+	if !m.EraseUserDataMock.invocationsDone() && afterEraseUserDataCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecasesMock.EraseUserData at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.EraseUserDataMock.expectedInvocations), m.EraseUserDataMock.expectedInvocationsOrigin, afterEraseUserDataCounter)
 	}
 }
 
-type mUsecasesMockGetRefunds struct {
+type mUsecasesMockExportUserData struct {
 	optional           bool
 	mock               *UsecasesMock
-	defaultExpectation *UsecasesMockGetRefundsExpectation
-	expectations       []*UsecasesMockGetRefundsExpectation
+	defaultExpectation *UsecasesMockExportUserDataExpectation
+	expectations       []*UsecasesMockExportUserDataExpectation
 
-	callArgs []*UsecasesMockGetRefundsParams
+	callArgs []*UsecasesMockExportUserDataParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UsecasesMockGetRefundsExpectation specifies expectation struct of the Usecases.GetRefunds
-type UsecasesMockGetRefundsExpectation struct {
+// UsecasesMockExportUserDataExpectation specifies expectation struct of the Usecases.ExportUserData
+type UsecasesMockExportUserDataExpectation struct {
 	mock               *UsecasesMock
-	params             *UsecasesMockGetRefundsParams
-	paramPtrs          *UsecasesMockGetRefundsParamPtrs
-	expectationOrigins UsecasesMockGetRefundsExpectationOrigins
-	results            *UsecasesMockGetRefundsResults
+	params             *UsecasesMockExportUserDataParams
+	paramPtrs          *UsecasesMockExportUserDataParamPtrs
+	expectationOrigins UsecasesMockExportUserDataExpectationOrigins
+	results            *UsecasesMockExportUserDataResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UsecasesMockGetRefundsParams contains parameters of the Usecases.GetRefunds
-type UsecasesMockGetRefundsParams struct {
-	req *dto.ViewRefundsRequest
+// UsecasesMockExportUserDataParams contains parameters of the Usecases.ExportUserData
+type UsecasesMockExportUserDataParams struct {
+	ctx context.Context
+	req *dto.ExportUserDataRequest
 }
 
-// UsecasesMockGetRefundsParamPtrs contains pointers to parameters of the Usecases.GetRefunds
-type UsecasesMockGetRefundsParamPtrs struct {
-	req **dto.ViewRefundsRequest
+// UsecasesMockExportUserDataParamPtrs contains pointers to parameters of the Usecases.ExportUserData
+type UsecasesMockExportUserDataParamPtrs struct {
+	ctx *context.Context
+	req **dto.ExportUserDataRequest
 }
 
-// UsecasesMockGetRefundsResults contains results of the Usecases.GetRefunds
-type UsecasesMockGetRefundsResults struct {
-	oa1 []domain.OrderView
+// UsecasesMockExportUserDataResults contains results of the Usecases.ExportUserData
+type UsecasesMockExportUserDataResults struct {
+	up1 *domain.UserData
 	err error
 }
 
-// UsecasesMockGetRefundsOrigins contains origins of expectations of the Usecases.GetRefunds
-type UsecasesMockGetRefundsExpectationOrigins struct {
+// UsecasesMockExportUserDataOrigins contains origins of expectations of the Usecases.ExportUserData
+type UsecasesMockExportUserDataExpectationOrigins struct {
 	origin    string
+	originCtx string
 	originReq string
 }
 
@@ -1078,309 +1236,2397 @@ type UsecasesMockGetRefundsExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetRefunds *mUsecasesMockGetRefunds) Optional() *mUsecasesMockGetRefunds {
-	mmGetRefunds.optional = true
-	return mmGetRefunds
+func (mmExportUserData *mUsecasesMockExportUserData) Optional() *mUsecasesMockExportUserData {
+	mmExportUserData.optional = true
+	return mmExportUserData
 }
 
-// Expect sets up expected params for Usecases.GetRefunds
-func (mmGetRefunds *mUsecasesMockGetRefunds) Expect(req *dto.ViewRefundsRequest) *mUsecasesMockGetRefunds {
-	if mmGetRefunds.mock.funcGetRefunds != nil {
-		mmGetRefunds.mock.t.Fatalf("UsecasesMock.GetRefunds mock is already set by Set")
+// Expect sets up expected params for Usecases.ExportUserData
+func (mmExportUserData *mUsecasesMockExportUserData) Expect(ctx context.Context, req *dto.ExportUserDataRequest) *mUsecasesMockExportUserData {
+	if mmExportUserData.mock.funcExportUserData != nil {
+		mmExportUserData.mock.t.Fatalf("UsecasesMock.ExportUserData mock is already set by Set")
 	}
 
-	if mmGetRefunds.defaultExpectation == nil {
-		mmGetRefunds.defaultExpectation = &UsecasesMockGetRefundsExpectation{}
+	if mmExportUserData.defaultExpectation == nil {
+		mmExportUserData.defaultExpectation = &UsecasesMockExportUserDataExpectation{}
 	}
 
-	if mmGetRefunds.defaultExpectation.paramPtrs != nil {
-		mmGetRefunds.mock.t.Fatalf("UsecasesMock.GetRefunds mock is already set by ExpectParams functions")
+	if mmExportUserData.defaultExpectation.paramPtrs != nil {
+		mmExportUserData.mock.t.Fatalf("UsecasesMock.ExportUserData mock is already set by ExpectParams functions")
 	}
 
-	mmGetRefunds.defaultExpectation.params = &UsecasesMockGetRefundsParams{req}
-	mmGetRefunds.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetRefunds.expectations {
-		if minimock.Equal(e.params, mmGetRefunds.defaultExpectation.params) {
-			mmGetRefunds.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRefunds.defaultExpectation.params)
+	mmExportUserData.defaultExpectation.params = &UsecasesMockExportUserDataParams{ctx, req}
+	mmExportUserData.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExportUserData.expectations {
+		if minimock.Equal(e.params, mmExportUserData.defaultExpectation.params) {
+			mmExportUserData.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExportUserData.defaultExpectation.params)
 		}
 	}
 
-	return mmGetRefunds
+	return mmExportUserData
 }
 
-// ExpectReqParam1 sets up expected param req for Usecases.GetRefunds
-func (mmGetRefunds *mUsecasesMockGetRefunds) ExpectReqParam1(req *dto.ViewRefundsRequest) *mUsecasesMockGetRefunds {
-	if mmGetRefunds.mock.funcGetRefunds != nil {
-		mmGetRefunds.mock.t.Fatalf("UsecasesMock.GetRefunds mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Usecases.ExportUserData
+func (mmExportUserData *mUsecasesMockExportUserData) ExpectCtxParam1(ctx context.Context) *mUsecasesMockExportUserData {
+	if mmExportUserData.mock.funcExportUserData != nil {
+		mmExportUserData.mock.t.Fatalf("UsecasesMock.ExportUserData mock is already set by Set")
 	}
 
-	if mmGetRefunds.defaultExpectation == nil {
-		mmGetRefunds.defaultExpectation = &UsecasesMockGetRefundsExpectation{}
+	if mmExportUserData.defaultExpectation == nil {
+		mmExportUserData.defaultExpectation = &UsecasesMockExportUserDataExpectation{}
 	}
 
-	if mmGetRefunds.defaultExpectation.params != nil {
-		mmGetRefunds.mock.t.Fatalf("UsecasesMock.GetRefunds mock is already set by Expect")
+	if mmExportUserData.defaultExpectation.params != nil {
+		mmExportUserData.mock.t.Fatalf("UsecasesMock.ExportUserData mock is already set by Expect")
 	}
 
-	if mmGetRefunds.defaultExpectation.paramPtrs == nil {
-		mmGetRefunds.defaultExpectation.paramPtrs = &UsecasesMockGetRefundsParamPtrs{}
+	if mmExportUserData.defaultExpectation.paramPtrs == nil {
+		mmExportUserData.defaultExpectation.paramPtrs = &UsecasesMockExportUserDataParamPtrs{}
 	}
-	mmGetRefunds.defaultExpectation.paramPtrs.req = &req
-	mmGetRefunds.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+	mmExportUserData.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExportUserData.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetRefunds
+	return mmExportUserData
 }
 
-// Inspect accepts an inspector function that has same arguments as the Usecases.GetRefunds
-func (mmGetRefunds *mUsecasesMockGetRefunds) Inspect(f func(req *dto.ViewRefundsRequest)) *mUsecasesMockGetRefunds {
-	if mmGetRefunds.mock.inspectFuncGetRefunds != nil {
-		mmGetRefunds.mock.t.Fatalf("Inspect function is already set for UsecasesMock.GetRefunds")
+// ExpectReqParam2 sets up expected param req for Usecases.ExportUserData
+func (mmExportUserData *mUsecasesMockExportUserData) ExpectReqParam2(req *dto.ExportUserDataRequest) *mUsecasesMockExportUserData {
+	if mmExportUserData.mock.funcExportUserData != nil {
+		mmExportUserData.mock.t.Fatalf("UsecasesMock.ExportUserData mock is already set by Set")
 	}
 
-	mmGetRefunds.mock.inspectFuncGetRefunds = f
+	if mmExportUserData.defaultExpectation == nil {
+		mmExportUserData.defaultExpectation = &UsecasesMockExportUserDataExpectation{}
+	}
+
+	if mmExportUserData.defaultExpectation.params != nil {
+		mmExportUserData.mock.t.Fatalf("UsecasesMock.ExportUserData mock is already set by Expect")
+	}
+
+	if mmExportUserData.defaultExpectation.paramPtrs == nil {
+		mmExportUserData.defaultExpectation.paramPtrs = &UsecasesMockExportUserDataParamPtrs{}
+	}
+	mmExportUserData.defaultExpectation.paramPtrs.req = &req
+	mmExportUserData.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmExportUserData
+}
+
+// Inspect accepts an inspector function that has same arguments as the Usecases.ExportUserData
+func (mmExportUserData *mUsecasesMockExportUserData) Inspect(f func(ctx context.Context, req *dto.ExportUserDataRequest)) *mUsecasesMockExportUserData {
+	if mmExportUserData.mock.inspectFuncExportUserData != nil {
+		mmExportUserData.mock.t.Fatalf("Inspect function is already set for UsecasesMock.ExportUserData")
+	}
+
+	mmExportUserData.mock.inspectFuncExportUserData = f
+
+	return mmExportUserData
+}
+
+// Return sets up results that will be returned by Usecases.ExportUserData
+func (mmExportUserData *mUsecasesMockExportUserData) Return(up1 *domain.UserData, err error) *UsecasesMock {
+	if mmExportUserData.mock.funcExportUserData != nil {
+		mmExportUserData.mock.t.Fatalf("UsecasesMock.ExportUserData mock is already set by Set")
+	}
+
+	if mmExportUserData.defaultExpectation == nil {
+		mmExportUserData.defaultExpectation = &UsecasesMockExportUserDataExpectation{mock: mmExportUserData.mock}
+	}
+	mmExportUserData.defaultExpectation.results = &UsecasesMockExportUserDataResults{up1, err}
+	mmExportUserData.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExportUserData.mock
+}
+
+// Set uses given function f to mock the Usecases.ExportUserData method
+func (mmExportUserData *mUsecasesMockExportUserData) Set(f func(ctx context.Context, req *dto.ExportUserDataRequest) (up1 *domain.UserData, err error)) *UsecasesMock {
+	if mmExportUserData.defaultExpectation != nil {
+		mmExportUserData.mock.t.Fatalf("Default expectation is already set for the Usecases.ExportUserData method")
+	}
+
+	if len(mmExportUserData.expectations) > 0 {
+		mmExportUserData.mock.t.Fatalf("Some expectations are already set for the Usecases.ExportUserData method")
+	}
+
+	mmExportUserData.mock.funcExportUserData = f
+	mmExportUserData.mock.funcExportUserDataOrigin = minimock.CallerInfo(1)
+	return mmExportUserData.mock
+}
+
+// When sets expectation for the Usecases.ExportUserData which will trigger the result defined by the following
+// Then helper
+func (mmExportUserData *mUsecasesMockExportUserData) When(ctx context.Context, req *dto.ExportUserDataRequest) *UsecasesMockExportUserDataExpectation {
+	if mmExportUserData.mock.funcExportUserData != nil {
+		mmExportUserData.mock.t.Fatalf("UsecasesMock.ExportUserData mock is already set by Set")
+	}
+
+	expectation := &UsecasesMockExportUserDataExpectation{
+		mock:               mmExportUserData.mock,
+		params:             &UsecasesMockExportUserDataParams{ctx, req},
+		expectationOrigins: UsecasesMockExportUserDataExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExportUserData.expectations = append(mmExportUserData.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Usecases.ExportUserData return parameters for the expectation previously defined by the When method
+func (e *UsecasesMockExportUserDataExpectation) Then(up1 *domain.UserData, err error) *UsecasesMock {
+	e.results = &UsecasesMockExportUserDataResults{up1, err}
+	return e.mock
+}
+
+// Times sets number of times Usecases.ExportUserData should be invoked
+func (mmExportUserData *mUsecasesMockExportUserData) Times(n uint64) *mUsecasesMockExportUserData {
+	if n == 0 {
+		mmExportUserData.mock.t.Fatalf("Times of UsecasesMock.ExportUserData mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExportUserData.expectedInvocations, n)
+	mmExportUserData.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExportUserData
+}
+
+func (mmExportUserData *mUsecasesMockExportUserData) invocationsDone() bool {
+	if len(mmExportUserData.expectations) == 0 && mmExportUserData.defaultExpectation == nil && mmExportUserData.mock.funcExportUserData == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExportUserData.mock.afterExportUserDataCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExportUserData.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ExportUserData implements mm_manager_service.Usecases
+func (mmExportUserData *UsecasesMock) ExportUserData(ctx context.Context, req *dto.ExportUserDataRequest) (up1 *domain.UserData, err error) {
+	mm_atomic.AddUint64(&mmExportUserData.beforeExportUserDataCounter, 1)
+	defer mm_atomic.AddUint64(&mmExportUserData.afterExportUserDataCounter, 1)
+
+	mmExportUserData.t.Helper()
+
+	if mmExportUserData.inspectFuncExportUserData != nil {
+		mmExportUserData.inspectFuncExportUserData(ctx, req)
+	}
+
+	mm_params := UsecasesMockExportUserDataParams{ctx, req}
+
+	// Record call args
+	mmExportUserData.ExportUserDataMock.mutex.Lock()
+	mmExportUserData.ExportUserDataMock.callArgs = append(mmExportUserData.ExportUserDataMock.callArgs, &mm_params)
+	mmExportUserData.ExportUserDataMock.mutex.Unlock()
+
+	for _, e := range mmExportUserData.ExportUserDataMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.up1, e.results.err
+		}
+	}
+
+	if mmExportUserData.ExportUserDataMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExportUserData.ExportUserDataMock.defaultExpectation.Counter, 1)
+		mm_want := mmExportUserData.ExportUserDataMock.defaultExpectation.params
+		mm_want_ptrs := mmExportUserData.ExportUserDataMock.defaultExpectation.paramPtrs
+
+		mm_got := UsecasesMockExportUserDataParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExportUserData.t.Errorf("UsecasesMock.ExportUserData got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportUserData.ExportUserDataMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmExportUserData.t.Errorf("UsecasesMock.ExportUserData got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExportUserData.ExportUserDataMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExportUserData.t.Errorf("UsecasesMock.ExportUserData got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExportUserData.ExportUserDataMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExportUserData.ExportUserDataMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExportUserData.t.Fatal("No results are set for the UsecasesMock.ExportUserData")
+		}
+		return (*mm_results).up1, (*mm_results).err
+	}
+	if mmExportUserData.funcExportUserData != nil {
+		return mmExportUserData.funcExportUserData(ctx, req)
+	}
+	mmExportUserData.t.Fatalf("Unexpected call to UsecasesMock.ExportUserData. %v %v", ctx, req)
+	return
+}
+
+// ExportUserDataAfterCounter returns a count of finished UsecasesMock.ExportUserData invocations
+func (mmExportUserData *UsecasesMock) ExportUserDataAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportUserData.afterExportUserDataCounter)
+}
+
+// ExportUserDataBeforeCounter returns a count of UsecasesMock.ExportUserData invocations
+func (mmExportUserData *UsecasesMock) ExportUserDataBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExportUserData.beforeExportUserDataCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsecasesMock.ExportUserData.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExportUserData *mUsecasesMockExportUserData) Calls() []*UsecasesMockExportUserDataParams {
+	mmExportUserData.mutex.RLock()
+
+	argCopy := make([]*UsecasesMockExportUserDataParams, len(mmExportUserData.callArgs))
+	copy(argCopy, mmExportUserData.callArgs)
+
+	mmExportUserData.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExportUserDataDone returns true if the count of the ExportUserData invocations corresponds
+// the number of defined expectations
+func (m *UsecasesMock) MinimockExportUserDataDone() bool {
+	if m.ExportUserDataMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExportUserDataMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExportUserDataMock.invocationsDone()
+}
+
+// MinimockExportUserDataInspect logs each unmet expectation
+func (m *UsecasesMock) MinimockExportUserDataInspect() {
+	for _, e := range m.ExportUserDataMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsecasesMock.ExportUserData at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExportUserDataCounter := mm_atomic.LoadUint64(&m.afterExportUserDataCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExportUserDataMock.defaultExpectation != nil && afterExportUserDataCounter < 1 {
+		if m.ExportUserDataMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecasesMock.ExportUserData at\n%s", m.ExportUserDataMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsecasesMock.ExportUserData at\n%s with params: %#v", m.ExportUserDataMock.defaultExpectation.expectationOrigins.origin, *m.ExportUserDataMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExportUserData != nil && afterExportUserDataCounter < 1 {
+		m.t.Errorf("Expected call to UsecasesMock.ExportUserData at\n%s", m.funcExportUserDataOrigin)
+	}
+
+	if !m.ExportUserDataMock.invocationsDone() && afterExportUserDataCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecasesMock.ExportUserData at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExportUserDataMock.expectedInvocations), m.ExportUserDataMock.expectedInvocationsOrigin, afterExportUserDataCounter)
+	}
+}
+
+type mUsecasesMockGetOrder struct {
+	optional           bool
+	mock               *UsecasesMock
+	defaultExpectation *UsecasesMockGetOrderExpectation
+	expectations       []*UsecasesMockGetOrderExpectation
+
+	callArgs []*UsecasesMockGetOrderParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsecasesMockGetOrderExpectation specifies expectation struct of the Usecases.GetOrder
+type UsecasesMockGetOrderExpectation struct {
+	mock               *UsecasesMock
+	params             *UsecasesMockGetOrderParams
+	paramPtrs          *UsecasesMockGetOrderParamPtrs
+	expectationOrigins UsecasesMockGetOrderExpectationOrigins
+	results            *UsecasesMockGetOrderResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsecasesMockGetOrderParams contains parameters of the Usecases.GetOrder
+type UsecasesMockGetOrderParams struct {
+	ctx context.Context
+	req *dto.GetOrderRequest
+}
+
+// UsecasesMockGetOrderParamPtrs contains pointers to parameters of the Usecases.GetOrder
+type UsecasesMockGetOrderParamPtrs struct {
+	ctx *context.Context
+	req **dto.GetOrderRequest
+}
+
+// UsecasesMockGetOrderResults contains results of the Usecases.GetOrder
+type UsecasesMockGetOrderResults struct {
+	gp1 *dto.GetOrderResponse
+	err error
+}
+
+// UsecasesMockGetOrderOrigins contains origins of expectations of the Usecases.GetOrder
+type UsecasesMockGetOrderExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetOrder *mUsecasesMockGetOrder) Optional() *mUsecasesMockGetOrder {
+	mmGetOrder.optional = true
+	return mmGetOrder
+}
+
+// Expect sets up expected params for Usecases.GetOrder
+func (mmGetOrder *mUsecasesMockGetOrder) Expect(ctx context.Context, req *dto.GetOrderRequest) *mUsecasesMockGetOrder {
+	if mmGetOrder.mock.funcGetOrder != nil {
+		mmGetOrder.mock.t.Fatalf("UsecasesMock.GetOrder mock is already set by Set")
+	}
+
+	if mmGetOrder.defaultExpectation == nil {
+		mmGetOrder.defaultExpectation = &UsecasesMockGetOrderExpectation{}
+	}
+
+	if mmGetOrder.defaultExpectation.paramPtrs != nil {
+		mmGetOrder.mock.t.Fatalf("UsecasesMock.GetOrder mock is already set by ExpectParams functions")
+	}
+
+	mmGetOrder.defaultExpectation.params = &UsecasesMockGetOrderParams{ctx, req}
+	mmGetOrder.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOrder.expectations {
+		if minimock.Equal(e.params, mmGetOrder.defaultExpectation.params) {
+			mmGetOrder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrder.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOrder
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Usecases.GetOrder
+func (mmGetOrder *mUsecasesMockGetOrder) ExpectCtxParam1(ctx context.Context) *mUsecasesMockGetOrder {
+	if mmGetOrder.mock.funcGetOrder != nil {
+		mmGetOrder.mock.t.Fatalf("UsecasesMock.GetOrder mock is already set by Set")
+	}
+
+	if mmGetOrder.defaultExpectation == nil {
+		mmGetOrder.defaultExpectation = &UsecasesMockGetOrderExpectation{}
+	}
+
+	if mmGetOrder.defaultExpectation.params != nil {
+		mmGetOrder.mock.t.Fatalf("UsecasesMock.GetOrder mock is already set by Expect")
+	}
+
+	if mmGetOrder.defaultExpectation.paramPtrs == nil {
+		mmGetOrder.defaultExpectation.paramPtrs = &UsecasesMockGetOrderParamPtrs{}
+	}
+	mmGetOrder.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOrder.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetOrder
+}
+
+// ExpectReqParam2 sets up expected param req for Usecases.GetOrder
+func (mmGetOrder *mUsecasesMockGetOrder) ExpectReqParam2(req *dto.GetOrderRequest) *mUsecasesMockGetOrder {
+	if mmGetOrder.mock.funcGetOrder != nil {
+		mmGetOrder.mock.t.Fatalf("UsecasesMock.GetOrder mock is already set by Set")
+	}
+
+	if mmGetOrder.defaultExpectation == nil {
+		mmGetOrder.defaultExpectation = &UsecasesMockGetOrderExpectation{}
+	}
+
+	if mmGetOrder.defaultExpectation.params != nil {
+		mmGetOrder.mock.t.Fatalf("UsecasesMock.GetOrder mock is already set by Expect")
+	}
+
+	if mmGetOrder.defaultExpectation.paramPtrs == nil {
+		mmGetOrder.defaultExpectation.paramPtrs = &UsecasesMockGetOrderParamPtrs{}
+	}
+	mmGetOrder.defaultExpectation.paramPtrs.req = &req
+	mmGetOrder.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmGetOrder
+}
+
+// Inspect accepts an inspector function that has same arguments as the Usecases.GetOrder
+func (mmGetOrder *mUsecasesMockGetOrder) Inspect(f func(ctx context.Context, req *dto.GetOrderRequest)) *mUsecasesMockGetOrder {
+	if mmGetOrder.mock.inspectFuncGetOrder != nil {
+		mmGetOrder.mock.t.Fatalf("Inspect function is already set for UsecasesMock.GetOrder")
+	}
+
+	mmGetOrder.mock.inspectFuncGetOrder = f
+
+	return mmGetOrder
+}
+
+// Return sets up results that will be returned by Usecases.GetOrder
+func (mmGetOrder *mUsecasesMockGetOrder) Return(gp1 *dto.GetOrderResponse, err error) *UsecasesMock {
+	if mmGetOrder.mock.funcGetOrder != nil {
+		mmGetOrder.mock.t.Fatalf("UsecasesMock.GetOrder mock is already set by Set")
+	}
+
+	if mmGetOrder.defaultExpectation == nil {
+		mmGetOrder.defaultExpectation = &UsecasesMockGetOrderExpectation{mock: mmGetOrder.mock}
+	}
+	mmGetOrder.defaultExpectation.results = &UsecasesMockGetOrderResults{gp1, err}
+	mmGetOrder.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOrder.mock
+}
+
+// Set uses given function f to mock the Usecases.GetOrder method
+func (mmGetOrder *mUsecasesMockGetOrder) Set(f func(ctx context.Context, req *dto.GetOrderRequest) (gp1 *dto.GetOrderResponse, err error)) *UsecasesMock {
+	if mmGetOrder.defaultExpectation != nil {
+		mmGetOrder.mock.t.Fatalf("Default expectation is already set for the Usecases.GetOrder method")
+	}
+
+	if len(mmGetOrder.expectations) > 0 {
+		mmGetOrder.mock.t.Fatalf("Some expectations are already set for the Usecases.GetOrder method")
+	}
+
+	mmGetOrder.mock.funcGetOrder = f
+	mmGetOrder.mock.funcGetOrderOrigin = minimock.CallerInfo(1)
+	return mmGetOrder.mock
+}
+
+// When sets expectation for the Usecases.GetOrder which will trigger the result defined by the following
+// Then helper
+func (mmGetOrder *mUsecasesMockGetOrder) When(ctx context.Context, req *dto.GetOrderRequest) *UsecasesMockGetOrderExpectation {
+	if mmGetOrder.mock.funcGetOrder != nil {
+		mmGetOrder.mock.t.Fatalf("UsecasesMock.GetOrder mock is already set by Set")
+	}
+
+	expectation := &UsecasesMockGetOrderExpectation{
+		mock:               mmGetOrder.mock,
+		params:             &UsecasesMockGetOrderParams{ctx, req},
+		expectationOrigins: UsecasesMockGetOrderExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetOrder.expectations = append(mmGetOrder.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Usecases.GetOrder return parameters for the expectation previously defined by the When method
+func (e *UsecasesMockGetOrderExpectation) Then(gp1 *dto.GetOrderResponse, err error) *UsecasesMock {
+	e.results = &UsecasesMockGetOrderResults{gp1, err}
+	return e.mock
+}
+
+// Times sets number of times Usecases.GetOrder should be invoked
+func (mmGetOrder *mUsecasesMockGetOrder) Times(n uint64) *mUsecasesMockGetOrder {
+	if n == 0 {
+		mmGetOrder.mock.t.Fatalf("Times of UsecasesMock.GetOrder mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetOrder.expectedInvocations, n)
+	mmGetOrder.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOrder
+}
+
+func (mmGetOrder *mUsecasesMockGetOrder) invocationsDone() bool {
+	if len(mmGetOrder.expectations) == 0 && mmGetOrder.defaultExpectation == nil && mmGetOrder.mock.funcGetOrder == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOrder.mock.afterGetOrderCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOrder.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetOrder implements mm_manager_service.Usecases
+func (mmGetOrder *UsecasesMock) GetOrder(ctx context.Context, req *dto.GetOrderRequest) (gp1 *dto.GetOrderResponse, err error) {
+	mm_atomic.AddUint64(&mmGetOrder.beforeGetOrderCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOrder.afterGetOrderCounter, 1)
+
+	mmGetOrder.t.Helper()
+
+	if mmGetOrder.inspectFuncGetOrder != nil {
+		mmGetOrder.inspectFuncGetOrder(ctx, req)
+	}
+
+	mm_params := UsecasesMockGetOrderParams{ctx, req}
+
+	// Record call args
+	mmGetOrder.GetOrderMock.mutex.Lock()
+	mmGetOrder.GetOrderMock.callArgs = append(mmGetOrder.GetOrderMock.callArgs, &mm_params)
+	mmGetOrder.GetOrderMock.mutex.Unlock()
+
+	for _, e := range mmGetOrder.GetOrderMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.gp1, e.results.err
+		}
+	}
+
+	if mmGetOrder.GetOrderMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOrder.GetOrderMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOrder.GetOrderMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOrder.GetOrderMock.defaultExpectation.paramPtrs
+
+		mm_got := UsecasesMockGetOrderParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetOrder.t.Errorf("UsecasesMock.GetOrder got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrder.GetOrderMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmGetOrder.t.Errorf("UsecasesMock.GetOrder got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrder.GetOrderMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOrder.t.Errorf("UsecasesMock.GetOrder got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetOrder.GetOrderMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOrder.GetOrderMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOrder.t.Fatal("No results are set for the UsecasesMock.GetOrder")
+		}
+		return (*mm_results).gp1, (*mm_results).err
+	}
+	if mmGetOrder.funcGetOrder != nil {
+		return mmGetOrder.funcGetOrder(ctx, req)
+	}
+	mmGetOrder.t.Fatalf("Unexpected call to UsecasesMock.GetOrder. %v %v", ctx, req)
+	return
+}
+
+// GetOrderAfterCounter returns a count of finished UsecasesMock.GetOrder invocations
+func (mmGetOrder *UsecasesMock) GetOrderAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrder.afterGetOrderCounter)
+}
+
+// GetOrderBeforeCounter returns a count of UsecasesMock.GetOrder invocations
+func (mmGetOrder *UsecasesMock) GetOrderBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrder.beforeGetOrderCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsecasesMock.GetOrder.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOrder *mUsecasesMockGetOrder) Calls() []*UsecasesMockGetOrderParams {
+	mmGetOrder.mutex.RLock()
+
+	argCopy := make([]*UsecasesMockGetOrderParams, len(mmGetOrder.callArgs))
+	copy(argCopy, mmGetOrder.callArgs)
+
+	mmGetOrder.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOrderDone returns true if the count of the GetOrder invocations corresponds
+// the number of defined expectations
+func (m *UsecasesMock) MinimockGetOrderDone() bool {
+	if m.GetOrderMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetOrderMock.invocationsDone()
+}
+
+// MinimockGetOrderInspect logs each unmet expectation
+func (m *UsecasesMock) MinimockGetOrderInspect() {
+	for _, e := range m.GetOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsecasesMock.GetOrder at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetOrderCounter := mm_atomic.LoadUint64(&m.afterGetOrderCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrderMock.defaultExpectation != nil && afterGetOrderCounter < 1 {
+		if m.GetOrderMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecasesMock.GetOrder at\n%s", m.GetOrderMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsecasesMock.GetOrder at\n%s with params: %#v", m.GetOrderMock.defaultExpectation.expectationOrigins.origin, *m.GetOrderMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrder != nil && afterGetOrderCounter < 1 {
+		m.t.Errorf("Expected call to UsecasesMock.GetOrder at\n%s", m.funcGetOrderOrigin)
+	}
+
+	if !m.GetOrderMock.invocationsDone() && afterGetOrderCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecasesMock.GetOrder at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetOrderMock.expectedInvocations), m.GetOrderMock.expectedInvocationsOrigin, afterGetOrderCounter)
+	}
+}
+
+type mUsecasesMockGetOrders struct {
+	optional           bool
+	mock               *UsecasesMock
+	defaultExpectation *UsecasesMockGetOrdersExpectation
+	expectations       []*UsecasesMockGetOrdersExpectation
+
+	callArgs []*UsecasesMockGetOrdersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsecasesMockGetOrdersExpectation specifies expectation struct of the Usecases.GetOrders
+type UsecasesMockGetOrdersExpectation struct {
+	mock               *UsecasesMock
+	params             *UsecasesMockGetOrdersParams
+	paramPtrs          *UsecasesMockGetOrdersParamPtrs
+	expectationOrigins UsecasesMockGetOrdersExpectationOrigins
+	results            *UsecasesMockGetOrdersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsecasesMockGetOrdersParams contains parameters of the Usecases.GetOrders
+type UsecasesMockGetOrdersParams struct {
+	ctx context.Context
+	req *dto.ViewOrdersRequest
+}
+
+// UsecasesMockGetOrdersParamPtrs contains pointers to parameters of the Usecases.GetOrders
+type UsecasesMockGetOrdersParamPtrs struct {
+	ctx *context.Context
+	req **dto.ViewOrdersRequest
+}
+
+// UsecasesMockGetOrdersResults contains results of the Usecases.GetOrders
+type UsecasesMockGetOrdersResults struct {
+	oa1 []domain.OrderView
+	err error
+}
+
+// UsecasesMockGetOrdersOrigins contains origins of expectations of the Usecases.GetOrders
+type UsecasesMockGetOrdersExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetOrders *mUsecasesMockGetOrders) Optional() *mUsecasesMockGetOrders {
+	mmGetOrders.optional = true
+	return mmGetOrders
+}
+
+// Expect sets up expected params for Usecases.GetOrders
+func (mmGetOrders *mUsecasesMockGetOrders) Expect(ctx context.Context, req *dto.ViewOrdersRequest) *mUsecasesMockGetOrders {
+	if mmGetOrders.mock.funcGetOrders != nil {
+		mmGetOrders.mock.t.Fatalf("UsecasesMock.GetOrders mock is already set by Set")
+	}
+
+	if mmGetOrders.defaultExpectation == nil {
+		mmGetOrders.defaultExpectation = &UsecasesMockGetOrdersExpectation{}
+	}
+
+	if mmGetOrders.defaultExpectation.paramPtrs != nil {
+		mmGetOrders.mock.t.Fatalf("UsecasesMock.GetOrders mock is already set by ExpectParams functions")
+	}
+
+	mmGetOrders.defaultExpectation.params = &UsecasesMockGetOrdersParams{ctx, req}
+	mmGetOrders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOrders.expectations {
+		if minimock.Equal(e.params, mmGetOrders.defaultExpectation.params) {
+			mmGetOrders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrders.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOrders
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Usecases.GetOrders
+func (mmGetOrders *mUsecasesMockGetOrders) ExpectCtxParam1(ctx context.Context) *mUsecasesMockGetOrders {
+	if mmGetOrders.mock.funcGetOrders != nil {
+		mmGetOrders.mock.t.Fatalf("UsecasesMock.GetOrders mock is already set by Set")
+	}
+
+	if mmGetOrders.defaultExpectation == nil {
+		mmGetOrders.defaultExpectation = &UsecasesMockGetOrdersExpectation{}
+	}
+
+	if mmGetOrders.defaultExpectation.params != nil {
+		mmGetOrders.mock.t.Fatalf("UsecasesMock.GetOrders mock is already set by Expect")
+	}
+
+	if mmGetOrders.defaultExpectation.paramPtrs == nil {
+		mmGetOrders.defaultExpectation.paramPtrs = &UsecasesMockGetOrdersParamPtrs{}
+	}
+	mmGetOrders.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOrders.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetOrders
+}
+
+// ExpectReqParam2 sets up expected param req for Usecases.GetOrders
+func (mmGetOrders *mUsecasesMockGetOrders) ExpectReqParam2(req *dto.ViewOrdersRequest) *mUsecasesMockGetOrders {
+	if mmGetOrders.mock.funcGetOrders != nil {
+		mmGetOrders.mock.t.Fatalf("UsecasesMock.GetOrders mock is already set by Set")
+	}
+
+	if mmGetOrders.defaultExpectation == nil {
+		mmGetOrders.defaultExpectation = &UsecasesMockGetOrdersExpectation{}
+	}
+
+	if mmGetOrders.defaultExpectation.params != nil {
+		mmGetOrders.mock.t.Fatalf("UsecasesMock.GetOrders mock is already set by Expect")
+	}
+
+	if mmGetOrders.defaultExpectation.paramPtrs == nil {
+		mmGetOrders.defaultExpectation.paramPtrs = &UsecasesMockGetOrdersParamPtrs{}
+	}
+	mmGetOrders.defaultExpectation.paramPtrs.req = &req
+	mmGetOrders.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmGetOrders
+}
+
+// Inspect accepts an inspector function that has same arguments as the Usecases.GetOrders
+func (mmGetOrders *mUsecasesMockGetOrders) Inspect(f func(ctx context.Context, req *dto.ViewOrdersRequest)) *mUsecasesMockGetOrders {
+	if mmGetOrders.mock.inspectFuncGetOrders != nil {
+		mmGetOrders.mock.t.Fatalf("Inspect function is already set for UsecasesMock.GetOrders")
+	}
+
+	mmGetOrders.mock.inspectFuncGetOrders = f
+
+	return mmGetOrders
+}
+
+// Return sets up results that will be returned by Usecases.GetOrders
+func (mmGetOrders *mUsecasesMockGetOrders) Return(oa1 []domain.OrderView, err error) *UsecasesMock {
+	if mmGetOrders.mock.funcGetOrders != nil {
+		mmGetOrders.mock.t.Fatalf("UsecasesMock.GetOrders mock is already set by Set")
+	}
+
+	if mmGetOrders.defaultExpectation == nil {
+		mmGetOrders.defaultExpectation = &UsecasesMockGetOrdersExpectation{mock: mmGetOrders.mock}
+	}
+	mmGetOrders.defaultExpectation.results = &UsecasesMockGetOrdersResults{oa1, err}
+	mmGetOrders.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOrders.mock
+}
+
+// Set uses given function f to mock the Usecases.GetOrders method
+func (mmGetOrders *mUsecasesMockGetOrders) Set(f func(ctx context.Context, req *dto.ViewOrdersRequest) (oa1 []domain.OrderView, err error)) *UsecasesMock {
+	if mmGetOrders.defaultExpectation != nil {
+		mmGetOrders.mock.t.Fatalf("Default expectation is already set for the Usecases.GetOrders method")
+	}
+
+	if len(mmGetOrders.expectations) > 0 {
+		mmGetOrders.mock.t.Fatalf("Some expectations are already set for the Usecases.GetOrders method")
+	}
+
+	mmGetOrders.mock.funcGetOrders = f
+	mmGetOrders.mock.funcGetOrdersOrigin = minimock.CallerInfo(1)
+	return mmGetOrders.mock
+}
+
+// When sets expectation for the Usecases.GetOrders which will trigger the result defined by the following
+// Then helper
+func (mmGetOrders *mUsecasesMockGetOrders) When(ctx context.Context, req *dto.ViewOrdersRequest) *UsecasesMockGetOrdersExpectation {
+	if mmGetOrders.mock.funcGetOrders != nil {
+		mmGetOrders.mock.t.Fatalf("UsecasesMock.GetOrders mock is already set by Set")
+	}
+
+	expectation := &UsecasesMockGetOrdersExpectation{
+		mock:               mmGetOrders.mock,
+		params:             &UsecasesMockGetOrdersParams{ctx, req},
+		expectationOrigins: UsecasesMockGetOrdersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetOrders.expectations = append(mmGetOrders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Usecases.GetOrders return parameters for the expectation previously defined by the When method
+func (e *UsecasesMockGetOrdersExpectation) Then(oa1 []domain.OrderView, err error) *UsecasesMock {
+	e.results = &UsecasesMockGetOrdersResults{oa1, err}
+	return e.mock
+}
+
+// Times sets number of times Usecases.GetOrders should be invoked
+func (mmGetOrders *mUsecasesMockGetOrders) Times(n uint64) *mUsecasesMockGetOrders {
+	if n == 0 {
+		mmGetOrders.mock.t.Fatalf("Times of UsecasesMock.GetOrders mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetOrders.expectedInvocations, n)
+	mmGetOrders.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOrders
+}
+
+func (mmGetOrders *mUsecasesMockGetOrders) invocationsDone() bool {
+	if len(mmGetOrders.expectations) == 0 && mmGetOrders.defaultExpectation == nil && mmGetOrders.mock.funcGetOrders == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOrders.mock.afterGetOrdersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOrders.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetOrders implements mm_manager_service.Usecases
+func (mmGetOrders *UsecasesMock) GetOrders(ctx context.Context, req *dto.ViewOrdersRequest) (oa1 []domain.OrderView, err error) {
+	mm_atomic.AddUint64(&mmGetOrders.beforeGetOrdersCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOrders.afterGetOrdersCounter, 1)
+
+	mmGetOrders.t.Helper()
+
+	if mmGetOrders.inspectFuncGetOrders != nil {
+		mmGetOrders.inspectFuncGetOrders(ctx, req)
+	}
+
+	mm_params := UsecasesMockGetOrdersParams{ctx, req}
+
+	// Record call args
+	mmGetOrders.GetOrdersMock.mutex.Lock()
+	mmGetOrders.GetOrdersMock.callArgs = append(mmGetOrders.GetOrdersMock.callArgs, &mm_params)
+	mmGetOrders.GetOrdersMock.mutex.Unlock()
+
+	for _, e := range mmGetOrders.GetOrdersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.oa1, e.results.err
+		}
+	}
+
+	if mmGetOrders.GetOrdersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOrders.GetOrdersMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOrders.GetOrdersMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOrders.GetOrdersMock.defaultExpectation.paramPtrs
+
+		mm_got := UsecasesMockGetOrdersParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetOrders.t.Errorf("UsecasesMock.GetOrders got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrders.GetOrdersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmGetOrders.t.Errorf("UsecasesMock.GetOrders got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrders.GetOrdersMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOrders.t.Errorf("UsecasesMock.GetOrders got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetOrders.GetOrdersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOrders.GetOrdersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOrders.t.Fatal("No results are set for the UsecasesMock.GetOrders")
+		}
+		return (*mm_results).oa1, (*mm_results).err
+	}
+	if mmGetOrders.funcGetOrders != nil {
+		return mmGetOrders.funcGetOrders(ctx, req)
+	}
+	mmGetOrders.t.Fatalf("Unexpected call to UsecasesMock.GetOrders. %v %v", ctx, req)
+	return
+}
+
+// GetOrdersAfterCounter returns a count of finished UsecasesMock.GetOrders invocations
+func (mmGetOrders *UsecasesMock) GetOrdersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrders.afterGetOrdersCounter)
+}
+
+// GetOrdersBeforeCounter returns a count of UsecasesMock.GetOrders invocations
+func (mmGetOrders *UsecasesMock) GetOrdersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrders.beforeGetOrdersCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsecasesMock.GetOrders.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOrders *mUsecasesMockGetOrders) Calls() []*UsecasesMockGetOrdersParams {
+	mmGetOrders.mutex.RLock()
+
+	argCopy := make([]*UsecasesMockGetOrdersParams, len(mmGetOrders.callArgs))
+	copy(argCopy, mmGetOrders.callArgs)
+
+	mmGetOrders.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOrdersDone returns true if the count of the GetOrders invocations corresponds
+// the number of defined expectations
+func (m *UsecasesMock) MinimockGetOrdersDone() bool {
+	if m.GetOrdersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetOrdersMock.invocationsDone()
+}
+
+// MinimockGetOrdersInspect logs each unmet expectation
+func (m *UsecasesMock) MinimockGetOrdersInspect() {
+	for _, e := range m.GetOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsecasesMock.GetOrders at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetOrdersCounter := mm_atomic.LoadUint64(&m.afterGetOrdersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrdersMock.defaultExpectation != nil && afterGetOrdersCounter < 1 {
+		if m.GetOrdersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecasesMock.GetOrders at\n%s", m.GetOrdersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsecasesMock.GetOrders at\n%s with params: %#v", m.GetOrdersMock.defaultExpectation.expectationOrigins.origin, *m.GetOrdersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrders != nil && afterGetOrdersCounter < 1 {
+		m.t.Errorf("Expected call to UsecasesMock.GetOrders at\n%s", m.funcGetOrdersOrigin)
+	}
+
+	if !m.GetOrdersMock.invocationsDone() && afterGetOrdersCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecasesMock.GetOrders at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetOrdersMock.expectedInvocations), m.GetOrdersMock.expectedInvocationsOrigin, afterGetOrdersCounter)
+	}
+}
+
+type mUsecasesMockGetRefunds struct {
+	optional           bool
+	mock               *UsecasesMock
+	defaultExpectation *UsecasesMockGetRefundsExpectation
+	expectations       []*UsecasesMockGetRefundsExpectation
+
+	callArgs []*UsecasesMockGetRefundsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsecasesMockGetRefundsExpectation specifies expectation struct of the Usecases.GetRefunds
+type UsecasesMockGetRefundsExpectation struct {
+	mock               *UsecasesMock
+	params             *UsecasesMockGetRefundsParams
+	paramPtrs          *UsecasesMockGetRefundsParamPtrs
+	expectationOrigins UsecasesMockGetRefundsExpectationOrigins
+	results            *UsecasesMockGetRefundsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsecasesMockGetRefundsParams contains parameters of the Usecases.GetRefunds
+type UsecasesMockGetRefundsParams struct {
+	ctx context.Context
+	req *dto.ViewRefundsRequest
+}
+
+// UsecasesMockGetRefundsParamPtrs contains pointers to parameters of the Usecases.GetRefunds
+type UsecasesMockGetRefundsParamPtrs struct {
+	ctx *context.Context
+	req **dto.ViewRefundsRequest
+}
+
+// UsecasesMockGetRefundsResults contains results of the Usecases.GetRefunds
+type UsecasesMockGetRefundsResults struct {
+	oa1 []domain.OrderView
+	err error
+}
+
+// UsecasesMockGetRefundsOrigins contains origins of expectations of the Usecases.GetRefunds
+type UsecasesMockGetRefundsExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetRefunds *mUsecasesMockGetRefunds) Optional() *mUsecasesMockGetRefunds {
+	mmGetRefunds.optional = true
+	return mmGetRefunds
+}
+
+// Expect sets up expected params for Usecases.GetRefunds
+func (mmGetRefunds *mUsecasesMockGetRefunds) Expect(ctx context.Context, req *dto.ViewRefundsRequest) *mUsecasesMockGetRefunds {
+	if mmGetRefunds.mock.funcGetRefunds != nil {
+		mmGetRefunds.mock.t.Fatalf("UsecasesMock.GetRefunds mock is already set by Set")
+	}
+
+	if mmGetRefunds.defaultExpectation == nil {
+		mmGetRefunds.defaultExpectation = &UsecasesMockGetRefundsExpectation{}
+	}
+
+	if mmGetRefunds.defaultExpectation.paramPtrs != nil {
+		mmGetRefunds.mock.t.Fatalf("UsecasesMock.GetRefunds mock is already set by ExpectParams functions")
+	}
+
+	mmGetRefunds.defaultExpectation.params = &UsecasesMockGetRefundsParams{ctx, req}
+	mmGetRefunds.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetRefunds.expectations {
+		if minimock.Equal(e.params, mmGetRefunds.defaultExpectation.params) {
+			mmGetRefunds.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetRefunds.defaultExpectation.params)
+		}
+	}
+
+	return mmGetRefunds
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Usecases.GetRefunds
+func (mmGetRefunds *mUsecasesMockGetRefunds) ExpectCtxParam1(ctx context.Context) *mUsecasesMockGetRefunds {
+	if mmGetRefunds.mock.funcGetRefunds != nil {
+		mmGetRefunds.mock.t.Fatalf("UsecasesMock.GetRefunds mock is already set by Set")
+	}
+
+	if mmGetRefunds.defaultExpectation == nil {
+		mmGetRefunds.defaultExpectation = &UsecasesMockGetRefundsExpectation{}
+	}
+
+	if mmGetRefunds.defaultExpectation.params != nil {
+		mmGetRefunds.mock.t.Fatalf("UsecasesMock.GetRefunds mock is already set by Expect")
+	}
+
+	if mmGetRefunds.defaultExpectation.paramPtrs == nil {
+		mmGetRefunds.defaultExpectation.paramPtrs = &UsecasesMockGetRefundsParamPtrs{}
+	}
+	mmGetRefunds.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetRefunds.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetRefunds
+}
+
+// ExpectReqParam2 sets up expected param req for Usecases.GetRefunds
+func (mmGetRefunds *mUsecasesMockGetRefunds) ExpectReqParam2(req *dto.ViewRefundsRequest) *mUsecasesMockGetRefunds {
+	if mmGetRefunds.mock.funcGetRefunds != nil {
+		mmGetRefunds.mock.t.Fatalf("UsecasesMock.GetRefunds mock is already set by Set")
+	}
+
+	if mmGetRefunds.defaultExpectation == nil {
+		mmGetRefunds.defaultExpectation = &UsecasesMockGetRefundsExpectation{}
+	}
+
+	if mmGetRefunds.defaultExpectation.params != nil {
+		mmGetRefunds.mock.t.Fatalf("UsecasesMock.GetRefunds mock is already set by Expect")
+	}
+
+	if mmGetRefunds.defaultExpectation.paramPtrs == nil {
+		mmGetRefunds.defaultExpectation.paramPtrs = &UsecasesMockGetRefundsParamPtrs{}
+	}
+	mmGetRefunds.defaultExpectation.paramPtrs.req = &req
+	mmGetRefunds.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmGetRefunds
+}
+
+// Inspect accepts an inspector function that has same arguments as the Usecases.GetRefunds
+func (mmGetRefunds *mUsecasesMockGetRefunds) Inspect(f func(ctx context.Context, req *dto.ViewRefundsRequest)) *mUsecasesMockGetRefunds {
+	if mmGetRefunds.mock.inspectFuncGetRefunds != nil {
+		mmGetRefunds.mock.t.Fatalf("Inspect function is already set for UsecasesMock.GetRefunds")
+	}
+
+	mmGetRefunds.mock.inspectFuncGetRefunds = f
+
+	return mmGetRefunds
+}
+
+// Return sets up results that will be returned by Usecases.GetRefunds
+func (mmGetRefunds *mUsecasesMockGetRefunds) Return(oa1 []domain.OrderView, err error) *UsecasesMock {
+	if mmGetRefunds.mock.funcGetRefunds != nil {
+		mmGetRefunds.mock.t.Fatalf("UsecasesMock.GetRefunds mock is already set by Set")
+	}
+
+	if mmGetRefunds.defaultExpectation == nil {
+		mmGetRefunds.defaultExpectation = &UsecasesMockGetRefundsExpectation{mock: mmGetRefunds.mock}
+	}
+	mmGetRefunds.defaultExpectation.results = &UsecasesMockGetRefundsResults{oa1, err}
+	mmGetRefunds.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetRefunds.mock
+}
+
+// Set uses given function f to mock the Usecases.GetRefunds method
+func (mmGetRefunds *mUsecasesMockGetRefunds) Set(f func(ctx context.Context, req *dto.ViewRefundsRequest) (oa1 []domain.OrderView, err error)) *UsecasesMock {
+	if mmGetRefunds.defaultExpectation != nil {
+		mmGetRefunds.mock.t.Fatalf("Default expectation is already set for the Usecases.GetRefunds method")
+	}
+
+	if len(mmGetRefunds.expectations) > 0 {
+		mmGetRefunds.mock.t.Fatalf("Some expectations are already set for the Usecases.GetRefunds method")
+	}
+
+	mmGetRefunds.mock.funcGetRefunds = f
+	mmGetRefunds.mock.funcGetRefundsOrigin = minimock.CallerInfo(1)
+	return mmGetRefunds.mock
+}
+
+// When sets expectation for the Usecases.GetRefunds which will trigger the result defined by the following
+// Then helper
+func (mmGetRefunds *mUsecasesMockGetRefunds) When(ctx context.Context, req *dto.ViewRefundsRequest) *UsecasesMockGetRefundsExpectation {
+	if mmGetRefunds.mock.funcGetRefunds != nil {
+		mmGetRefunds.mock.t.Fatalf("UsecasesMock.GetRefunds mock is already set by Set")
+	}
+
+	expectation := &UsecasesMockGetRefundsExpectation{
+		mock:               mmGetRefunds.mock,
+		params:             &UsecasesMockGetRefundsParams{ctx, req},
+		expectationOrigins: UsecasesMockGetRefundsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetRefunds.expectations = append(mmGetRefunds.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Usecases.GetRefunds return parameters for the expectation previously defined by the When method
+func (e *UsecasesMockGetRefundsExpectation) Then(oa1 []domain.OrderView, err error) *UsecasesMock {
+	e.results = &UsecasesMockGetRefundsResults{oa1, err}
+	return e.mock
+}
+
+// Times sets number of times Usecases.GetRefunds should be invoked
+func (mmGetRefunds *mUsecasesMockGetRefunds) Times(n uint64) *mUsecasesMockGetRefunds {
+	if n == 0 {
+		mmGetRefunds.mock.t.Fatalf("Times of UsecasesMock.GetRefunds mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetRefunds.expectedInvocations, n)
+	mmGetRefunds.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetRefunds
+}
+
+func (mmGetRefunds *mUsecasesMockGetRefunds) invocationsDone() bool {
+	if len(mmGetRefunds.expectations) == 0 && mmGetRefunds.defaultExpectation == nil && mmGetRefunds.mock.funcGetRefunds == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetRefunds.mock.afterGetRefundsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetRefunds.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetRefunds implements mm_manager_service.Usecases
+func (mmGetRefunds *UsecasesMock) GetRefunds(ctx context.Context, req *dto.ViewRefundsRequest) (oa1 []domain.OrderView, err error) {
+	mm_atomic.AddUint64(&mmGetRefunds.beforeGetRefundsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetRefunds.afterGetRefundsCounter, 1)
+
+	mmGetRefunds.t.Helper()
+
+	if mmGetRefunds.inspectFuncGetRefunds != nil {
+		mmGetRefunds.inspectFuncGetRefunds(ctx, req)
+	}
+
+	mm_params := UsecasesMockGetRefundsParams{ctx, req}
+
+	// Record call args
+	mmGetRefunds.GetRefundsMock.mutex.Lock()
+	mmGetRefunds.GetRefundsMock.callArgs = append(mmGetRefunds.GetRefundsMock.callArgs, &mm_params)
+	mmGetRefunds.GetRefundsMock.mutex.Unlock()
+
+	for _, e := range mmGetRefunds.GetRefundsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.oa1, e.results.err
+		}
+	}
+
+	if mmGetRefunds.GetRefundsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetRefunds.GetRefundsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetRefunds.GetRefundsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetRefunds.GetRefundsMock.defaultExpectation.paramPtrs
+
+		mm_got := UsecasesMockGetRefundsParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetRefunds.t.Errorf("UsecasesMock.GetRefunds got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRefunds.GetRefundsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmGetRefunds.t.Errorf("UsecasesMock.GetRefunds got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRefunds.GetRefundsMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetRefunds.t.Errorf("UsecasesMock.GetRefunds got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetRefunds.GetRefundsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetRefunds.GetRefundsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetRefunds.t.Fatal("No results are set for the UsecasesMock.GetRefunds")
+		}
+		return (*mm_results).oa1, (*mm_results).err
+	}
+	if mmGetRefunds.funcGetRefunds != nil {
+		return mmGetRefunds.funcGetRefunds(ctx, req)
+	}
+	mmGetRefunds.t.Fatalf("Unexpected call to UsecasesMock.GetRefunds. %v %v", ctx, req)
+	return
+}
+
+// GetRefundsAfterCounter returns a count of finished UsecasesMock.GetRefunds invocations
+func (mmGetRefunds *UsecasesMock) GetRefundsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRefunds.afterGetRefundsCounter)
+}
+
+// GetRefundsBeforeCounter returns a count of UsecasesMock.GetRefunds invocations
+func (mmGetRefunds *UsecasesMock) GetRefundsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetRefunds.beforeGetRefundsCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsecasesMock.GetRefunds.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetRefunds *mUsecasesMockGetRefunds) Calls() []*UsecasesMockGetRefundsParams {
+	mmGetRefunds.mutex.RLock()
+
+	argCopy := make([]*UsecasesMockGetRefundsParams, len(mmGetRefunds.callArgs))
+	copy(argCopy, mmGetRefunds.callArgs)
+
+	mmGetRefunds.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetRefundsDone returns true if the count of the GetRefunds invocations corresponds
+// the number of defined expectations
+func (m *UsecasesMock) MinimockGetRefundsDone() bool {
+	if m.GetRefundsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetRefundsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetRefundsMock.invocationsDone()
+}
+
+// MinimockGetRefundsInspect logs each unmet expectation
+func (m *UsecasesMock) MinimockGetRefundsInspect() {
+	for _, e := range m.GetRefundsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsecasesMock.GetRefunds at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetRefundsCounter := mm_atomic.LoadUint64(&m.afterGetRefundsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetRefundsMock.defaultExpectation != nil && afterGetRefundsCounter < 1 {
+		if m.GetRefundsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecasesMock.GetRefunds at\n%s", m.GetRefundsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsecasesMock.GetRefunds at\n%s with params: %#v", m.GetRefundsMock.defaultExpectation.expectationOrigins.origin, *m.GetRefundsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetRefunds != nil && afterGetRefundsCounter < 1 {
+		m.t.Errorf("Expected call to UsecasesMock.GetRefunds at\n%s", m.funcGetRefundsOrigin)
+	}
+
+	if !m.GetRefundsMock.invocationsDone() && afterGetRefundsCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecasesMock.GetRefunds at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetRefundsMock.expectedInvocations), m.GetRefundsMock.expectedInvocationsOrigin, afterGetRefundsCounter)
+	}
+}
+
+type mUsecasesMockGive struct {
+	optional           bool
+	mock               *UsecasesMock
+	defaultExpectation *UsecasesMockGiveExpectation
+	expectations       []*UsecasesMockGiveExpectation
+
+	callArgs []*UsecasesMockGiveParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsecasesMockGiveExpectation specifies expectation struct of the Usecases.Give
+type UsecasesMockGiveExpectation struct {
+	mock               *UsecasesMock
+	params             *UsecasesMockGiveParams
+	paramPtrs          *UsecasesMockGiveParamPtrs
+	expectationOrigins UsecasesMockGiveExpectationOrigins
+	results            *UsecasesMockGiveResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsecasesMockGiveParams contains parameters of the Usecases.Give
+type UsecasesMockGiveParams struct {
+	ctx context.Context
+	req *dto.GiveOrdersRequest
+}
+
+// UsecasesMockGiveParamPtrs contains pointers to parameters of the Usecases.Give
+type UsecasesMockGiveParamPtrs struct {
+	ctx *context.Context
+	req **dto.GiveOrdersRequest
+}
+
+// UsecasesMockGiveResults contains results of the Usecases.Give
+type UsecasesMockGiveResults struct {
+	ea1 []error
+}
+
+// UsecasesMockGiveOrigins contains origins of expectations of the Usecases.Give
+type UsecasesMockGiveExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGive *mUsecasesMockGive) Optional() *mUsecasesMockGive {
+	mmGive.optional = true
+	return mmGive
+}
+
+// Expect sets up expected params for Usecases.Give
+func (mmGive *mUsecasesMockGive) Expect(ctx context.Context, req *dto.GiveOrdersRequest) *mUsecasesMockGive {
+	if mmGive.mock.funcGive != nil {
+		mmGive.mock.t.Fatalf("UsecasesMock.Give mock is already set by Set")
+	}
+
+	if mmGive.defaultExpectation == nil {
+		mmGive.defaultExpectation = &UsecasesMockGiveExpectation{}
+	}
+
+	if mmGive.defaultExpectation.paramPtrs != nil {
+		mmGive.mock.t.Fatalf("UsecasesMock.Give mock is already set by ExpectParams functions")
+	}
+
+	mmGive.defaultExpectation.params = &UsecasesMockGiveParams{ctx, req}
+	mmGive.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGive.expectations {
+		if minimock.Equal(e.params, mmGive.defaultExpectation.params) {
+			mmGive.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGive.defaultExpectation.params)
+		}
+	}
+
+	return mmGive
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Usecases.Give
+func (mmGive *mUsecasesMockGive) ExpectCtxParam1(ctx context.Context) *mUsecasesMockGive {
+	if mmGive.mock.funcGive != nil {
+		mmGive.mock.t.Fatalf("UsecasesMock.Give mock is already set by Set")
+	}
+
+	if mmGive.defaultExpectation == nil {
+		mmGive.defaultExpectation = &UsecasesMockGiveExpectation{}
+	}
+
+	if mmGive.defaultExpectation.params != nil {
+		mmGive.mock.t.Fatalf("UsecasesMock.Give mock is already set by Expect")
+	}
+
+	if mmGive.defaultExpectation.paramPtrs == nil {
+		mmGive.defaultExpectation.paramPtrs = &UsecasesMockGiveParamPtrs{}
+	}
+	mmGive.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGive.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGive
+}
+
+// ExpectReqParam2 sets up expected param req for Usecases.Give
+func (mmGive *mUsecasesMockGive) ExpectReqParam2(req *dto.GiveOrdersRequest) *mUsecasesMockGive {
+	if mmGive.mock.funcGive != nil {
+		mmGive.mock.t.Fatalf("UsecasesMock.Give mock is already set by Set")
+	}
+
+	if mmGive.defaultExpectation == nil {
+		mmGive.defaultExpectation = &UsecasesMockGiveExpectation{}
+	}
+
+	if mmGive.defaultExpectation.params != nil {
+		mmGive.mock.t.Fatalf("UsecasesMock.Give mock is already set by Expect")
+	}
+
+	if mmGive.defaultExpectation.paramPtrs == nil {
+		mmGive.defaultExpectation.paramPtrs = &UsecasesMockGiveParamPtrs{}
+	}
+	mmGive.defaultExpectation.paramPtrs.req = &req
+	mmGive.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmGive
+}
+
+// Inspect accepts an inspector function that has same arguments as the Usecases.Give
+func (mmGive *mUsecasesMockGive) Inspect(f func(ctx context.Context, req *dto.GiveOrdersRequest)) *mUsecasesMockGive {
+	if mmGive.mock.inspectFuncGive != nil {
+		mmGive.mock.t.Fatalf("Inspect function is already set for UsecasesMock.Give")
+	}
+
+	mmGive.mock.inspectFuncGive = f
+
+	return mmGive
+}
+
+// Return sets up results that will be returned by Usecases.Give
+func (mmGive *mUsecasesMockGive) Return(ea1 []error) *UsecasesMock {
+	if mmGive.mock.funcGive != nil {
+		mmGive.mock.t.Fatalf("UsecasesMock.Give mock is already set by Set")
+	}
+
+	if mmGive.defaultExpectation == nil {
+		mmGive.defaultExpectation = &UsecasesMockGiveExpectation{mock: mmGive.mock}
+	}
+	mmGive.defaultExpectation.results = &UsecasesMockGiveResults{ea1}
+	mmGive.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGive.mock
+}
+
+// Set uses given function f to mock the Usecases.Give method
+func (mmGive *mUsecasesMockGive) Set(f func(ctx context.Context, req *dto.GiveOrdersRequest) (ea1 []error)) *UsecasesMock {
+	if mmGive.defaultExpectation != nil {
+		mmGive.mock.t.Fatalf("Default expectation is already set for the Usecases.Give method")
+	}
+
+	if len(mmGive.expectations) > 0 {
+		mmGive.mock.t.Fatalf("Some expectations are already set for the Usecases.Give method")
+	}
+
+	mmGive.mock.funcGive = f
+	mmGive.mock.funcGiveOrigin = minimock.CallerInfo(1)
+	return mmGive.mock
+}
+
+// When sets expectation for the Usecases.Give which will trigger the result defined by the following
+// Then helper
+func (mmGive *mUsecasesMockGive) When(ctx context.Context, req *dto.GiveOrdersRequest) *UsecasesMockGiveExpectation {
+	if mmGive.mock.funcGive != nil {
+		mmGive.mock.t.Fatalf("UsecasesMock.Give mock is already set by Set")
+	}
+
+	expectation := &UsecasesMockGiveExpectation{
+		mock:               mmGive.mock,
+		params:             &UsecasesMockGiveParams{ctx, req},
+		expectationOrigins: UsecasesMockGiveExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGive.expectations = append(mmGive.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Usecases.Give return parameters for the expectation previously defined by the When method
+func (e *UsecasesMockGiveExpectation) Then(ea1 []error) *UsecasesMock {
+	e.results = &UsecasesMockGiveResults{ea1}
+	return e.mock
+}
+
+// Times sets number of times Usecases.Give should be invoked
+func (mmGive *mUsecasesMockGive) Times(n uint64) *mUsecasesMockGive {
+	if n == 0 {
+		mmGive.mock.t.Fatalf("Times of UsecasesMock.Give mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGive.expectedInvocations, n)
+	mmGive.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGive
+}
+
+func (mmGive *mUsecasesMockGive) invocationsDone() bool {
+	if len(mmGive.expectations) == 0 && mmGive.defaultExpectation == nil && mmGive.mock.funcGive == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGive.mock.afterGiveCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGive.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Give implements mm_manager_service.Usecases
+func (mmGive *UsecasesMock) Give(ctx context.Context, req *dto.GiveOrdersRequest) (ea1 []error) {
+	mm_atomic.AddUint64(&mmGive.beforeGiveCounter, 1)
+	defer mm_atomic.AddUint64(&mmGive.afterGiveCounter, 1)
+
+	mmGive.t.Helper()
+
+	if mmGive.inspectFuncGive != nil {
+		mmGive.inspectFuncGive(ctx, req)
+	}
+
+	mm_params := UsecasesMockGiveParams{ctx, req}
+
+	// Record call args
+	mmGive.GiveMock.mutex.Lock()
+	mmGive.GiveMock.callArgs = append(mmGive.GiveMock.callArgs, &mm_params)
+	mmGive.GiveMock.mutex.Unlock()
+
+	for _, e := range mmGive.GiveMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ea1
+		}
+	}
+
+	if mmGive.GiveMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGive.GiveMock.defaultExpectation.Counter, 1)
+		mm_want := mmGive.GiveMock.defaultExpectation.params
+		mm_want_ptrs := mmGive.GiveMock.defaultExpectation.paramPtrs
+
+		mm_got := UsecasesMockGiveParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGive.t.Errorf("UsecasesMock.Give got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGive.GiveMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmGive.t.Errorf("UsecasesMock.Give got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGive.GiveMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGive.t.Errorf("UsecasesMock.Give got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGive.GiveMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGive.GiveMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGive.t.Fatal("No results are set for the UsecasesMock.Give")
+		}
+		return (*mm_results).ea1
+	}
+	if mmGive.funcGive != nil {
+		return mmGive.funcGive(ctx, req)
+	}
+	mmGive.t.Fatalf("Unexpected call to UsecasesMock.Give. %v %v", ctx, req)
+	return
+}
+
+// GiveAfterCounter returns a count of finished UsecasesMock.Give invocations
+func (mmGive *UsecasesMock) GiveAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGive.afterGiveCounter)
+}
+
+// GiveBeforeCounter returns a count of UsecasesMock.Give invocations
+func (mmGive *UsecasesMock) GiveBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGive.beforeGiveCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsecasesMock.Give.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGive *mUsecasesMockGive) Calls() []*UsecasesMockGiveParams {
+	mmGive.mutex.RLock()
+
+	argCopy := make([]*UsecasesMockGiveParams, len(mmGive.callArgs))
+	copy(argCopy, mmGive.callArgs)
+
+	mmGive.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGiveDone returns true if the count of the Give invocations corresponds
+// the number of defined expectations
+func (m *UsecasesMock) MinimockGiveDone() bool {
+	if m.GiveMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GiveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GiveMock.invocationsDone()
+}
+
+// MinimockGiveInspect logs each unmet expectation
+func (m *UsecasesMock) MinimockGiveInspect() {
+	for _, e := range m.GiveMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsecasesMock.Give at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGiveCounter := mm_atomic.LoadUint64(&m.afterGiveCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GiveMock.defaultExpectation != nil && afterGiveCounter < 1 {
+		if m.GiveMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecasesMock.Give at\n%s", m.GiveMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsecasesMock.Give at\n%s with params: %#v", m.GiveMock.defaultExpectation.expectationOrigins.origin, *m.GiveMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGive != nil && afterGiveCounter < 1 {
+		m.t.Errorf("Expected call to UsecasesMock.Give at\n%s", m.funcGiveOrigin)
+	}
+
+	if !m.GiveMock.invocationsDone() && afterGiveCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecasesMock.Give at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GiveMock.expectedInvocations), m.GiveMock.expectedInvocationsOrigin, afterGiveCounter)
+	}
+}
+
+type mUsecasesMockQueryAuditLog struct {
+	optional           bool
+	mock               *UsecasesMock
+	defaultExpectation *UsecasesMockQueryAuditLogExpectation
+	expectations       []*UsecasesMockQueryAuditLogExpectation
+
+	callArgs []*UsecasesMockQueryAuditLogParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsecasesMockQueryAuditLogExpectation specifies expectation struct of the Usecases.QueryAuditLog
+type UsecasesMockQueryAuditLogExpectation struct {
+	mock               *UsecasesMock
+	params             *UsecasesMockQueryAuditLogParams
+	paramPtrs          *UsecasesMockQueryAuditLogParamPtrs
+	expectationOrigins UsecasesMockQueryAuditLogExpectationOrigins
+	results            *UsecasesMockQueryAuditLogResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsecasesMockQueryAuditLogParams contains parameters of the Usecases.QueryAuditLog
+type UsecasesMockQueryAuditLogParams struct {
+	ctx context.Context
+	req *dto.QueryAuditLogRequest
+}
+
+// UsecasesMockQueryAuditLogParamPtrs contains pointers to parameters of the Usecases.QueryAuditLog
+type UsecasesMockQueryAuditLogParamPtrs struct {
+	ctx *context.Context
+	req **dto.QueryAuditLogRequest
+}
+
+// UsecasesMockQueryAuditLogResults contains results of the Usecases.QueryAuditLog
+type UsecasesMockQueryAuditLogResults struct {
+	aa1 []domain.AuditRecord
+	err error
+}
+
+// UsecasesMockQueryAuditLogOrigins contains origins of expectations of the Usecases.QueryAuditLog
+type UsecasesMockQueryAuditLogExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmQueryAuditLog *mUsecasesMockQueryAuditLog) Optional() *mUsecasesMockQueryAuditLog {
+	mmQueryAuditLog.optional = true
+	return mmQueryAuditLog
+}
+
+// Expect sets up expected params for Usecases.QueryAuditLog
+func (mmQueryAuditLog *mUsecasesMockQueryAuditLog) Expect(ctx context.Context, req *dto.QueryAuditLogRequest) *mUsecasesMockQueryAuditLog {
+	if mmQueryAuditLog.mock.funcQueryAuditLog != nil {
+		mmQueryAuditLog.mock.t.Fatalf("UsecasesMock.QueryAuditLog mock is already set by Set")
+	}
+
+	if mmQueryAuditLog.defaultExpectation == nil {
+		mmQueryAuditLog.defaultExpectation = &UsecasesMockQueryAuditLogExpectation{}
+	}
+
+	if mmQueryAuditLog.defaultExpectation.paramPtrs != nil {
+		mmQueryAuditLog.mock.t.Fatalf("UsecasesMock.QueryAuditLog mock is already set by ExpectParams functions")
+	}
+
+	mmQueryAuditLog.defaultExpectation.params = &UsecasesMockQueryAuditLogParams{ctx, req}
+	mmQueryAuditLog.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmQueryAuditLog.expectations {
+		if minimock.Equal(e.params, mmQueryAuditLog.defaultExpectation.params) {
+			mmQueryAuditLog.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmQueryAuditLog.defaultExpectation.params)
+		}
+	}
+
+	return mmQueryAuditLog
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Usecases.QueryAuditLog
+func (mmQueryAuditLog *mUsecasesMockQueryAuditLog) ExpectCtxParam1(ctx context.Context) *mUsecasesMockQueryAuditLog {
+	if mmQueryAuditLog.mock.funcQueryAuditLog != nil {
+		mmQueryAuditLog.mock.t.Fatalf("UsecasesMock.QueryAuditLog mock is already set by Set")
+	}
+
+	if mmQueryAuditLog.defaultExpectation == nil {
+		mmQueryAuditLog.defaultExpectation = &UsecasesMockQueryAuditLogExpectation{}
+	}
+
+	if mmQueryAuditLog.defaultExpectation.params != nil {
+		mmQueryAuditLog.mock.t.Fatalf("UsecasesMock.QueryAuditLog mock is already set by Expect")
+	}
+
+	if mmQueryAuditLog.defaultExpectation.paramPtrs == nil {
+		mmQueryAuditLog.defaultExpectation.paramPtrs = &UsecasesMockQueryAuditLogParamPtrs{}
+	}
+	mmQueryAuditLog.defaultExpectation.paramPtrs.ctx = &ctx
+	mmQueryAuditLog.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmQueryAuditLog
+}
+
+// ExpectReqParam2 sets up expected param req for Usecases.QueryAuditLog
+func (mmQueryAuditLog *mUsecasesMockQueryAuditLog) ExpectReqParam2(req *dto.QueryAuditLogRequest) *mUsecasesMockQueryAuditLog {
+	if mmQueryAuditLog.mock.funcQueryAuditLog != nil {
+		mmQueryAuditLog.mock.t.Fatalf("UsecasesMock.QueryAuditLog mock is already set by Set")
+	}
+
+	if mmQueryAuditLog.defaultExpectation == nil {
+		mmQueryAuditLog.defaultExpectation = &UsecasesMockQueryAuditLogExpectation{}
+	}
+
+	if mmQueryAuditLog.defaultExpectation.params != nil {
+		mmQueryAuditLog.mock.t.Fatalf("UsecasesMock.QueryAuditLog mock is already set by Expect")
+	}
+
+	if mmQueryAuditLog.defaultExpectation.paramPtrs == nil {
+		mmQueryAuditLog.defaultExpectation.paramPtrs = &UsecasesMockQueryAuditLogParamPtrs{}
+	}
+	mmQueryAuditLog.defaultExpectation.paramPtrs.req = &req
+	mmQueryAuditLog.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmQueryAuditLog
+}
+
+// Inspect accepts an inspector function that has same arguments as the Usecases.QueryAuditLog
+func (mmQueryAuditLog *mUsecasesMockQueryAuditLog) Inspect(f func(ctx context.Context, req *dto.QueryAuditLogRequest)) *mUsecasesMockQueryAuditLog {
+	if mmQueryAuditLog.mock.inspectFuncQueryAuditLog != nil {
+		mmQueryAuditLog.mock.t.Fatalf("Inspect function is already set for UsecasesMock.QueryAuditLog")
+	}
+
+	mmQueryAuditLog.mock.inspectFuncQueryAuditLog = f
+
+	return mmQueryAuditLog
+}
+
+// Return sets up results that will be returned by Usecases.QueryAuditLog
+func (mmQueryAuditLog *mUsecasesMockQueryAuditLog) Return(aa1 []domain.AuditRecord, err error) *UsecasesMock {
+	if mmQueryAuditLog.mock.funcQueryAuditLog != nil {
+		mmQueryAuditLog.mock.t.Fatalf("UsecasesMock.QueryAuditLog mock is already set by Set")
+	}
+
+	if mmQueryAuditLog.defaultExpectation == nil {
+		mmQueryAuditLog.defaultExpectation = &UsecasesMockQueryAuditLogExpectation{mock: mmQueryAuditLog.mock}
+	}
+	mmQueryAuditLog.defaultExpectation.results = &UsecasesMockQueryAuditLogResults{aa1, err}
+	mmQueryAuditLog.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmQueryAuditLog.mock
+}
+
+// Set uses given function f to mock the Usecases.QueryAuditLog method
+func (mmQueryAuditLog *mUsecasesMockQueryAuditLog) Set(f func(ctx context.Context, req *dto.QueryAuditLogRequest) (aa1 []domain.AuditRecord, err error)) *UsecasesMock {
+	if mmQueryAuditLog.defaultExpectation != nil {
+		mmQueryAuditLog.mock.t.Fatalf("Default expectation is already set for the Usecases.QueryAuditLog method")
+	}
+
+	if len(mmQueryAuditLog.expectations) > 0 {
+		mmQueryAuditLog.mock.t.Fatalf("Some expectations are already set for the Usecases.QueryAuditLog method")
+	}
+
+	mmQueryAuditLog.mock.funcQueryAuditLog = f
+	mmQueryAuditLog.mock.funcQueryAuditLogOrigin = minimock.CallerInfo(1)
+	return mmQueryAuditLog.mock
+}
+
+// When sets expectation for the Usecases.QueryAuditLog which will trigger the result defined by the following
+// Then helper
+func (mmQueryAuditLog *mUsecasesMockQueryAuditLog) When(ctx context.Context, req *dto.QueryAuditLogRequest) *UsecasesMockQueryAuditLogExpectation {
+	if mmQueryAuditLog.mock.funcQueryAuditLog != nil {
+		mmQueryAuditLog.mock.t.Fatalf("UsecasesMock.QueryAuditLog mock is already set by Set")
+	}
+
+	expectation := &UsecasesMockQueryAuditLogExpectation{
+		mock:               mmQueryAuditLog.mock,
+		params:             &UsecasesMockQueryAuditLogParams{ctx, req},
+		expectationOrigins: UsecasesMockQueryAuditLogExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmQueryAuditLog.expectations = append(mmQueryAuditLog.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Usecases.QueryAuditLog return parameters for the expectation previously defined by the When method
+func (e *UsecasesMockQueryAuditLogExpectation) Then(aa1 []domain.AuditRecord, err error) *UsecasesMock {
+	e.results = &UsecasesMockQueryAuditLogResults{aa1, err}
+	return e.mock
+}
+
+// Times sets number of times Usecases.QueryAuditLog should be invoked
+func (mmQueryAuditLog *mUsecasesMockQueryAuditLog) Times(n uint64) *mUsecasesMockQueryAuditLog {
+	if n == 0 {
+		mmQueryAuditLog.mock.t.Fatalf("Times of UsecasesMock.QueryAuditLog mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmQueryAuditLog.expectedInvocations, n)
+	mmQueryAuditLog.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmQueryAuditLog
+}
+
+func (mmQueryAuditLog *mUsecasesMockQueryAuditLog) invocationsDone() bool {
+	if len(mmQueryAuditLog.expectations) == 0 && mmQueryAuditLog.defaultExpectation == nil && mmQueryAuditLog.mock.funcQueryAuditLog == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmQueryAuditLog.mock.afterQueryAuditLogCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmQueryAuditLog.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// QueryAuditLog implements mm_manager_service.Usecases
+func (mmQueryAuditLog *UsecasesMock) QueryAuditLog(ctx context.Context, req *dto.QueryAuditLogRequest) (aa1 []domain.AuditRecord, err error) {
+	mm_atomic.AddUint64(&mmQueryAuditLog.beforeQueryAuditLogCounter, 1)
+	defer mm_atomic.AddUint64(&mmQueryAuditLog.afterQueryAuditLogCounter, 1)
+
+	mmQueryAuditLog.t.Helper()
+
+	if mmQueryAuditLog.inspectFuncQueryAuditLog != nil {
+		mmQueryAuditLog.inspectFuncQueryAuditLog(ctx, req)
+	}
+
+	mm_params := UsecasesMockQueryAuditLogParams{ctx, req}
+
+	// Record call args
+	mmQueryAuditLog.QueryAuditLogMock.mutex.Lock()
+	mmQueryAuditLog.QueryAuditLogMock.callArgs = append(mmQueryAuditLog.QueryAuditLogMock.callArgs, &mm_params)
+	mmQueryAuditLog.QueryAuditLogMock.mutex.Unlock()
+
+	for _, e := range mmQueryAuditLog.QueryAuditLogMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.aa1, e.results.err
+		}
+	}
+
+	if mmQueryAuditLog.QueryAuditLogMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmQueryAuditLog.QueryAuditLogMock.defaultExpectation.Counter, 1)
+		mm_want := mmQueryAuditLog.QueryAuditLogMock.defaultExpectation.params
+		mm_want_ptrs := mmQueryAuditLog.QueryAuditLogMock.defaultExpectation.paramPtrs
+
+		mm_got := UsecasesMockQueryAuditLogParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmQueryAuditLog.t.Errorf("UsecasesMock.QueryAuditLog got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmQueryAuditLog.QueryAuditLogMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmQueryAuditLog.t.Errorf("UsecasesMock.QueryAuditLog got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmQueryAuditLog.QueryAuditLogMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmQueryAuditLog.t.Errorf("UsecasesMock.QueryAuditLog got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmQueryAuditLog.QueryAuditLogMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmQueryAuditLog.QueryAuditLogMock.defaultExpectation.results
+		if mm_results == nil {
+			mmQueryAuditLog.t.Fatal("No results are set for the UsecasesMock.QueryAuditLog")
+		}
+		return (*mm_results).aa1, (*mm_results).err
+	}
+	if mmQueryAuditLog.funcQueryAuditLog != nil {
+		return mmQueryAuditLog.funcQueryAuditLog(ctx, req)
+	}
+	mmQueryAuditLog.t.Fatalf("Unexpected call to UsecasesMock.QueryAuditLog. %v %v", ctx, req)
+	return
+}
+
+// QueryAuditLogAfterCounter returns a count of finished UsecasesMock.QueryAuditLog invocations
+func (mmQueryAuditLog *UsecasesMock) QueryAuditLogAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmQueryAuditLog.afterQueryAuditLogCounter)
+}
+
+// QueryAuditLogBeforeCounter returns a count of UsecasesMock.QueryAuditLog invocations
+func (mmQueryAuditLog *UsecasesMock) QueryAuditLogBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmQueryAuditLog.beforeQueryAuditLogCounter)
+}
+
+// Calls returns a list of arguments used in each call to UsecasesMock.QueryAuditLog.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmQueryAuditLog *mUsecasesMockQueryAuditLog) Calls() []*UsecasesMockQueryAuditLogParams {
+	mmQueryAuditLog.mutex.RLock()
+
+	argCopy := make([]*UsecasesMockQueryAuditLogParams, len(mmQueryAuditLog.callArgs))
+	copy(argCopy, mmQueryAuditLog.callArgs)
+
+	mmQueryAuditLog.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockQueryAuditLogDone returns true if the count of the QueryAuditLog invocations corresponds
+// the number of defined expectations
+func (m *UsecasesMock) MinimockQueryAuditLogDone() bool {
+	if m.QueryAuditLogMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.QueryAuditLogMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.QueryAuditLogMock.invocationsDone()
+}
+
+// MinimockQueryAuditLogInspect logs each unmet expectation
+func (m *UsecasesMock) MinimockQueryAuditLogInspect() {
+	for _, e := range m.QueryAuditLogMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to UsecasesMock.QueryAuditLog at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterQueryAuditLogCounter := mm_atomic.LoadUint64(&m.afterQueryAuditLogCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.QueryAuditLogMock.defaultExpectation != nil && afterQueryAuditLogCounter < 1 {
+		if m.QueryAuditLogMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecasesMock.QueryAuditLog at\n%s", m.QueryAuditLogMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to UsecasesMock.QueryAuditLog at\n%s with params: %#v", m.QueryAuditLogMock.defaultExpectation.expectationOrigins.origin, *m.QueryAuditLogMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcQueryAuditLog != nil && afterQueryAuditLogCounter < 1 {
+		m.t.Errorf("Expected call to UsecasesMock.QueryAuditLog at\n%s", m.funcQueryAuditLogOrigin)
+	}
+
+	if !m.QueryAuditLogMock.invocationsDone() && afterQueryAuditLogCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecasesMock.QueryAuditLog at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.QueryAuditLogMock.expectedInvocations), m.QueryAuditLogMock.expectedInvocationsOrigin, afterQueryAuditLogCounter)
+	}
+}
+
+type mUsecasesMockReturn struct {
+	optional           bool
+	mock               *UsecasesMock
+	defaultExpectation *UsecasesMockReturnExpectation
+	expectations       []*UsecasesMockReturnExpectation
+
+	callArgs []*UsecasesMockReturnParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// UsecasesMockReturnExpectation specifies expectation struct of the Usecases.Return
+type UsecasesMockReturnExpectation struct {
+	mock               *UsecasesMock
+	params             *UsecasesMockReturnParams
+	paramPtrs          *UsecasesMockReturnParamPtrs
+	expectationOrigins UsecasesMockReturnExpectationOrigins
+	results            *UsecasesMockReturnResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// UsecasesMockReturnParams contains parameters of the Usecases.Return
+type UsecasesMockReturnParams struct {
+	ctx context.Context
+	req *dto.ReturnRequest
+}
+
+// UsecasesMockReturnParamPtrs contains pointers to parameters of the Usecases.Return
+type UsecasesMockReturnParamPtrs struct {
+	ctx *context.Context
+	req **dto.ReturnRequest
+}
+
+// UsecasesMockReturnResults contains results of the Usecases.Return
+type UsecasesMockReturnResults struct {
+	err error
+}
+
+// UsecasesMockReturnOrigins contains origins of expectations of the Usecases.Return
+type UsecasesMockReturnExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReturn *mUsecasesMockReturn) Optional() *mUsecasesMockReturn {
+	mmReturn.optional = true
+	return mmReturn
+}
+
+// Expect sets up expected params for Usecases.Return
+func (mmReturn *mUsecasesMockReturn) Expect(ctx context.Context, req *dto.ReturnRequest) *mUsecasesMockReturn {
+	if mmReturn.mock.funcReturn != nil {
+		mmReturn.mock.t.Fatalf("UsecasesMock.Return mock is already set by Set")
+	}
+
+	if mmReturn.defaultExpectation == nil {
+		mmReturn.defaultExpectation = &UsecasesMockReturnExpectation{}
+	}
+
+	if mmReturn.defaultExpectation.paramPtrs != nil {
+		mmReturn.mock.t.Fatalf("UsecasesMock.Return mock is already set by ExpectParams functions")
+	}
+
+	mmReturn.defaultExpectation.params = &UsecasesMockReturnParams{ctx, req}
+	mmReturn.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReturn.expectations {
+		if minimock.Equal(e.params, mmReturn.defaultExpectation.params) {
+			mmReturn.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReturn.defaultExpectation.params)
+		}
+	}
+
+	return mmReturn
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Usecases.Return
+func (mmReturn *mUsecasesMockReturn) ExpectCtxParam1(ctx context.Context) *mUsecasesMockReturn {
+	if mmReturn.mock.funcReturn != nil {
+		mmReturn.mock.t.Fatalf("UsecasesMock.Return mock is already set by Set")
+	}
+
+	if mmReturn.defaultExpectation == nil {
+		mmReturn.defaultExpectation = &UsecasesMockReturnExpectation{}
+	}
+
+	if mmReturn.defaultExpectation.params != nil {
+		mmReturn.mock.t.Fatalf("UsecasesMock.Return mock is already set by Expect")
+	}
+
+	if mmReturn.defaultExpectation.paramPtrs == nil {
+		mmReturn.defaultExpectation.paramPtrs = &UsecasesMockReturnParamPtrs{}
+	}
+	mmReturn.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReturn.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReturn
+}
+
+// ExpectReqParam2 sets up expected param req for Usecases.Return
+func (mmReturn *mUsecasesMockReturn) ExpectReqParam2(req *dto.ReturnRequest) *mUsecasesMockReturn {
+	if mmReturn.mock.funcReturn != nil {
+		mmReturn.mock.t.Fatalf("UsecasesMock.Return mock is already set by Set")
+	}
+
+	if mmReturn.defaultExpectation == nil {
+		mmReturn.defaultExpectation = &UsecasesMockReturnExpectation{}
+	}
+
+	if mmReturn.defaultExpectation.params != nil {
+		mmReturn.mock.t.Fatalf("UsecasesMock.Return mock is already set by Expect")
+	}
+
+	if mmReturn.defaultExpectation.paramPtrs == nil {
+		mmReturn.defaultExpectation.paramPtrs = &UsecasesMockReturnParamPtrs{}
+	}
+	mmReturn.defaultExpectation.paramPtrs.req = &req
+	mmReturn.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
 
-	return mmGetRefunds
+	return mmReturn
 }
 
-// Return sets up results that will be returned by Usecases.GetRefunds
-func (mmGetRefunds *mUsecasesMockGetRefunds) Return(oa1 []domain.OrderView, err error) *UsecasesMock {
-	if mmGetRefunds.mock.funcGetRefunds != nil {
-		mmGetRefunds.mock.t.Fatalf("UsecasesMock.GetRefunds mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the Usecases.Return
+func (mmReturn *mUsecasesMockReturn) Inspect(f func(ctx context.Context, req *dto.ReturnRequest)) *mUsecasesMockReturn {
+	if mmReturn.mock.inspectFuncReturn != nil {
+		mmReturn.mock.t.Fatalf("Inspect function is already set for UsecasesMock.Return")
 	}
 
-	if mmGetRefunds.defaultExpectation == nil {
-		mmGetRefunds.defaultExpectation = &UsecasesMockGetRefundsExpectation{mock: mmGetRefunds.mock}
+	mmReturn.mock.inspectFuncReturn = f
+
+	return mmReturn
+}
+
+// Return sets up results that will be returned by Usecases.Return
+func (mmReturn *mUsecasesMockReturn) Return(err error) *UsecasesMock {
+	if mmReturn.mock.funcReturn != nil {
+		mmReturn.mock.t.Fatalf("UsecasesMock.Return mock is already set by Set")
 	}
-	mmGetRefunds.defaultExpectation.results = &UsecasesMockGetRefundsResults{oa1, err}
-	mmGetRefunds.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetRefunds.mock
+
+	if mmReturn.defaultExpectation == nil {
+		mmReturn.defaultExpectation = &UsecasesMockReturnExpectation{mock: mmReturn.mock}
+	}
+	mmReturn.defaultExpectation.results = &UsecasesMockReturnResults{err}
+	mmReturn.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReturn.mock
 }
 
-// Set uses given function f to mock the Usecases.GetRefunds method
-func (mmGetRefunds *mUsecasesMockGetRefunds) Set(f func(req *dto.ViewRefundsRequest) (oa1 []domain.OrderView, err error)) *UsecasesMock {
-	if mmGetRefunds.defaultExpectation != nil {
-		mmGetRefunds.mock.t.Fatalf("Default expectation is already set for the Usecases.GetRefunds method")
+// Set uses given function f to mock the Usecases.Return method
+func (mmReturn *mUsecasesMockReturn) Set(f func(ctx context.Context, req *dto.ReturnRequest) (err error)) *UsecasesMock {
+	if mmReturn.defaultExpectation != nil {
+		mmReturn.mock.t.Fatalf("Default expectation is already set for the Usecases.Return method")
 	}
 
-	if len(mmGetRefunds.expectations) > 0 {
-		mmGetRefunds.mock.t.Fatalf("Some expectations are already set for the Usecases.GetRefunds method")
+	if len(mmReturn.expectations) > 0 {
+		mmReturn.mock.t.Fatalf("Some expectations are already set for the Usecases.Return method")
 	}
 
-	mmGetRefunds.mock.funcGetRefunds = f
-	mmGetRefunds.mock.funcGetRefundsOrigin = minimock.CallerInfo(1)
-	return mmGetRefunds.mock
+	mmReturn.mock.funcReturn = f
+	mmReturn.mock.funcReturnOrigin = minimock.CallerInfo(1)
+	return mmReturn.mock
 }
 
-// When sets expectation for the Usecases.GetRefunds which will trigger the result defined by the following
+// When sets expectation for the Usecases.Return which will trigger the result defined by the following
 // Then helper
-func (mmGetRefunds *mUsecasesMockGetRefunds) When(req *dto.ViewRefundsRequest) *UsecasesMockGetRefundsExpectation {
-	if mmGetRefunds.mock.funcGetRefunds != nil {
-		mmGetRefunds.mock.t.Fatalf("UsecasesMock.GetRefunds mock is already set by Set")
+func (mmReturn *mUsecasesMockReturn) When(ctx context.Context, req *dto.ReturnRequest) *UsecasesMockReturnExpectation {
+	if mmReturn.mock.funcReturn != nil {
+		mmReturn.mock.t.Fatalf("UsecasesMock.Return mock is already set by Set")
 	}
 
-	expectation := &UsecasesMockGetRefundsExpectation{
-		mock:               mmGetRefunds.mock,
-		params:             &UsecasesMockGetRefundsParams{req},
-		expectationOrigins: UsecasesMockGetRefundsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UsecasesMockReturnExpectation{
+		mock:               mmReturn.mock,
+		params:             &UsecasesMockReturnParams{ctx, req},
+		expectationOrigins: UsecasesMockReturnExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetRefunds.expectations = append(mmGetRefunds.expectations, expectation)
+	mmReturn.expectations = append(mmReturn.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Usecases.GetRefunds return parameters for the expectation previously defined by the When method
-func (e *UsecasesMockGetRefundsExpectation) Then(oa1 []domain.OrderView, err error) *UsecasesMock {
-	e.results = &UsecasesMockGetRefundsResults{oa1, err}
+// Then sets up Usecases.Return return parameters for the expectation previously defined by the When method
+func (e *UsecasesMockReturnExpectation) Then(err error) *UsecasesMock {
+	e.results = &UsecasesMockReturnResults{err}
 	return e.mock
 }
 
-// Times sets number of times Usecases.GetRefunds should be invoked
-func (mmGetRefunds *mUsecasesMockGetRefunds) Times(n uint64) *mUsecasesMockGetRefunds {
+// Times sets number of times Usecases.Return should be invoked
+func (mmReturn *mUsecasesMockReturn) Times(n uint64) *mUsecasesMockReturn {
 	if n == 0 {
-		mmGetRefunds.mock.t.Fatalf("Times of UsecasesMock.GetRefunds mock can not be zero")
+		mmReturn.mock.t.Fatalf("Times of UsecasesMock.Return mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetRefunds.expectedInvocations, n)
-	mmGetRefunds.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetRefunds
+	mm_atomic.StoreUint64(&mmReturn.expectedInvocations, n)
+	mmReturn.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReturn
 }
 
-func (mmGetRefunds *mUsecasesMockGetRefunds) invocationsDone() bool {
-	if len(mmGetRefunds.expectations) == 0 && mmGetRefunds.defaultExpectation == nil && mmGetRefunds.mock.funcGetRefunds == nil {
+func (mmReturn *mUsecasesMockReturn) invocationsDone() bool {
+	if len(mmReturn.expectations) == 0 && mmReturn.defaultExpectation == nil && mmReturn.mock.funcReturn == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetRefunds.mock.afterGetRefundsCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetRefunds.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmReturn.mock.afterReturnCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReturn.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetRefunds implements mm_manager_service.Usecases
-func (mmGetRefunds *UsecasesMock) GetRefunds(req *dto.ViewRefundsRequest) (oa1 []domain.OrderView, err error) {
-	mm_atomic.AddUint64(&mmGetRefunds.beforeGetRefundsCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetRefunds.afterGetRefundsCounter, 1)
+// Return implements mm_manager_service.Usecases
+func (mmReturn *UsecasesMock) Return(ctx context.Context, req *dto.ReturnRequest) (err error) {
+	mm_atomic.AddUint64(&mmReturn.beforeReturnCounter, 1)
+	defer mm_atomic.AddUint64(&mmReturn.afterReturnCounter, 1)
 
-	mmGetRefunds.t.Helper()
+	mmReturn.t.Helper()
 
-	if mmGetRefunds.inspectFuncGetRefunds != nil {
-		mmGetRefunds.inspectFuncGetRefunds(req)
+	if mmReturn.inspectFuncReturn != nil {
+		mmReturn.inspectFuncReturn(ctx, req)
 	}
 
-	mm_params := UsecasesMockGetRefundsParams{req}
+	mm_params := UsecasesMockReturnParams{ctx, req}
 
 	// Record call args
-	mmGetRefunds.GetRefundsMock.mutex.Lock()
-	mmGetRefunds.GetRefundsMock.callArgs = append(mmGetRefunds.GetRefundsMock.callArgs, &mm_params)
-	mmGetRefunds.GetRefundsMock.mutex.Unlock()
+	mmReturn.ReturnMock.mutex.Lock()
+	mmReturn.ReturnMock.callArgs = append(mmReturn.ReturnMock.callArgs, &mm_params)
+	mmReturn.ReturnMock.mutex.Unlock()
 
-	for _, e := range mmGetRefunds.GetRefundsMock.expectations {
+	for _, e := range mmReturn.ReturnMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.oa1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetRefunds.GetRefundsMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetRefunds.GetRefundsMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetRefunds.GetRefundsMock.defaultExpectation.params
-		mm_want_ptrs := mmGetRefunds.GetRefundsMock.defaultExpectation.paramPtrs
+	if mmReturn.ReturnMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReturn.ReturnMock.defaultExpectation.Counter, 1)
+		mm_want := mmReturn.ReturnMock.defaultExpectation.params
+		mm_want_ptrs := mmReturn.ReturnMock.defaultExpectation.paramPtrs
 
-		mm_got := UsecasesMockGetRefundsParams{req}
+		mm_got := UsecasesMockReturnParams{ctx, req}
 
 		if mm_want_ptrs != nil {
 
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReturn.t.Errorf("UsecasesMock.Return got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReturn.ReturnMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
 			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
-				mmGetRefunds.t.Errorf("UsecasesMock.GetRefunds got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetRefunds.GetRefundsMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+				mmReturn.t.Errorf("UsecasesMock.Return got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReturn.ReturnMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetRefunds.t.Errorf("UsecasesMock.GetRefunds got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetRefunds.GetRefundsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmReturn.t.Errorf("UsecasesMock.Return got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReturn.ReturnMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetRefunds.GetRefundsMock.defaultExpectation.results
+		mm_results := mmReturn.ReturnMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetRefunds.t.Fatal("No results are set for the UsecasesMock.GetRefunds")
+			mmReturn.t.Fatal("No results are set for the UsecasesMock.Return")
 		}
-		return (*mm_results).oa1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetRefunds.funcGetRefunds != nil {
-		return mmGetRefunds.funcGetRefunds(req)
+	if mmReturn.funcReturn != nil {
+		return mmReturn.funcReturn(ctx, req)
 	}
-	mmGetRefunds.t.Fatalf("Unexpected call to UsecasesMock.GetRefunds. %v", req)
+	mmReturn.t.Fatalf("Unexpected call to UsecasesMock.Return. %v %v", ctx, req)
 	return
 }
 
-// GetRefundsAfterCounter returns a count of finished UsecasesMock.GetRefunds invocations
-func (mmGetRefunds *UsecasesMock) GetRefundsAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetRefunds.afterGetRefundsCounter)
+// ReturnAfterCounter returns a count of finished UsecasesMock.Return invocations
+func (mmReturn *UsecasesMock) ReturnAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReturn.afterReturnCounter)
 }
 
-// GetRefundsBeforeCounter returns a count of UsecasesMock.GetRefunds invocations
-func (mmGetRefunds *UsecasesMock) GetRefundsBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetRefunds.beforeGetRefundsCounter)
+// ReturnBeforeCounter returns a count of UsecasesMock.Return invocations
+func (mmReturn *UsecasesMock) ReturnBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReturn.beforeReturnCounter)
 }
 
-// Calls returns a list of arguments used in each call to UsecasesMock.GetRefunds.
+// Calls returns a list of arguments used in each call to UsecasesMock.Return.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetRefunds *mUsecasesMockGetRefunds) Calls() []*UsecasesMockGetRefundsParams {
-	mmGetRefunds.mutex.RLock()
+func (mmReturn *mUsecasesMockReturn) Calls() []*UsecasesMockReturnParams {
+	mmReturn.mutex.RLock()
 
-	argCopy := make([]*UsecasesMockGetRefundsParams, len(mmGetRefunds.callArgs))
-	copy(argCopy, mmGetRefunds.callArgs)
+	argCopy := make([]*UsecasesMockReturnParams, len(mmReturn.callArgs))
+	copy(argCopy, mmReturn.callArgs)
 
-	mmGetRefunds.mutex.RUnlock()
+	mmReturn.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetRefundsDone returns true if the count of the GetRefunds invocations corresponds
+// MinimockReturnDone returns true if the count of the Return invocations corresponds
 // the number of defined expectations
-func (m *UsecasesMock) MinimockGetRefundsDone() bool {
-	if m.GetRefundsMock.optional {
+func (m *UsecasesMock) MinimockReturnDone() bool {
+	if m.ReturnMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetRefundsMock.expectations {
+	for _, e := range m.ReturnMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetRefundsMock.invocationsDone()
+	return m.ReturnMock.invocationsDone()
 }
 
-// MinimockGetRefundsInspect logs each unmet expectation
-func (m *UsecasesMock) MinimockGetRefundsInspect() {
-	for _, e := range m.GetRefundsMock.expectations {
+// MinimockReturnInspect logs each unmet expectation
+func (m *UsecasesMock) MinimockReturnInspect() {
+	for _, e := range m.ReturnMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UsecasesMock.GetRefunds at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UsecasesMock.Return at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetRefundsCounter := mm_atomic.LoadUint64(&m.afterGetRefundsCounter)
+	afterReturnCounter := mm_atomic.LoadUint64(&m.afterReturnCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetRefundsMock.defaultExpectation != nil && afterGetRefundsCounter < 1 {
-		if m.GetRefundsMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UsecasesMock.GetRefunds at\n%s", m.GetRefundsMock.defaultExpectation.returnOrigin)
+	if m.ReturnMock.defaultExpectation != nil && afterReturnCounter < 1 {
+		if m.ReturnMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecasesMock.Return at\n%s", m.ReturnMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UsecasesMock.GetRefunds at\n%s with params: %#v", m.GetRefundsMock.defaultExpectation.expectationOrigins.origin, *m.GetRefundsMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UsecasesMock.Return at\n%s with params: %#v", m.ReturnMock.defaultExpectation.expectationOrigins.origin, *m.ReturnMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetRefunds != nil && afterGetRefundsCounter < 1 {
-		m.t.Errorf("Expected call to UsecasesMock.GetRefunds at\n%s", m.funcGetRefundsOrigin)
+	if m.funcReturn != nil && afterReturnCounter < 1 {
+		m.t.Errorf("Expected call to UsecasesMock.Return at\n%s", m.funcReturnOrigin)
 	}
 
-	if !m.GetRefundsMock.invocationsDone() && afterGetRefundsCounter > 0 {
-		m.t.Errorf("Expected %d calls to UsecasesMock.GetRefunds at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetRefundsMock.expectedInvocations), m.GetRefundsMock.expectedInvocationsOrigin, afterGetRefundsCounter)
+	if !m.ReturnMock.invocationsDone() && afterReturnCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecasesMock.Return at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReturnMock.expectedInvocations), m.ReturnMock.expectedInvocationsOrigin, afterReturnCounter)
 	}
 }
 
-type mUsecasesMockGive struct {
+type mUsecasesMockSearchOrders struct {
 	optional           bool
 	mock               *UsecasesMock
-	defaultExpectation *UsecasesMockGiveExpectation
-	expectations       []*UsecasesMockGiveExpectation
+	defaultExpectation *UsecasesMockSearchOrdersExpectation
+	expectations       []*UsecasesMockSearchOrdersExpectation
 
-	callArgs []*UsecasesMockGiveParams
+	callArgs []*UsecasesMockSearchOrdersParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UsecasesMockGiveExpectation specifies expectation struct of the Usecases.Give
-type UsecasesMockGiveExpectation struct {
+// UsecasesMockSearchOrdersExpectation specifies expectation struct of the Usecases.SearchOrders
+type UsecasesMockSearchOrdersExpectation struct {
 	mock               *UsecasesMock
-	params             *UsecasesMockGiveParams
-	paramPtrs          *UsecasesMockGiveParamPtrs
-	expectationOrigins UsecasesMockGiveExpectationOrigins
-	results            *UsecasesMockGiveResults
+	params             *UsecasesMockSearchOrdersParams
+	paramPtrs          *UsecasesMockSearchOrdersParamPtrs
+	expectationOrigins UsecasesMockSearchOrdersExpectationOrigins
+	results            *UsecasesMockSearchOrdersResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UsecasesMockGiveParams contains parameters of the Usecases.Give
-type UsecasesMockGiveParams struct {
-	req *dto.GiveOrdersRequest
+// UsecasesMockSearchOrdersParams contains parameters of the Usecases.SearchOrders
+type UsecasesMockSearchOrdersParams struct {
+	ctx context.Context
+	req *dto.SearchOrdersRequest
 }
 
-// UsecasesMockGiveParamPtrs contains pointers to parameters of the Usecases.Give
-type UsecasesMockGiveParamPtrs struct {
-	req **dto.GiveOrdersRequest
+// UsecasesMockSearchOrdersParamPtrs contains pointers to parameters of the Usecases.SearchOrders
+type UsecasesMockSearchOrdersParamPtrs struct {
+	ctx *context.Context
+	req **dto.SearchOrdersRequest
 }
 
-// UsecasesMockGiveResults contains results of the Usecases.Give
-type UsecasesMockGiveResults struct {
-	ea1 []error
+// UsecasesMockSearchOrdersResults contains results of the Usecases.SearchOrders
+type UsecasesMockSearchOrdersResults struct {
+	sp1 *dto.SearchOrdersResponse
+	err error
 }
 
-// UsecasesMockGiveOrigins contains origins of expectations of the Usecases.Give
-type UsecasesMockGiveExpectationOrigins struct {
+// UsecasesMockSearchOrdersOrigins contains origins of expectations of the Usecases.SearchOrders
+type UsecasesMockSearchOrdersExpectationOrigins struct {
 	origin    string
+	originCtx string
 	originReq string
 }
 
@@ -1389,309 +3635,341 @@ type UsecasesMockGiveExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGive *mUsecasesMockGive) Optional() *mUsecasesMockGive {
-	mmGive.optional = true
-	return mmGive
+func (mmSearchOrders *mUsecasesMockSearchOrders) Optional() *mUsecasesMockSearchOrders {
+	mmSearchOrders.optional = true
+	return mmSearchOrders
 }
 
-// Expect sets up expected params for Usecases.Give
-func (mmGive *mUsecasesMockGive) Expect(req *dto.GiveOrdersRequest) *mUsecasesMockGive {
-	if mmGive.mock.funcGive != nil {
-		mmGive.mock.t.Fatalf("UsecasesMock.Give mock is already set by Set")
+// Expect sets up expected params for Usecases.SearchOrders
+func (mmSearchOrders *mUsecasesMockSearchOrders) Expect(ctx context.Context, req *dto.SearchOrdersRequest) *mUsecasesMockSearchOrders {
+	if mmSearchOrders.mock.funcSearchOrders != nil {
+		mmSearchOrders.mock.t.Fatalf("UsecasesMock.SearchOrders mock is already set by Set")
 	}
 
-	if mmGive.defaultExpectation == nil {
-		mmGive.defaultExpectation = &UsecasesMockGiveExpectation{}
+	if mmSearchOrders.defaultExpectation == nil {
+		mmSearchOrders.defaultExpectation = &UsecasesMockSearchOrdersExpectation{}
 	}
 
-	if mmGive.defaultExpectation.paramPtrs != nil {
-		mmGive.mock.t.Fatalf("UsecasesMock.Give mock is already set by ExpectParams functions")
+	if mmSearchOrders.defaultExpectation.paramPtrs != nil {
+		mmSearchOrders.mock.t.Fatalf("UsecasesMock.SearchOrders mock is already set by ExpectParams functions")
 	}
 
-	mmGive.defaultExpectation.params = &UsecasesMockGiveParams{req}
-	mmGive.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGive.expectations {
-		if minimock.Equal(e.params, mmGive.defaultExpectation.params) {
-			mmGive.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGive.defaultExpectation.params)
+	mmSearchOrders.defaultExpectation.params = &UsecasesMockSearchOrdersParams{ctx, req}
+	mmSearchOrders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSearchOrders.expectations {
+		if minimock.Equal(e.params, mmSearchOrders.defaultExpectation.params) {
+			mmSearchOrders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSearchOrders.defaultExpectation.params)
 		}
 	}
 
-	return mmGive
+	return mmSearchOrders
 }
 
-// ExpectReqParam1 sets up expected param req for Usecases.Give
-func (mmGive *mUsecasesMockGive) ExpectReqParam1(req *dto.GiveOrdersRequest) *mUsecasesMockGive {
-	if mmGive.mock.funcGive != nil {
-		mmGive.mock.t.Fatalf("UsecasesMock.Give mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Usecases.SearchOrders
+func (mmSearchOrders *mUsecasesMockSearchOrders) ExpectCtxParam1(ctx context.Context) *mUsecasesMockSearchOrders {
+	if mmSearchOrders.mock.funcSearchOrders != nil {
+		mmSearchOrders.mock.t.Fatalf("UsecasesMock.SearchOrders mock is already set by Set")
 	}
 
-	if mmGive.defaultExpectation == nil {
-		mmGive.defaultExpectation = &UsecasesMockGiveExpectation{}
+	if mmSearchOrders.defaultExpectation == nil {
+		mmSearchOrders.defaultExpectation = &UsecasesMockSearchOrdersExpectation{}
 	}
 
-	if mmGive.defaultExpectation.params != nil {
-		mmGive.mock.t.Fatalf("UsecasesMock.Give mock is already set by Expect")
+	if mmSearchOrders.defaultExpectation.params != nil {
+		mmSearchOrders.mock.t.Fatalf("UsecasesMock.SearchOrders mock is already set by Expect")
 	}
 
-	if mmGive.defaultExpectation.paramPtrs == nil {
-		mmGive.defaultExpectation.paramPtrs = &UsecasesMockGiveParamPtrs{}
+	if mmSearchOrders.defaultExpectation.paramPtrs == nil {
+		mmSearchOrders.defaultExpectation.paramPtrs = &UsecasesMockSearchOrdersParamPtrs{}
 	}
-	mmGive.defaultExpectation.paramPtrs.req = &req
-	mmGive.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+	mmSearchOrders.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSearchOrders.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGive
+	return mmSearchOrders
+}
+
+// ExpectReqParam2 sets up expected param req for Usecases.SearchOrders
+func (mmSearchOrders *mUsecasesMockSearchOrders) ExpectReqParam2(req *dto.SearchOrdersRequest) *mUsecasesMockSearchOrders {
+	if mmSearchOrders.mock.funcSearchOrders != nil {
+		mmSearchOrders.mock.t.Fatalf("UsecasesMock.SearchOrders mock is already set by Set")
+	}
+
+	if mmSearchOrders.defaultExpectation == nil {
+		mmSearchOrders.defaultExpectation = &UsecasesMockSearchOrdersExpectation{}
+	}
+
+	if mmSearchOrders.defaultExpectation.params != nil {
+		mmSearchOrders.mock.t.Fatalf("UsecasesMock.SearchOrders mock is already set by Expect")
+	}
+
+	if mmSearchOrders.defaultExpectation.paramPtrs == nil {
+		mmSearchOrders.defaultExpectation.paramPtrs = &UsecasesMockSearchOrdersParamPtrs{}
+	}
+	mmSearchOrders.defaultExpectation.paramPtrs.req = &req
+	mmSearchOrders.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmSearchOrders
 }
 
-// Inspect accepts an inspector function that has same arguments as the Usecases.Give
-func (mmGive *mUsecasesMockGive) Inspect(f func(req *dto.GiveOrdersRequest)) *mUsecasesMockGive {
-	if mmGive.mock.inspectFuncGive != nil {
-		mmGive.mock.t.Fatalf("Inspect function is already set for UsecasesMock.Give")
+// Inspect accepts an inspector function that has same arguments as the Usecases.SearchOrders
+func (mmSearchOrders *mUsecasesMockSearchOrders) Inspect(f func(ctx context.Context, req *dto.SearchOrdersRequest)) *mUsecasesMockSearchOrders {
+	if mmSearchOrders.mock.inspectFuncSearchOrders != nil {
+		mmSearchOrders.mock.t.Fatalf("Inspect function is already set for UsecasesMock.SearchOrders")
 	}
 
-	mmGive.mock.inspectFuncGive = f
+	mmSearchOrders.mock.inspectFuncSearchOrders = f
 
-	return mmGive
+	return mmSearchOrders
 }
 
-// Return sets up results that will be returned by Usecases.Give
-func (mmGive *mUsecasesMockGive) Return(ea1 []error) *UsecasesMock {
-	if mmGive.mock.funcGive != nil {
-		mmGive.mock.t.Fatalf("UsecasesMock.Give mock is already set by Set")
+// Return sets up results that will be returned by Usecases.SearchOrders
+func (mmSearchOrders *mUsecasesMockSearchOrders) Return(sp1 *dto.SearchOrdersResponse, err error) *UsecasesMock {
+	if mmSearchOrders.mock.funcSearchOrders != nil {
+		mmSearchOrders.mock.t.Fatalf("UsecasesMock.SearchOrders mock is already set by Set")
 	}
 
-	if mmGive.defaultExpectation == nil {
-		mmGive.defaultExpectation = &UsecasesMockGiveExpectation{mock: mmGive.mock}
+	if mmSearchOrders.defaultExpectation == nil {
+		mmSearchOrders.defaultExpectation = &UsecasesMockSearchOrdersExpectation{mock: mmSearchOrders.mock}
 	}
-	mmGive.defaultExpectation.results = &UsecasesMockGiveResults{ea1}
-	mmGive.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGive.mock
+	mmSearchOrders.defaultExpectation.results = &UsecasesMockSearchOrdersResults{sp1, err}
+	mmSearchOrders.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSearchOrders.mock
 }
 
-// Set uses given function f to mock the Usecases.Give method
-func (mmGive *mUsecasesMockGive) Set(f func(req *dto.GiveOrdersRequest) (ea1 []error)) *UsecasesMock {
-	if mmGive.defaultExpectation != nil {
-		mmGive.mock.t.Fatalf("Default expectation is already set for the Usecases.Give method")
+// Set uses given function f to mock the Usecases.SearchOrders method
+func (mmSearchOrders *mUsecasesMockSearchOrders) Set(f func(ctx context.Context, req *dto.SearchOrdersRequest) (sp1 *dto.SearchOrdersResponse, err error)) *UsecasesMock {
+	if mmSearchOrders.defaultExpectation != nil {
+		mmSearchOrders.mock.t.Fatalf("Default expectation is already set for the Usecases.SearchOrders method")
 	}
 
-	if len(mmGive.expectations) > 0 {
-		mmGive.mock.t.Fatalf("Some expectations are already set for the Usecases.Give method")
+	if len(mmSearchOrders.expectations) > 0 {
+		mmSearchOrders.mock.t.Fatalf("Some expectations are already set for the Usecases.SearchOrders method")
 	}
 
-	mmGive.mock.funcGive = f
-	mmGive.mock.funcGiveOrigin = minimock.CallerInfo(1)
-	return mmGive.mock
+	mmSearchOrders.mock.funcSearchOrders = f
+	mmSearchOrders.mock.funcSearchOrdersOrigin = minimock.CallerInfo(1)
+	return mmSearchOrders.mock
 }
 
-// When sets expectation for the Usecases.Give which will trigger the result defined by the following
+// When sets expectation for the Usecases.SearchOrders which will trigger the result defined by the following
 // Then helper
-func (mmGive *mUsecasesMockGive) When(req *dto.GiveOrdersRequest) *UsecasesMockGiveExpectation {
-	if mmGive.mock.funcGive != nil {
-		mmGive.mock.t.Fatalf("UsecasesMock.Give mock is already set by Set")
+func (mmSearchOrders *mUsecasesMockSearchOrders) When(ctx context.Context, req *dto.SearchOrdersRequest) *UsecasesMockSearchOrdersExpectation {
+	if mmSearchOrders.mock.funcSearchOrders != nil {
+		mmSearchOrders.mock.t.Fatalf("UsecasesMock.SearchOrders mock is already set by Set")
 	}
 
-	expectation := &UsecasesMockGiveExpectation{
-		mock:               mmGive.mock,
-		params:             &UsecasesMockGiveParams{req},
-		expectationOrigins: UsecasesMockGiveExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UsecasesMockSearchOrdersExpectation{
+		mock:               mmSearchOrders.mock,
+		params:             &UsecasesMockSearchOrdersParams{ctx, req},
+		expectationOrigins: UsecasesMockSearchOrdersExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGive.expectations = append(mmGive.expectations, expectation)
+	mmSearchOrders.expectations = append(mmSearchOrders.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Usecases.Give return parameters for the expectation previously defined by the When method
-func (e *UsecasesMockGiveExpectation) Then(ea1 []error) *UsecasesMock {
-	e.results = &UsecasesMockGiveResults{ea1}
+// Then sets up Usecases.SearchOrders return parameters for the expectation previously defined by the When method
+func (e *UsecasesMockSearchOrdersExpectation) Then(sp1 *dto.SearchOrdersResponse, err error) *UsecasesMock {
+	e.results = &UsecasesMockSearchOrdersResults{sp1, err}
 	return e.mock
 }
 
-// Times sets number of times Usecases.Give should be invoked
-func (mmGive *mUsecasesMockGive) Times(n uint64) *mUsecasesMockGive {
+// Times sets number of times Usecases.SearchOrders should be invoked
+func (mmSearchOrders *mUsecasesMockSearchOrders) Times(n uint64) *mUsecasesMockSearchOrders {
 	if n == 0 {
-		mmGive.mock.t.Fatalf("Times of UsecasesMock.Give mock can not be zero")
+		mmSearchOrders.mock.t.Fatalf("Times of UsecasesMock.SearchOrders mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGive.expectedInvocations, n)
-	mmGive.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGive
+	mm_atomic.StoreUint64(&mmSearchOrders.expectedInvocations, n)
+	mmSearchOrders.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSearchOrders
 }
 
-func (mmGive *mUsecasesMockGive) invocationsDone() bool {
-	if len(mmGive.expectations) == 0 && mmGive.defaultExpectation == nil && mmGive.mock.funcGive == nil {
+func (mmSearchOrders *mUsecasesMockSearchOrders) invocationsDone() bool {
+	if len(mmSearchOrders.expectations) == 0 && mmSearchOrders.defaultExpectation == nil && mmSearchOrders.mock.funcSearchOrders == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGive.mock.afterGiveCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGive.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSearchOrders.mock.afterSearchOrdersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSearchOrders.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Give implements mm_manager_service.Usecases
-func (mmGive *UsecasesMock) Give(req *dto.GiveOrdersRequest) (ea1 []error) {
-	mm_atomic.AddUint64(&mmGive.beforeGiveCounter, 1)
-	defer mm_atomic.AddUint64(&mmGive.afterGiveCounter, 1)
+// SearchOrders implements mm_manager_service.Usecases
+func (mmSearchOrders *UsecasesMock) SearchOrders(ctx context.Context, req *dto.SearchOrdersRequest) (sp1 *dto.SearchOrdersResponse, err error) {
+	mm_atomic.AddUint64(&mmSearchOrders.beforeSearchOrdersCounter, 1)
+	defer mm_atomic.AddUint64(&mmSearchOrders.afterSearchOrdersCounter, 1)
 
-	mmGive.t.Helper()
+	mmSearchOrders.t.Helper()
 
-	if mmGive.inspectFuncGive != nil {
-		mmGive.inspectFuncGive(req)
+	if mmSearchOrders.inspectFuncSearchOrders != nil {
+		mmSearchOrders.inspectFuncSearchOrders(ctx, req)
 	}
 
-	mm_params := UsecasesMockGiveParams{req}
+	mm_params := UsecasesMockSearchOrdersParams{ctx, req}
 
 	// Record call args
-	mmGive.GiveMock.mutex.Lock()
-	mmGive.GiveMock.callArgs = append(mmGive.GiveMock.callArgs, &mm_params)
-	mmGive.GiveMock.mutex.Unlock()
+	mmSearchOrders.SearchOrdersMock.mutex.Lock()
+	mmSearchOrders.SearchOrdersMock.callArgs = append(mmSearchOrders.SearchOrdersMock.callArgs, &mm_params)
+	mmSearchOrders.SearchOrdersMock.mutex.Unlock()
 
-	for _, e := range mmGive.GiveMock.expectations {
+	for _, e := range mmSearchOrders.SearchOrdersMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ea1
+			return e.results.sp1, e.results.err
 		}
 	}
 
-	if mmGive.GiveMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGive.GiveMock.defaultExpectation.Counter, 1)
-		mm_want := mmGive.GiveMock.defaultExpectation.params
-		mm_want_ptrs := mmGive.GiveMock.defaultExpectation.paramPtrs
+	if mmSearchOrders.SearchOrdersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSearchOrders.SearchOrdersMock.defaultExpectation.Counter, 1)
+		mm_want := mmSearchOrders.SearchOrdersMock.defaultExpectation.params
+		mm_want_ptrs := mmSearchOrders.SearchOrdersMock.defaultExpectation.paramPtrs
 
-		mm_got := UsecasesMockGiveParams{req}
+		mm_got := UsecasesMockSearchOrdersParams{ctx, req}
 
 		if mm_want_ptrs != nil {
 
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSearchOrders.t.Errorf("UsecasesMock.SearchOrders got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchOrders.SearchOrdersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
 			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
-				mmGive.t.Errorf("UsecasesMock.Give got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGive.GiveMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+				mmSearchOrders.t.Errorf("UsecasesMock.SearchOrders got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchOrders.SearchOrdersMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGive.t.Errorf("UsecasesMock.Give got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGive.GiveMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSearchOrders.t.Errorf("UsecasesMock.SearchOrders got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSearchOrders.SearchOrdersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGive.GiveMock.defaultExpectation.results
+		mm_results := mmSearchOrders.SearchOrdersMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGive.t.Fatal("No results are set for the UsecasesMock.Give")
+			mmSearchOrders.t.Fatal("No results are set for the UsecasesMock.SearchOrders")
 		}
-		return (*mm_results).ea1
+		return (*mm_results).sp1, (*mm_results).err
 	}
-	if mmGive.funcGive != nil {
-		return mmGive.funcGive(req)
+	if mmSearchOrders.funcSearchOrders != nil {
+		return mmSearchOrders.funcSearchOrders(ctx, req)
 	}
-	mmGive.t.Fatalf("Unexpected call to UsecasesMock.Give. %v", req)
+	mmSearchOrders.t.Fatalf("Unexpected call to UsecasesMock.SearchOrders. %v %v", ctx, req)
 	return
 }
 
-// GiveAfterCounter returns a count of finished UsecasesMock.Give invocations
-func (mmGive *UsecasesMock) GiveAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGive.afterGiveCounter)
+// SearchOrdersAfterCounter returns a count of finished UsecasesMock.SearchOrders invocations
+func (mmSearchOrders *UsecasesMock) SearchOrdersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchOrders.afterSearchOrdersCounter)
 }
 
-// GiveBeforeCounter returns a count of UsecasesMock.Give invocations
-func (mmGive *UsecasesMock) GiveBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGive.beforeGiveCounter)
+// SearchOrdersBeforeCounter returns a count of UsecasesMock.SearchOrders invocations
+func (mmSearchOrders *UsecasesMock) SearchOrdersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchOrders.beforeSearchOrdersCounter)
 }
 
-// Calls returns a list of arguments used in each call to UsecasesMock.Give.
+// Calls returns a list of arguments used in each call to UsecasesMock.SearchOrders.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGive *mUsecasesMockGive) Calls() []*UsecasesMockGiveParams {
-	mmGive.mutex.RLock()
+func (mmSearchOrders *mUsecasesMockSearchOrders) Calls() []*UsecasesMockSearchOrdersParams {
+	mmSearchOrders.mutex.RLock()
 
-	argCopy := make([]*UsecasesMockGiveParams, len(mmGive.callArgs))
-	copy(argCopy, mmGive.callArgs)
+	argCopy := make([]*UsecasesMockSearchOrdersParams, len(mmSearchOrders.callArgs))
+	copy(argCopy, mmSearchOrders.callArgs)
 
-	mmGive.mutex.RUnlock()
+	mmSearchOrders.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGiveDone returns true if the count of the Give invocations corresponds
+// MinimockSearchOrdersDone returns true if the count of the SearchOrders invocations corresponds
 // the number of defined expectations
-func (m *UsecasesMock) MinimockGiveDone() bool {
-	if m.GiveMock.optional {
+func (m *UsecasesMock) MinimockSearchOrdersDone() bool {
+	if m.SearchOrdersMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GiveMock.expectations {
+	for _, e := range m.SearchOrdersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GiveMock.invocationsDone()
+	return m.SearchOrdersMock.invocationsDone()
 }
 
-// MinimockGiveInspect logs each unmet expectation
-func (m *UsecasesMock) MinimockGiveInspect() {
-	for _, e := range m.GiveMock.expectations {
+// MinimockSearchOrdersInspect logs each unmet expectation
+func (m *UsecasesMock) MinimockSearchOrdersInspect() {
+	for _, e := range m.SearchOrdersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UsecasesMock.Give at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UsecasesMock.SearchOrders at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGiveCounter := mm_atomic.LoadUint64(&m.afterGiveCounter)
+	afterSearchOrdersCounter := mm_atomic.LoadUint64(&m.afterSearchOrdersCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GiveMock.defaultExpectation != nil && afterGiveCounter < 1 {
-		if m.GiveMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UsecasesMock.Give at\n%s", m.GiveMock.defaultExpectation.returnOrigin)
+	if m.SearchOrdersMock.defaultExpectation != nil && afterSearchOrdersCounter < 1 {
+		if m.SearchOrdersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecasesMock.SearchOrders at\n%s", m.SearchOrdersMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UsecasesMock.Give at\n%s with params: %#v", m.GiveMock.defaultExpectation.expectationOrigins.origin, *m.GiveMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UsecasesMock.SearchOrders at\n%s with params: %#v", m.SearchOrdersMock.defaultExpectation.expectationOrigins.origin, *m.SearchOrdersMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGive != nil && afterGiveCounter < 1 {
-		m.t.Errorf("Expected call to UsecasesMock.Give at\n%s", m.funcGiveOrigin)
+	if m.funcSearchOrders != nil && afterSearchOrdersCounter < 1 {
+		m.t.Errorf("Expected call to UsecasesMock.SearchOrders at\n%s", m.funcSearchOrdersOrigin)
 	}
 
-	if !m.GiveMock.invocationsDone() && afterGiveCounter > 0 {
-		m.t.Errorf("Expected %d calls to UsecasesMock.Give at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GiveMock.expectedInvocations), m.GiveMock.expectedInvocationsOrigin, afterGiveCounter)
+	if !m.SearchOrdersMock.invocationsDone() && afterSearchOrdersCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecasesMock.SearchOrders at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SearchOrdersMock.expectedInvocations), m.SearchOrdersMock.expectedInvocationsOrigin, afterSearchOrdersCounter)
 	}
 }
 
-type mUsecasesMockReturn struct {
+type mUsecasesMockWatch struct {
 	optional           bool
 	mock               *UsecasesMock
-	defaultExpectation *UsecasesMockReturnExpectation
-	expectations       []*UsecasesMockReturnExpectation
+	defaultExpectation *UsecasesMockWatchExpectation
+	expectations       []*UsecasesMockWatchExpectation
 
-	callArgs []*UsecasesMockReturnParams
+	callArgs []*UsecasesMockWatchParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// UsecasesMockReturnExpectation specifies expectation struct of the Usecases.Return
-type UsecasesMockReturnExpectation struct {
+// UsecasesMockWatchExpectation specifies expectation struct of the Usecases.Watch
+type UsecasesMockWatchExpectation struct {
 	mock               *UsecasesMock
-	params             *UsecasesMockReturnParams
-	paramPtrs          *UsecasesMockReturnParamPtrs
-	expectationOrigins UsecasesMockReturnExpectationOrigins
-	results            *UsecasesMockReturnResults
+	params             *UsecasesMockWatchParams
+	paramPtrs          *UsecasesMockWatchParamPtrs
+	expectationOrigins UsecasesMockWatchExpectationOrigins
+	results            *UsecasesMockWatchResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// UsecasesMockReturnParams contains parameters of the Usecases.Return
-type UsecasesMockReturnParams struct {
-	req *dto.ReturnRequest
+// UsecasesMockWatchParams contains parameters of the Usecases.Watch
+type UsecasesMockWatchParams struct {
+	ctx context.Context
+	req *dto.WatchOrdersRequest
 }
 
-// UsecasesMockReturnParamPtrs contains pointers to parameters of the Usecases.Return
-type UsecasesMockReturnParamPtrs struct {
-	req **dto.ReturnRequest
+// UsecasesMockWatchParamPtrs contains pointers to parameters of the Usecases.Watch
+type UsecasesMockWatchParamPtrs struct {
+	ctx *context.Context
+	req **dto.WatchOrdersRequest
 }
 
-// UsecasesMockReturnResults contains results of the Usecases.Return
-type UsecasesMockReturnResults struct {
+// UsecasesMockWatchResults contains results of the Usecases.Watch
+type UsecasesMockWatchResults struct {
+	sp1 *broadcast.Subscription
 	err error
 }
 
-// UsecasesMockReturnOrigins contains origins of expectations of the Usecases.Return
-type UsecasesMockReturnExpectationOrigins struct {
+// UsecasesMockWatchOrigins contains origins of expectations of the Usecases.Watch
+type UsecasesMockWatchExpectationOrigins struct {
 	origin    string
+	originCtx string
 	originReq string
 }
 
@@ -1700,264 +3978,292 @@ type UsecasesMockReturnExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmReturn *mUsecasesMockReturn) Optional() *mUsecasesMockReturn {
-	mmReturn.optional = true
-	return mmReturn
+func (mmWatch *mUsecasesMockWatch) Optional() *mUsecasesMockWatch {
+	mmWatch.optional = true
+	return mmWatch
 }
 
-// Expect sets up expected params for Usecases.Return
-func (mmReturn *mUsecasesMockReturn) Expect(req *dto.ReturnRequest) *mUsecasesMockReturn {
-	if mmReturn.mock.funcReturn != nil {
-		mmReturn.mock.t.Fatalf("UsecasesMock.Return mock is already set by Set")
+// Expect sets up expected params for Usecases.Watch
+func (mmWatch *mUsecasesMockWatch) Expect(ctx context.Context, req *dto.WatchOrdersRequest) *mUsecasesMockWatch {
+	if mmWatch.mock.funcWatch != nil {
+		mmWatch.mock.t.Fatalf("UsecasesMock.Watch mock is already set by Set")
 	}
 
-	if mmReturn.defaultExpectation == nil {
-		mmReturn.defaultExpectation = &UsecasesMockReturnExpectation{}
+	if mmWatch.defaultExpectation == nil {
+		mmWatch.defaultExpectation = &UsecasesMockWatchExpectation{}
 	}
 
-	if mmReturn.defaultExpectation.paramPtrs != nil {
-		mmReturn.mock.t.Fatalf("UsecasesMock.Return mock is already set by ExpectParams functions")
+	if mmWatch.defaultExpectation.paramPtrs != nil {
+		mmWatch.mock.t.Fatalf("UsecasesMock.Watch mock is already set by ExpectParams functions")
 	}
 
-	mmReturn.defaultExpectation.params = &UsecasesMockReturnParams{req}
-	mmReturn.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmReturn.expectations {
-		if minimock.Equal(e.params, mmReturn.defaultExpectation.params) {
-			mmReturn.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReturn.defaultExpectation.params)
+	mmWatch.defaultExpectation.params = &UsecasesMockWatchParams{ctx, req}
+	mmWatch.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmWatch.expectations {
+		if minimock.Equal(e.params, mmWatch.defaultExpectation.params) {
+			mmWatch.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmWatch.defaultExpectation.params)
 		}
 	}
 
-	return mmReturn
+	return mmWatch
 }
 
-// ExpectReqParam1 sets up expected param req for Usecases.Return
-func (mmReturn *mUsecasesMockReturn) ExpectReqParam1(req *dto.ReturnRequest) *mUsecasesMockReturn {
-	if mmReturn.mock.funcReturn != nil {
-		mmReturn.mock.t.Fatalf("UsecasesMock.Return mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Usecases.Watch
+func (mmWatch *mUsecasesMockWatch) ExpectCtxParam1(ctx context.Context) *mUsecasesMockWatch {
+	if mmWatch.mock.funcWatch != nil {
+		mmWatch.mock.t.Fatalf("UsecasesMock.Watch mock is already set by Set")
 	}
 
-	if mmReturn.defaultExpectation == nil {
-		mmReturn.defaultExpectation = &UsecasesMockReturnExpectation{}
+	if mmWatch.defaultExpectation == nil {
+		mmWatch.defaultExpectation = &UsecasesMockWatchExpectation{}
 	}
 
-	if mmReturn.defaultExpectation.params != nil {
-		mmReturn.mock.t.Fatalf("UsecasesMock.Return mock is already set by Expect")
+	if mmWatch.defaultExpectation.params != nil {
+		mmWatch.mock.t.Fatalf("UsecasesMock.Watch mock is already set by Expect")
 	}
 
-	if mmReturn.defaultExpectation.paramPtrs == nil {
-		mmReturn.defaultExpectation.paramPtrs = &UsecasesMockReturnParamPtrs{}
+	if mmWatch.defaultExpectation.paramPtrs == nil {
+		mmWatch.defaultExpectation.paramPtrs = &UsecasesMockWatchParamPtrs{}
 	}
-	mmReturn.defaultExpectation.paramPtrs.req = &req
-	mmReturn.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+	mmWatch.defaultExpectation.paramPtrs.ctx = &ctx
+	mmWatch.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmReturn
+	return mmWatch
 }
 
-// Inspect accepts an inspector function that has same arguments as the Usecases.Return
-func (mmReturn *mUsecasesMockReturn) Inspect(f func(req *dto.ReturnRequest)) *mUsecasesMockReturn {
-	if mmReturn.mock.inspectFuncReturn != nil {
-		mmReturn.mock.t.Fatalf("Inspect function is already set for UsecasesMock.Return")
+// ExpectReqParam2 sets up expected param req for Usecases.Watch
+func (mmWatch *mUsecasesMockWatch) ExpectReqParam2(req *dto.WatchOrdersRequest) *mUsecasesMockWatch {
+	if mmWatch.mock.funcWatch != nil {
+		mmWatch.mock.t.Fatalf("UsecasesMock.Watch mock is already set by Set")
 	}
 
-	mmReturn.mock.inspectFuncReturn = f
+	if mmWatch.defaultExpectation == nil {
+		mmWatch.defaultExpectation = &UsecasesMockWatchExpectation{}
+	}
 
-	return mmReturn
+	if mmWatch.defaultExpectation.params != nil {
+		mmWatch.mock.t.Fatalf("UsecasesMock.Watch mock is already set by Expect")
+	}
+
+	if mmWatch.defaultExpectation.paramPtrs == nil {
+		mmWatch.defaultExpectation.paramPtrs = &UsecasesMockWatchParamPtrs{}
+	}
+	mmWatch.defaultExpectation.paramPtrs.req = &req
+	mmWatch.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmWatch
 }
 
-// Return sets up results that will be returned by Usecases.Return
-func (mmReturn *mUsecasesMockReturn) Return(err error) *UsecasesMock {
-	if mmReturn.mock.funcReturn != nil {
-		mmReturn.mock.t.Fatalf("UsecasesMock.Return mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the Usecases.Watch
+func (mmWatch *mUsecasesMockWatch) Inspect(f func(ctx context.Context, req *dto.WatchOrdersRequest)) *mUsecasesMockWatch {
+	if mmWatch.mock.inspectFuncWatch != nil {
+		mmWatch.mock.t.Fatalf("Inspect function is already set for UsecasesMock.Watch")
 	}
 
-	if mmReturn.defaultExpectation == nil {
-		mmReturn.defaultExpectation = &UsecasesMockReturnExpectation{mock: mmReturn.mock}
+	mmWatch.mock.inspectFuncWatch = f
+
+	return mmWatch
+}
+
+// Return sets up results that will be returned by Usecases.Watch
+func (mmWatch *mUsecasesMockWatch) Return(sp1 *broadcast.Subscription, err error) *UsecasesMock {
+	if mmWatch.mock.funcWatch != nil {
+		mmWatch.mock.t.Fatalf("UsecasesMock.Watch mock is already set by Set")
 	}
-	mmReturn.defaultExpectation.results = &UsecasesMockReturnResults{err}
-	mmReturn.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmReturn.mock
+
+	if mmWatch.defaultExpectation == nil {
+		mmWatch.defaultExpectation = &UsecasesMockWatchExpectation{mock: mmWatch.mock}
+	}
+	mmWatch.defaultExpectation.results = &UsecasesMockWatchResults{sp1, err}
+	mmWatch.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmWatch.mock
 }
 
-// Set uses given function f to mock the Usecases.Return method
-func (mmReturn *mUsecasesMockReturn) Set(f func(req *dto.ReturnRequest) (err error)) *UsecasesMock {
-	if mmReturn.defaultExpectation != nil {
-		mmReturn.mock.t.Fatalf("Default expectation is already set for the Usecases.Return method")
+// Set uses given function f to mock the Usecases.Watch method
+func (mmWatch *mUsecasesMockWatch) Set(f func(ctx context.Context, req *dto.WatchOrdersRequest) (sp1 *broadcast.Subscription, err error)) *UsecasesMock {
+	if mmWatch.defaultExpectation != nil {
+		mmWatch.mock.t.Fatalf("Default expectation is already set for the Usecases.Watch method")
 	}
 
-	if len(mmReturn.expectations) > 0 {
-		mmReturn.mock.t.Fatalf("Some expectations are already set for the Usecases.Return method")
+	if len(mmWatch.expectations) > 0 {
+		mmWatch.mock.t.Fatalf("Some expectations are already set for the Usecases.Watch method")
 	}
 
-	mmReturn.mock.funcReturn = f
-	mmReturn.mock.funcReturnOrigin = minimock.CallerInfo(1)
-	return mmReturn.mock
+	mmWatch.mock.funcWatch = f
+	mmWatch.mock.funcWatchOrigin = minimock.CallerInfo(1)
+	return mmWatch.mock
 }
 
-// When sets expectation for the Usecases.Return which will trigger the result defined by the following
+// When sets expectation for the Usecases.Watch which will trigger the result defined by the following
 // Then helper
-func (mmReturn *mUsecasesMockReturn) When(req *dto.ReturnRequest) *UsecasesMockReturnExpectation {
-	if mmReturn.mock.funcReturn != nil {
-		mmReturn.mock.t.Fatalf("UsecasesMock.Return mock is already set by Set")
+func (mmWatch *mUsecasesMockWatch) When(ctx context.Context, req *dto.WatchOrdersRequest) *UsecasesMockWatchExpectation {
+	if mmWatch.mock.funcWatch != nil {
+		mmWatch.mock.t.Fatalf("UsecasesMock.Watch mock is already set by Set")
 	}
 
-	expectation := &UsecasesMockReturnExpectation{
-		mock:               mmReturn.mock,
-		params:             &UsecasesMockReturnParams{req},
-		expectationOrigins: UsecasesMockReturnExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &UsecasesMockWatchExpectation{
+		mock:               mmWatch.mock,
+		params:             &UsecasesMockWatchParams{ctx, req},
+		expectationOrigins: UsecasesMockWatchExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmReturn.expectations = append(mmReturn.expectations, expectation)
+	mmWatch.expectations = append(mmWatch.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Usecases.Return return parameters for the expectation previously defined by the When method
-func (e *UsecasesMockReturnExpectation) Then(err error) *UsecasesMock {
-	e.results = &UsecasesMockReturnResults{err}
+// Then sets up Usecases.Watch return parameters for the expectation previously defined by the When method
+func (e *UsecasesMockWatchExpectation) Then(sp1 *broadcast.Subscription, err error) *UsecasesMock {
+	e.results = &UsecasesMockWatchResults{sp1, err}
 	return e.mock
 }
 
-// Times sets number of times Usecases.Return should be invoked
-func (mmReturn *mUsecasesMockReturn) Times(n uint64) *mUsecasesMockReturn {
+// Times sets number of times Usecases.Watch should be invoked
+func (mmWatch *mUsecasesMockWatch) Times(n uint64) *mUsecasesMockWatch {
 	if n == 0 {
-		mmReturn.mock.t.Fatalf("Times of UsecasesMock.Return mock can not be zero")
+		mmWatch.mock.t.Fatalf("Times of UsecasesMock.Watch mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmReturn.expectedInvocations, n)
-	mmReturn.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmReturn
+	mm_atomic.StoreUint64(&mmWatch.expectedInvocations, n)
+	mmWatch.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmWatch
 }
 
-func (mmReturn *mUsecasesMockReturn) invocationsDone() bool {
-	if len(mmReturn.expectations) == 0 && mmReturn.defaultExpectation == nil && mmReturn.mock.funcReturn == nil {
+func (mmWatch *mUsecasesMockWatch) invocationsDone() bool {
+	if len(mmWatch.expectations) == 0 && mmWatch.defaultExpectation == nil && mmWatch.mock.funcWatch == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmReturn.mock.afterReturnCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmReturn.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmWatch.mock.afterWatchCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmWatch.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Return implements mm_manager_service.Usecases
-func (mmReturn *UsecasesMock) Return(req *dto.ReturnRequest) (err error) {
-	mm_atomic.AddUint64(&mmReturn.beforeReturnCounter, 1)
-	defer mm_atomic.AddUint64(&mmReturn.afterReturnCounter, 1)
+// Watch implements mm_manager_service.Usecases
+func (mmWatch *UsecasesMock) Watch(ctx context.Context, req *dto.WatchOrdersRequest) (sp1 *broadcast.Subscription, err error) {
+	mm_atomic.AddUint64(&mmWatch.beforeWatchCounter, 1)
+	defer mm_atomic.AddUint64(&mmWatch.afterWatchCounter, 1)
 
-	mmReturn.t.Helper()
+	mmWatch.t.Helper()
 
-	if mmReturn.inspectFuncReturn != nil {
-		mmReturn.inspectFuncReturn(req)
+	if mmWatch.inspectFuncWatch != nil {
+		mmWatch.inspectFuncWatch(ctx, req)
 	}
 
-	mm_params := UsecasesMockReturnParams{req}
+	mm_params := UsecasesMockWatchParams{ctx, req}
 
 	// Record call args
-	mmReturn.ReturnMock.mutex.Lock()
-	mmReturn.ReturnMock.callArgs = append(mmReturn.ReturnMock.callArgs, &mm_params)
-	mmReturn.ReturnMock.mutex.Unlock()
+	mmWatch.WatchMock.mutex.Lock()
+	mmWatch.WatchMock.callArgs = append(mmWatch.WatchMock.callArgs, &mm_params)
+	mmWatch.WatchMock.mutex.Unlock()
 
-	for _, e := range mmReturn.ReturnMock.expectations {
+	for _, e := range mmWatch.WatchMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.sp1, e.results.err
 		}
 	}
 
-	if mmReturn.ReturnMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmReturn.ReturnMock.defaultExpectation.Counter, 1)
-		mm_want := mmReturn.ReturnMock.defaultExpectation.params
-		mm_want_ptrs := mmReturn.ReturnMock.defaultExpectation.paramPtrs
+	if mmWatch.WatchMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmWatch.WatchMock.defaultExpectation.Counter, 1)
+		mm_want := mmWatch.WatchMock.defaultExpectation.params
+		mm_want_ptrs := mmWatch.WatchMock.defaultExpectation.paramPtrs
 
-		mm_got := UsecasesMockReturnParams{req}
+		mm_got := UsecasesMockWatchParams{ctx, req}
 
 		if mm_want_ptrs != nil {
 
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmWatch.t.Errorf("UsecasesMock.Watch got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWatch.WatchMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
 			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
-				mmReturn.t.Errorf("UsecasesMock.Return got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmReturn.ReturnMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+				mmWatch.t.Errorf("UsecasesMock.Watch got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmWatch.WatchMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmReturn.t.Errorf("UsecasesMock.Return got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmReturn.ReturnMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmWatch.t.Errorf("UsecasesMock.Watch got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmWatch.WatchMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmReturn.ReturnMock.defaultExpectation.results
+		mm_results := mmWatch.WatchMock.defaultExpectation.results
 		if mm_results == nil {
-			mmReturn.t.Fatal("No results are set for the UsecasesMock.Return")
+			mmWatch.t.Fatal("No results are set for the UsecasesMock.Watch")
 		}
-		return (*mm_results).err
+		return (*mm_results).sp1, (*mm_results).err
 	}
-	if mmReturn.funcReturn != nil {
-		return mmReturn.funcReturn(req)
+	if mmWatch.funcWatch != nil {
+		return mmWatch.funcWatch(ctx, req)
 	}
-	mmReturn.t.Fatalf("Unexpected call to UsecasesMock.Return. %v", req)
+	mmWatch.t.Fatalf("Unexpected call to UsecasesMock.Watch. %v %v", ctx, req)
 	return
 }
 
-// ReturnAfterCounter returns a count of finished UsecasesMock.Return invocations
-func (mmReturn *UsecasesMock) ReturnAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmReturn.afterReturnCounter)
+// WatchAfterCounter returns a count of finished UsecasesMock.Watch invocations
+func (mmWatch *UsecasesMock) WatchAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWatch.afterWatchCounter)
 }
 
-// ReturnBeforeCounter returns a count of UsecasesMock.Return invocations
-func (mmReturn *UsecasesMock) ReturnBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmReturn.beforeReturnCounter)
+// WatchBeforeCounter returns a count of UsecasesMock.Watch invocations
+func (mmWatch *UsecasesMock) WatchBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmWatch.beforeWatchCounter)
 }
 
-// Calls returns a list of arguments used in each call to UsecasesMock.Return.
+// Calls returns a list of arguments used in each call to UsecasesMock.Watch.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmReturn *mUsecasesMockReturn) Calls() []*UsecasesMockReturnParams {
-	mmReturn.mutex.RLock()
+func (mmWatch *mUsecasesMockWatch) Calls() []*UsecasesMockWatchParams {
+	mmWatch.mutex.RLock()
 
-	argCopy := make([]*UsecasesMockReturnParams, len(mmReturn.callArgs))
-	copy(argCopy, mmReturn.callArgs)
+	argCopy := make([]*UsecasesMockWatchParams, len(mmWatch.callArgs))
+	copy(argCopy, mmWatch.callArgs)
 
-	mmReturn.mutex.RUnlock()
+	mmWatch.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockReturnDone returns true if the count of the Return invocations corresponds
+// MinimockWatchDone returns true if the count of the Watch invocations corresponds
 // the number of defined expectations
-func (m *UsecasesMock) MinimockReturnDone() bool {
-	if m.ReturnMock.optional {
+func (m *UsecasesMock) MinimockWatchDone() bool {
+	if m.WatchMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.ReturnMock.expectations {
+	for _, e := range m.WatchMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.ReturnMock.invocationsDone()
+	return m.WatchMock.invocationsDone()
 }
 
-// MinimockReturnInspect logs each unmet expectation
-func (m *UsecasesMock) MinimockReturnInspect() {
-	for _, e := range m.ReturnMock.expectations {
+// MinimockWatchInspect logs each unmet expectation
+func (m *UsecasesMock) MinimockWatchInspect() {
+	for _, e := range m.WatchMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to UsecasesMock.Return at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to UsecasesMock.Watch at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterReturnCounter := mm_atomic.LoadUint64(&m.afterReturnCounter)
+	afterWatchCounter := mm_atomic.LoadUint64(&m.afterWatchCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.ReturnMock.defaultExpectation != nil && afterReturnCounter < 1 {
-		if m.ReturnMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to UsecasesMock.Return at\n%s", m.ReturnMock.defaultExpectation.returnOrigin)
+	if m.WatchMock.defaultExpectation != nil && afterWatchCounter < 1 {
+		if m.WatchMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to UsecasesMock.Watch at\n%s", m.WatchMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to UsecasesMock.Return at\n%s with params: %#v", m.ReturnMock.defaultExpectation.expectationOrigins.origin, *m.ReturnMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to UsecasesMock.Watch at\n%s with params: %#v", m.WatchMock.defaultExpectation.expectationOrigins.origin, *m.WatchMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcReturn != nil && afterReturnCounter < 1 {
-		m.t.Errorf("Expected call to UsecasesMock.Return at\n%s", m.funcReturnOrigin)
+	if m.funcWatch != nil && afterWatchCounter < 1 {
+		m.t.Errorf("Expected call to UsecasesMock.Watch at\n%s", m.funcWatchOrigin)
 	}
 
-	if !m.ReturnMock.invocationsDone() && afterReturnCounter > 0 {
-		m.t.Errorf("Expected %d calls to UsecasesMock.Return at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.ReturnMock.expectedInvocations), m.ReturnMock.expectedInvocationsOrigin, afterReturnCounter)
+	if !m.WatchMock.invocationsDone() && afterWatchCounter > 0 {
+		m.t.Errorf("Expected %d calls to UsecasesMock.Watch at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.WatchMock.expectedInvocations), m.WatchMock.expectedInvocationsOrigin, afterWatchCounter)
 	}
 }
 
@@ -1969,13 +4275,25 @@ func (m *UsecasesMock) MinimockFinish() {
 
 			m.MinimockAcceptRefundInspect()
 
+			m.MinimockEraseUserDataInspect()
+
+			m.MinimockExportUserDataInspect()
+
+			m.MinimockGetOrderInspect()
+
 			m.MinimockGetOrdersInspect()
 
 			m.MinimockGetRefundsInspect()
 
 			m.MinimockGiveInspect()
 
+			m.MinimockQueryAuditLogInspect()
+
 			m.MinimockReturnInspect()
+
+			m.MinimockSearchOrdersInspect()
+
+			m.MinimockWatchInspect()
 		}
 	})
 }
@@ -2001,8 +4319,14 @@ func (m *UsecasesMock) minimockDone() bool {
 	return done &&
 		m.MinimockAcceptOrderDone() &&
 		m.MinimockAcceptRefundDone() &&
+		m.MinimockEraseUserDataDone() &&
+		m.MinimockExportUserDataDone() &&
+		m.MinimockGetOrderDone() &&
 		m.MinimockGetOrdersDone() &&
 		m.MinimockGetRefundsDone() &&
 		m.MinimockGiveDone() &&
-		m.MinimockReturnDone()
+		m.MinimockQueryAuditLogDone() &&
+		m.MinimockReturnDone() &&
+		m.MinimockSearchOrdersDone() &&
+		m.MinimockWatchDone()
 }
@@ -24,13 +24,14 @@ func (s *ManagerService) Refund(ctx context.Context, req *desc.RefundRequest) (*
 	}
 
 	usecase_req := &dto.RefundRequest{
-		UserID:  req.GetUserId(),
-		OrderID: req.GetOrderId(),
+		UserID:          req.GetUserId(),
+		OrderID:         req.GetOrderId(),
+		ExpectedVersion: req.ExpectedVersion,
 	}
 
-	err := s.au.AcceptRefund(usecase_req)
+	err := s.au.AcceptRefund(ctx, usecase_req)
 	if IsServiceError(err) {
-		s.sendEvent([]uint64{req.GetOrderId()}, domain.EventOrderReturned, err)
+		s.sendEvent(ctx, []uint64{req.GetOrderId()}, domain.EventOrderReturned, err)
 		metrics.IncTotalErrors(handler, err)
 	}
 
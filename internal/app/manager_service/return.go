@@ -24,12 +24,13 @@ func (s *ManagerService) Return(ctx context.Context, req *desc.ReturnRequest) (*
 	}
 
 	usecase_req := &dto.ReturnRequest{
-		OrderID: req.GetOrderId(),
+		OrderID:         req.GetOrderId(),
+		ExpectedVersion: req.ExpectedVersion,
 	}
 
-	err := s.ru.Return(usecase_req)
+	err := s.ru.Return(ctx, usecase_req)
 	if IsServiceError(err) {
-		s.sendEvent([]uint64{req.GetOrderId()}, domain.EventOrderGiveCourier, err)
+		s.sendEvent(ctx, []uint64{req.GetOrderId()}, domain.EventOrderGiveCourier, err)
 		metrics.IncTotalErrors(handler, err)
 	}
 
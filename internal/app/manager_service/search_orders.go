@@ -0,0 +1,64 @@
+package manager_service
+
+import (
+	"context"
+	"time"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/dto"
+	"gitlab.ozon.dev/chppppr/homework/internal/metrics"
+	desc "gitlab.ozon.dev/chppppr/homework/pkg/manager-service/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var sortFieldToDomain = map[desc.SortField]string{
+	desc.SortField_SORT_FIELD_ORDER_ID:        domain.SortByOrderID,
+	desc.SortField_SORT_FIELD_EXPIRATION_DATE: domain.SortByExpirationDate,
+	desc.SortField_SORT_FIELD_ACCEPTED_AT:     domain.SortByAcceptedAt,
+	desc.SortField_SORT_FIELD_UPDATED_AT:      domain.SortByUpdatedAt,
+	desc.SortField_SORT_FIELD_COST:            domain.SortByCost,
+	desc.SortField_SORT_FIELD_WEIGHT:          domain.SortByWeight,
+}
+
+func (s *ManagerService) SearchOrders(ctx context.Context, req *desc.SearchOrdersRequest) (*desc.SearchOrdersResponse, error) {
+	const handler = "search_orders"
+
+	timer := time.Now()
+	defer func() { metrics.ObserveResponseTime(time.Since(timer), handler) }()
+
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	usecase_req := &dto.SearchOrdersRequest{
+		OrderFilter: domain.OrderFilter{
+			UserID:      req.GetUserId(),
+			Statuses:    req.GetStatuses(),
+			Accepted:    DateRangeToDomain(req.GetAccepted()),
+			Expiring:    DateRangeToDomain(req.GetExpiring()),
+			Updated:     DateRangeToDomain(req.GetUpdated()),
+			PackageType: req.GetPackageType(),
+			Cost:        UintRangeToDomain(req.GetCost()),
+			Weight:      UintRangeToDomain(req.GetWeight()),
+			SortBy:      sortFieldToDomain[req.GetSortBy()],
+			Descending:  req.GetDescending(),
+		},
+		PageSize:  req.GetPageSize(),
+		PageToken: req.GetPageToken(),
+	}
+
+	res, err := s.su.SearchOrders(ctx, usecase_req)
+	if IsServiceError(err) {
+		metrics.IncTotalErrors(handler, err)
+	}
+
+	if err != nil {
+		return nil, DomainErrToGRPC(err)
+	}
+
+	return &desc.SearchOrdersResponse{
+		Orders:        OrderRecordsToProto(res.Orders),
+		NextPageToken: res.NextPageToken,
+	}, nil
+}
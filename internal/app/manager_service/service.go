@@ -1,8 +1,11 @@
 package manager_service
 
 import (
+	"context"
 	"log"
 
+	"gitlab.ozon.dev/chppppr/homework/internal/auth"
+	"gitlab.ozon.dev/chppppr/homework/internal/broadcast"
 	"gitlab.ozon.dev/chppppr/homework/internal/clients"
 	"gitlab.ozon.dev/chppppr/homework/internal/domain"
 	"gitlab.ozon.dev/chppppr/homework/internal/dto"
@@ -11,21 +14,40 @@ import (
 
 type (
 	AcceptUsecase interface {
-		AcceptOrder(req *dto.AddOrderRequest) error
-		AcceptRefund(req *dto.RefundRequest) error
+		AcceptOrder(ctx context.Context, req *dto.AddOrderRequest) error
+		AcceptRefund(ctx context.Context, req *dto.RefundRequest) error
 	}
 
 	GiveUsecase interface {
-		Give(req *dto.GiveOrdersRequest) []error
+		Give(ctx context.Context, req *dto.GiveOrdersRequest) []error
 	}
 
 	ReturnUsecase interface {
-		Return(req *dto.ReturnRequest) error
+		Return(ctx context.Context, req *dto.ReturnRequest) error
 	}
 
 	ViewUsecase interface {
-		GetOrders(req *dto.ViewOrdersRequest) ([]domain.OrderView, error)
-		GetRefunds(req *dto.ViewRefundsRequest) ([]domain.OrderView, error)
+		GetOrders(ctx context.Context, req *dto.ViewOrdersRequest) ([]domain.OrderView, error)
+		GetRefunds(ctx context.Context, req *dto.ViewRefundsRequest) ([]domain.OrderView, error)
+		GetOrder(ctx context.Context, req *dto.GetOrderRequest) (*dto.GetOrderResponse, error)
+	}
+
+	SearchUsecase interface {
+		SearchOrders(ctx context.Context, req *dto.SearchOrdersRequest) (*dto.SearchOrdersResponse, error)
+	}
+
+	WatchUsecase interface {
+		Watch(ctx context.Context, req *dto.WatchOrdersRequest) (*broadcast.Subscription, error)
+	}
+
+	// UserDataUsecase отвечает на запросы клиентов об их данных
+	UserDataUsecase interface {
+		ExportUserData(ctx context.Context, req *dto.ExportUserDataRequest) (*domain.UserData, error)
+		EraseUserData(ctx context.Context, req *dto.EraseUserDataRequest) (*dto.EraseUserDataResponse, error)
+	}
+
+	AuditUsecase interface {
+		QueryAuditLog(ctx context.Context, req *dto.QueryAuditLogRequest) ([]domain.AuditRecord, error)
 	}
 
 	Usecases interface {
@@ -33,6 +55,10 @@ type (
 		GiveUsecase
 		ReturnUsecase
 		ViewUsecase
+		SearchUsecase
+		WatchUsecase
+		UserDataUsecase
+		AuditUsecase
 	}
 
 	ManagerService struct {
@@ -40,26 +66,39 @@ type (
 		gu GiveUsecase
 		ru ReturnUsecase
 		vu ViewUsecase
+		su SearchUsecase
+		wu WatchUsecase
+		// du - nil, если хранилище не поддерживает запросы о данных клиента
+		du UserDataUsecase
+		// qu - nil, если хранилище не поддерживает журнал действий
+		qu AuditUsecase
 		pr clients.KafkaProducer
 
 		desc.UnimplementedManagerServiceServer
 	}
 )
 
-func NewManagerService(au AcceptUsecase, gu GiveUsecase, ru ReturnUsecase, vu ViewUsecase, pr clients.KafkaProducer) *ManagerService {
+func NewManagerService(au AcceptUsecase, gu GiveUsecase, ru ReturnUsecase, vu ViewUsecase, su SearchUsecase, wu WatchUsecase, du UserDataUsecase, qu AuditUsecase, pr clients.KafkaProducer) *ManagerService {
 	s := &ManagerService{
 		au: au,
 		gu: gu,
 		ru: ru,
 		vu: vu,
+		su: su,
+		wu: wu,
+		du: du,
+		qu: qu,
 		pr: pr,
 	}
 
 	return s
 }
 
-func (s *ManagerService) sendEvent(orderIDs []uint64, event domain.EventType, err error) {
-	prod_err := s.pr.Send(orderIDs, event, err)
+func (s *ManagerService) sendEvent(ctx context.Context, orderIDs []uint64, event domain.EventType, err error) {
+	ev := domain.NewEvent(orderIDs, event, err)
+	ev.Actor = auth.Actor(ctx)
+
+	prod_err := s.pr.SendEvent(ev)
 	if prod_err != nil {
 		log.Println("ManagerService.sendEvent() failed: ", prod_err)
 	}
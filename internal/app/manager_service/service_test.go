@@ -32,7 +32,7 @@ func TestManagerService_AddOrder(t *testing.T) {
 	us := mock.NewUsecasesMock(ctrl)
 	prod := mock.NewKafkaProducerMock(ctrl)
 
-	mng := NewManagerService(us, us, us, us, prod)
+	mng := NewManagerService(us, us, us, us, us, us, us, us, prod)
 	ctx := context.Background()
 
 	cur_time := utils.CurrentDate()
@@ -97,7 +97,7 @@ func TestManagerService_AddOrder(t *testing.T) {
 				data := td["Success"]
 				req := data.req_dto
 
-				us.AcceptOrderMock.When(req).Then(nil)
+				us.AcceptOrderMock.When(minimock.AnyContext, req).Then(nil)
 			},
 			wantErr: assert.NoError,
 		},
@@ -110,7 +110,7 @@ func TestManagerService_AddOrder(t *testing.T) {
 				data := td["AlreadyExist"]
 				req := data.req_dto
 
-				us.AcceptOrderMock.When(req).Then(domain.ErrAlreadyExist)
+				us.AcceptOrderMock.When(minimock.AnyContext, req).Then(domain.ErrAlreadyExist)
 			},
 			wantErr: assert.Error,
 		},
@@ -124,8 +124,8 @@ func TestManagerService_AddOrder(t *testing.T) {
 				req := data.req_dto
 
 				some_service_error := fmt.Errorf("some bad service error")
-				us.AcceptOrderMock.When(req).Then(some_service_error)
-				prod.SendMock.When([]uint64{req.OrderID}, domain.EventOrderAccepted, some_service_error).Then(nil)
+				us.AcceptOrderMock.When(minimock.AnyContext, req).Then(some_service_error)
+				expectEvent(t, prod, domain.NewEvent([]uint64{req.OrderID}, domain.EventOrderAccepted, some_service_error))
 			},
 			wantErr: assert.Error,
 		},
@@ -158,7 +158,7 @@ func TestManagerService_GiveOrder(t *testing.T) {
 	us := mock.NewUsecasesMock(ctrl)
 	prod := mock.NewKafkaProducerMock(ctrl)
 
-	mng := NewManagerService(us, us, us, us, prod)
+	mng := NewManagerService(us, us, us, us, us, us, us, us, prod)
 	ctx := context.Background()
 
 	td := map[string]TestData{
@@ -203,7 +203,7 @@ func TestManagerService_GiveOrder(t *testing.T) {
 				data := td["Success"]
 				req := data.req_dto
 
-				us.GiveMock.When(req).Then(nil)
+				us.GiveMock.When(minimock.AnyContext, req).Then(nil)
 			},
 			wantErr: assert.NoError,
 		},
@@ -216,7 +216,7 @@ func TestManagerService_GiveOrder(t *testing.T) {
 				data := td["NotFound"]
 				req := data.req_dto
 
-				us.GiveMock.When(req).Then([]error{domain.ErrNotFound})
+				us.GiveMock.When(minimock.AnyContext, req).Then([]error{domain.ErrNotFound})
 			},
 			wantErr: assert.Error,
 		},
@@ -231,8 +231,8 @@ func TestManagerService_GiveOrder(t *testing.T) {
 
 				some_service_error := fmt.Errorf("some bad service error")
 				err_join := errors.Join(some_service_error)
-				us.GiveMock.When(req).Then([]error{some_service_error})
-				prod.SendMock.When(req.Orders, domain.EventOrderGiveClient, err_join).Then(nil)
+				us.GiveMock.When(minimock.AnyContext, req).Then([]error{some_service_error})
+				expectEvent(t, prod, domain.NewEvent(req.Orders, domain.EventOrderGiveClient, err_join))
 			},
 			wantErr: assert.Error,
 		},
@@ -265,7 +265,7 @@ func TestManagerService_Refund(t *testing.T) {
 	us := mock.NewUsecasesMock(ctrl)
 	prod := mock.NewKafkaProducerMock(ctrl)
 
-	mng := NewManagerService(us, us, us, us, prod)
+	mng := NewManagerService(us, us, us, us, us, us, us, us, prod)
 	ctx := context.Background()
 
 	td := map[string]TestData{
@@ -316,7 +316,7 @@ func TestManagerService_Refund(t *testing.T) {
 				data := td["Success"]
 				req := data.req_dto
 
-				us.AcceptRefundMock.When(req).Then(nil)
+				us.AcceptRefundMock.When(minimock.AnyContext, req).Then(nil)
 			},
 			wantErr: assert.NoError,
 		},
@@ -329,7 +329,7 @@ func TestManagerService_Refund(t *testing.T) {
 				data := td["NotFound"]
 				req := data.req_dto
 
-				us.AcceptRefundMock.When(req).Then(domain.ErrNotFound)
+				us.AcceptRefundMock.When(minimock.AnyContext, req).Then(domain.ErrNotFound)
 			},
 			wantErr: assert.Error,
 		},
@@ -343,8 +343,8 @@ func TestManagerService_Refund(t *testing.T) {
 				req := data.req_dto
 
 				some_service_error := fmt.Errorf("some bad service error")
-				us.AcceptRefundMock.When(req).Then(some_service_error)
-				prod.SendMock.When([]uint64{req.OrderID}, domain.EventOrderReturned, some_service_error).Then(nil)
+				us.AcceptRefundMock.When(minimock.AnyContext, req).Then(some_service_error)
+				expectEvent(t, prod, domain.NewEvent([]uint64{req.OrderID}, domain.EventOrderReturned, some_service_error))
 			},
 			wantErr: assert.Error,
 		},
@@ -377,7 +377,7 @@ func TestManagerService_Return(t *testing.T) {
 	us := mock.NewUsecasesMock(ctrl)
 	prod := mock.NewKafkaProducerMock(ctrl)
 
-	mng := NewManagerService(us, us, us, us, prod)
+	mng := NewManagerService(us, us, us, us, us, us, us, us, prod)
 	ctx := context.Background()
 
 	td := map[string]TestData{
@@ -422,7 +422,7 @@ func TestManagerService_Return(t *testing.T) {
 				data := td["Success"]
 				req := data.req_dto
 
-				us.ReturnMock.When(req).Then(nil)
+				us.ReturnMock.When(minimock.AnyContext, req).Then(nil)
 			},
 			wantErr: assert.NoError,
 		},
@@ -435,7 +435,7 @@ func TestManagerService_Return(t *testing.T) {
 				data := td["NotFound"]
 				req := data.req_dto
 
-				us.ReturnMock.When(req).Then(domain.ErrNotFound)
+				us.ReturnMock.When(minimock.AnyContext, req).Then(domain.ErrNotFound)
 			},
 			wantErr: assert.Error,
 		},
@@ -449,8 +449,8 @@ func TestManagerService_Return(t *testing.T) {
 				req := data.req_dto
 
 				some_service_error := fmt.Errorf("some bad service error")
-				us.ReturnMock.When(req).Then(some_service_error)
-				prod.SendMock.When([]uint64{req.OrderID}, domain.EventOrderGiveCourier, some_service_error).Then(nil)
+				us.ReturnMock.When(minimock.AnyContext, req).Then(some_service_error)
+				expectEvent(t, prod, domain.NewEvent([]uint64{req.OrderID}, domain.EventOrderGiveCourier, some_service_error))
 			},
 			wantErr: assert.Error,
 		},
@@ -466,3 +466,83 @@ func TestManagerService_Return(t *testing.T) {
 		})
 	}
 }
+
+func TestManagerService_GetOrder(t *testing.T) {
+	type args struct {
+		req *desc.GetOrderRequest
+	}
+
+	ctrl := minimock.NewController(t)
+	us := mock.NewUsecasesMock(ctrl)
+	prod := mock.NewKafkaProducerMock(ctrl)
+
+	mng := NewManagerService(us, us, us, us, us, us, us, us, prod)
+	ctx := context.Background()
+
+	time_str := utils.TimeToString(utils.CurrentDate())
+	res := &dto.GetOrderResponse{
+		Order: domain.OrderRecord{
+			Order:      &domain.Order{ExpirationDate: time_str},
+			OrderID:    1,
+			UserID:     1,
+			Status:     domain.StatusAccepted,
+			AcceptedAt: time_str,
+			UpdatedAt:  time_str,
+		},
+	}
+
+	tests := []struct {
+		name    string
+		prepare func()
+		args    args
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success",
+			args: args{
+				req: &desc.GetOrderRequest{OrderId: 1},
+			},
+			prepare: func() {
+				us.GetOrderMock.When(minimock.AnyContext, &dto.GetOrderRequest{OrderID: 1}).Then(res, nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "NotFound",
+			args: args{
+				req: &desc.GetOrderRequest{OrderId: 2},
+			},
+			prepare: func() {
+				us.GetOrderMock.When(minimock.AnyContext, &dto.GetOrderRequest{OrderID: 2}).Then(nil, domain.ErrNotFound)
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "InvalidArgument",
+			args: args{
+				req: &desc.GetOrderRequest{},
+			},
+			prepare: func() {},
+			wantErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			tt.prepare()
+
+			_, err := mng.GetOrder(ctx, tt.args.req)
+			tt.wantErr(t, err)
+		})
+	}
+}
+
+// expectEvent ждёт событие о заказах без учёта времени его создания
+func expectEvent(t *testing.T, prod *mock.KafkaProducerMock, want *domain.Event) {
+	prod.SendEventMock.Set(func(ev *domain.Event) error {
+		want.Timestamp = ev.Timestamp
+		assert.Equal(t, want, ev)
+		return nil
+	})
+}
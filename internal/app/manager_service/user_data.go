@@ -0,0 +1,109 @@
+package manager_service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/dto"
+	"gitlab.ozon.dev/chppppr/homework/internal/metrics"
+	desc "gitlab.ozon.dev/chppppr/homework/pkg/manager-service/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	UserDataOrder    = "order"
+	UserDataRefund   = "refund"
+	UserDataReminder = "reminder"
+	UserDataEvent    = "event"
+)
+
+var errUserDataUnsupported = status.Error(codes.Unimplemented, "storage doesn't support user data requests")
+
+func (s *ManagerService) ExportUserData(req *desc.ExportUserDataRequest, stream grpc.ServerStreamingServer[desc.UserDataRecord]) error {
+	const handler = "export_user_data"
+
+	if err := req.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if s.du == nil {
+		return errUserDataUnsupported
+	}
+
+	data, err := s.du.ExportUserData(stream.Context(), &dto.ExportUserDataRequest{UserID: req.GetUserId()})
+	if IsServiceError(err) {
+		metrics.IncTotalErrors(handler, err)
+	}
+
+	if err != nil {
+		return DomainErrToGRPC(err)
+	}
+
+	return streamUserData(data, stream)
+}
+
+type userDataItem struct {
+	kind  string
+	value any
+}
+
+// userDataItems раскладывает данные клиента на отдельные записи выгрузки
+func userDataItems(data *domain.UserData) []userDataItem {
+	items := make([]userDataItem, 0, len(data.Orders)+len(data.Refunds)+len(data.Reminders)+len(data.Events))
+	for _, order := range data.Orders {
+		items = append(items, userDataItem{UserDataOrder, order})
+	}
+	for _, orderID := range data.Refunds {
+		items = append(items, userDataItem{UserDataRefund, map[string]uint64{"orderID": orderID}})
+	}
+	for _, reminder := range data.Reminders {
+		items = append(items, userDataItem{UserDataReminder, reminder})
+	}
+	for _, event := range data.Events {
+		items = append(items, userDataItem{UserDataEvent, event})
+	}
+	return items
+}
+
+func streamUserData(data *domain.UserData, stream grpc.ServerStreamingServer[desc.UserDataRecord]) error {
+	for _, item := range userDataItems(data) {
+		raw, err := json.Marshal(item.value)
+		if err != nil {
+			return status.Error(codes.Internal, err.Error())
+		}
+
+		if err = stream.Send(&desc.UserDataRecord{Kind: item.kind, Data: string(raw)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+func (s *ManagerService) EraseUserData(ctx context.Context, req *desc.EraseUserDataRequest) (*desc.EraseUserDataResponse, error) {
+	const handler = "erase_user_data"
+
+	timer := time.Now()
+	defer func() { metrics.ObserveResponseTime(time.Since(timer), handler) }()
+
+	if err := req.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if s.du == nil {
+		return nil, errUserDataUnsupported
+	}
+
+	res, err := s.du.EraseUserData(ctx, &dto.EraseUserDataRequest{UserID: req.GetUserId()})
+	if IsServiceError(err) {
+		metrics.IncTotalErrors(handler, err)
+	}
+
+	if err != nil {
+		return nil, DomainErrToGRPC(err)
+	}
+
+	return &desc.EraseUserDataResponse{Orders: res.Orders}, nil
+}
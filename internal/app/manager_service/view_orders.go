@@ -27,7 +27,7 @@ func (s *ManagerService) ViewOrders(ctx context.Context, req *desc.ViewOrdersReq
 		OrdersLimit:  req.GetLimit(),
 	}
 
-	orders, err := s.vu.GetOrders(usecase_req)
+	orders, err := s.vu.GetOrders(ctx, usecase_req)
 	if IsServiceError(err) {
 		metrics.IncTotalErrors(handler, err)
 	}
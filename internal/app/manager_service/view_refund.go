@@ -26,7 +26,7 @@ func (s *ManagerService) ViewRefunds(ctx context.Context, req *desc.ViewRefundsR
 		OrdersPerPage: req.GetOrdersPerPage(),
 	}
 
-	orders, err := s.vu.GetRefunds(usecase_req)
+	orders, err := s.vu.GetRefunds(ctx, usecase_req)
 	if IsServiceError(err) {
 		metrics.IncTotalErrors(handler, err)
 	}
@@ -0,0 +1,63 @@
+package manager_service
+
+import (
+	"gitlab.ozon.dev/chppppr/homework/internal/broadcast"
+	"gitlab.ozon.dev/chppppr/homework/internal/dto"
+	"gitlab.ozon.dev/chppppr/homework/internal/metrics"
+	desc "gitlab.ozon.dev/chppppr/homework/pkg/manager-service/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *ManagerService) WatchOrders(req *desc.WatchOrdersRequest, stream grpc.ServerStreamingServer[desc.OrderStatusEvent]) error {
+	const handler = "watch_orders"
+
+	if err := req.Validate(); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	usecase_req := &dto.WatchOrdersRequest{
+		UserID: req.GetUserId(),
+		Status: req.GetStatus(),
+		Cursor: req.GetCursor(),
+	}
+
+	sub, err := s.wu.Watch(stream.Context(), usecase_req)
+	if IsServiceError(err) {
+		metrics.IncTotalErrors(handler, err)
+	}
+
+	if err != nil {
+		return DomainErrToGRPC(err)
+	}
+	defer sub.Close()
+
+	return streamChanges(sub, stream)
+}
+
+//gocyclo:ignore
+//gocognit:ignore
+func streamChanges(sub *broadcast.Subscription, stream grpc.ServerStreamingServer[desc.OrderStatusEvent]) error {
+	for {
+		select {
+		case change, ok := <-sub.C():
+			if !ok {
+				return watchClosedErr(sub.Err())
+			}
+
+			if err := stream.Send(OrderStatusChangeToProto(&change)); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+func watchClosedErr(err error) error {
+	if err != nil {
+		return DomainErrToGRPC(err)
+	}
+	return status.Error(codes.Unavailable, "watch is closed by server")
+}
@@ -0,0 +1,117 @@
+package auth
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+var ErrBadCredentials = errors.New("invalid credentials")
+
+type claims struct {
+	Role        string `json:"role"`
+	PickupPoint string `json:"pickup_point,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// Authenticator проверяет API-ключи и JWT, подписанные HMAC-SHA256
+type Authenticator struct {
+	// keys хранит хэши ключей, чтобы сами ключи не оставались в памяти процесса
+	keys   map[[sha256.Size]byte]Identity
+	secret []byte
+}
+
+// NewAuthenticator принимает API-ключи вида key:subject:role[:pickup_point] через запятую
+// и секрет подписи JWT. С пустым секретом JWT не принимаются
+func NewAuthenticator(keys string, secret []byte) (*Authenticator, error) {
+	a := &Authenticator{keys: make(map[[sha256.Size]byte]Identity), secret: secret}
+	for _, entry := range strings.Split(keys, ",") {
+		if err := a.addKey(strings.TrimSpace(entry)); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(a.keys) == 0 && len(secret) == 0 {
+		return nil, errors.New("no API keys and no JWT secret: nobody can authenticate")
+	}
+	return a, nil
+}
+
+func (a *Authenticator) addKey(entry string) error {
+	if entry == "" {
+		return nil
+	}
+
+	key, id, err := parseKey(entry)
+	if err != nil {
+		return err
+	}
+
+	a.keys[sha256.Sum256([]byte(key))] = *id
+	return nil
+}
+
+func parseKey(entry string) (string, *Identity, error) {
+	key, rest, _ := strings.Cut(entry, ":")
+	subject, rest, ok := strings.Cut(rest, ":")
+	if !ok || key == "" || subject == "" {
+		return "", nil, errors.New("API key must look like key:subject:role[:pickup_point]")
+	}
+
+	role, point, _ := strings.Cut(rest, ":")
+
+	if !IsRole(role) {
+		return "", nil, fmt.Errorf("API key of %q: unknown role %q", subject, role)
+	}
+	return key, &Identity{Subject: subject, Role: role, PickupPoint: point}, nil
+}
+
+func (a *Authenticator) APIKey(key string) (*Identity, error) {
+	id, ok := a.keys[sha256.Sum256([]byte(key))]
+	if !ok {
+		return nil, ErrBadCredentials
+	}
+	return &id, nil
+}
+
+// Token проверяет подпись и срок действия JWT. Токен без срока действия не принимается
+func (a *Authenticator) Token(token string) (*Identity, error) {
+	if len(a.secret) == 0 {
+		return nil, ErrBadCredentials
+	}
+
+	var c claims
+	_, err := jwt.ParseWithClaims(token, &c, func(*jwt.Token) (any, error) {
+		return a.secret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrBadCredentials, err)
+	}
+
+	if c.Subject == "" || !IsRole(c.Role) {
+		return nil, fmt.Errorf("%w: token without subject or with unknown role", ErrBadCredentials)
+	}
+	return &Identity{Subject: c.Subject, Role: c.Role, PickupPoint: c.PickupPoint}, nil
+}
+
+// IssueToken выпускает JWT для клиента, действующий ttl
+func IssueToken(secret []byte, id *Identity, ttl time.Duration) (string, error) {
+	if !IsRole(id.Role) {
+		return "", fmt.Errorf("unknown role %q", id.Role)
+	}
+
+	now := time.Now()
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims{
+		Role:        id.Role,
+		PickupPoint: id.PickupPoint,
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   id.Subject,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+	}).SignedString(secret)
+}
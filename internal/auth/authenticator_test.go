@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAuthenticator(t *testing.T) {
+	_, err := NewAuthenticator("", nil)
+	require.Error(t, err, "nobody can authenticate")
+
+	_, err = NewAuthenticator("key:courier-1:pilot", nil)
+	require.ErrorContains(t, err, "unknown role")
+
+	_, err = NewAuthenticator("key-without-role", nil)
+	require.Error(t, err)
+}
+
+func TestAuthenticator_APIKey(t *testing.T) {
+	a, err := NewAuthenticator("k1:pvz-1:staff, k2:courier-1:courier", nil)
+	require.NoError(t, err)
+
+	id, err := a.APIKey("k2")
+	require.NoError(t, err)
+	require.Equal(t, &Identity{Subject: "courier-1", Role: RoleCourier}, id)
+
+	_, err = a.APIKey("k3")
+	require.ErrorIs(t, err, ErrBadCredentials)
+
+	_, err = a.Token("k1")
+	require.ErrorIs(t, err, ErrBadCredentials, "JWT are disabled without secret")
+}
+
+func TestAuthenticator_Token(t *testing.T) {
+	secret := []byte("secret")
+	a, err := NewAuthenticator("", secret)
+	require.NoError(t, err)
+
+	token, err := IssueToken(secret, &Identity{Subject: "analyst-1", Role: RoleAnalyst}, time.Hour)
+	require.NoError(t, err)
+	id, err := a.Token(token)
+	require.NoError(t, err)
+	require.Equal(t, &Identity{Subject: "analyst-1", Role: RoleAnalyst}, id)
+
+	expired, err := IssueToken(secret, id, -time.Minute)
+	require.NoError(t, err)
+	_, err = a.Token(expired)
+	require.ErrorIs(t, err, ErrBadCredentials)
+
+	forged, err := IssueToken([]byte("other"), id, time.Hour)
+	require.NoError(t, err)
+	_, err = a.Token(forged)
+	require.ErrorIs(t, err, ErrBadCredentials)
+
+	// без срока действия токен бессрочный и не принимается
+	endless, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims{
+		Role:             RoleAdmin,
+		RegisteredClaims: jwt.RegisteredClaims{Subject: "admin"},
+	}).SignedString(secret)
+	require.NoError(t, err)
+	_, err = a.Token(endless)
+	require.ErrorIs(t, err, ErrBadCredentials)
+}
+
+func TestFromCertificate(t *testing.T) {
+	cert := &x509.Certificate{Subject: pkix.Name{CommonName: "courier-1", OrganizationalUnit: []string{"delivery", RoleCourier}}}
+	id, err := FromCertificate(cert)
+	require.NoError(t, err)
+	require.Equal(t, &Identity{Subject: "courier-1", Role: RoleCourier}, id)
+
+	_, err = FromCertificate(&x509.Certificate{Subject: pkix.Name{CommonName: "gateway"}})
+	require.ErrorIs(t, err, ErrBadCredentials, "certificate without role grants nothing")
+}
@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"context"
+	"os"
+	"strings"
+)
+
+// Заголовки учётных данных. Через HTTP передаются как Authorization: Bearer <jwt> и X-Api-Key
+const (
+	AuthorizationHeader = "authorization"
+	APIKeyHeader        = "x-api-key"
+	bearerPrefix        = "Bearer "
+)
+
+// Credentials добавляют API-ключ или JWT к каждому вызову клиента gRPC
+type Credentials struct {
+	header string
+	value  string
+}
+
+func NewAPIKeyCredentials(key string) Credentials {
+	return Credentials{header: APIKeyHeader, value: key}
+}
+
+func NewTokenCredentials(token string) Credentials {
+	return Credentials{header: AuthorizationHeader, value: bearerPrefix + token}
+}
+
+func (c Credentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{c.header: c.value}, nil
+}
+
+// RequireTransportSecurity разрешает передачу без TLS: шифрование канала настраивается отдельно
+func (c Credentials) RequireTransportSecurity() bool {
+	return false
+}
+
+// BearerToken выделяет токен из значения заголовка Authorization
+func BearerToken(authorization string) (string, bool) {
+	if len(authorization) <= len(bearerPrefix) || !strings.EqualFold(authorization[:len(bearerPrefix)], bearerPrefix) {
+		return "", false
+	}
+	return authorization[len(bearerPrefix):], true
+}
+
+// CredentialsFromEnv берёт для клиента JWT из MANAGER_TOKEN или API-ключ из MANAGER_API_KEY
+func CredentialsFromEnv() (Credentials, bool) {
+	if token := os.Getenv("MANAGER_TOKEN"); token != "" {
+		return NewTokenCredentials(token), true
+	}
+	if key := os.Getenv("MANAGER_API_KEY"); key != "" {
+		return NewAPIKeyCredentials(key), true
+	}
+	return Credentials{}, false
+}
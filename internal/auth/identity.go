@@ -0,0 +1,80 @@
+package auth
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+)
+
+// Роли клиентов API
+const (
+	// RoleStaff - сотрудник ПВЗ: приём, выдача и возвраты заказов
+	RoleStaff = "staff"
+	// RoleCourier забирает у ПВЗ возвраты и просроченные заказы
+	RoleCourier = "courier"
+	RoleAdmin   = "admin"
+	// RoleAnalyst только читает заказы
+	RoleAnalyst = "analyst"
+)
+
+func IsRole(role string) bool {
+	switch role {
+	case RoleStaff, RoleCourier, RoleAdmin, RoleAnalyst:
+		return true
+	}
+	return false
+}
+
+// Identity - проверенный клиент API
+type Identity struct {
+	Subject string
+	Role    string
+	// PickupPoint - ПВЗ, к которому привязан клиент, может быть пустым
+	PickupPoint string
+}
+
+func (id *Identity) String() string {
+	return id.Role + ":" + id.Subject
+}
+
+type identityKey struct{}
+
+func WithIdentity(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+func FromContext(ctx context.Context) (*Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(*Identity)
+	return id, ok
+}
+
+// Actor - кто выполняет запрос, для журналов и событий. Пустая строка - клиент неизвестен
+func Actor(ctx context.Context) string {
+	if id, ok := FromContext(ctx); ok {
+		return id.String()
+	}
+	return ""
+}
+
+// FromCertificate сопоставляет сертификату клиента mTLS личность: имя - CN,
+// роль - первое подразделение (OU), совпадающее с ролью, ПВЗ - местность (L).
+// Сертификат без роли, например сертификат шлюза, сам по себе прав не даёт
+func FromCertificate(cert *x509.Certificate) (*Identity, error) {
+	if cert.Subject.CommonName == "" {
+		return nil, fmt.Errorf("%w: client certificate without CN", ErrBadCredentials)
+	}
+
+	for _, unit := range cert.Subject.OrganizationalUnit {
+		if IsRole(unit) {
+			return &Identity{Subject: cert.Subject.CommonName, Role: unit, PickupPoint: first(cert.Subject.Locality)}, nil
+		}
+	}
+	return nil, fmt.Errorf("%w: client certificate of %q has no role", ErrBadCredentials, cert.Subject.CommonName)
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,175 @@
+package backup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+)
+
+const DefaultBatchSize = 500
+
+type (
+	Source interface {
+		SearchOrders(ctx context.Context, filter *domain.OrderFilter) ([]domain.OrderRecord, error)
+		storage.RemindersReader
+		storage.Snapshotter
+	}
+
+	// SchemaVersioner - хранилище со схемой: её версия записывается в архив
+	// и проверяется при восстановлении
+	SchemaVersioner interface {
+		SchemaVersion(ctx context.Context) (int64, error)
+	}
+
+	Options struct {
+		BatchSize uint64
+		// Source - описание источника для заголовка, например json или postgres
+		Source string
+	}
+)
+
+func batchSize(size uint64) uint64 {
+	if size == 0 {
+		return DefaultBatchSize
+	}
+	return size
+}
+
+func schemaVersion(ctx context.Context, st any) (int64, error) {
+	sv, ok := st.(SchemaVersioner)
+	if !ok {
+		return 0, nil
+	}
+
+	version, err := sv.SchemaVersion(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("schema version: %w", err)
+	}
+	return version, nil
+}
+
+// Write снимает копию хранилища на одном снимке данных. Архив пишется во временный
+// файл и заменяет path только целиком
+func Write(ctx context.Context, src Source, path string, opts Options) (trailer *Trailer, err error) {
+	tmp := path + ".tmp"
+	err = src.RunInSnapshot(ctx, func(ctxTx context.Context) error {
+		trailer, err = writeFile(ctxTx, src, tmp, opts)
+		return err
+	})
+	if err != nil {
+		_ = os.Remove(tmp)
+		return nil, err
+	}
+
+	if err = os.Rename(tmp, path); err != nil {
+		return nil, fmt.Errorf("backup rename: %w", err)
+	}
+	return trailer, nil
+}
+
+func writeHeader(ctx context.Context, src Source, enc *encoder, opts Options) error {
+	version, err := schemaVersion(ctx, src)
+	if err != nil {
+		return err
+	}
+
+	if err = enc.header(&Header{
+		Format:        Format,
+		FormatVersion: FormatVersion,
+		SchemaVersion: version,
+		Source:        opts.Source,
+		CreatedAt:     time.Now().UTC(),
+	}); err != nil {
+		return fmt.Errorf("backup header: %w", err)
+	}
+	return nil
+}
+
+func writeFile(ctx context.Context, src Source, path string, opts Options) (*Trailer, error) {
+	file, err := os.Create(path)
+	if err != nil {
+		return nil, fmt.Errorf("backup create: %w", err)
+	}
+	defer file.Close()
+
+	enc := newEncoder(file)
+	if err = writeHeader(ctx, src, enc, opts); err != nil {
+		return nil, err
+	}
+
+	if err = writeOrders(ctx, src, enc, batchSize(opts.BatchSize)); err != nil {
+		return nil, err
+	}
+
+	trailer, err := enc.close()
+	if err != nil {
+		return nil, fmt.Errorf("backup trailer: %w", err)
+	}
+	return trailer, file.Sync()
+}
+
+// writeOrders пишет заказы пачками в порядке номеров, за каждой пачкой - её напоминания
+func writeOrders(ctx context.Context, src Source, enc *encoder, size uint64) error {
+	after, err := writeBatch(ctx, src, enc, nil, size)
+	for after != nil && err == nil {
+		after, err = writeBatch(ctx, src, enc, after, size)
+	}
+	return err
+}
+
+// writeBatch пишет пачку заказов после after и возвращает курсор следующей, nil - заказы закончились
+func writeBatch(ctx context.Context, src Source, enc *encoder, after *domain.SearchCursor, size uint64) (*domain.SearchCursor, error) {
+	batch, err := src.SearchOrders(ctx, &domain.OrderFilter{
+		SortBy: domain.SortByOrderID,
+		After:  after,
+		Limit:  size,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("read orders: %w", err)
+	}
+
+	if len(batch) == 0 {
+		return nil, nil
+	}
+
+	ordersID, err := writeOrderRecords(enc, batch)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = writeReminders(ctx, src, enc, ordersID); err != nil {
+		return nil, err
+	}
+
+	last := ordersID[len(ordersID)-1]
+	return &domain.SearchCursor{Value: last, OrderID: last}, nil
+}
+
+func writeOrderRecords(enc *encoder, batch []domain.OrderRecord) ([]uint64, error) {
+	ordersID := make([]uint64, 0, len(batch))
+	for i := range batch {
+		if err := enc.order(&batch[i]); err != nil {
+			return nil, fmt.Errorf("write order %d: %w", batch[i].OrderID, err)
+		}
+		ordersID = append(ordersID, batch[i].OrderID)
+	}
+	return ordersID, nil
+}
+
+func writeReminders(ctx context.Context, src Source, enc *encoder, ordersID []uint64) error {
+	reminders, err := src.GetReminders(ctx, ordersID)
+	if err != nil {
+		return fmt.Errorf("read reminders: %w", err)
+	}
+
+	for i := range reminders {
+		if err = enc.reminder(&reminders[i]); err != nil {
+			return fmt.Errorf("write reminder: %w", err)
+		}
+	}
+	return nil
+}
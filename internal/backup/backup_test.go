@@ -0,0 +1,194 @@
+package backup
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain/strategy"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage/memory"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage/storage_json"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+func openStorage(t *testing.T, path string) *storage_json.Storage {
+	st, err := storage_json.NewStorage(
+		storage_json.NewOrdersHistory(),
+		storage_json.NewRefunds(),
+		storage_json.NewUsers(),
+		path,
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { st.Close() })
+	return st
+}
+
+// newSource: заказы 1..5, заказ 2 выдан, заказ 3 возвращён, по заказу 4 отправлено напоминание
+func newSource(t *testing.T) *storage_json.Storage {
+	ctx := context.Background()
+	st := openStorage(t, filepath.Join(t.TempDir(), "source.json"))
+
+	expDate := utils.TimeToString(utils.CurrentDate().AddDate(0, 0, 3))
+	for orderID := uint64(1); orderID <= 5; orderID++ {
+		order, err := domain.NewOrder(100*orderID, 10, expDate, strategy.ContainerTypeMap["box"])
+		require.NoError(t, err)
+		require.NoError(t, st.AddOrder(ctx, orderID%2+1, orderID, order))
+	}
+
+	require.NoError(t, st.RemoveOrders(ctx, []uint64{2, 3}, domain.StatusGiveClient))
+	order, err := st.GetOrder(ctx, 2, 3)
+	require.NoError(t, err)
+	require.NoError(t, st.AddRefund(ctx, 2, 3, order))
+	require.NoError(t, st.AddReminders(ctx, 1, []uint64{4}))
+	return st
+}
+
+func allOrders(t *testing.T, st Target) []domain.OrderRecord {
+	orders, err := st.SearchOrders(context.Background(), &domain.OrderFilter{SortBy: domain.SortByOrderID, Limit: 100})
+	require.NoError(t, err)
+	return orders
+}
+
+func TestBackupRestore(t *testing.T) {
+	ctx := context.Background()
+	src := newSource(t)
+	path := filepath.Join(t.TempDir(), "orders.backup")
+
+	trailer, err := Write(ctx, src, path, Options{BatchSize: 2, Source: "json"})
+	require.NoError(t, err)
+	require.EqualValues(t, 5, trailer.Orders)
+	require.EqualValues(t, 1, trailer.Reminders)
+
+	header, verified, err := Verify(path)
+	require.NoError(t, err)
+	require.Equal(t, "json", header.Source)
+	require.Equal(t, trailer, verified)
+
+	dst := openStorage(t, filepath.Join(t.TempDir(), "target.json"))
+	_, err = Restore(ctx, dst, path, 2)
+	require.NoError(t, err)
+	require.Equal(t, allOrders(t, src), allOrders(t, dst))
+
+	reminders, err := dst.GetReminders(ctx, []uint64{1, 2, 3, 4, 5})
+	require.NoError(t, err)
+	require.Len(t, reminders, 1)
+	require.EqualValues(t, 4, reminders[0].OrderID)
+
+	refunds, err := dst.GetRefunds(ctx, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, refunds, 1)
+
+	// в непустое хранилище не восстанавливается
+	_, err = Restore(ctx, dst, path, 2)
+	require.ErrorIs(t, err, ErrTargetNotEmpty)
+}
+
+// rewrite распаковывает архив, меняет его содержимое и запаковывает обратно
+func rewrite(t *testing.T, path string, change func(string) string) {
+	file, err := os.Open(path)
+	require.NoError(t, err)
+	gz, err := gzip.NewReader(file)
+	require.NoError(t, err)
+	raw, err := io.ReadAll(gz)
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	file, err = os.Create(path)
+	require.NoError(t, err)
+	w := gzip.NewWriter(file)
+	_, err = w.Write([]byte(change(string(raw))))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.NoError(t, file.Close())
+}
+
+func TestRestore_Corrupted(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "orders.backup")
+	_, err := Write(ctx, newSource(t), path, Options{})
+	require.NoError(t, err)
+
+	rewrite(t, path, func(s string) string {
+		return strings.Replace(s, `"cost":520`, `"cost":1`, 1)
+	})
+
+	dst := openStorage(t, filepath.Join(t.TempDir(), "target.json"))
+	_, err = Restore(ctx, dst, path, 0)
+	require.ErrorIs(t, err, ErrCorrupted)
+	require.Empty(t, allOrders(t, dst), "nothing is restored from corrupted backup")
+
+	// обрезанный архив
+	rewrite(t, path, func(s string) string {
+		return s[:strings.LastIndex(s, `{"kind":"trailer"`)]
+	})
+	_, _, err = Verify(path)
+	require.ErrorIs(t, err, ErrCorrupted)
+}
+
+type versionedTarget struct {
+	*storage_json.Storage
+	version int64
+}
+
+func (v versionedTarget) SchemaVersion(context.Context) (int64, error) {
+	return v.version, nil
+}
+
+func TestRestore_NewerSchema(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "orders.backup")
+	src := versionedTarget{Storage: newSource(t), version: 20}
+	_, err := Write(ctx, src, path, Options{})
+	require.NoError(t, err)
+
+	header, _, err := Verify(path)
+	require.NoError(t, err)
+	require.EqualValues(t, 20, header.SchemaVersion)
+
+	dst := versionedTarget{Storage: openStorage(t, filepath.Join(t.TempDir(), "target.json")), version: 10}
+	_, err = Restore(ctx, dst, path, 0)
+	require.ErrorContains(t, err, "newer than target")
+
+	dst.version = 20
+	_, err = Restore(ctx, dst, path, 0)
+	require.NoError(t, err)
+}
+
+// failingTarget обрывает загрузку на второй пачке заказов
+type failingTarget struct {
+	Target
+	batches int
+}
+
+func (f *failingTarget) RestoreOrders(ctx context.Context, orders []domain.OrderRecord) error {
+	f.batches++
+	if f.batches == 2 {
+		return errors.New("connection lost")
+	}
+	return f.Target.RestoreOrders(ctx, orders)
+}
+
+func TestRestore_FailsMidStream(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "orders.backup")
+	_, err := Write(ctx, newSource(t), path, Options{})
+	require.NoError(t, err)
+
+	dst := memory.NewStorage()
+	_, err = Restore(ctx, &failingTarget{Target: dst}, path, 2)
+	require.ErrorContains(t, err, "connection lost")
+	require.Empty(t, allOrders(t, dst), "first batch is rolled back")
+
+	// повтор загружает архив целиком, а не упирается в ErrTargetNotEmpty
+	trailer, err := Restore(ctx, dst, path, 2)
+	require.NoError(t, err)
+	require.EqualValues(t, 5, trailer.Orders)
+	require.Len(t, allOrders(t, dst), 5)
+}
@@ -0,0 +1,214 @@
+package backup
+
+import (
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"time"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+)
+
+// Архив - gzip с JSON-строками: заголовок, записи заказов и напоминаний,
+// последней строкой - итог с числом записей и sha256 всех строк до него
+const (
+	Format        = "pvz-orders-backup"
+	FormatVersion = 1
+
+	kindOrder    = "order"
+	kindReminder = "reminder"
+	kindTrailer  = "trailer"
+)
+
+var ErrCorrupted = errors.New("backup is corrupted")
+
+type (
+	// Header описывает архив. SchemaVersion - версия миграций postgres-источника,
+	// 0 - источник без схемы, например JSON-хранилище
+	Header struct {
+		Format        string    `json:"format"`
+		FormatVersion int       `json:"formatVersion"`
+		SchemaVersion int64     `json:"schemaVersion"`
+		Source        string    `json:"source"`
+		CreatedAt     time.Time `json:"createdAt"`
+	}
+
+	Trailer struct {
+		Orders    uint64 `json:"orders"`
+		Reminders uint64 `json:"reminders"`
+		SHA256    string `json:"sha256"`
+	}
+
+	record struct {
+		Kind     string              `json:"kind"`
+		Order    *domain.OrderRecord `json:"order,omitempty"`
+		Reminder *domain.Reminder    `json:"reminder,omitempty"`
+		Trailer  *Trailer            `json:"trailer,omitempty"`
+	}
+
+	// encoder пишет строки архива и считает их контрольную сумму
+	encoder struct {
+		gz      *gzip.Writer
+		sum     hash.Hash
+		enc     *json.Encoder
+		trailer Trailer
+	}
+
+	// decoder читает строки архива и сверяет их с итогом
+	decoder struct {
+		gz        *gzip.Reader
+		lines     *bufio.Reader
+		sum       hash.Hash
+		orders    uint64
+		reminders uint64
+		trailer   *Trailer
+	}
+)
+
+func newEncoder(w io.Writer) *encoder {
+	gz := gzip.NewWriter(w)
+	sum := sha256.New()
+	return &encoder{gz: gz, sum: sum, enc: json.NewEncoder(io.MultiWriter(gz, sum))}
+}
+
+func (e *encoder) header(h *Header) error {
+	return e.enc.Encode(h)
+}
+
+func (e *encoder) order(r *domain.OrderRecord) error {
+	e.trailer.Orders++
+	return e.enc.Encode(record{Kind: kindOrder, Order: r})
+}
+
+func (e *encoder) reminder(r *domain.Reminder) error {
+	e.trailer.Reminders++
+	return e.enc.Encode(record{Kind: kindReminder, Reminder: r})
+}
+
+// close дописывает итог, он в контрольную сумму не входит
+func (e *encoder) close() (*Trailer, error) {
+	e.trailer.SHA256 = hex.EncodeToString(e.sum.Sum(nil))
+	if err := json.NewEncoder(e.gz).Encode(record{Kind: kindTrailer, Trailer: &e.trailer}); err != nil {
+		return nil, err
+	}
+	return &e.trailer, e.gz.Close()
+}
+
+func newDecoder(r io.Reader) (*decoder, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCorrupted, err)
+	}
+	return &decoder{gz: gz, lines: bufio.NewReader(gz), sum: sha256.New()}, nil
+}
+
+func (d *decoder) line() ([]byte, error) {
+	line, err := d.lines.ReadBytes('\n')
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("%w: unexpected end of archive", ErrCorrupted)
+	} else if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCorrupted, err)
+	}
+	return line, nil
+}
+
+func (d *decoder) header() (*Header, error) {
+	line, err := d.line()
+	if err != nil {
+		return nil, err
+	}
+	d.sum.Write(line)
+
+	var h Header
+	if err = json.Unmarshal(line, &h); err != nil {
+		return nil, fmt.Errorf("%w: header: %v", ErrCorrupted, err)
+	}
+
+	if h.Format != Format {
+		return nil, fmt.Errorf("not a backup: format %q", h.Format)
+	}
+	if h.FormatVersion > FormatVersion {
+		return nil, fmt.Errorf("backup format version %d is newer than supported %d", h.FormatVersion, FormatVersion)
+	}
+	return &h, nil
+}
+
+// next возвращает следующую запись, nil - архив закончился и итог сошёлся
+func (d *decoder) next() (*record, error) {
+	line, err := d.line()
+	if err != nil {
+		return nil, err
+	}
+
+	var rec record
+	if err = json.Unmarshal(line, &rec); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrCorrupted, err)
+	}
+
+	if rec.Kind == kindTrailer {
+		return nil, d.finish(rec.Trailer)
+	}
+
+	d.sum.Write(line)
+	return &rec, d.count(&rec)
+}
+
+func (r *record) valid() bool {
+	switch r.Kind {
+	case kindOrder:
+		return r.Order != nil && r.Order.Order != nil
+	case kindReminder:
+		return r.Reminder != nil
+	default:
+		return false
+	}
+}
+
+func (d *decoder) count(rec *record) error {
+	if !rec.valid() {
+		return fmt.Errorf("%w: bad record of kind %q", ErrCorrupted, rec.Kind)
+	}
+
+	if rec.Kind == kindOrder {
+		d.orders++
+	} else {
+		d.reminders++
+	}
+	return nil
+}
+
+// check сверяет прочитанное с итогом
+func (d *decoder) check(trailer *Trailer) error {
+	if trailer == nil || hex.EncodeToString(d.sum.Sum(nil)) != trailer.SHA256 {
+		return fmt.Errorf("%w: checksum mismatch", ErrCorrupted)
+	}
+
+	if trailer.Orders != d.orders || trailer.Reminders != d.reminders {
+		return fmt.Errorf("%w: %d orders and %d reminders, trailer expects %d and %d",
+			ErrCorrupted, d.orders, d.reminders, trailer.Orders, trailer.Reminders)
+	}
+	return nil
+}
+
+func (d *decoder) finish(trailer *Trailer) error {
+	if err := d.check(trailer); err != nil {
+		return err
+	}
+
+	if _, err := d.lines.ReadByte(); !errors.Is(err, io.EOF) {
+		return fmt.Errorf("%w: data after trailer", ErrCorrupted)
+	}
+
+	d.trailer = trailer
+	return nil
+}
+
+func (d *decoder) close() error {
+	return d.gz.Close()
+}
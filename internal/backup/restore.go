@@ -0,0 +1,219 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+)
+
+var ErrTargetNotEmpty = errors.New("restore target is not empty")
+
+type Target interface {
+	RunInTx(ctx context.Context, fn func(ctxTx context.Context) error) error
+	SearchOrders(ctx context.Context, filter *domain.OrderFilter) ([]domain.OrderRecord, error)
+	storage.Restorer
+	AddReminders(ctx context.Context, stage uint64, ordersID []uint64) error
+	MarkRemindersSent(ctx context.Context, stage uint64, ordersID []uint64) error
+}
+
+// Verify читает архив целиком и сверяет контрольную сумму и число записей
+func Verify(path string) (*Header, *Trailer, error) {
+	var header *Header
+	trailer, err := read(path, func(h *Header) error {
+		header = h
+		return nil
+	}, func(*record) error { return nil })
+	if err != nil {
+		return nil, nil, err
+	}
+	return header, trailer, nil
+}
+
+func read(path string, onHeader func(*Header) error, onRecord func(*record) error) (*Trailer, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("backup open: %w", err)
+	}
+	defer file.Close()
+
+	dec, err := newDecoder(file)
+	if err != nil {
+		return nil, err
+	}
+	defer dec.close()
+
+	header, err := dec.header()
+	if err != nil {
+		return nil, err
+	}
+	if err = onHeader(header); err != nil {
+		return nil, err
+	}
+
+	return readRecords(dec, onRecord)
+}
+
+func readRecords(dec *decoder, onRecord func(*record) error) (*Trailer, error) {
+	for {
+		done, err := readRecord(dec, onRecord)
+		if done || err != nil {
+			return dec.trailer, err
+		}
+	}
+}
+
+// readRecord передаёт onRecord следующую запись, done - архив закончился
+func readRecord(dec *decoder, onRecord func(*record) error) (bool, error) {
+	rec, err := dec.next()
+	if rec == nil || err != nil {
+		return true, err
+	}
+	return false, onRecord(rec)
+}
+
+// Restore загружает архив в пустое хранилище одной транзакцией dst.RunInTx.
+// Хранилище остаётся пустым после оборванной загрузки, только если его транзакции
+// откатываются, как в postgres. JSON-хранилище изменения не откатывает, поэтому
+// его загружают во временный файл и ставят на место только после успеха.
+// Архив сначала проверяется целиком, чтобы повреждённая копия не загружалась зря.
+// Время отправки напоминаний не восстанавливается: хранилища проставляют его сами
+func Restore(ctx context.Context, dst Target, path string, size uint64) (trailer *Trailer, err error) {
+	header, _, err := Verify(path)
+	if err != nil {
+		return nil, err
+	}
+
+	err = dst.RunInTx(ctx, func(ctxTx context.Context) error {
+		if err := checkTarget(ctxTx, dst, header); err != nil {
+			return err
+		}
+
+		trailer, err = load(ctxTx, dst, path, size)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return trailer, nil
+}
+
+// load читает архив заново при каждом запуске, поэтому повтор транзакции начинает с начала
+func load(ctx context.Context, dst Target, path string, size uint64) (*Trailer, error) {
+	l := &loader{dst: dst, size: batchSize(size), reminders: make(map[uint64][]uint64), sent: make(map[uint64][]uint64)}
+	trailer, err := read(path, func(*Header) error { return nil }, func(rec *record) error {
+		return l.add(ctx, rec)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return trailer, l.flush(ctx)
+}
+
+func checkTarget(ctx context.Context, dst Target, header *Header) error {
+	version, err := schemaVersion(ctx, dst)
+	if err != nil {
+		return err
+	}
+
+	if version < header.SchemaVersion {
+		return fmt.Errorf("backup schema version %d is newer than target %d: migrate the target first",
+			header.SchemaVersion, version)
+	}
+
+	orders, err := dst.SearchOrders(ctx, &domain.OrderFilter{SortBy: domain.SortByOrderID, Limit: 1})
+	if err != nil {
+		return fmt.Errorf("read target: %w", err)
+	}
+
+	if len(orders) != 0 {
+		return ErrTargetNotEmpty
+	}
+	return nil
+}
+
+// loader копит записи пачками. Напоминания ссылаются на заказы,
+// поэтому сбрасываются только после них
+type loader struct {
+	dst       Target
+	size      uint64
+	orders    []domain.OrderRecord
+	reminders map[uint64][]uint64
+	// sent - напоминания с датой отправки, остальные восстанавливаются неотправленными
+	sent    map[uint64][]uint64
+	pending uint64
+}
+
+func (l *loader) add(ctx context.Context, rec *record) error {
+	if rec.Kind == kindOrder {
+		rec.Order.Normalize()
+		l.orders = append(l.orders, *rec.Order)
+	} else {
+		r := rec.Reminder
+		l.reminders[r.Stage] = append(l.reminders[r.Stage], r.OrderID)
+		if r.SentAt != nil {
+			l.sent[r.Stage] = append(l.sent[r.Stage], r.OrderID)
+		}
+	}
+
+	l.pending++
+	if l.pending < l.size {
+		return nil
+	}
+	return l.flush(ctx)
+}
+
+func (l *loader) flush(ctx context.Context) error {
+	if err := l.flushOrders(ctx); err != nil {
+		return err
+	}
+
+	if err := l.flushReminders(ctx); err != nil {
+		return err
+	}
+
+	l.orders, l.reminders, l.sent, l.pending = nil, make(map[uint64][]uint64), make(map[uint64][]uint64), 0
+	return nil
+}
+
+func (l *loader) flushOrders(ctx context.Context) error {
+	if len(l.orders) == 0 {
+		return nil
+	}
+
+	if err := l.dst.RestoreOrders(ctx, l.orders); err != nil {
+		return fmt.Errorf("restore orders: %w", err)
+	}
+	return nil
+}
+
+func (l *loader) flushReminders(ctx context.Context) error {
+	stages := make([]uint64, 0, len(l.reminders))
+	for stage := range l.reminders {
+		stages = append(stages, stage)
+	}
+	slices.Sort(stages)
+
+	for _, stage := range stages {
+		if err := l.flushStage(ctx, stage); err != nil {
+			return fmt.Errorf("restore reminders: %w", err)
+		}
+	}
+	return nil
+}
+
+func (l *loader) flushStage(ctx context.Context, stage uint64) error {
+	if err := l.dst.AddReminders(ctx, stage, l.reminders[stage]); err != nil {
+		return err
+	}
+
+	if len(l.sent[stage]) == 0 {
+		return nil
+	}
+	return l.dst.MarkRemindersSent(ctx, stage, l.sent[stage])
+}
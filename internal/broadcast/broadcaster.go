@@ -0,0 +1,183 @@
+package broadcast
+
+import (
+	"sync"
+	"time"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+)
+
+type (
+	Subscription struct {
+		b      *Broadcaster
+		filter domain.WatchFilter
+		ch     chan domain.OrderStatusChange
+		err    error
+	}
+
+	// Broadcaster рассылает изменения статусов заказов подписчикам внутри процесса.
+	// Последние historySize событий хранятся, чтобы переподключившийся подписчик
+	// мог продолжить с курсора последнего полученного события.
+	Broadcaster struct {
+		mtx         sync.Mutex
+		seq         uint64
+		history     []domain.OrderStatusChange
+		historySize int
+		bufferSize  int
+		subs        map[*Subscription]struct{}
+	}
+)
+
+func NewBroadcaster(historySize, bufferSize int) *Broadcaster {
+	return &Broadcaster{
+		history:     make([]domain.OrderStatusChange, 0, historySize),
+		historySize: historySize,
+		bufferSize:  bufferSize,
+		subs:        make(map[*Subscription]struct{}),
+	}
+}
+
+// Publish никогда не блокируется: подписчик, не успевающий вычитывать события,
+// отключается с ошибкой domain.ErrSubscriberLagged и может переподключиться с курсора.
+func (b *Broadcaster) Publish(changes ...domain.OrderStatusChange) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	for _, c := range changes {
+		b.seq++
+		c.Cursor = b.seq
+		c.UpdatedAt = time.Now().UTC()
+
+		b.history = append(b.history, c)
+		if len(b.history) > b.historySize {
+			b.history = b.history[1:]
+		}
+
+		for sub := range b.subs {
+			b.deliver(sub, c)
+		}
+	}
+}
+
+func (b *Broadcaster) deliver(sub *Subscription, c domain.OrderStatusChange) {
+	if !sub.filter.Match(&c) {
+		return
+	}
+
+	select {
+	case sub.ch <- c:
+	default:
+		b.drop(sub, domain.ErrSubscriberLagged)
+	}
+}
+
+func (b *Broadcaster) drop(sub *Subscription, err error) {
+	if _, ok := b.subs[sub]; !ok {
+		return
+	}
+
+	delete(b.subs, sub)
+	sub.err = err
+	close(sub.ch)
+}
+
+// Subscribe подписывает на изменения, подходящие под filter. Если cursor не нулевой,
+// сначала отдаются сохранённые события после него.
+func (b *Broadcaster) Subscribe(filter domain.WatchFilter, cursor uint64) (*Subscription, error) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	replay, err := b.replay(filter, cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	sub := &Subscription{
+		b:      b,
+		filter: filter,
+		ch:     make(chan domain.OrderStatusChange, b.bufferSize+len(replay)),
+	}
+	for _, c := range replay {
+		sub.ch <- c
+	}
+
+	b.subs[sub] = struct{}{}
+	return sub, nil
+}
+
+func (b *Broadcaster) replay(filter domain.WatchFilter, cursor uint64) ([]domain.OrderStatusChange, error) {
+	if cursor == 0 {
+		return nil, nil
+	}
+
+	if !b.inHistory(cursor) {
+		return nil, domain.ErrCursorExpired
+	}
+
+	// события после cursor — последние seq-cursor элементов истории
+	replay := make([]domain.OrderStatusChange, 0)
+	for _, c := range b.history[len(b.history)-int(b.seq-cursor):] {
+		if filter.Match(&c) {
+			replay = append(replay, c)
+		}
+	}
+	return replay, nil
+}
+
+func (b *Broadcaster) inHistory(cursor uint64) bool {
+	oldest := b.seq - uint64(len(b.history)) + 1
+	return cursor <= b.seq && cursor+1 >= oldest
+}
+
+// UserEvents возвращает сохранённые события по заказам клиента
+func (b *Broadcaster) UserEvents(userID uint64) []domain.OrderStatusChange {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	events := make([]domain.OrderStatusChange, 0)
+	for _, c := range b.history {
+		if c.UserID == userID {
+			events = append(events, c)
+		}
+	}
+	return events
+}
+
+// Pseudonymize заменяет клиента псевдонимом в сохранённых событиях.
+// Уже доставленные подписчикам события не меняются
+func (b *Broadcaster) Pseudonymize(userID, pseudonym uint64) {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	for i := range b.history {
+		if b.history[i].UserID == userID {
+			b.history[i].UserID = pseudonym
+		}
+	}
+}
+
+// Close отключает всех подписчиков, например при остановке сервиса
+func (b *Broadcaster) Close() {
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	for sub := range b.subs {
+		b.drop(sub, nil)
+	}
+}
+
+func (s *Subscription) C() <-chan domain.OrderStatusChange {
+	return s.ch
+}
+
+// Err возвращает причину отключения подписчика после закрытия канала C
+func (s *Subscription) Err() error {
+	return s.err
+}
+
+func (s *Subscription) Close() {
+	s.b.mtx.Lock()
+	defer s.b.mtx.Unlock()
+
+	s.b.drop(s, nil)
+}
@@ -0,0 +1,91 @@
+package broadcast
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+)
+
+func changes(ordersID ...uint64) []domain.OrderStatusChange {
+	out := make([]domain.OrderStatusChange, len(ordersID))
+	for i, orderID := range ordersID {
+		out[i] = domain.OrderStatusChange{
+			OrderID: orderID,
+			UserID:  orderID % 2,
+			Status:  domain.StatusAccepted,
+		}
+	}
+	return out
+}
+
+func recv(t *testing.T, sub *Subscription, n int) []uint64 {
+	ordersID := make([]uint64, 0, n)
+	for range n {
+		c, ok := <-sub.C()
+		require.True(t, ok)
+		ordersID = append(ordersID, c.OrderID)
+	}
+	return ordersID
+}
+
+func TestBroadcaster_Filter(t *testing.T) {
+	b := NewBroadcaster(10, 10)
+
+	sub, err := b.Subscribe(domain.WatchFilter{UserID: 1}, 0)
+	require.NoError(t, err)
+	defer sub.Close()
+
+	b.Publish(changes(1, 2, 3, 4)...)
+	require.Equal(t, []uint64{1, 3}, recv(t, sub, 2))
+}
+
+func TestBroadcaster_ResumeFromCursor(t *testing.T) {
+	b := NewBroadcaster(10, 10)
+	b.Publish(changes(1, 2, 3)...)
+
+	sub, err := b.Subscribe(domain.WatchFilter{}, 1)
+	require.NoError(t, err)
+	defer sub.Close()
+
+	b.Publish(changes(4)...)
+	require.Equal(t, []uint64{2, 3, 4}, recv(t, sub, 3))
+}
+
+func TestBroadcaster_CursorExpired(t *testing.T) {
+	b := NewBroadcaster(2, 10)
+	b.Publish(changes(1, 2, 3, 4)...)
+
+	_, err := b.Subscribe(domain.WatchFilter{}, 1)
+	require.ErrorIs(t, err, domain.ErrCursorExpired)
+
+	_, err = b.Subscribe(domain.WatchFilter{}, 10)
+	require.ErrorIs(t, err, domain.ErrCursorExpired)
+
+	sub, err := b.Subscribe(domain.WatchFilter{}, 2)
+	require.NoError(t, err)
+	sub.Close()
+}
+
+func TestBroadcaster_SlowSubscriber(t *testing.T) {
+	b := NewBroadcaster(10, 1)
+
+	sub, err := b.Subscribe(domain.WatchFilter{}, 0)
+	require.NoError(t, err)
+
+	b.Publish(changes(1, 2)...)
+
+	c, ok := <-sub.C()
+	require.True(t, ok)
+	require.Equal(t, uint64(1), c.OrderID)
+
+	_, ok = <-sub.C()
+	require.False(t, ok)
+	require.ErrorIs(t, sub.Err(), domain.ErrSubscriberLagged)
+
+	// после переподключения с курсора пропущенные события не теряются
+	sub, err = b.Subscribe(domain.WatchFilter{}, c.Cursor)
+	require.NoError(t, err)
+	defer sub.Close()
+	require.Equal(t, []uint64{2}, recv(t, sub, 1))
+}
@@ -0,0 +1,134 @@
+package broadcast
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+)
+
+// Storage публикует изменения статусов заказов после успешного
+// выполнения изменяющих методов обёрнутого хранилища.
+type Storage struct {
+	storage.Storage
+	b *Broadcaster
+}
+
+type pendingKey struct{}
+
+// pending копит изменения внутри транзакции, чтобы не публиковать их до коммита
+type pending struct {
+	changes []domain.OrderStatusChange
+}
+
+func NewStorage(st storage.Storage, b *Broadcaster) *Storage {
+	return &Storage{
+		Storage: st,
+		b:       b,
+	}
+}
+
+func (s *Storage) publish(ctx context.Context, ordersID ...uint64) {
+	changes := make([]domain.OrderStatusChange, 0, len(ordersID))
+	for _, orderID := range ordersID {
+		stat, err := s.Storage.GetOrderStatus(storage.ReadYourWrites(ctx), orderID)
+		if err != nil {
+			log.Printf("[broadcast.Storage] can't get status of order %d: %v\n", orderID, err)
+			continue
+		}
+
+		changes = append(changes, domain.OrderStatusChange{
+			OrderID: orderID,
+			UserID:  stat.UserID,
+			Status:  stat.Status,
+		})
+	}
+
+	if p, ok := ctx.Value(pendingKey{}).(*pending); ok {
+		p.changes = append(p.changes, changes...)
+		return
+	}
+
+	s.b.Publish(changes...)
+}
+
+func (s *Storage) RunInTx(ctx context.Context, fn func(ctxTx context.Context) error) error {
+	p := &pending{}
+	err := s.Storage.RunInTx(ctx, func(ctxTx context.Context) error {
+		p.changes = p.changes[:0]
+		return fn(context.WithValue(ctxTx, pendingKey{}, p))
+	})
+	if err != nil {
+		return err
+	}
+
+	s.b.Publish(p.changes...)
+	return nil
+}
+
+func (s *Storage) AddOrder(ctx context.Context, userID, orderID uint64, order *domain.Order) error {
+	if err := s.Storage.AddOrder(ctx, userID, orderID, order); err != nil {
+		return err
+	}
+	s.publish(ctx, orderID)
+	return nil
+}
+
+func (s *Storage) AddOrderStatus(ctx context.Context, orderID, userID uint64, status string, order *domain.Order) error {
+	if err := s.Storage.AddOrderStatus(ctx, orderID, userID, status, order); err != nil {
+		return err
+	}
+	s.publish(ctx, orderID)
+	return nil
+}
+
+func (s *Storage) SetOrderStatus(ctx context.Context, orderID uint64, status string) error {
+	if err := s.Storage.SetOrderStatus(ctx, orderID, status); err != nil {
+		return err
+	}
+	s.publish(ctx, orderID)
+	return nil
+}
+
+func (s *Storage) RemoveOrder(ctx context.Context, orderID uint64, status string) error {
+	if err := s.Storage.RemoveOrder(ctx, orderID, status); err != nil {
+		return err
+	}
+	s.publish(ctx, orderID)
+	return nil
+}
+
+func (s *Storage) RemoveOrders(ctx context.Context, ordersID []uint64, status string) error {
+	if err := s.Storage.RemoveOrders(ctx, ordersID, status); err != nil {
+		return err
+	}
+	s.publish(ctx, ordersID...)
+	return nil
+}
+
+func (s *Storage) AddRefund(ctx context.Context, userID, orderID uint64, order *domain.Order) error {
+	if err := s.Storage.AddRefund(ctx, userID, orderID, order); err != nil {
+		return err
+	}
+	s.publish(ctx, orderID)
+	return nil
+}
+
+func (s *Storage) RemoveRefund(ctx context.Context, orderID uint64) error {
+	if err := s.Storage.RemoveRefund(ctx, orderID); err != nil {
+		return err
+	}
+	s.publish(ctx, orderID)
+	return nil
+}
+
+func (s *Storage) ExpireOrders(ctx context.Context, expiredBefore time.Time) ([]uint64, error) {
+	orders, err := s.Storage.ExpireOrders(ctx, expiredBefore)
+	if err != nil {
+		return nil, err
+	}
+	s.publish(ctx, orders...)
+	return orders, nil
+}
@@ -0,0 +1,58 @@
+package broadcast
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain/strategy"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage/storage_json"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+func newTestStorage(t *testing.T) (*Storage, *Broadcaster) {
+	st, err := storage_json.NewStorage(
+		storage_json.NewOrdersHistory(),
+		storage_json.NewRefunds(),
+		storage_json.NewUsers(),
+		filepath.Join(t.TempDir(), "storage.json"),
+	)
+	require.NoError(t, err)
+
+	b := NewBroadcaster(10, 10)
+	return NewStorage(st, b), b
+}
+
+func TestStorage_RunInTxPublishesAfterCommit(t *testing.T) {
+	ctx := context.Background()
+	st, b := newTestStorage(t)
+
+	order, err := domain.NewOrder(100, 1, utils.CurrentDateString(), strategy.ContainerTypeMap[""])
+	require.NoError(t, err)
+	require.NoError(t, st.AddOrder(ctx, 1, 10, order))
+
+	sub, err := b.Subscribe(domain.WatchFilter{}, 1)
+	require.NoError(t, err)
+	defer sub.Close()
+
+	someErr := errors.New("some error")
+	err = st.RunInTx(ctx, func(ctxTx context.Context) error {
+		require.NoError(t, st.SetOrderStatus(ctxTx, 10, domain.StatusExpired))
+		require.Empty(t, sub.C())
+		return someErr
+	})
+	require.ErrorIs(t, err, someErr)
+	require.Empty(t, sub.C())
+
+	err = st.RunInTx(ctx, func(ctxTx context.Context) error {
+		return st.SetOrderStatus(ctxTx, 10, domain.StatusGiveCourier)
+	})
+	require.NoError(t, err)
+
+	c := <-sub.C()
+	require.Equal(t, uint64(10), c.OrderID)
+	require.Equal(t, domain.StatusGiveCourier, c.Status)
+}
@@ -0,0 +1,44 @@
+package broadcast
+
+import (
+	"context"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+)
+
+// UserData дополняет запросы о данных клиента историей событий подписки:
+// выгрузка включает события клиента, а стирание заменяет его в них псевдонимом.
+// История хранится в памяти каждого процесса сервиса, поэтому в других
+// процессах события клиента вытесняются только новыми событиями
+type UserData struct {
+	storage.UserData
+	b *Broadcaster
+}
+
+func NewUserData(ud storage.UserData, b *Broadcaster) *UserData {
+	return &UserData{
+		UserData: ud,
+		b:        b,
+	}
+}
+
+func (u *UserData) ExportUserData(ctx context.Context, userID uint64) (*domain.UserData, error) {
+	data, err := u.UserData.ExportUserData(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	data.Events = u.b.UserEvents(userID)
+	return data, nil
+}
+
+func (u *UserData) EraseUserData(ctx context.Context, userID, pseudonym uint64) ([]uint64, error) {
+	orders, err := u.UserData.EraseUserData(ctx, userID, pseudonym)
+	if err != nil {
+		return nil, err
+	}
+
+	u.b.Pseudonymize(userID, pseudonym)
+	return orders, nil
+}
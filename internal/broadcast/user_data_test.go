@@ -0,0 +1,50 @@
+package broadcast
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+)
+
+type stubUserData struct {
+	erased uint64
+}
+
+func (s *stubUserData) ExportUserData(ctx context.Context, userID uint64) (*domain.UserData, error) {
+	return &domain.UserData{UserID: userID}, nil
+}
+
+func (s *stubUserData) EraseUserData(ctx context.Context, userID, pseudonym uint64) ([]uint64, error) {
+	s.erased = userID
+	return []uint64{10}, nil
+}
+
+func TestUserData_ExportAndEraseEvents(t *testing.T) {
+	ctx := context.Background()
+	b := NewBroadcaster(10, 10)
+	b.Publish(
+		domain.OrderStatusChange{OrderID: 10, UserID: 1, Status: domain.StatusAccepted},
+		domain.OrderStatusChange{OrderID: 20, UserID: 2, Status: domain.StatusAccepted},
+	)
+
+	inner := &stubUserData{}
+	ud := NewUserData(inner, b)
+
+	data, err := ud.ExportUserData(ctx, 1)
+	require.NoError(t, err)
+	require.Len(t, data.Events, 1)
+	require.Equal(t, uint64(10), data.Events[0].OrderID)
+
+	orders, err := ud.EraseUserData(ctx, 1, 99)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{10}, orders)
+	require.Equal(t, uint64(1), inner.erased)
+
+	data, err = ud.ExportUserData(ctx, 1)
+	require.NoError(t, err)
+	require.Empty(t, data.Events)
+	require.Len(t, b.UserEvents(99), 1)
+	require.Len(t, b.UserEvents(2), 1)
+}
@@ -15,9 +15,12 @@ type (
 		Return(ctx context.Context, req *dto.ReturnRequest) error
 		ViewOrders(ctx context.Context, req *dto.ViewOrdersRequest) (*dto.ViewOrdersResponse, error)
 		ViewRefunds(ctx context.Context, req *dto.ViewRefundsRequest) (*dto.ViewRefundsResponse, error)
+		GetOrder(ctx context.Context, req *dto.GetOrderRequest) (*dto.GetOrderResponse, error)
+		SearchOrders(ctx context.Context, req *dto.SearchOrdersRequest) (*dto.SearchOrdersResponse, error)
 	}
 
 	KafkaProducer interface {
 		Send(orderIDs []uint64, eventType domain.EventType, err_ser error) error
+		SendEvent(ev *domain.Event) error
 	}
 )
@@ -21,8 +21,10 @@ func NewProducerClient(producer sarama.SyncProducer, topic string) *ProducerClie
 }
 
 func (p *ProducerClient) Send(orderIDs []uint64, eventType domain.EventType, err_ser error) error {
-	ev := domain.NewEvent(orderIDs, eventType, err_ser)
+	return p.SendEvent(domain.NewEvent(orderIDs, eventType, err_ser))
+}
 
+func (p *ProducerClient) SendEvent(ev *domain.Event) error {
 	bytes, err := json.Marshal(ev)
 	if err != nil {
 		return err
@@ -2,6 +2,7 @@ package manager
 
 import (
 	"context"
+	"fmt"
 
 	"gitlab.ozon.dev/chppppr/homework/internal/domain"
 	"gitlab.ozon.dev/chppppr/homework/internal/dto"
@@ -95,6 +96,102 @@ func (s *ManagerServiceClient) ViewRefunds(ctx context.Context, req *dto.ViewRef
 	return &dto.ViewRefundsResponse{Orders: res}, err
 }
 
+func (s *ManagerServiceClient) GetOrder(ctx context.Context, req *dto.GetOrderRequest) (*dto.GetOrderResponse, error) {
+	req_proto := &manager_service.GetOrderRequest{
+		OrderId: req.OrderID,
+	}
+
+	res_proto, err := s.mng.GetOrder(ctx, req_proto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.GetOrderResponse{
+		Order:               orderInfoToDomain([]*manager_service.OrderInfo{res_proto.GetOrder()})[0],
+		DaysUntilExpiration: res_proto.GetDaysUntilExpiration(),
+		Refundable:          res_proto.GetRefundable(),
+	}, nil
+}
+
+var sortFieldToProto = map[string]manager_service.SortField{
+	domain.SortByOrderID:        manager_service.SortField_SORT_FIELD_ORDER_ID,
+	domain.SortByExpirationDate: manager_service.SortField_SORT_FIELD_EXPIRATION_DATE,
+	domain.SortByAcceptedAt:     manager_service.SortField_SORT_FIELD_ACCEPTED_AT,
+	domain.SortByUpdatedAt:      manager_service.SortField_SORT_FIELD_UPDATED_AT,
+	domain.SortByCost:           manager_service.SortField_SORT_FIELD_COST,
+	domain.SortByWeight:         manager_service.SortField_SORT_FIELD_WEIGHT,
+}
+
+func (s *ManagerServiceClient) SearchOrders(ctx context.Context, req *dto.SearchOrdersRequest) (*dto.SearchOrdersResponse, error) {
+	sortBy, ok := sortFieldToProto[req.SortBy]
+	if !ok && req.SortBy != "" {
+		return nil, fmt.Errorf("unknown sort field %q: %w", req.SortBy, domain.ErrWrongInput)
+	}
+
+	req_proto := &manager_service.SearchOrdersRequest{
+		UserId:      req.UserID,
+		Statuses:    req.Statuses,
+		Accepted:    dateRangeToProto(req.Accepted),
+		Expiring:    dateRangeToProto(req.Expiring),
+		Updated:     dateRangeToProto(req.Updated),
+		PackageType: req.PackageType,
+		Cost:        uintRangeToProto(req.Cost),
+		Weight:      uintRangeToProto(req.Weight),
+		SortBy:      sortBy,
+		Descending:  req.Descending,
+		PageSize:    req.PageSize,
+		PageToken:   req.PageToken,
+	}
+
+	res_proto, err := s.mng.SearchOrders(ctx, req_proto)
+	res := orderInfoToDomain(res_proto.GetOrders())
+
+	return &dto.SearchOrdersResponse{Orders: res, NextPageToken: res_proto.GetNextPageToken()}, err
+}
+
+func dateRangeToProto(in domain.DateRange) *manager_service.DateRange {
+	out := &manager_service.DateRange{}
+	if in.From != nil {
+		out.From = timestamppb.New(*in.From)
+	}
+	if in.To != nil {
+		out.To = timestamppb.New(*in.To)
+	}
+	return out
+}
+
+func uintRangeToProto(in domain.UintRange) *manager_service.UintRange {
+	return &manager_service.UintRange{
+		Min: in.Min,
+		Max: in.Max,
+	}
+}
+
+func orderInfoToDomain(in []*manager_service.OrderInfo) []domain.OrderRecord {
+	out := make([]domain.OrderRecord, len(in))
+
+	for i, info := range in {
+		order := info.GetOrder()
+
+		out[i] = domain.OrderRecord{
+			Order: &domain.Order{
+				ExpirationDate: utils.TimeToString(order.GetExpirationDate().AsTime()),
+				PackageType:    order.GetPackageType(),
+				Cost:           order.GetCost(),
+				Weight:         order.GetWeight(),
+				UseTape:        order.GetUseTape(),
+			},
+			OrderID:    info.GetOrderId(),
+			UserID:     info.GetUserId(),
+			Status:     info.GetStatus(),
+			AcceptedAt: utils.TimeToString(info.GetAcceptedAt().AsTime()),
+			UpdatedAt:  utils.TimeToString(info.GetUpdatedAt().AsTime()),
+		}
+	}
+
+	return out
+}
+
 func orderViewToDomain(in []*manager_service.OrderView) []domain.OrderView {
 	out := make([]domain.OrderView, len(in))
 
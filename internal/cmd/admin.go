@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"gitlab.ozon.dev/chppppr/homework/internal/migrate"
+)
+
+func init() {
+	adminCmd.AddCommand(adminMigrateCmd)
+
+	resetAdminMigrateFlags(adminMigrateCmd)
+	adminMigrateCmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
+		cmd.Usage()
+		resetAdminMigrateFlags(cmd)
+	})
+}
+
+var (
+	migrateFrom       string
+	migrateTo         string
+	migrateBatchSize  uint64
+	migrateDryRun     bool
+	migrateCheckpoint string
+
+	adminCmd = &cobra.Command{
+		Use:   "admin",
+		Short: "Administrative commands",
+		Long:  "Administrative commands working with storages directly, without manager service",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Usage()
+		},
+	}
+
+	adminMigrateCmd = &cobra.Command{
+		Use:   "migrate",
+		Short: "Migrate orders between storages",
+		Long: `Migrate orders history, held orders and refunds between storages.
+Storage is json:path or postgres:dsn. Interrupted migration resumes from checkpoint file,
+orders already present in target with the same data are skipped, with other data are reported as conflicts`,
+		Run: adminMigrateCmdRun,
+	}
+)
+
+func resetAdminMigrateFlags(cmd *cobra.Command) {
+	cmd.ResetFlags()
+	flags := cmd.PersistentFlags()
+	flags.StringVar(&migrateFrom, "from", "", "source storage: json:path or postgres:dsn")
+	flags.StringVar(&migrateTo, "to", "", "target storage: json:path or postgres:dsn")
+	flags.Uint64Var(&migrateBatchSize, "batch", migrate.DefaultBatchSize, "orders per batch")
+	flags.BoolVar(&migrateDryRun, "dry-run", false, "only report counts and conflicts")
+	flags.StringVar(&migrateCheckpoint, "checkpoint", "migrate.checkpoint", "checkpoint file, empty disables resume")
+	cmd.MarkPersistentFlagRequired("from")
+	cmd.MarkPersistentFlagRequired("to")
+}
+
+func printMigrateReport(rep *migrate.Report) {
+	if rep.ResumedAfter != nil {
+		fmt.Printf("resumed after order %d\n", *rep.ResumedAfter)
+	}
+
+	fmt.Printf("orders: %d, users: %d, held: %d, refunds: %d\n", rep.Orders, rep.Users, rep.Held, rep.Refunds)
+	fmt.Printf("migrated: %d, already migrated: %d, conflicts: %d\n", rep.Migrated, rep.Skipped, len(rep.Conflicts))
+	for _, orderID := range rep.Conflicts {
+		fmt.Printf("conflict: order %d already exists in target with other data\n", orderID)
+	}
+}
+
+func runMigrate() (*migrate.Report, error) {
+	src, closeSrc, err := migrate.Open(ctx, migrateFrom)
+	if err != nil {
+		return nil, err
+	}
+	defer closeSrc()
+
+	dst, closeDst, err := migrate.Open(ctx, migrateTo)
+	if err != nil {
+		return nil, err
+	}
+	defer closeDst()
+
+	m := migrate.NewMigrator(src, dst, migrate.Options{
+		BatchSize:  migrateBatchSize,
+		DryRun:     migrateDryRun,
+		Checkpoint: migrateCheckpoint,
+	})
+	return m.Run(ctx)
+}
+
+func adminMigrateCmdRun(cmd *cobra.Command, args []string) {
+	defer resetAdminMigrateFlags(cmd)
+
+	if migrateDryRun {
+		fmt.Println("dry run: nothing will be written")
+	}
+
+	rep, err := runMigrate()
+	if rep != nil {
+		printMigrateReport(rep)
+	}
+	if err != nil {
+		fmt.Println(err)
+	}
+}
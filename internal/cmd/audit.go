@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/migrate"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+)
+
+const auditPageSize = 1000
+
+func init() {
+	adminCmd.AddCommand(adminAuditCmd)
+
+	resetAdminAuditFlags(adminAuditCmd)
+	adminAuditCmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
+		cmd.Usage()
+		resetAdminAuditFlags(cmd)
+	})
+}
+
+var (
+	auditDB string
+
+	adminAuditCmd = &cobra.Command{
+		Use:   "audit",
+		Short: "Verify audit log",
+		Long: `Verify hash chain of audit log in storage postgres:dsn.
+Every record must reference hash of previous one and match its own hash,
+so any changed or removed record breaks the chain`,
+		Run: adminAuditCmdRun,
+	}
+)
+
+func resetAdminAuditFlags(cmd *cobra.Command) {
+	cmd.ResetFlags()
+	flags := cmd.PersistentFlags()
+	flags.StringVar(&auditDB, "db", "", "storage with audit log: postgres:dsn")
+	cmd.MarkPersistentFlagRequired("db")
+}
+
+// verifyAudit проверяет журнал постранично, передавая хэш последней записи следующей странице
+func verifyAudit(al storage.AuditLog) (uint64, string, error) {
+	var (
+		count uint64
+		hash  string
+	)
+
+	page := func(afterID uint64) ([]domain.AuditRecord, error) {
+		return al.QueryAuditLog(ctx, &domain.AuditFilter{AfterID: afterID, Limit: auditPageSize})
+	}
+
+	records, err := page(0)
+	for ; err == nil && len(records) > 0; records, err = page(records[len(records)-1].ID) {
+		if hash, err = domain.VerifyAuditChain(hash, records); err != nil {
+			return count, hash, err
+		}
+		count += uint64(len(records))
+	}
+	return count, hash, err
+}
+
+func runAudit() (uint64, string, error) {
+	st, closeSt, err := migrate.Open(ctx, auditDB)
+	if err != nil {
+		return 0, "", err
+	}
+	defer closeSt()
+
+	al, ok := st.(storage.AuditLog)
+	if !ok {
+		return 0, "", fmt.Errorf("storage %q does not support audit log", auditDB)
+	}
+
+	return verifyAudit(al)
+}
+
+func adminAuditCmdRun(cmd *cobra.Command, args []string) {
+	defer resetAdminAuditFlags(cmd)
+
+	count, hash, err := runAudit()
+	if errors.Is(err, domain.ErrAuditChainBroken) {
+		fmt.Printf("audit log is broken: %v\n", err)
+		return
+	}
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("audit log is valid, records: %d, last hash: %s\n", count, hash)
+}
@@ -0,0 +1,220 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"gitlab.ozon.dev/chppppr/homework/internal/backup"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/migrate"
+)
+
+func init() {
+	adminCmd.AddCommand(adminBackupCmd)
+	adminCmd.AddCommand(adminRestoreCmd)
+
+	resetAdminBackupFlags(adminBackupCmd)
+	adminBackupCmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
+		cmd.Usage()
+		resetAdminBackupFlags(cmd)
+	})
+
+	resetAdminRestoreFlags(adminRestoreCmd)
+	adminRestoreCmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
+		cmd.Usage()
+		resetAdminRestoreFlags(cmd)
+	})
+}
+
+var (
+	backupFrom      string
+	backupOut       string
+	backupBatchSize uint64
+
+	restoreIn        string
+	restoreTo        string
+	restoreBatchSize uint64
+	restoreVerify    bool
+
+	adminBackupCmd = &cobra.Command{
+		Use:   "backup",
+		Short: "Backup orders to archive",
+		Long: `Backup orders, including archived ones, and sent reminders to gzip archive.
+Storage is json:path or postgres:dsn. All orders are read from one snapshot,
+archive keeps schema version of source and checksum of its records`,
+		Run: adminBackupCmdRun,
+	}
+
+	adminRestoreCmd = &cobra.Command{
+		Use:   "restore",
+		Short: "Restore orders from archive",
+		Long: `Restore orders and reminders from archive to empty storage: json:path or postgres:dsn.
+Archive is verified before restore, postgres target must be migrated to archive schema version or newer.
+A failed restore leaves the target empty: postgres rolls back the restore transaction,
+json is restored into a temporary file that replaces the target only on success.
+With --verify archive is only checked`,
+		Run: adminRestoreCmdRun,
+	}
+)
+
+func resetAdminBackupFlags(cmd *cobra.Command) {
+	cmd.ResetFlags()
+	flags := cmd.PersistentFlags()
+	flags.StringVar(&backupFrom, "from", "", "source storage: json:path or postgres:dsn")
+	flags.StringVar(&backupOut, "out", "", "archive file")
+	flags.Uint64Var(&backupBatchSize, "batch", backup.DefaultBatchSize, "orders per batch")
+	cmd.MarkPersistentFlagRequired("from")
+	cmd.MarkPersistentFlagRequired("out")
+}
+
+func resetAdminRestoreFlags(cmd *cobra.Command) {
+	cmd.ResetFlags()
+	flags := cmd.PersistentFlags()
+	flags.StringVar(&restoreIn, "in", "", "archive file")
+	flags.StringVar(&restoreTo, "to", "", "target storage: json:path or postgres:dsn")
+	flags.Uint64Var(&restoreBatchSize, "batch", backup.DefaultBatchSize, "records per batch")
+	flags.BoolVar(&restoreVerify, "verify", false, "only verify archive")
+	cmd.MarkPersistentFlagRequired("in")
+}
+
+func runBackup() (*backup.Trailer, error) {
+	st, closeSt, err := migrate.Open(ctx, backupFrom)
+	if err != nil {
+		return nil, err
+	}
+	defer closeSt()
+
+	src, ok := st.(backup.Source)
+	if !ok {
+		return nil, fmt.Errorf("storage %q does not support backup", backupFrom)
+	}
+
+	scheme, _, _ := strings.Cut(backupFrom, ":")
+	return backup.Write(ctx, src, backupOut, backup.Options{BatchSize: backupBatchSize, Source: scheme})
+}
+
+func adminBackupCmdRun(cmd *cobra.Command, args []string) {
+	defer resetAdminBackupFlags(cmd)
+
+	trailer, err := runBackup()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("orders: %d, reminders: %d, sha256: %s\n", trailer.Orders, trailer.Reminders, trailer.SHA256)
+}
+
+func runRestore() (*backup.Trailer, error) {
+	if restoreVerify {
+		header, trailer, err := backup.Verify(restoreIn)
+		if err == nil {
+			fmt.Printf("backup of %s created at %s, schema version %d\n",
+				header.Source, header.CreatedAt.Format("02-01-2006 15:04:05"), header.SchemaVersion)
+		}
+		return trailer, err
+	}
+
+	if restoreTo == "" {
+		return nil, fmt.Errorf("required flag \"to\" not set")
+	}
+
+	if scheme, path, _ := strings.Cut(restoreTo, ":"); scheme == migrate.SchemeJSON {
+		return restoreJSON(path)
+	}
+	return restore(restoreTo)
+}
+
+func restore(spec string) (*backup.Trailer, error) {
+	st, closeSt, err := migrate.Open(ctx, spec)
+	if err != nil {
+		return nil, err
+	}
+	defer closeSt()
+
+	return backup.Restore(ctx, st, restoreIn, restoreBatchSize)
+}
+
+// restoreJSON загружает архив во временный файл и ставит его на место целевого
+// только после успешной загрузки: транзакции JSON-хранилища не откатываются
+func restoreJSON(path string) (*backup.Trailer, error) {
+	if err := checkJSONEmpty(path); err != nil {
+		return nil, err
+	}
+
+	tmp := path + ".restore"
+	removeJSON(tmp)
+	trailer, err := restoreSnapshot(tmp)
+	if err != nil {
+		removeJSON(tmp)
+		return nil, err
+	}
+
+	// журнал загрузки сжат в снапшот, а журнал цели очищен при её открытии
+	if err = os.Rename(tmp, path); err != nil {
+		return nil, fmt.Errorf("replace %s: %w", path, err)
+	}
+	os.Remove(tmp + ".wal")
+	return trailer, nil
+}
+
+// restoreSnapshot загружает архив в новое JSON-хранилище и сжимает его журнал в снапшот
+func restoreSnapshot(path string) (*backup.Trailer, error) {
+	st, closeSt, err := migrate.Open(ctx, migrate.SchemeJSON+":"+path)
+	if err != nil {
+		return nil, err
+	}
+	defer closeSt()
+
+	trailer, err := backup.Restore(ctx, st, restoreIn, restoreBatchSize)
+	if err != nil {
+		return nil, err
+	}
+
+	saver, ok := st.(interface{ Save() error })
+	if !ok {
+		return nil, fmt.Errorf("json storage can't save snapshot")
+	}
+	return trailer, saver.Save()
+}
+
+func checkJSONEmpty(path string) error {
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	st, closeSt, err := migrate.Open(ctx, migrate.SchemeJSON+":"+path)
+	if err != nil {
+		return err
+	}
+	defer closeSt()
+
+	orders, err := st.SearchOrders(ctx, &domain.OrderFilter{SortBy: domain.SortByOrderID, Limit: 1})
+	if err != nil {
+		return fmt.Errorf("read target: %w", err)
+	}
+	if len(orders) != 0 {
+		return backup.ErrTargetNotEmpty
+	}
+	return nil
+}
+
+func removeJSON(path string) {
+	os.Remove(path)
+	os.Remove(path + ".wal")
+}
+
+func adminRestoreCmdRun(cmd *cobra.Command, args []string) {
+	defer resetAdminRestoreFlags(cmd)
+
+	trailer, err := runRestore()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Printf("orders: %d, reminders: %d\n", trailer.Orders, trailer.Reminders)
+}
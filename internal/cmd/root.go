@@ -16,6 +16,7 @@ func init() {
 	rootCmd.AddCommand(returnCmd)
 	rootCmd.AddCommand(viewCmd)
 	rootCmd.AddCommand(workersCmd)
+	rootCmd.AddCommand(adminCmd)
 
 	rootCmd.DisableSuggestions = false
 	rootCmd.CompletionOptions.DisableDefaultCmd = true
@@ -0,0 +1,168 @@
+package cmd
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/manifoldco/promptui"
+	"github.com/spf13/cobra"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/dto"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+func init() {
+	viewCmd.AddCommand(viewSearchCmd)
+
+	resetViewSearchFlags(viewSearchCmd)
+	viewSearchCmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
+		cmd.Usage()
+		resetViewSearchFlags(cmd)
+	})
+}
+
+var (
+	statuses     []string
+	acceptedFrom string
+	acceptedTo   string
+	expiresFrom  string
+	expiresTo    string
+	updatedFrom  string
+	updatedTo    string
+	costMin      uint64
+	costMax      uint64
+	weightMin    uint64
+	weightMax    uint64
+	sortBy       string
+	descending   bool
+	pageToken    string
+
+	viewSearchCmd = &cobra.Command{
+		Use:   "search",
+		Short: "Search orders",
+		Long:  "Search orders by status, dates, package type, cost and weight",
+		Run:   viewSearchCmdRun,
+	}
+)
+
+func resetViewSearchFlags(cmd *cobra.Command) {
+	cmd.ResetFlags()
+	flags := cmd.PersistentFlags()
+	flags.Uint64VarP(&userID, "userID", "u", 0, "userID")
+	flags.StringSliceVarP(&statuses, "status", "s", []string{}, "list of statuses")
+	flags.StringVar(&acceptedFrom, "acceptedFrom", "", "accepted from date (dd-mm-yyyy)")
+	flags.StringVar(&acceptedTo, "acceptedTo", "", "accepted to date (dd-mm-yyyy)")
+	flags.StringVar(&expiresFrom, "expiresFrom", "", "expires from date (dd-mm-yyyy)")
+	flags.StringVar(&expiresTo, "expiresTo", "", "expires to date (dd-mm-yyyy)")
+	flags.StringVar(&updatedFrom, "updatedFrom", "", "updated from date (dd-mm-yyyy)")
+	flags.StringVar(&updatedTo, "updatedTo", "", "updated to date (dd-mm-yyyy)")
+	flags.StringVarP(&containerType, "containerType", "t", "", "package type")
+	flags.Uint64Var(&costMin, "costMin", 0, "min cost")
+	flags.Uint64Var(&costMax, "costMax", 0, "max cost")
+	flags.Uint64Var(&weightMin, "weightMin", 0, "min weight")
+	flags.Uint64Var(&weightMax, "weightMax", 0, "max weight")
+	flags.StringVar(&sortBy, "sort", domain.SortByOrderID, "sort field: order_id, expiration_date, accepted_at, updated_at, cost, weight")
+	flags.BoolVar(&descending, "desc", false, "descending sort")
+	flags.Uint64VarP(&ordersLimit, "n", "n", 25, "page size")
+	flags.StringVar(&pageToken, "token", "", "page token from previous search")
+}
+
+func parseDate(date string) (*time.Time, error) {
+	if date == "" {
+		return nil, nil
+	}
+
+	t, err := utils.StringToTime(date)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
+func parseDateRange(from, to string) (r domain.DateRange, err error) {
+	if r.From, err = parseDate(from); err != nil {
+		return r, err
+	}
+	r.To, err = parseDate(to)
+	return r, err
+}
+
+func parseUintRange(cmd *cobra.Command, minFlag, maxFlag string, lo, hi uint64) (r domain.UintRange) {
+	if cmd.PersistentFlags().Changed(minFlag) {
+		r.Min = &lo
+	}
+	if cmd.PersistentFlags().Changed(maxFlag) {
+		r.Max = &hi
+	}
+	return r
+}
+
+func newSearchFilter(cmd *cobra.Command) (f domain.OrderFilter, err error) {
+	if f.Accepted, err = parseDateRange(acceptedFrom, acceptedTo); err != nil {
+		return f, err
+	}
+	if f.Expiring, err = parseDateRange(expiresFrom, expiresTo); err != nil {
+		return f, err
+	}
+	if f.Updated, err = parseDateRange(updatedFrom, updatedTo); err != nil {
+		return f, err
+	}
+
+	f.UserID = userID
+	f.Statuses = statuses
+	f.PackageType = containerType
+	f.Cost = parseUintRange(cmd, "costMin", "costMax", costMin, costMax)
+	f.Weight = parseUintRange(cmd, "weightMin", "weightMax", weightMin, weightMax)
+	f.SortBy = sortBy
+	f.Descending = descending
+	return f, nil
+}
+
+func viewSearchCmdRun(cmd *cobra.Command, args []string) {
+	defer resetViewSearchFlags(cmd)
+
+	filter, err := newSearchFilter(cmd)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	req := &dto.SearchOrdersRequest{
+		OrderFilter: filter,
+		PageSize:    ordersLimit,
+		PageToken:   pageToken,
+	}
+
+	res, err := mng_client.SearchOrders(ctx, req)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	templates := &promptui.SelectTemplates{
+		Label:    "{{.}}",
+		Active:   "\U0001F336 {{.OrderID | cyan}}",
+		Inactive: "  {{.OrderID | cyan}}",
+		Selected: " ",
+		Details: `-----Order-----
+{{ "OrderID:" | faint }}  {{ .OrderID }} {{ "UserID:" | faint }}  {{ .UserID }} {{ "Status:" | faint }} {{ .Status }}
+{{"Cost:" | faint }} {{ .Cost }}rub {{"Weight:" | faint }} {{ .Weight }}gr {{ "Package Type:" | faint }} {{ .PackageType }}
+{{ "Accepted:" | faint }} {{ .AcceptedAt }} {{ "Updated:" | faint }} {{ .UpdatedAt }} {{ "Expiration date:" | faint }} {{ .ExpirationDate }}`,
+	}
+
+	promt := promptui.Select{
+		Label:     "Found orders",
+		Items:     res.Orders,
+		Templates: templates,
+	}
+
+	InOutLock()
+	_, _, err = promt.Run()
+	if err != nil {
+		fmt.Println(err)
+	}
+	if res.NextPageToken != "" {
+		fmt.Printf("next page: view search --token=%s\n", res.NextPageToken)
+	}
+	InOutUnlock()
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gitlab.ozon.dev/chppppr/homework/internal/auth"
+)
+
+func init() {
+	adminCmd.AddCommand(adminTokenCmd)
+
+	resetAdminTokenFlags(adminTokenCmd)
+	adminTokenCmd.SetHelpFunc(func(cmd *cobra.Command, args []string) {
+		cmd.Usage()
+		resetAdminTokenFlags(cmd)
+	})
+}
+
+var (
+	tokenSubject string
+	tokenRole    string
+	tokenPoint   string
+	tokenTTL     time.Duration
+
+	adminTokenCmd = &cobra.Command{
+		Use:   "token",
+		Short: "Issue API token",
+		Long: `Issue JWT for manager service client, signed with AUTH_JWT_SECRET.
+Roles: staff, courier, admin, analyst. Clients pass token in MANAGER_TOKEN`,
+		Run: adminTokenCmdRun,
+	}
+)
+
+func resetAdminTokenFlags(cmd *cobra.Command) {
+	cmd.ResetFlags()
+	flags := cmd.PersistentFlags()
+	flags.StringVar(&tokenSubject, "subject", "", "client name")
+	flags.StringVar(&tokenRole, "role", auth.RoleStaff, "client role")
+	flags.StringVar(&tokenPoint, "pickup-point", "", "pickup point of client, recorded in audit log")
+	flags.DurationVar(&tokenTTL, "ttl", 24*time.Hour, "token lifetime")
+	cmd.MarkPersistentFlagRequired("subject")
+}
+
+func issueToken() (string, error) {
+	secret := os.Getenv("AUTH_JWT_SECRET")
+	if secret == "" {
+		return "", errors.New("AUTH_JWT_SECRET is not set")
+	}
+	return auth.IssueToken([]byte(secret), &auth.Identity{Subject: tokenSubject, Role: tokenRole, PickupPoint: tokenPoint}, tokenTTL)
+}
+
+func adminTokenCmdRun(cmd *cobra.Command, args []string) {
+	defer resetAdminTokenFlags(cmd)
+
+	token, err := issueToken()
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	fmt.Println(token)
+}
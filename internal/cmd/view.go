@@ -50,17 +50,16 @@ var (
 	viewOrderCmd = &cobra.Command{
 		Use:   "order",
 		Short: "View orders",
-		Long:  "View orders",
+		Long:  "View orders of user, or a single order by its orderID if userID isn't set",
 		Run:   viewOrdersCmdRun,
 	}
 )
 
 func resetViewOrderFlags(cmd *cobra.Command) {
 	cmd.ResetFlags()
-	cmd.PersistentFlags().Uint64VarP(&userID, "userID", "u", 0, "userID (required)")
-	cmd.PersistentFlags().Uint64VarP(&orderID, "orderID", "o", 1, "first orderID which should be output")
+	cmd.PersistentFlags().Uint64VarP(&userID, "userID", "u", 0, "userID")
+	cmd.PersistentFlags().Uint64VarP(&orderID, "orderID", "o", 1, "first orderID which should be output, or the order to view if userID isn't set")
 	cmd.PersistentFlags().Uint64VarP(&ordersLimit, "n", "n", 25, "limit of returned orders (defalut 25)")
-	cmd.MarkPersistentFlagRequired("userID")
 }
 
 func resetViewRefundFlags(cmd *cobra.Command) {
@@ -110,6 +109,11 @@ func viewRefundCmdRun(cmd *cobra.Command, args []string) {
 func viewOrdersCmdRun(cmd *cobra.Command, args []string) {
 	defer resetViewOrderFlags(cmd)
 
+	if !cmd.PersistentFlags().Changed("userID") {
+		viewOrder(cmd)
+		return
+	}
+
 	req := &dto.ViewOrdersRequest{
 		UserID:       userID,
 		FirstOrderID: orderID,
@@ -146,3 +150,30 @@ func viewOrdersCmdRun(cmd *cobra.Command, args []string) {
 	}
 	InOutUnlock()
 }
+
+func viewOrder(cmd *cobra.Command) {
+	if !cmd.PersistentFlags().Changed("orderID") {
+		fmt.Println("userID or orderID must be set")
+		return
+	}
+
+	res, err := mng_client.GetOrder(ctx, &dto.GetOrderRequest{OrderID: orderID})
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	order := res.Order
+	InOutLock()
+	fmt.Printf("-----Order-----\n"+
+		"OrderID: %d UserID: %d Status: %s\n"+
+		"Cost: %drub Weight: %dgr Package Type: %s\n"+
+		"Accepted: %s Updated: %s Expiration date: %s\n"+
+		"Days until expiration: %d Refundable: %t\n",
+		order.OrderID, order.UserID, order.Status,
+		order.Cost, order.Weight, order.PackageType,
+		order.AcceptedAt, order.UpdatedAt, order.ExpirationDate,
+		res.DaysUntilExpiration, res.Refundable,
+	)
+	InOutUnlock()
+}
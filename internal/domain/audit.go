@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	AuditOutcomeSuccess = "success"
+	AuditOutcomeFailure = "failure"
+)
+
+var ErrAuditChainBroken = errors.New("audit chain is broken")
+
+type (
+	// AuditRecord - запись журнала изменяющих вызовов API. Hash - sha256 от PrevHash
+	// и полей записи, поэтому изменение, вставка или удаление записи рвёт цепочку
+	AuditRecord struct {
+		ID          uint64    `json:"id" db:"id"`
+		CreatedAt   time.Time `json:"createdAt" db:"created_at"`
+		Actor       string    `json:"actor" db:"actor"`
+		PickupPoint string    `json:"pickupPoint" db:"pickup_point"`
+		Method      string    `json:"method" db:"method"`
+		OrderIDs    []uint64  `json:"orderIDs" db:"order_ids"`
+		// PayloadDigest - HMAC-SHA256 тела запроса с ключом сервера: по нему с ключом
+		// проверяется запрос, но данные клиента не хранятся
+		PayloadDigest string `json:"payloadDigest" db:"payload_digest"`
+		Outcome       string `json:"outcome" db:"outcome"`
+		Code          string `json:"code" db:"code"`
+		PrevHash      string `json:"prevHash" db:"prev_hash"`
+		Hash          string `json:"hash" db:"hash"`
+	}
+
+	// AuditFilter отбирает записи журнала по порядку. Пустые поля не ограничивают выборку
+	AuditFilter struct {
+		Actor   string
+		OrderID uint64
+		From    *time.Time
+		To      *time.Time
+		AfterID uint64
+		Limit   uint64
+	}
+)
+
+// ComputeHash считает хэш записи. Время округляется до микросекунд, как его хранит postgres
+func (r *AuditRecord) ComputeHash() string {
+	orders := r.OrderIDs
+	if orders == nil {
+		orders = []uint64{}
+	}
+
+	raw, _ := json.Marshal(struct {
+		PrevHash      string   `json:"prevHash"`
+		CreatedAt     string   `json:"createdAt"`
+		Actor         string   `json:"actor"`
+		PickupPoint   string   `json:"pickupPoint"`
+		Method        string   `json:"method"`
+		OrderIDs      []uint64 `json:"orderIDs"`
+		PayloadDigest string   `json:"payloadDigest"`
+		Outcome       string   `json:"outcome"`
+		Code          string   `json:"code"`
+	}{
+		PrevHash:      r.PrevHash,
+		CreatedAt:     r.CreatedAt.UTC().Truncate(time.Microsecond).Format(time.RFC3339Nano),
+		Actor:         r.Actor,
+		PickupPoint:   r.PickupPoint,
+		Method:        r.Method,
+		OrderIDs:      orders,
+		PayloadDigest: r.PayloadDigest,
+		Outcome:       r.Outcome,
+		Code:          r.Code,
+	})
+
+	sum := sha256.Sum256(raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// Chain привязывает запись к предыдущей
+func (r *AuditRecord) Chain(prevHash string) {
+	r.CreatedAt = r.CreatedAt.UTC().Truncate(time.Microsecond)
+	r.PrevHash = prevHash
+	r.Hash = r.ComputeHash()
+}
+
+// VerifyAuditChain проверяет записи по порядку, начиная с хэша prevHash,
+// и возвращает хэш последней. Первая запись журнала ссылается на пустой хэш
+func VerifyAuditChain(prevHash string, records []AuditRecord) (string, error) {
+	for i := range records {
+		r := &records[i]
+		if r.PrevHash != prevHash || r.ComputeHash() != r.Hash {
+			return prevHash, fmt.Errorf("%w at record %d", ErrAuditChainBroken, r.ID)
+		}
+		prevHash = r.Hash
+	}
+	return prevHash, nil
+}
@@ -9,6 +9,9 @@ const (
 	EventOrderGiveClient  EventType = "order issued to client"
 	EventOrderGiveCourier EventType = "order issued to courier"
 	EventOrderReturned    EventType = "order returned"
+	EventOrderExpired     EventType = "order expired"
+
+	EventOrderExpiringSoon EventType = "order_expiring_soon"
 )
 
 type Event struct {
@@ -17,6 +20,13 @@ type Event struct {
 
 	OrderIDs   []uint64 `json:"orders_id"`
 	ErrService string   `json:"error_service"`
+
+	UserID         uint64 `json:"user_id,omitempty"`
+	ExpirationDate string `json:"expiration_date,omitempty"`
+	ReminderStage  uint64 `json:"reminder_stage,omitempty"`
+
+	// Actor - клиент API, по запросу которого произошло событие, вида роль:имя
+	Actor string `json:"actor,omitempty"`
 }
 
 func errToString(err error) string {
@@ -36,3 +46,17 @@ func NewEvent(orderIDs []uint64, eventType EventType, err_ser error) *Event {
 		ErrService: errToString(err_ser),
 	}
 }
+
+// NewExpiringSoonEvent создаёт напоминание клиенту о том, что до окончания
+// срока хранения заказа осталось stage дней.
+func NewExpiringSoonEvent(order *OrderView, stage uint64) *Event {
+	return &Event{
+		EventType: EventOrderExpiringSoon,
+		Timestamp: time.Now().UTC(),
+
+		OrderIDs:       []uint64{order.OrderID},
+		UserID:         order.UserID,
+		ExpirationDate: order.ExpirationDate,
+		ReminderStage:  stage,
+	}
+}
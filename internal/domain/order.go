@@ -2,6 +2,7 @@ package domain
 
 import (
 	"errors"
+	"fmt"
 
 	"gitlab.ozon.dev/chppppr/homework/internal/domain/strategy"
 )
@@ -11,6 +12,7 @@ const (
 	StatusGiveClient  = "issued to client"
 	StatusGiveCourier = "issued to courier"
 	StatusReturned    = "returned"
+	StatusExpired     = "expired_awaiting_courier"
 )
 
 var (
@@ -21,6 +23,7 @@ var (
 	ErrNotExpirationDate    = errors.New("expiration date hasn't expired yet")
 	ErrExpirationDatePassed = errors.New("expiration date has already passed")
 	ErrTwoDaysPassed        = errors.New("2 days have passed since the order was issued to the client")
+	ErrVersionMismatch      = errors.New("order has been changed: version mismatch")
 )
 
 type (
@@ -34,19 +37,37 @@ type (
 
 	OrderStatus struct {
 		*Order
-		Status    string `json:"status" db:"status"`
-		UpdatedAt string `json:"updatedAt" db:"updated_at"`
-		UserID    uint64 `json:"userID" db:"user_id"`
+		Status     string `json:"status" db:"status"`
+		AcceptedAt string `json:"acceptedAt" db:"accepted_at"`
+		UpdatedAt  string `json:"updatedAt" db:"updated_at"`
+		UserID     uint64 `json:"userID" db:"user_id"`
+		// Version увеличивается при каждой смене статуса
+		Version uint64 `json:"version" db:"version"`
 	}
 
 	OrderView struct {
 		*Order
 		UserID  uint64 `json:"userID" db:"user_id"`
 		OrderID uint64 `json:"orderID" db:"order_id"`
+		Version uint64 `json:"version" db:"version"`
 		Exist   bool   `json:"exist" db:"-"`
 	}
 )
 
+// IsHeld проверяет, что заказ с таким статусом лежит на хранении в ПВЗ
+func IsHeld(status string) bool {
+	return status == StatusAccepted || status == StatusExpired
+}
+
+// CheckVersion сверяет версию заказа с ожидаемой клиентом, nil отключает проверку
+func (s *OrderStatus) CheckVersion(orderID uint64, expected *uint64) error {
+	if expected == nil || *expected == s.Version {
+		return nil
+	}
+
+	return fmt.Errorf("order %d: expected version %d, actual %d: %w", orderID, *expected, s.Version, ErrVersionMismatch)
+}
+
 func NewOrder(
 	cost, weight uint64,
 	expDate string,
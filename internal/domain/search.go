@@ -0,0 +1,175 @@
+package domain
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+const (
+	SortByOrderID        = "order_id"
+	SortByExpirationDate = "expiration_date"
+	SortByAcceptedAt     = "accepted_at"
+	SortByUpdatedAt      = "updated_at"
+	SortByCost           = "cost"
+	SortByWeight         = "weight"
+)
+
+type (
+	DateRange struct {
+		From *time.Time
+		To   *time.Time
+	}
+
+	UintRange struct {
+		Min *uint64
+		Max *uint64
+	}
+
+	// OrderRecord — полные данные заказа вместе с его текущим статусом
+	OrderRecord struct {
+		*Order
+		OrderID    uint64 `json:"orderID" db:"order_id"`
+		UserID     uint64 `json:"userID" db:"user_id"`
+		Status     string `json:"status" db:"status"`
+		AcceptedAt string `json:"acceptedAt" db:"accepted_at"`
+		UpdatedAt  string `json:"updatedAt" db:"updated_at"`
+		Version    uint64 `json:"version" db:"version"`
+	}
+
+	// SearchCursor — ключ сортировки последнего заказа предыдущей страницы.
+	// Даты хранятся как unix-время, числовые поля — как есть.
+	SearchCursor struct {
+		Value   uint64 `json:"v"`
+		OrderID uint64 `json:"id"`
+	}
+
+	OrderFilter struct {
+		UserID      uint64
+		Statuses    []string
+		Accepted    DateRange
+		Expiring    DateRange
+		Updated     DateRange
+		PackageType string
+		Cost        UintRange
+		Weight      UintRange
+		SortBy      string
+		Descending  bool
+
+		After *SearchCursor
+		Limit uint64
+	}
+)
+
+func (r *OrderRecord) OrderStatus() *OrderStatus {
+	return &OrderStatus{
+		Order:      r.Order,
+		Status:     r.Status,
+		AcceptedAt: r.AcceptedAt,
+		UpdatedAt:  r.UpdatedAt,
+		UserID:     r.UserID,
+		Version:    r.Version,
+	}
+}
+
+// Normalize дополняет записи старого формата JSON-хранилища, где не было дат приёма и версий
+func (r *OrderRecord) Normalize() {
+	if r.AcceptedAt == "" {
+		r.AcceptedAt = r.UpdatedAt
+	}
+	if r.AcceptedAt == "" {
+		r.AcceptedAt = utils.CurrentDateString()
+	}
+	if r.UpdatedAt == "" {
+		r.UpdatedAt = r.AcceptedAt
+	}
+	if r.Version == 0 {
+		r.Version = 1
+	}
+}
+
+func IsSortField(sortBy string) bool {
+	switch sortBy {
+	case SortByOrderID, SortByExpirationDate, SortByAcceptedAt, SortByUpdatedAt, SortByCost, SortByWeight:
+		return true
+	}
+	return false
+}
+
+func dateKey(date string) (uint64, error) {
+	t, err := utils.StringToTime(date)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(t.Unix()), nil
+}
+
+// SortKey возвращает значение поля sortBy, по которому строится курсор страницы
+//
+//gocyclo:ignore
+func (r *OrderRecord) SortKey(sortBy string) (uint64, error) {
+	switch sortBy {
+	case SortByOrderID:
+		return r.OrderID, nil
+	case SortByExpirationDate:
+		return dateKey(r.ExpirationDate)
+	case SortByAcceptedAt:
+		return dateKey(r.AcceptedAt)
+	case SortByUpdatedAt:
+		return dateKey(r.UpdatedAt)
+	case SortByCost:
+		return r.Cost, nil
+	case SortByWeight:
+		return r.Weight, nil
+	}
+	return 0, fmt.Errorf("unknown sort field %q: %w", sortBy, ErrWrongInput)
+}
+
+// Contains проверяет, что дата в формате "02-01-2006" попадает в диапазон
+func (r DateRange) Contains(date string) bool {
+	if r.From == nil && r.To == nil {
+		return true
+	}
+
+	t, err := utils.StringToTime(date)
+	return err == nil && r.includes(t)
+}
+
+func (r DateRange) includes(t time.Time) bool {
+	return (r.From == nil || !t.Before(*r.From)) && (r.To == nil || !t.After(*r.To))
+}
+
+func (r UintRange) Contains(v uint64) bool {
+	return (r.Min == nil || v >= *r.Min) && (r.Max == nil || v <= *r.Max)
+}
+
+// Match проверяет запись на соответствие фильтру без учёта курсора и лимита
+//
+//gocyclo:ignore
+func (f *OrderFilter) Match(r *OrderRecord) bool {
+	return (f.UserID == 0 || r.UserID == f.UserID) &&
+		(len(f.Statuses) == 0 || slices.Contains(f.Statuses, r.Status)) &&
+		(f.PackageType == "" || r.PackageType == f.PackageType) &&
+		f.Accepted.Contains(r.AcceptedAt) &&
+		f.Expiring.Contains(r.ExpirationDate) &&
+		f.Updated.Contains(r.UpdatedAt) &&
+		f.Cost.Contains(r.Cost) &&
+		f.Weight.Contains(r.Weight)
+}
+
+// Compare сравнивает позиции двух заказов в порядке сортировки фильтра
+func (f *OrderFilter) Compare(a, b SearchCursor) int {
+	c := cmp.Or(cmp.Compare(a.Value, b.Value), cmp.Compare(a.OrderID, b.OrderID))
+	if f.Descending {
+		return -c
+	}
+	return c
+}
+
+// IsAfter проверяет, что позиция идёт строго после курсора фильтра
+func (f *OrderFilter) IsAfter(pos SearchCursor) bool {
+	return f.After == nil || f.Compare(pos, *f.After) > 0
+}
@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"time"
+)
+
+type (
+	// Reminder - напоминание о сроке хранения. SentAt пуст, пока напоминание не отправлено
+	Reminder struct {
+		OrderID uint64     `json:"orderID" db:"order_id"`
+		Stage   uint64     `json:"stage" db:"stage"`
+		SentAt  *time.Time `json:"sentAt,omitempty" db:"sent_at"`
+	}
+
+	// UserData - все хранимые данные клиента: заказы с текущим статусом и датами,
+	// включая архивные, ожидающие курьера возвраты, напоминания и события статусов
+	UserData struct {
+		UserID    uint64        `json:"userID"`
+		Orders    []OrderRecord `json:"orders"`
+		Refunds   []uint64      `json:"refunds"`
+		Reminders []Reminder    `json:"reminders"`
+		// Events - события изменения статусов из истории подписки на заказы
+		Events []OrderStatusChange `json:"events"`
+	}
+)
+
+// Pseudonymize заменяет идентификатор клиента псевдонимом. Псевдоним зависит
+// только от ключа, поэтому заказы клиента остаются сгруппированы для отчётов,
+// а без ключа связать его с клиентом нельзя. Старший бит сброшен: в postgres
+// идентификаторы хранятся в bigint
+func Pseudonymize(userID uint64, key []byte) uint64 {
+	mac := hmac.New(sha256.New, key)
+	_ = binary.Write(mac, binary.BigEndian, userID)
+	return binary.BigEndian.Uint64(mac.Sum(nil)) >> 1
+}
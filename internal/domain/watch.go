@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	ErrCursorExpired    = errors.New("cursor is out of watch history")
+	ErrSubscriberLagged = errors.New("subscriber is too slow")
+)
+
+type (
+	// OrderStatusChange — событие изменения статуса заказа.
+	// Cursor монотонно возрастает в пределах одного процесса сервиса.
+	OrderStatusChange struct {
+		Cursor    uint64    `json:"cursor"`
+		OrderID   uint64    `json:"orderID"`
+		UserID    uint64    `json:"userID"`
+		Status    string    `json:"status"`
+		UpdatedAt time.Time `json:"updatedAt"`
+	}
+
+	WatchFilter struct {
+		UserID uint64
+		Status string
+	}
+)
+
+func (f WatchFilter) Match(c *OrderStatusChange) bool {
+	if f.UserID != 0 && f.UserID != c.UserID {
+		return false
+	}
+	return f.Status == "" || f.Status == c.Status
+}
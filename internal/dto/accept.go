@@ -11,6 +11,7 @@ type AddOrderRequest struct {
 }
 
 type RefundRequest struct {
-	UserID  uint64 `json:"userID"`
-	OrderID uint64 `json:"orderID"`
+	UserID          uint64  `json:"userID"`
+	OrderID         uint64  `json:"orderID"`
+	ExpectedVersion *uint64 `json:"expectedVersion,omitempty"`
 }
@@ -0,0 +1,12 @@
+package dto
+
+import "time"
+
+type QueryAuditLogRequest struct {
+	Actor   string
+	OrderID uint64
+	From    *time.Time
+	To      *time.Time
+	AfterID uint64
+	Limit   uint64
+}
@@ -1,5 +1,6 @@
 package dto
 
 type GiveOrdersRequest struct {
-	Orders []uint64 `json:"orders"`
+	Orders           []uint64          `json:"orders"`
+	ExpectedVersions map[uint64]uint64 `json:"expectedVersions,omitempty"`
 }
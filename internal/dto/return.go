@@ -1,5 +1,6 @@
 package dto
 
 type ReturnRequest struct {
-	OrderID uint64 `json:"orderID"`
+	OrderID         uint64  `json:"orderID"`
+	ExpectedVersion *uint64 `json:"expectedVersion,omitempty"`
 }
@@ -0,0 +1,14 @@
+package dto
+
+type ExportUserDataRequest struct {
+	UserID uint64 `json:"userID"`
+}
+
+type EraseUserDataRequest struct {
+	UserID uint64 `json:"userID"`
+}
+
+// EraseUserDataResponse не содержит псевдоним: по нему можно было бы снова связать заказы с клиентом
+type EraseUserDataResponse struct {
+	Orders uint64
+}
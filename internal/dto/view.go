@@ -22,3 +22,30 @@ type ViewOrdersRequest struct {
 type ViewOrdersResponse struct {
 	Orders []domain.OrderView
 }
+
+type WatchOrdersRequest struct {
+	UserID uint64 `json:"userID"`
+	Status string `json:"status"`
+	Cursor uint64 `json:"cursor"`
+}
+
+type SearchOrdersRequest struct {
+	domain.OrderFilter
+	PageSize  uint64 `json:"pageSize"`
+	PageToken string `json:"pageToken"`
+}
+
+type SearchOrdersResponse struct {
+	Orders        []domain.OrderRecord
+	NextPageToken string
+}
+
+type GetOrderRequest struct {
+	OrderID uint64 `json:"orderID"`
+}
+
+type GetOrderResponse struct {
+	Order               domain.OrderRecord
+	DaysUntilExpiration int64
+	Refundable          bool
+}
@@ -0,0 +1,202 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const (
+	DefaultInterval = 5 * time.Second
+	DefaultTimeout  = 2 * time.Second
+
+	StatusReady        = "ready"
+	StatusNotReady     = "not_ready"
+	StatusShuttingDown = "shutting_down"
+
+	statusOK      = "ok"
+	statusError   = "error"
+	statusUnknown = "unknown"
+)
+
+type (
+	// Check проверяет доступность зависимости и должен учитывать ctx
+	Check func(ctx context.Context) error
+
+	Config struct {
+		// Interval - как часто проверяются зависимости
+		Interval time.Duration `mapstructure:"interval"`
+		// Timeout - сколько ждать каждую проверку
+		Timeout time.Duration `mapstructure:"timeout"`
+		// ShutdownDelay - сколько сервис продолжает обслуживать запросы после того,
+		// как перестал быть готовым, чтобы балансировщик успел это заметить
+		ShutdownDelay time.Duration `mapstructure:"shutdown_delay"`
+	}
+
+	Dependency struct {
+		Status string `json:"status"`
+		Error  string `json:"error,omitempty"`
+	}
+
+	Report struct {
+		Status       string                `json:"status"`
+		CheckedAt    time.Time             `json:"checked_at"`
+		Dependencies map[string]Dependency `json:"dependencies"`
+	}
+
+	dependency struct {
+		name  string
+		check Check
+	}
+
+	// Checker периодически проверяет зависимости сервиса и выставляет
+	// по результату статус в grpc.health.v1 и отчёт для /readyz
+	Checker struct {
+		cfg      Config
+		deps     []dependency
+		srv      *health.Server
+		services []string
+		mu       sync.RWMutex
+		report   Report
+		shutdown atomic.Bool
+	}
+)
+
+// NewChecker выставляет статус services в srv. До первой проверки сервис не готов
+func NewChecker(cfg Config, srv *health.Server, services ...string) *Checker {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultInterval
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultTimeout
+	}
+
+	c := &Checker{
+		cfg:      cfg,
+		srv:      srv,
+		services: append([]string{""}, services...),
+		report:   Report{Status: StatusNotReady, Dependencies: map[string]Dependency{}},
+	}
+	c.setServingStatus(healthpb.HealthCheckResponse_NOT_SERVING)
+	return c
+}
+
+// Add добавляет зависимость, без которой сервис не готов. Вызывается до Run
+func (c *Checker) Add(name string, check Check) {
+	c.deps = append(c.deps, dependency{name: name, check: check})
+	c.report.Dependencies[name] = Dependency{Status: statusUnknown}
+}
+
+func (c *Checker) setServingStatus(status healthpb.HealthCheckResponse_ServingStatus) {
+	for _, service := range c.services {
+		c.srv.SetServingStatus(service, status)
+	}
+}
+
+// runCheck не ждёт проверку дольше ctx, даже если она сама не учитывает ctx
+func runCheck(ctx context.Context, check Check) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- check(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// check проверяет все зависимости одновременно
+func (c *Checker) check(ctx context.Context) Report {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+	defer cancel()
+
+	errs := make([]error, len(c.deps))
+	wg := &sync.WaitGroup{}
+	for i, dep := range c.deps {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = runCheck(ctx, dep.check)
+		}()
+	}
+	wg.Wait()
+
+	report := Report{Status: StatusReady, CheckedAt: time.Now(), Dependencies: make(map[string]Dependency, len(c.deps))}
+	for i, dep := range c.deps {
+		if errs[i] != nil {
+			report.Status = StatusNotReady
+			report.Dependencies[dep.name] = Dependency{Status: statusError, Error: errs[i].Error()}
+			continue
+		}
+		report.Dependencies[dep.name] = Dependency{Status: statusOK}
+	}
+	return report
+}
+
+// Update проверяет зависимости и обновляет статус. После Shutdown сервис остаётся не готовым
+func (c *Checker) Update(ctx context.Context) {
+	report := c.check(ctx)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.shutdown.Load() {
+		report.Status = StatusShuttingDown
+	}
+	c.report = report
+
+	status := healthpb.HealthCheckResponse_NOT_SERVING
+	if report.Status == StatusReady {
+		status = healthpb.HealthCheckResponse_SERVING
+	}
+	c.setServingStatus(status)
+}
+
+// Run проверяет зависимости сразу и затем каждые Interval, пока не отменён ctx
+func (c *Checker) Run(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(c.cfg.Interval)
+		defer ticker.Stop()
+
+		for {
+			c.Update(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// Shutdown переводит сервис в неготовые насовсем, вызывается в начале остановки
+func (c *Checker) Shutdown() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.shutdown.Store(true)
+	c.report.Status = StatusShuttingDown
+	c.srv.Shutdown()
+}
+
+// ShutdownDelay - сколько ждать после Shutdown перед остановкой серверов
+func (c *Checker) ShutdownDelay() time.Duration {
+	return c.cfg.ShutdownDelay
+}
+
+func (c *Checker) Report() Report {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.report
+}
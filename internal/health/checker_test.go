@@ -0,0 +1,94 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const service = "manager.ManagerService"
+
+func servingStatus(t *testing.T, srv *health.Server) healthpb.HealthCheckResponse_ServingStatus {
+	resp, err := srv.Check(context.Background(), &healthpb.HealthCheckRequest{Service: service})
+	require.NoError(t, err)
+	return resp.Status
+}
+
+func readiness(t *testing.T, c *Checker) (int, Report) {
+	w := httptest.NewRecorder()
+	c.Readiness(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+	var report Report
+	require.NoError(t, json.NewDecoder(w.Body).Decode(&report))
+	return w.Code, report
+}
+
+func TestChecker(t *testing.T) {
+	srv := health.NewServer()
+	c := NewChecker(Config{Timeout: 50 * time.Millisecond}, srv, service)
+
+	var kafkaErr error
+	c.Add("postgres", func(ctx context.Context) error { return nil })
+	c.Add("kafka", func(ctx context.Context) error { return kafkaErr })
+
+	// до первой проверки сервис не готов
+	code, report := readiness(t, c)
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, StatusNotReady, report.Status)
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, srv))
+
+	c.Update(context.Background())
+	code, report = readiness(t, c)
+	require.Equal(t, http.StatusOK, code)
+	require.Equal(t, StatusReady, report.Status)
+	require.Equal(t, healthpb.HealthCheckResponse_SERVING, servingStatus(t, srv))
+
+	kafkaErr = errors.New("no brokers")
+	c.Update(context.Background())
+	code, report = readiness(t, c)
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, Dependency{Status: statusOK}, report.Dependencies["postgres"])
+	require.Equal(t, Dependency{Status: statusError, Error: "no brokers"}, report.Dependencies["kafka"])
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, srv))
+
+	// после остановки сервис не готов, даже если зависимости доступны
+	kafkaErr = nil
+	c.Shutdown()
+	c.Update(context.Background())
+	code, report = readiness(t, c)
+	require.Equal(t, http.StatusServiceUnavailable, code)
+	require.Equal(t, StatusShuttingDown, report.Status)
+	require.Equal(t, healthpb.HealthCheckResponse_NOT_SERVING, servingStatus(t, srv))
+
+	w := httptest.NewRecorder()
+	c.Liveness(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestCheckerTimeout(t *testing.T) {
+	c := NewChecker(Config{Timeout: 20 * time.Millisecond}, health.NewServer())
+
+	block := make(chan struct{})
+	defer close(block)
+	// проверка, которая не учитывает ctx, не задерживает остальные
+	c.Add("kafka", func(ctx context.Context) error {
+		<-block
+		return nil
+	})
+
+	start := time.Now()
+	c.Update(context.Background())
+	require.Less(t, time.Since(start), time.Second)
+
+	report := c.Report()
+	require.Equal(t, StatusNotReady, report.Status)
+	require.Equal(t, context.DeadlineExceeded.Error(), report.Dependencies["kafka"].Error)
+}
@@ -0,0 +1,30 @@
+package health
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Liveness отвечает, пока процесс обслуживает запросы, и не зависит от зависимостей:
+// перезапуск не поможет, если недоступна база
+func (c *Checker) Liveness(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, map[string]string{"status": "alive"})
+}
+
+// Readiness отдаёт последний отчёт о зависимостях, 503 - если сервис не готов
+func (c *Checker) Readiness(w http.ResponseWriter, r *http.Request) {
+	report := c.Report()
+
+	code := http.StatusOK
+	if report.Status != StatusReady {
+		code = http.StatusServiceUnavailable
+	}
+	writeJSON(w, code, report)
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
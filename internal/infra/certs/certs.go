@@ -0,0 +1,117 @@
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+)
+
+// Server отдаёт TLS-конфигурацию сервера с сертификатом и CA клиентов,
+// которые перечитываются при замене файлов без перезапуска
+type Server struct {
+	clientAuth bool
+	cert       *reloadable[*tls.Certificate]
+	// clientCAs - nil, если клиенты проверяются только при ClientAuth по системным корневым
+	clientCAs *reloadable[*x509.CertPool]
+}
+
+func NewServer(cfg ServerConfig) (*Server, error) {
+	cert, err := newKeyPair(cfg.CertFile, cfg.KeyFile, cfg.ReloadInterval)
+	if err != nil {
+		return nil, fmt.Errorf("NewServer: %w", err)
+	}
+
+	s := &Server{clientAuth: cfg.ClientAuth, cert: cert}
+	if cfg.ClientCAFile == "" {
+		if cfg.ClientAuth {
+			return nil, errors.New("NewServer: client_auth requires client_ca_file")
+		}
+		return s, nil
+	}
+
+	if s.clientCAs, err = newPool(cfg.ClientCAFile, cfg.ReloadInterval); err != nil {
+		return nil, fmt.Errorf("NewServer: %w", err)
+	}
+	return s, nil
+}
+
+// Config собирает конфигурацию заново при каждом рукопожатии, чтобы подхватить новые файлы
+func (s *Server) Config() *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			return s.handshakeConfig(), nil
+		},
+	}
+}
+
+func (s *Server) handshakeConfig() *tls.Config {
+	cfg := &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		Certificates: []tls.Certificate{*s.cert.get()},
+		// конфигурация из GetConfigForClient заменяет исходную целиком, вместе с ALPN для gRPC
+		NextProtos: []string{"h2"},
+	}
+
+	if s.clientCAs == nil {
+		return cfg
+	}
+
+	// без client_auth сертификат необязателен, но предъявленный проверяется
+	cfg.ClientCAs = s.clientCAs.get()
+	cfg.ClientAuth = tls.VerifyClientCertIfGiven
+	if s.clientAuth {
+		cfg.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return cfg
+}
+
+// Client отдаёт TLS-конфигурацию клиента. Сертификат клиента перечитывается
+// при замене файлов, CA сервера читается один раз при создании
+type Client struct {
+	serverName string
+	roots      *x509.CertPool
+	// cert - nil, если сертификат клиента не настроен
+	cert *reloadable[*tls.Certificate]
+}
+
+func NewClient(cfg ClientConfig) (*Client, error) {
+	roots, err := loadRoots(cfg.CAFile)
+	if err != nil {
+		return nil, fmt.Errorf("NewClient: %w", err)
+	}
+
+	c := &Client{serverName: cfg.ServerName, roots: roots}
+	if cfg.CertFile == "" && cfg.KeyFile == "" {
+		return c, nil
+	}
+
+	if c.cert, err = newKeyPair(cfg.CertFile, cfg.KeyFile, cfg.ReloadInterval); err != nil {
+		return nil, fmt.Errorf("NewClient: %w", err)
+	}
+	return c, nil
+}
+
+// loadRoots: без файла - nil, то есть системные корневые сертификаты
+func loadRoots(file string) (*x509.CertPool, error) {
+	if file == "" {
+		return nil, nil
+	}
+	return loadPool(file)
+}
+
+func (c *Client) Config() *tls.Config {
+	cfg := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		RootCAs:    c.roots,
+		ServerName: c.serverName,
+	}
+
+	if c.cert != nil {
+		cfg.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			return c.cert.get(), nil
+		}
+	}
+	return cfg
+}
@@ -0,0 +1,206 @@
+package certs
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testCA выпускает сертификаты для тестов, внешний CA не нужен
+type testCA struct {
+	cert   *x509.Certificate
+	key    *ecdsa.PrivateKey
+	dir    string
+	serial int64
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	ca := &testCA{cert: cert, key: key, dir: t.TempDir(), serial: 1}
+	writePEM(t, ca.file("ca.crt"), "CERTIFICATE", der)
+	return ca
+}
+
+func (ca *testCA) file(name string) string {
+	return filepath.Join(ca.dir, name)
+}
+
+func writePEM(t *testing.T, path, kind string, der []byte) {
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: kind, Bytes: der}), 0o600))
+}
+
+// issue выпускает сертификат name и пишет его в name.crt и name.key
+func (ca *testCA) issue(t *testing.T, name string, subject pkix.Name, usage x509.ExtKeyUsage) *big.Int {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	ca.serial++
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(ca.serial),
+		Subject:      subject,
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	writePEM(t, ca.file(name+".crt"), "CERTIFICATE", der)
+	writePEM(t, ca.file(name+".key"), "EC PRIVATE KEY", keyDER)
+	return tmpl.SerialNumber
+}
+
+// handshake соединяет клиента и сервер и возвращает состояния соединений
+func handshake(t *testing.T, server, client *tls.Config) (tls.ConnectionState, tls.ConnectionState, error) {
+	serverConn, clientConn := net.Pipe()
+	defer serverConn.Close()
+	defer clientConn.Close()
+
+	srv := tls.Server(serverConn, server)
+	errc := make(chan error, 1)
+	go func() {
+		err := srv.Handshake()
+		// клиент TLS 1.3 узнаёт об отказе только при чтении
+		serverConn.Close()
+		errc <- err
+	}()
+
+	// ALPN клиента добавляет gRPC
+	client.NextProtos = []string{"h2"}
+	cli := tls.Client(clientConn, client)
+	err := cli.Handshake()
+	if err == nil {
+		if _, err = cli.Read(make([]byte, 1)); errors.Is(err, io.EOF) {
+			err = nil
+		}
+	}
+	if srvErr := <-errc; srvErr != nil {
+		err = srvErr
+	}
+	return srv.ConnectionState(), cli.ConnectionState(), err
+}
+
+func newServer(t *testing.T, ca *testCA, clientAuth bool) *Server {
+	srv, err := NewServer(ServerConfig{
+		Enabled:        true,
+		CertFile:       ca.file("server.crt"),
+		KeyFile:        ca.file("server.key"),
+		ClientCAFile:   ca.file("ca.crt"),
+		ClientAuth:     clientAuth,
+		ReloadInterval: time.Nanosecond,
+	})
+	require.NoError(t, err)
+	return srv
+}
+
+func newClient(t *testing.T, ca *testCA, name string) *Client {
+	cfg := ClientConfig{Enabled: true, CAFile: ca.file("ca.crt"), ServerName: "localhost", ReloadInterval: time.Nanosecond}
+	if name != "" {
+		cfg.CertFile, cfg.KeyFile = ca.file(name+".crt"), ca.file(name+".key")
+	}
+
+	client, err := NewClient(cfg)
+	require.NoError(t, err)
+	return client
+}
+
+func TestMutualTLS(t *testing.T) {
+	ca := newTestCA(t)
+	ca.issue(t, "server", pkix.Name{CommonName: "localhost"}, x509.ExtKeyUsageServerAuth)
+	ca.issue(t, "courier", pkix.Name{CommonName: "courier-1", OrganizationalUnit: []string{"courier"}}, x509.ExtKeyUsageClientAuth)
+
+	srv := newServer(t, ca, true)
+
+	state, _, err := handshake(t, srv.Config(), newClient(t, ca, "courier").Config())
+	require.NoError(t, err)
+	require.NotEmpty(t, state.VerifiedChains)
+	require.Equal(t, "courier-1", state.VerifiedChains[0][0].Subject.CommonName)
+	require.Equal(t, "h2", state.NegotiatedProtocol, "gRPC requires ALPN")
+
+	_, _, err = handshake(t, srv.Config(), newClient(t, ca, "").Config())
+	require.Error(t, err, "client certificate is required")
+
+	// без client_auth сертификат клиента необязателен
+	_, _, err = handshake(t, newServer(t, ca, false).Config(), newClient(t, ca, "").Config())
+	require.NoError(t, err)
+
+	// сертификат, выпущенный другим CA, не принимается
+	other := newTestCA(t)
+	other.issue(t, "courier", pkix.Name{CommonName: "courier-1"}, x509.ExtKeyUsageClientAuth)
+	client, err := NewClient(ClientConfig{
+		CAFile:     ca.file("ca.crt"),
+		CertFile:   other.file("courier.crt"),
+		KeyFile:    other.file("courier.key"),
+		ServerName: "localhost",
+	})
+	require.NoError(t, err)
+	_, _, err = handshake(t, srv.Config(), client.Config())
+	require.Error(t, err)
+}
+
+func TestServer_ReloadsCertificate(t *testing.T) {
+	ca := newTestCA(t)
+	first := ca.issue(t, "server", pkix.Name{CommonName: "localhost"}, x509.ExtKeyUsageServerAuth)
+	srv := newServer(t, ca, false)
+	client := newClient(t, ca, "")
+
+	_, state, err := handshake(t, srv.Config(), client.Config())
+	require.NoError(t, err)
+	require.Equal(t, first, state.PeerCertificates[0].SerialNumber)
+
+	second := ca.issue(t, "server", pkix.Name{CommonName: "localhost"}, x509.ExtKeyUsageServerAuth)
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(ca.file("server.crt"), later, later))
+
+	_, state, err = handshake(t, srv.Config(), client.Config())
+	require.NoError(t, err)
+	require.Equal(t, second, state.PeerCertificates[0].SerialNumber)
+
+	// повреждённые файлы не заменяют рабочий сертификат
+	require.NoError(t, os.WriteFile(ca.file("server.crt"), []byte("garbage"), 0o600))
+	_, state, err = handshake(t, srv.Config(), client.Config())
+	require.NoError(t, err)
+	require.Equal(t, second, state.PeerCertificates[0].SerialNumber)
+}
+
+func TestNewServer_ClientAuthRequiresCA(t *testing.T) {
+	ca := newTestCA(t)
+	ca.issue(t, "server", pkix.Name{CommonName: "localhost"}, x509.ExtKeyUsageServerAuth)
+
+	_, err := NewServer(ServerConfig{CertFile: ca.file("server.crt"), KeyFile: ca.file("server.key"), ClientAuth: true})
+	require.Error(t, err)
+}
@@ -0,0 +1,37 @@
+package certs
+
+import "time"
+
+// DefaultReloadInterval - как часто проверяются файлы сертификатов, если интервал не задан
+const DefaultReloadInterval = 10 * time.Second
+
+type (
+	// ServerConfig - TLS сервера. С ClientAuth клиент обязан предъявить
+	// сертификат, подписанный одним из ClientCAFile
+	ServerConfig struct {
+		Enabled        bool          `mapstructure:"enabled"`
+		CertFile       string        `mapstructure:"cert_file"`
+		KeyFile        string        `mapstructure:"key_file"`
+		ClientCAFile   string        `mapstructure:"client_ca_file"`
+		ClientAuth     bool          `mapstructure:"client_auth"`
+		ReloadInterval time.Duration `mapstructure:"reload_interval"`
+	}
+
+	// ClientConfig - TLS клиента. CertFile и KeyFile нужны, если сервер требует mTLS,
+	// без CAFile сертификат сервера проверяется по системным корневым
+	ClientConfig struct {
+		Enabled        bool          `mapstructure:"enabled"`
+		CAFile         string        `mapstructure:"ca_file"`
+		CertFile       string        `mapstructure:"cert_file"`
+		KeyFile        string        `mapstructure:"key_file"`
+		ServerName     string        `mapstructure:"server_name"`
+		ReloadInterval time.Duration `mapstructure:"reload_interval"`
+	}
+)
+
+func reloadInterval(interval time.Duration) time.Duration {
+	if interval <= 0 {
+		return DefaultReloadInterval
+	}
+	return interval
+}
@@ -0,0 +1,19 @@
+package certs
+
+import (
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// TransportCredentials - учётные данные клиента gRPC: TLS, если он включён, иначе без шифрования
+func TransportCredentials(cfg ClientConfig) (credentials.TransportCredentials, error) {
+	if !cfg.Enabled {
+		return insecure.NewCredentials(), nil
+	}
+
+	client, err := NewClient(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(client.Config()), nil
+}
@@ -0,0 +1,108 @@
+package certs
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// reloadable перечитывает значение из файлов, когда они меняются. Файлы
+// проверяются не чаще interval при обращении к значению, поэтому фоновая
+// горутина не нужна. Если новые файлы не читаются, остаётся прежнее значение
+type reloadable[T any] struct {
+	files    []string
+	load     func() (T, error)
+	interval time.Duration
+
+	mu        sync.Mutex
+	value     T
+	stamp     string
+	checkedAt time.Time
+}
+
+func newReloadable[T any](interval time.Duration, load func() (T, error), files ...string) (*reloadable[T], error) {
+	r := &reloadable[T]{files: files, load: load, interval: reloadInterval(interval)}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// filesStamp - время изменения и размер файлов: меняются при любой замене файла
+func filesStamp(files []string) (string, error) {
+	var b strings.Builder
+	for _, file := range files {
+		info, err := os.Stat(file)
+		if err != nil {
+			return "", err
+		}
+		fmt.Fprintf(&b, "%d:%d;", info.ModTime().UnixNano(), info.Size())
+	}
+	return b.String(), nil
+}
+
+func (r *reloadable[T]) reload() error {
+	stamp, err := filesStamp(r.files)
+	if err != nil {
+		return err
+	}
+
+	if stamp == r.stamp {
+		return nil
+	}
+
+	value, err := r.load()
+	if err != nil {
+		return err
+	}
+
+	r.value, r.stamp = value, stamp
+	return nil
+}
+
+func (r *reloadable[T]) get() T {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if time.Since(r.checkedAt) >= r.interval {
+		r.checkedAt = time.Now()
+		if err := r.reload(); err != nil {
+			log.Printf("certs: keep previous %s: %v\n", strings.Join(r.files, ", "), err)
+		}
+	}
+	return r.value
+}
+
+func newKeyPair(certFile, keyFile string, interval time.Duration) (*reloadable[*tls.Certificate], error) {
+	return newReloadable(interval, func() (*tls.Certificate, error) {
+		cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("load key pair: %w", err)
+		}
+		return &cert, nil
+	}, certFile, keyFile)
+}
+
+func loadPool(file string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("load CA: %w", err)
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("load CA: no certificates in %s", file)
+	}
+	return pool, nil
+}
+
+func newPool(file string, interval time.Duration) (*reloadable[*x509.CertPool], error) {
+	return newReloadable(interval, func() (*x509.CertPool, error) {
+		return loadPool(file)
+	}, file)
+}
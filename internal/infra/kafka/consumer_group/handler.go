@@ -1,20 +1,32 @@
 package consumer_group
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log/slog"
 
 	"github.com/IBM/sarama"
 	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/notifier"
 )
 
-type ConsumerGroupHandler struct {
-	logger *slog.Logger
-}
+type (
+	Notifier interface {
+		Notify(ctx context.Context, n *notifier.Notification) error
+	}
+
+	ConsumerGroupHandler struct {
+		logger   *slog.Logger
+		notifier Notifier
+	}
+)
 
-func NewConsumerGroupHandler(logger *slog.Logger) *ConsumerGroupHandler {
-	return &ConsumerGroupHandler{logger: logger}
+func NewConsumerGroupHandler(logger *slog.Logger, nt Notifier) *ConsumerGroupHandler {
+	return &ConsumerGroupHandler{
+		logger:   logger,
+		notifier: nt,
+	}
 }
 
 func (h *ConsumerGroupHandler) Setup(_ sarama.ConsumerGroupSession) error {
@@ -45,10 +57,27 @@ func (h *ConsumerGroupHandler) handleMessage(session sarama.ConsumerGroupSession
 		)
 	}
 
+	if event.EventType == domain.EventOrderExpiringSoon {
+		h.notify(session.Context(), &event)
+	}
+
 	session.MarkMessage(message, "")
 	session.Commit()
 }
 
+func (h *ConsumerGroupHandler) notify(ctx context.Context, event *domain.Event) {
+	for _, n := range notifier.NewExpiringSoonNotifications(event) {
+		if err := h.notifier.Notify(ctx, n); err != nil {
+			h.logger.Error(
+				"notify",
+				"event", event.EventType,
+				"order_id", n.OrderID,
+				"error", err.Error(),
+			)
+		}
+	}
+}
+
 //gocognit:ignore
 func (h *ConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
 	for {
@@ -18,3 +18,26 @@ func NewSyncProducer(conf kafka.Config, opts ...Option) (sarama.SyncProducer, er
 
 	return syncProducer, nil
 }
+
+// NewClient создаёт клиента, через которого можно и отправлять сообщения,
+// и проверять доступность брокеров
+func NewClient(conf kafka.Config, opts ...Option) (sarama.Client, error) {
+	config := PrepareConfig(opts...)
+
+	client, err := sarama.NewClient(conf.Brokers, config)
+	if err != nil {
+		return nil, fmt.Errorf("NewClient failed: %w", err)
+	}
+
+	return client, nil
+}
+
+// NewSyncProducerFromClient не закрывает client при закрытии продюсера
+func NewSyncProducerFromClient(client sarama.Client) (sarama.SyncProducer, error) {
+	syncProducer, err := sarama.NewSyncProducerFromClient(client)
+	if err != nil {
+		return nil, fmt.Errorf("NewSyncProducerFromClient failed: %w", err)
+	}
+
+	return syncProducer, nil
+}
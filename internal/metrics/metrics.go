@@ -1,6 +1,7 @@
 package metrics
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
@@ -8,8 +9,21 @@ import (
 )
 
 const (
-	labelHandler = "handler"
-	labelError   = "error"
+	labelHandler   = "handler"
+	labelError     = "error"
+	labelStage     = "stage"
+	labelIsolation = "isolation"
+	labelReason    = "reason"
+	labelMethod    = "method"
+	labelResult    = "result"
+	labelSource    = "source"
+	labelLimiter   = "limiter"
+	labelCode      = "code"
+)
+
+const (
+	CacheHit  = "hit"
+	CacheMiss = "miss"
 )
 
 var (
@@ -56,6 +70,92 @@ var (
 		labelHandler,
 		labelError,
 	})
+
+	expiredOrdersBacklog = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "manager_service_expired_orders_backlog",
+		Help: "number of expired orders awaiting courier",
+	})
+
+	totalSentReminders = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "manager_service_total_sent_reminders",
+		Help: "total number of sent expiration reminders",
+	}, []string{
+		labelStage,
+	})
+
+	totalArchivedOrders = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "manager_service_total_archived_orders",
+		Help: "total number of completed orders moved to archive",
+	})
+
+	totalTxRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "manager_service_tx_retries_total",
+		Help: "total number of retried transactions",
+	}, []string{
+		labelIsolation,
+		labelReason,
+	})
+
+	totalTxRetriesExhausted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "manager_service_tx_retries_exhausted_total",
+		Help: "total number of transactions failed after all retry attempts",
+	}, []string{
+		labelIsolation,
+		labelReason,
+	})
+
+	totalReplicaFallbacks = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "manager_service_db_replica_fallbacks_total",
+		Help: "total number of read-only transactions sent to primary instead of replica",
+	}, []string{
+		labelReason,
+	})
+
+	totalCacheRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "manager_service_storage_cache_requests_total",
+		Help: "total number of storage cache lookups by result",
+	}, []string{
+		labelMethod,
+		labelResult,
+	})
+
+	totalCacheInvalidations = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "manager_service_storage_cache_invalidations_total",
+		Help: "total number of orders invalidated in storage cache",
+	}, []string{
+		labelSource,
+	})
+
+	totalRateLimited = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "manager_service_rate_limited_total",
+		Help: "total number of requests rejected by rate limiter",
+	}, []string{
+		labelLimiter,
+		labelMethod,
+		labelReason,
+	})
+
+	totalUnauditedRejections = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "manager_service_audit_unaudited_rejections_total",
+		Help: "total number of calls rejected before authentication or by rate limiter, counted instead of audit log",
+	}, []string{
+		labelMethod,
+		labelCode,
+	})
+
+	inFlightRequests = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "manager_service_in_flight_requests",
+		Help: "number of requests being processed",
+	}, []string{
+		labelLimiter,
+	})
+
+	rateLimitBuckets = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "manager_service_rate_limit_buckets",
+		Help: "number of callers tracked by rate limiter",
+	}, []string{
+		labelLimiter,
+	})
 )
 
 func AddTotalAcceptedOrders(count int, handler string) {
@@ -98,3 +198,77 @@ func IncTotalErrors(handler string, err error) {
 		labelError:   err.Error(),
 	}).Inc()
 }
+
+func SetExpiredOrdersBacklog(count uint64) {
+	expiredOrdersBacklog.Set(float64(count))
+}
+
+func AddTotalSentReminders(count int, stage uint64) {
+	totalSentReminders.With(prometheus.Labels{
+		labelStage: strconv.FormatUint(stage, 10),
+	}).Add(float64(count))
+}
+
+func AddTotalArchivedOrders(count uint64) {
+	totalArchivedOrders.Add(float64(count))
+}
+
+func IncTxRetries(isolation, reason string) {
+	totalTxRetries.With(prometheus.Labels{
+		labelIsolation: isolation,
+		labelReason:    reason,
+	}).Inc()
+}
+
+func IncTxRetriesExhausted(isolation, reason string) {
+	totalTxRetriesExhausted.With(prometheus.Labels{
+		labelIsolation: isolation,
+		labelReason:    reason,
+	}).Inc()
+}
+
+func IncReplicaFallbacks(reason string) {
+	totalReplicaFallbacks.With(prometheus.Labels{
+		labelReason: reason,
+	}).Inc()
+}
+
+func IncCacheRequests(method, result string) {
+	totalCacheRequests.With(prometheus.Labels{
+		labelMethod: method,
+		labelResult: result,
+	}).Inc()
+}
+
+func AddCacheInvalidations(count int, source string) {
+	totalCacheInvalidations.With(prometheus.Labels{
+		labelSource: source,
+	}).Add(float64(count))
+}
+
+func IncRateLimited(limiter, method, reason string) {
+	totalRateLimited.With(prometheus.Labels{
+		labelLimiter: limiter,
+		labelMethod:  method,
+		labelReason:  reason,
+	}).Inc()
+}
+
+func IncUnauditedRejections(method, code string) {
+	totalUnauditedRejections.With(prometheus.Labels{
+		labelMethod: method,
+		labelCode:   code,
+	}).Inc()
+}
+
+func SetInFlightRequests(limiter string, count int64) {
+	inFlightRequests.With(prometheus.Labels{
+		labelLimiter: limiter,
+	}).Set(float64(count))
+}
+
+func SetRateLimitBuckets(limiter string, count int) {
+	rateLimitBuckets.With(prometheus.Labels{
+		labelLimiter: limiter,
+	}).Set(float64(count))
+}
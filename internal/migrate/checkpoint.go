@@ -0,0 +1,73 @@
+package migrate
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+)
+
+type (
+	checkpoint struct {
+		path string
+	}
+
+	checkpointData struct {
+		LastOrderID uint64 `json:"lastOrderID"`
+	}
+)
+
+// load возвращает курсор после последнего перенесённого заказа, nil - начать с начала
+func (c checkpoint) load() (*domain.SearchCursor, error) {
+	if c.path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(c.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, fmt.Errorf("checkpoint load: %w", err)
+	}
+
+	var cp checkpointData
+	if err = json.Unmarshal(data, &cp); err != nil {
+		return nil, fmt.Errorf("checkpoint load: %w", err)
+	}
+	return &domain.SearchCursor{Value: cp.LastOrderID, OrderID: cp.LastOrderID}, nil
+}
+
+// save заменяет файл атомарно, чтобы прерванная запись не испортила контрольную точку
+func (c checkpoint) save(lastOrderID uint64) error {
+	if c.path == "" {
+		return nil
+	}
+
+	data, err := json.Marshal(checkpointData{LastOrderID: lastOrderID})
+	if err != nil {
+		return fmt.Errorf("checkpoint save: %w", err)
+	}
+
+	tmp := c.path + ".tmp"
+	if err = os.WriteFile(tmp, data, 0666); err != nil {
+		return fmt.Errorf("checkpoint save: %w", err)
+	}
+
+	if err = os.Rename(tmp, c.path); err != nil {
+		return fmt.Errorf("checkpoint save: %w", err)
+	}
+	return nil
+}
+
+func (c checkpoint) remove() error {
+	if c.path == "" {
+		return nil
+	}
+
+	if err := os.Remove(c.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("checkpoint remove: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+)
+
+const DefaultBatchSize = 500
+
+const (
+	actionRestore = iota
+	actionSkip
+	actionConflict
+)
+
+type (
+	Source interface {
+		SearchOrders(ctx context.Context, filter *domain.OrderFilter) ([]domain.OrderRecord, error)
+	}
+
+	Target interface {
+		GetOrderStatus(ctx context.Context, orderID uint64) (*domain.OrderStatus, error)
+		storage.Restorer
+	}
+
+	Options struct {
+		BatchSize uint64
+		// DryRun только считает заказы и конфликты, ничего не записывая
+		DryRun bool
+		// Checkpoint - файл с номером последнего перенесённого заказа,
+		// по нему прерванный перенос продолжается. Пустой путь отключает продолжение
+		Checkpoint string
+	}
+
+	// Report - итог переноса. Migrated - перенесённые заказы (в dry-run - которые будут перенесены),
+	// Skipped - уже перенесённые ранее, Conflicts - номера заказов, которые уже есть в приёмнике с другими данными
+	Report struct {
+		Orders    uint64
+		Users     uint64
+		Held      uint64
+		Refunds   uint64
+		Migrated  uint64
+		Skipped   uint64
+		Conflicts []uint64
+		// ResumedAfter - номер заказа из контрольной точки, nil - перенос с начала
+		ResumedAfter *uint64
+	}
+
+	// Migrator переносит историю заказов, хранение и возвраты пачками в порядке номеров заказов
+	Migrator struct {
+		src        Source
+		dst        Target
+		opts       Options
+		checkpoint checkpoint
+	}
+)
+
+func NewMigrator(src Source, dst Target, opts Options) *Migrator {
+	if opts.BatchSize == 0 {
+		opts.BatchSize = DefaultBatchSize
+	}
+
+	return &Migrator{
+		src:        src,
+		dst:        dst,
+		opts:       opts,
+		checkpoint: checkpoint{path: opts.Checkpoint},
+	}
+}
+
+func sameOrder(stat *domain.OrderStatus, r *domain.OrderRecord) bool {
+	return stat.UserID == r.UserID &&
+		stat.Status == r.Status &&
+		stat.Version == r.Version &&
+		*stat.Order == *r.Order
+}
+
+func (m *Migrator) classify(ctx context.Context, r *domain.OrderRecord) (int, error) {
+	stat, err := m.dst.GetOrderStatus(ctx, r.OrderID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return actionRestore, nil
+	} else if err != nil {
+		return 0, fmt.Errorf("check order %d: %w", r.OrderID, err)
+	}
+
+	if sameOrder(stat, r) {
+		return actionSkip, nil
+	}
+	return actionConflict, nil
+}
+
+func newReport(resumed *domain.SearchCursor) *Report {
+	rep := &Report{}
+	if resumed != nil {
+		rep.ResumedAfter = &resumed.OrderID
+	}
+	return rep
+}
+
+func (rep *Report) count(r *domain.OrderRecord, users map[uint64]struct{}) {
+	rep.Orders++
+	users[r.UserID] = struct{}{}
+	if domain.IsHeld(r.Status) {
+		rep.Held++
+	}
+	if r.Status == domain.StatusReturned {
+		rep.Refunds++
+	}
+}
+
+func (rep *Report) add(action int, orderID uint64) {
+	switch action {
+	case actionRestore:
+		rep.Migrated++
+	case actionSkip:
+		rep.Skipped++
+	case actionConflict:
+		rep.Conflicts = append(rep.Conflicts, orderID)
+	}
+}
+
+// plan отбирает из пачки заказы, которых ещё нет в приёмнике
+func (m *Migrator) plan(ctx context.Context, batch []domain.OrderRecord, rep *Report, users map[uint64]struct{}) ([]domain.OrderRecord, error) {
+	restore := make([]domain.OrderRecord, 0, len(batch))
+	for _, r := range batch {
+		r.Normalize()
+		rep.count(&r, users)
+
+		action, err := m.classify(ctx, &r)
+		if err != nil {
+			return nil, err
+		}
+
+		rep.add(action, r.OrderID)
+		if action == actionRestore {
+			restore = append(restore, r)
+		}
+	}
+	return restore, nil
+}
+
+func (m *Migrator) filter(after *domain.SearchCursor) *domain.OrderFilter {
+	return &domain.OrderFilter{
+		SortBy: domain.SortByOrderID,
+		After:  after,
+		Limit:  m.opts.BatchSize,
+	}
+}
+
+// apply записывает пачку и сдвигает контрольную точку на её последний заказ
+func (m *Migrator) apply(ctx context.Context, orders []domain.OrderRecord, lastOrderID uint64) error {
+	if m.opts.DryRun {
+		return nil
+	}
+
+	if len(orders) > 0 {
+		if err := m.dst.RestoreOrders(ctx, orders); err != nil {
+			return fmt.Errorf("restore orders: %w", err)
+		}
+	}
+	return m.checkpoint.save(lastOrderID)
+}
+
+func (m *Migrator) Run(ctx context.Context) (*Report, error) {
+	after, err := m.checkpoint.load()
+	if err != nil {
+		return nil, err
+	}
+
+	rep := newReport(after)
+	if err = m.migrate(ctx, after, rep); err != nil {
+		return rep, err
+	}
+	return rep, m.finish()
+}
+
+func (m *Migrator) migrate(ctx context.Context, after *domain.SearchCursor, rep *Report) error {
+	users := make(map[uint64]struct{})
+	defer func() {
+		rep.Users = uint64(len(users))
+	}()
+
+	for {
+		next, err := m.step(ctx, after, rep, users)
+		if err != nil || next == nil {
+			return err
+		}
+		after = next
+	}
+}
+
+// step переносит одну пачку после курсора и возвращает курсор следующей, nil - заказы закончились
+func (m *Migrator) step(ctx context.Context, after *domain.SearchCursor, rep *Report, users map[uint64]struct{}) (*domain.SearchCursor, error) {
+	batch, err := m.src.SearchOrders(ctx, m.filter(after))
+	if err != nil {
+		return nil, fmt.Errorf("read orders: %w", err)
+	}
+
+	if len(batch) == 0 {
+		return nil, nil
+	}
+
+	restore, err := m.plan(ctx, batch, rep, users)
+	if err != nil {
+		return nil, err
+	}
+
+	last := batch[len(batch)-1].OrderID
+	return &domain.SearchCursor{Value: last, OrderID: last}, m.apply(ctx, restore, last)
+}
+
+// finish удаляет контрольную точку: повторный запуск пройдёт всё заново и пропустит перенесённое
+func (m *Migrator) finish() error {
+	if m.opts.DryRun {
+		return nil
+	}
+	return m.checkpoint.remove()
+}
@@ -0,0 +1,112 @@
+package migrate
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain/strategy"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage/memory"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+// newSource: заказы 1..5 клиентов 1 и 2, заказ 2 выдан, заказ 3 возвращён
+func newSource(t *testing.T) *memory.Storage {
+	ctx := context.Background()
+	st := memory.NewStorage()
+
+	for orderID := uint64(1); orderID <= 5; orderID++ {
+		order, err := domain.NewOrder(100*orderID, 10, utils.CurrentDateString(), strategy.ContainerTypeMap["box"])
+		require.NoError(t, err)
+		require.NoError(t, st.AddOrder(ctx, orderID%2+1, orderID, order))
+	}
+
+	require.NoError(t, st.RemoveOrder(ctx, 2, domain.StatusGiveClient))
+	require.NoError(t, st.RemoveOrder(ctx, 3, domain.StatusGiveClient))
+	order, err := st.GetOrder(ctx, 2, 3)
+	require.NoError(t, err)
+	require.NoError(t, st.AddRefund(ctx, 2, 3, order))
+	return st
+}
+
+func requireSameOrders(t *testing.T, src, dst *memory.Storage, ordersID ...uint64) {
+	ctx := context.Background()
+	for _, orderID := range ordersID {
+		want, err := src.GetOrderStatus(ctx, orderID)
+		require.NoError(t, err)
+		got, err := dst.GetOrderStatus(ctx, orderID)
+		require.NoError(t, err)
+		require.Equal(t, want, got)
+	}
+}
+
+func TestMigrator_Run(t *testing.T) {
+	ctx := context.Background()
+	src, dst := newSource(t), memory.NewStorage()
+	checkpoint := filepath.Join(t.TempDir(), "checkpoint")
+
+	rep, err := NewMigrator(src, dst, Options{BatchSize: 2, Checkpoint: checkpoint}).Run(ctx)
+	require.NoError(t, err)
+	require.Equal(t, &Report{Orders: 5, Users: 2, Held: 3, Refunds: 1, Migrated: 5}, rep)
+	requireSameOrders(t, src, dst, 1, 2, 3, 4, 5)
+
+	// выданный заказ больше не хранится, возвращённый попал в возвраты
+	require.NoError(t, dst.CanRemoveOrder(ctx, 1))
+	require.ErrorIs(t, dst.CanRemoveOrder(ctx, 2), domain.ErrAlreadyExist)
+	refunds, err := dst.GetRefunds(ctx, 1, 10)
+	require.NoError(t, err)
+	require.Len(t, refunds, 1)
+	require.Equal(t, uint64(3), refunds[0].OrderID)
+
+	_, err = os.Stat(checkpoint)
+	require.ErrorIs(t, err, os.ErrNotExist)
+
+	// повторный запуск ничего не переносит
+	rep, err = NewMigrator(src, dst, Options{BatchSize: 2, Checkpoint: checkpoint}).Run(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), rep.Migrated)
+	require.Equal(t, uint64(5), rep.Skipped)
+}
+
+func TestMigrator_DryRun(t *testing.T) {
+	ctx := context.Background()
+	src, dst := newSource(t), memory.NewStorage()
+
+	// в приёмнике уже есть заказ 4 с другими данными
+	order, err := domain.NewOrder(1, 1, utils.CurrentDateString(), strategy.ContainerTypeMap[""])
+	require.NoError(t, err)
+	require.NoError(t, dst.AddOrder(ctx, 1, 4, order))
+
+	rep, err := NewMigrator(src, dst, Options{DryRun: true}).Run(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), rep.Migrated)
+	require.Equal(t, []uint64{4}, rep.Conflicts)
+
+	count, err := dst.GetOrdersCountByStatus(ctx, domain.StatusAccepted)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+	_, err = dst.GetOrderStatus(ctx, 1)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+}
+
+func TestMigrator_Resume(t *testing.T) {
+	ctx := context.Background()
+	src, dst := newSource(t), memory.NewStorage()
+	path := filepath.Join(t.TempDir(), "checkpoint")
+
+	// первые три заказа перенесены до остановки
+	records, err := src.SearchOrders(ctx, &domain.OrderFilter{SortBy: domain.SortByOrderID, Limit: 3})
+	require.NoError(t, err)
+	require.NoError(t, dst.RestoreOrders(ctx, records))
+	require.NoError(t, checkpoint{path: path}.save(3))
+
+	rep, err := NewMigrator(src, dst, Options{BatchSize: 2, Checkpoint: path}).Run(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), *rep.ResumedAfter)
+	require.Equal(t, uint64(2), rep.Orders)
+	require.Equal(t, uint64(2), rep.Migrated)
+	requireSameOrders(t, src, dst, 1, 2, 3, 4, 5)
+}
@@ -0,0 +1,64 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage/postgres"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage/storage_json"
+)
+
+const (
+	SchemeJSON     = "json"
+	SchemePostgres = "postgres"
+)
+
+type Storage interface {
+	storage.Storage
+	storage.Restorer
+}
+
+func openJSON(path string) (Storage, func(), error) {
+	st, err := storage_json.NewStorage(
+		storage_json.NewOrdersHistory(),
+		storage_json.NewRefunds(),
+		storage_json.NewUsers(),
+		path,
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open json storage: %w", err)
+	}
+
+	return st, func() { st.Close() }, nil
+}
+
+func openPostgres(ctx context.Context, dsn string) (Storage, func(), error) {
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		return nil, nil, fmt.Errorf("open postgres storage: %w", err)
+	}
+
+	txManager := postgres.NewTxManager(pool)
+	return postgres.NewStorageDB(txManager, postgres.NewRepoPG(txManager)), pool.Close, nil
+}
+
+// Open открывает хранилище по адресу вида json:path или postgres:dsn.
+// Возвращаемая функция закрывает хранилище
+func Open(ctx context.Context, spec string) (Storage, func(), error) {
+	scheme, addr, ok := strings.Cut(spec, ":")
+	if !ok || addr == "" {
+		return nil, nil, fmt.Errorf("storage %q must look like json:path or postgres:dsn", spec)
+	}
+
+	switch scheme {
+	case SchemeJSON:
+		return openJSON(addr)
+	case SchemePostgres:
+		return openPostgres(ctx, addr)
+	default:
+		return nil, nil, fmt.Errorf("unknown storage scheme %q", scheme)
+	}
+}
@@ -0,0 +1,64 @@
+package notifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+)
+
+type (
+	// Notification — уведомление клиента о событии по его заказу
+	Notification struct {
+		UserID         uint64 `json:"user_id"`
+		OrderID        uint64 `json:"order_id"`
+		ExpirationDate string `json:"expiration_date"`
+		DaysLeft       uint64 `json:"days_left"`
+		Text           string `json:"text"`
+	}
+
+	Sink interface {
+		Name() string
+		Notify(ctx context.Context, n *Notification) error
+	}
+
+	Notifier struct {
+		sinks []Sink
+	}
+)
+
+func NewNotifier(sinks ...Sink) *Notifier {
+	return &Notifier{sinks: sinks}
+}
+
+// NewExpiringSoonNotifications строит уведомления по событию order_expiring_soon,
+// по одному на каждый заказ из события.
+func NewExpiringSoonNotifications(ev *domain.Event) []*Notification {
+	notifications := make([]*Notification, 0, len(ev.OrderIDs))
+	for _, orderID := range ev.OrderIDs {
+		notifications = append(notifications, &Notification{
+			UserID:         ev.UserID,
+			OrderID:        orderID,
+			ExpirationDate: ev.ExpirationDate,
+			DaysLeft:       ev.ReminderStage,
+			Text: fmt.Sprintf(
+				"Срок хранения заказа %d истекает %s, заберите его в течение %d дн.",
+				orderID, ev.ExpirationDate, ev.ReminderStage,
+			),
+		})
+	}
+	return notifications
+}
+
+// Notify отправляет уведомление во все приёмники. Ошибка одного приёмника
+// не мешает доставке в остальные.
+func (n *Notifier) Notify(ctx context.Context, notification *Notification) error {
+	var errs []error
+	for _, sink := range n.sinks {
+		if err := sink.Notify(ctx, notification); err != nil {
+			errs = append(errs, fmt.Errorf("sink %s: %w", sink.Name(), err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,103 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+)
+
+const (
+	SinkLog     = "log"
+	SinkWebhook = "webhook"
+)
+
+type (
+	SinkConfig struct {
+		Type    string        `mapstructure:"type"`
+		URL     string        `mapstructure:"url"`
+		Timeout time.Duration `mapstructure:"timeout"`
+	}
+
+	LogSink struct {
+		logger *slog.Logger
+	}
+
+	WebhookSink struct {
+		url    string
+		client *http.Client
+	}
+)
+
+func NewSinks(cfg []SinkConfig, logger *slog.Logger) ([]Sink, error) {
+	sinks := make([]Sink, 0, len(cfg))
+	for _, c := range cfg {
+		switch c.Type {
+		case SinkLog:
+			sinks = append(sinks, NewLogSink(logger))
+		case SinkWebhook:
+			sinks = append(sinks, NewWebhookSink(c.URL, c.Timeout))
+		default:
+			return nil, fmt.Errorf("unknown sink type %q", c.Type)
+		}
+	}
+	return sinks, nil
+}
+
+func NewLogSink(logger *slog.Logger) *LogSink {
+	return &LogSink{logger: logger}
+}
+
+func (s *LogSink) Name() string {
+	return SinkLog
+}
+
+func (s *LogSink) Notify(_ context.Context, n *Notification) error {
+	s.logger.Info(
+		"notify customer",
+		"user_id", n.UserID,
+		"order_id", n.OrderID,
+		"expiration_date", n.ExpirationDate,
+		"days_left", n.DaysLeft,
+		"text", n.Text,
+	)
+	return nil
+}
+
+func NewWebhookSink(url string, timeout time.Duration) *WebhookSink {
+	return &WebhookSink{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+func (s *WebhookSink) Name() string {
+	return SinkWebhook
+}
+
+func (s *WebhookSink) Notify(ctx context.Context, n *Notification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"path"
+	"strconv"
+	"time"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// RetryAfterHeader - метаданные ответа с числом секунд до повторной попытки.
+// Шлюз HTTP передаёт их как Grpc-Metadata-Retry-After
+const RetryAfterHeader = "retry-after"
+
+// inFlightRetry - когда повторять запрос, отклонённый из-за числа одновременных запросов
+const inFlightRetry = time.Second
+
+// forwardedForMetadata - X-Forwarded-For, который шлюз HTTP передаёт в метаданных
+const forwardedForMetadata = "x-forwarded-for"
+
+// caller - проверенная личность вызывающего, без проверки учётных данных - его адрес
+func (l *Limiter) caller(ctx context.Context) string {
+	if id, ok := auth.FromContext(ctx); ok {
+		return id.String()
+	}
+	return l.peerHost(ctx)
+}
+
+// peerHost - адрес клиента. За доверенным прокси, например шлюзом HTTP,
+// адрес берётся из x-forwarded-for
+func (l *Limiter) peerHost(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "unknown"
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+
+	md, _ := metadata.FromIncomingContext(ctx)
+	return l.clientHost(host, md.Get(forwardedForMetadata))
+}
+
+func rejected(delay time.Duration) (metadata.MD, error) {
+	md := metadata.Pairs(RetryAfterHeader, strconv.Itoa(RetryAfter(delay)))
+	return md, status.Errorf(codes.ResourceExhausted, "too many requests, retry after %s", delay.Round(time.Millisecond))
+}
+
+// Unary после проверки учётных данных считает запросы по личности вызывающего,
+// а перед ней - по адресу клиента, чтобы поток запросов без учётных данных
+// отсекался до проверки и журнала действий
+func (l *Limiter) Unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	method := path.Base(info.FullMethod)
+
+	release, ok := l.Acquire(method)
+	if !ok {
+		md, err := rejected(inFlightRetry)
+		grpc.SetHeader(ctx, md)
+		return nil, err
+	}
+	defer release()
+
+	if delay, ok := l.Allow(l.caller(ctx), method); !ok {
+		md, err := rejected(delay)
+		grpc.SetHeader(ctx, md)
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// Stream ограничивает только частоту открытия потоков: подписки живут долго
+// и заняли бы все места среди одновременно обрабатываемых запросов
+func (l *Limiter) Stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if delay, ok := l.Allow(l.caller(ss.Context()), path.Base(info.FullMethod)); !ok {
+		md, err := rejected(delay)
+		ss.SetHeader(md)
+		return err
+	}
+
+	return handler(srv, ss)
+}
@@ -0,0 +1,48 @@
+package ratelimit
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+)
+
+const (
+	// httpMethod - все запросы HTTP одного клиента делят одно ведро
+	httpMethod = "http"
+
+	forwardedForHeader = "X-Forwarded-For"
+)
+
+func remoteHost(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// Middleware ограничивает запросы HTTP по адресу клиента, за доверенным прокси -
+// по X-Forwarded-For. Запросы к API после этого ограничиваются ещё и на стороне
+// gRPC по личности вызывающего
+func (l *Limiter) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		release, ok := l.Acquire(httpMethod)
+		if !ok {
+			tooManyRequests(w, RetryAfter(inFlightRetry))
+			return
+		}
+		defer release()
+
+		if delay, ok := l.Allow(l.clientHost(remoteHost(r), r.Header.Values(forwardedForHeader)), httpMethod); !ok {
+			tooManyRequests(w, RetryAfter(delay))
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func tooManyRequests(w http.ResponseWriter, retryAfter int) {
+	w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+	http.Error(w, http.StatusText(http.StatusTooManyRequests), http.StatusTooManyRequests)
+}
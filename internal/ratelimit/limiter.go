@@ -0,0 +1,181 @@
+package ratelimit
+
+import (
+	"fmt"
+	"math"
+	"net/netip"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/metrics"
+	"golang.org/x/time/rate"
+)
+
+const (
+	DefaultIdleTTL = 10 * time.Minute
+
+	reasonRate     = "rate"
+	reasonInFlight = "in_flight"
+)
+
+type (
+	// Limit - ведро токенов: Rate запросов в секунду с запасом Burst.
+	// Rate 0 - без ограничения
+	Limit struct {
+		Rate  float64 `mapstructure:"rate"`
+		Burst int     `mapstructure:"burst"`
+	}
+
+	Config struct {
+		Enabled bool `mapstructure:"enabled"`
+		// Limit - ограничение для каждой пары вызывающий-метод
+		Limit `mapstructure:",squash"`
+		// Methods переопределяет Limit для отдельных методов, имя метода без учёта регистра
+		Methods map[string]Limit `mapstructure:"methods"`
+		// MaxInFlight - сколько запросов обрабатывается одновременно, 0 - без ограничения
+		MaxInFlight int64 `mapstructure:"max_in_flight"`
+		// IdleTTL - через сколько забывается ведро вызывающего без запросов
+		IdleTTL time.Duration `mapstructure:"idle_ttl"`
+		// TrustedProxies - адреса и подсети прокси, которым верят в X-Forwarded-For:
+		// запросы через них ограничиваются по адресу клиента, а не прокси
+		TrustedProxies []string `mapstructure:"trusted_proxies"`
+	}
+
+	key struct {
+		caller string
+		method string
+	}
+
+	bucket struct {
+		limiter *rate.Limiter
+		usedAt  time.Time
+	}
+
+	// Limiter ограничивает частоту запросов каждого вызывающего к каждому методу
+	// и общее число одновременно обрабатываемых запросов
+	Limiter struct {
+		name     string
+		cfg      Config
+		mu       sync.Mutex
+		buckets  map[key]*bucket
+		sweptAt  time.Time
+		inFlight atomic.Int64
+		proxies  []netip.Prefix
+		now      func() time.Time
+	}
+)
+
+// NewLimiter создаёт ограничитель, name различает ограничители в метриках
+func NewLimiter(name string, cfg Config) (*Limiter, error) {
+	proxies, err := parseProxies(cfg.TrustedProxies)
+	if err != nil {
+		return nil, fmt.Errorf("rate limiter %s: %w", name, err)
+	}
+
+	if cfg.IdleTTL <= 0 {
+		cfg.IdleTTL = DefaultIdleTTL
+	}
+
+	methods := make(map[string]Limit, len(cfg.Methods))
+	for method, limit := range cfg.Methods {
+		methods[strings.ToLower(method)] = limit
+	}
+	cfg.Methods = methods
+
+	return &Limiter{
+		name:    name,
+		cfg:     cfg,
+		buckets: make(map[key]*bucket),
+		proxies: proxies,
+		now:     time.Now,
+	}, nil
+}
+
+func (l *Limiter) limit(method string) Limit {
+	if limit, ok := l.cfg.Methods[strings.ToLower(method)]; ok {
+		return limit
+	}
+	return l.cfg.Limit
+}
+
+// newBucket без Burst разрешает всплеск в секунду запросов, но не меньше одного
+func newBucket(limit Limit) *bucket {
+	burst := limit.Burst
+	if burst <= 0 {
+		burst = int(math.Max(1, math.Ceil(limit.Rate)))
+	}
+	return &bucket{limiter: rate.NewLimiter(rate.Limit(limit.Rate), burst)}
+}
+
+// sweep забывает ведра, которыми не пользовались дольше IdleTTL:
+// за это время они успевают наполниться, так что ничего не теряется
+func (l *Limiter) sweep(now time.Time) {
+	if now.Sub(l.sweptAt) < l.cfg.IdleTTL {
+		return
+	}
+
+	for k, b := range l.buckets {
+		if now.Sub(b.usedAt) >= l.cfg.IdleTTL {
+			delete(l.buckets, k)
+		}
+	}
+	l.sweptAt = now
+	metrics.SetRateLimitBuckets(l.name, len(l.buckets))
+}
+
+// Allow забирает токен из ведра caller для method. Если токенов нет,
+// возвращает, через сколько он появится
+func (l *Limiter) Allow(caller, method string) (time.Duration, bool) {
+	limit := l.limit(method)
+	if limit.Rate <= 0 {
+		return 0, true
+	}
+
+	now := l.now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.sweep(now)
+	k := key{caller: caller, method: method}
+	b, ok := l.buckets[k]
+	if !ok {
+		b = newBucket(limit)
+		l.buckets[k] = b
+		metrics.SetRateLimitBuckets(l.name, len(l.buckets))
+	}
+	b.usedAt = now
+
+	r := b.limiter.ReserveN(now, 1)
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		metrics.IncRateLimited(l.name, method, reasonRate)
+		return delay, false
+	}
+	return 0, true
+}
+
+// Acquire занимает место среди одновременно обрабатываемых запросов.
+// release нужно вызвать по окончании обработки
+func (l *Limiter) Acquire(method string) (release func(), ok bool) {
+	if l.cfg.MaxInFlight <= 0 {
+		return func() {}, true
+	}
+
+	if n := l.inFlight.Add(1); n > l.cfg.MaxInFlight {
+		l.inFlight.Add(-1)
+		metrics.IncRateLimited(l.name, method, reasonInFlight)
+		return nil, false
+	}
+	metrics.SetInFlightRequests(l.name, l.inFlight.Load())
+
+	return func() {
+		metrics.SetInFlightRequests(l.name, l.inFlight.Add(-1))
+	}, true
+}
+
+// RetryAfter - целое число секунд для заголовка Retry-After, не меньше одной
+func RetryAfter(delay time.Duration) int {
+	return int(math.Max(1, math.Ceil(delay.Seconds())))
+}
@@ -0,0 +1,207 @@
+package ratelimit
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/chppppr/homework/internal/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+func newTestLimiter(t *testing.T, cfg Config) (*Limiter, *clock) {
+	c := &clock{now: time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)}
+	l, err := NewLimiter("test", cfg)
+	require.NoError(t, err)
+	l.now = c.Now
+	return l, c
+}
+
+func TestLimiterAllow(t *testing.T) {
+	l, c := newTestLimiter(t, Config{
+		Limit:   Limit{Rate: 1, Burst: 2},
+		Methods: map[string]Limit{"searchorders": {Rate: 0.5, Burst: 1}, "viewOrders": {}},
+	})
+
+	for range 2 {
+		_, ok := l.Allow("staff:pvz-1", "AddOrder")
+		require.True(t, ok)
+	}
+	delay, ok := l.Allow("staff:pvz-1", "AddOrder")
+	require.False(t, ok)
+	require.Equal(t, time.Second, delay)
+
+	// у другого вызывающего и другого метода свои ведра
+	_, ok = l.Allow("staff:pvz-2", "AddOrder")
+	require.True(t, ok)
+
+	// отклонённый запрос не забирает токен
+	c.now = c.now.Add(time.Second)
+	_, ok = l.Allow("staff:pvz-1", "AddOrder")
+	require.True(t, ok)
+
+	// имя метода в настройках без учёта регистра
+	_, ok = l.Allow("staff:pvz-1", "SearchOrders")
+	require.True(t, ok)
+	delay, ok = l.Allow("staff:pvz-1", "SearchOrders")
+	require.False(t, ok)
+	require.Equal(t, 2*time.Second, delay)
+
+	// Rate 0 - без ограничения
+	for range 10 {
+		_, ok = l.Allow("staff:pvz-1", "ViewOrders")
+		require.True(t, ok)
+	}
+}
+
+func TestLimiterForgetsIdleCallers(t *testing.T) {
+	l, c := newTestLimiter(t, Config{Limit: Limit{Rate: 1}, IdleTTL: time.Minute})
+
+	_, ok := l.Allow("staff:pvz-1", "AddOrder")
+	require.True(t, ok)
+	c.now = c.now.Add(30 * time.Second)
+	_, ok = l.Allow("staff:pvz-2", "AddOrder")
+	require.True(t, ok)
+	require.Len(t, l.buckets, 2)
+
+	c.now = c.now.Add(40 * time.Second)
+	_, ok = l.Allow("staff:pvz-2", "AddOrder")
+	require.True(t, ok)
+	require.Len(t, l.buckets, 1)
+}
+
+func TestLimiterInFlight(t *testing.T) {
+	l, _ := newTestLimiter(t, Config{MaxInFlight: 1})
+
+	release, ok := l.Acquire("AddOrder")
+	require.True(t, ok)
+	_, ok = l.Acquire("AddOrder")
+	require.False(t, ok)
+
+	release()
+	_, ok = l.Acquire("AddOrder")
+	require.True(t, ok)
+}
+
+func TestUnaryLimitsByIdentity(t *testing.T) {
+	l, _ := newTestLimiter(t, Config{Limit: Limit{Rate: 1, Burst: 1}})
+	info := &grpc.UnaryServerInfo{FullMethod: "/manager.ManagerService/AddOrder"}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	}
+
+	ctx := auth.WithIdentity(context.Background(), &auth.Identity{Subject: "pvz-1", Role: auth.RoleStaff})
+	resp, err := l.Unary(ctx, nil, info, handler)
+	require.NoError(t, err)
+	require.Equal(t, "ok", resp)
+
+	_, err = l.Unary(ctx, nil, info, handler)
+	require.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	other := auth.WithIdentity(context.Background(), &auth.Identity{Subject: "pvz-2", Role: auth.RoleStaff})
+	_, err = l.Unary(other, nil, info, handler)
+	require.NoError(t, err)
+}
+
+func TestMiddleware(t *testing.T) {
+	l, _ := newTestLimiter(t, Config{Limit: Limit{Rate: 0.2, Burst: 1}})
+	h := l.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	call := func(addr string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/orders", nil)
+		r.RemoteAddr = addr
+		h.ServeHTTP(w, r)
+		return w
+	}
+
+	require.Equal(t, http.StatusNoContent, call("10.0.0.1:5000").Code)
+
+	w := call("10.0.0.1:5001")
+	require.Equal(t, http.StatusTooManyRequests, w.Code)
+	require.Equal(t, "5", w.Header().Get("Retry-After"))
+
+	require.Equal(t, http.StatusNoContent, call("10.0.0.2:5000").Code)
+}
+
+func TestMiddlewareTrustsForwardedForFromProxies(t *testing.T) {
+	l, _ := newTestLimiter(t, Config{Limit: Limit{Rate: 0.2, Burst: 1}, TrustedProxies: []string{"10.0.0.1", "172.16.0.0/12"}})
+	h := l.Middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	call := func(addr, forwarded string) int {
+		w := httptest.NewRecorder()
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/orders", nil)
+		r.RemoteAddr = addr
+		r.Header.Set("X-Forwarded-For", forwarded)
+		h.ServeHTTP(w, r)
+		return w.Code
+	}
+
+	// клиенты за прокси получают свои ведра
+	require.Equal(t, http.StatusNoContent, call("10.0.0.1:5000", "203.0.113.1"))
+	require.Equal(t, http.StatusNoContent, call("10.0.0.1:5000", "203.0.113.2"))
+	require.Equal(t, http.StatusTooManyRequests, call("10.0.0.1:5000", "203.0.113.1"))
+
+	// адрес левее недоверенного подставлен клиентом
+	require.Equal(t, http.StatusTooManyRequests, call("10.0.0.1:5000", "198.51.100.1, 203.0.113.2, 172.17.0.2"))
+
+	// недоверенному адресу X-Forwarded-For не помогает
+	require.Equal(t, http.StatusNoContent, call("10.0.0.9:5000", "203.0.113.3"))
+	require.Equal(t, http.StatusTooManyRequests, call("10.0.0.9:5000", "203.0.113.4"))
+}
+
+func TestUnaryLimitsByPeerBeforeAuth(t *testing.T) {
+	l, _ := newTestLimiter(t, Config{Limit: Limit{Rate: 1, Burst: 1}, TrustedProxies: []string{"127.0.0.1"}})
+	info := &grpc.UnaryServerInfo{FullMethod: "/manager.ManagerService/AddOrder"}
+	handler := func(ctx context.Context, req any) (any, error) {
+		return "ok", nil
+	}
+
+	call := func(addr string, md ...string) error {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: netAddr(addr)})
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(md...))
+		_, err := l.Unary(ctx, nil, info, handler)
+		return err
+	}
+
+	require.NoError(t, call("10.0.0.1:5000"))
+	require.Equal(t, codes.ResourceExhausted, status.Code(call("10.0.0.1:5001")))
+
+	// запросы шлюза HTTP считаются по адресу клиента
+	require.NoError(t, call("127.0.0.1:6000", "x-forwarded-for", "203.0.113.1"))
+	require.NoError(t, call("127.0.0.1:6000", "x-forwarded-for", "203.0.113.2"))
+	require.Equal(t, codes.ResourceExhausted, status.Code(call("127.0.0.1:6000", "x-forwarded-for", "203.0.113.1")))
+}
+
+func netAddr(s string) net.Addr {
+	addr, err := net.ResolveTCPAddr("tcp", s)
+	if err != nil {
+		panic(err)
+	}
+	return addr
+}
+
+func TestNewLimiterRejectsBadProxies(t *testing.T) {
+	_, err := NewLimiter("test", Config{TrustedProxies: []string{"nginx"}})
+	require.Error(t, err)
+}
@@ -0,0 +1,43 @@
+package scheduler
+
+import (
+	"context"
+	"sync"
+)
+
+// LocalLocker - Locker в пределах одного процесса, для хранилищ без общей БД
+type LocalLocker struct {
+	mtx     sync.Mutex
+	running map[int64]struct{}
+}
+
+func NewLocalLocker() *LocalLocker {
+	return &LocalLocker{running: make(map[int64]struct{})}
+}
+
+func (l *LocalLocker) tryLock(key int64) bool {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	if _, ok := l.running[key]; ok {
+		return false
+	}
+	l.running[key] = struct{}{}
+	return true
+}
+
+func (l *LocalLocker) unlock(key int64) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	delete(l.running, key)
+}
+
+func (l *LocalLocker) TryRun(ctx context.Context, key int64, fn func(ctx context.Context) error) (bool, error) {
+	if !l.tryLock(key) {
+		return false, nil
+	}
+	defer l.unlock(key)
+
+	return true, fn(ctx)
+}
@@ -0,0 +1,91 @@
+package scheduler
+
+import (
+	"context"
+	"hash/fnv"
+	"log"
+	"sync"
+	"time"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+)
+
+type (
+	Job interface {
+		Name() string
+		Run(ctx context.Context) error
+	}
+
+	// Locker гарантирует, что задача с одним и тем же ключом
+	// одновременно выполняется только на одной реплике сервиса.
+	Locker interface {
+		TryRun(ctx context.Context, key int64, fn func(ctx context.Context) error) (bool, error)
+	}
+
+	scheduledJob struct {
+		job      Job
+		interval time.Duration
+	}
+
+	Scheduler struct {
+		locker Locker
+		jobs   []scheduledJob
+	}
+)
+
+func NewScheduler(locker Locker) *Scheduler {
+	return &Scheduler{locker: locker}
+}
+
+func (s *Scheduler) Add(job Job, interval time.Duration) {
+	s.jobs = append(s.jobs, scheduledJob{
+		job:      job,
+		interval: interval,
+	})
+}
+
+func (s *Scheduler) Run(ctx context.Context, wg *sync.WaitGroup) {
+	for _, sj := range s.jobs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.loop(ctx, sj)
+		}()
+	}
+}
+
+func (s *Scheduler) loop(ctx context.Context, sj scheduledJob) {
+	ticker := time.NewTicker(sj.interval)
+	defer ticker.Stop()
+
+	for {
+		s.runJob(ctx, sj.job)
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// runJob читает с основного сервера: решения задач по отставшей реплике
+// привели бы к повторной обработке уже обработанных заказов
+func (s *Scheduler) runJob(ctx context.Context, job Job) {
+	ctx = storage.ReadYourWrites(ctx)
+	ran, err := s.locker.TryRun(ctx, jobKey(job.Name()), job.Run)
+	if err != nil {
+		log.Printf("[Scheduler] job %s failed: %v\n", job.Name(), err)
+		return
+	}
+
+	if !ran {
+		log.Printf("[Scheduler] job %s is running on another replica\n", job.Name())
+	}
+}
+
+func jobKey(name string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(name))
+	return int64(h.Sum64())
+}
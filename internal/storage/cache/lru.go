@@ -0,0 +1,136 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+type (
+	entry[V any] struct {
+		key       uint64
+		value     V
+		expiresAt time.Time
+	}
+
+	// LRU - потокобезопасный кэш фиксированного размера с вытеснением давно
+	// не использованных записей и временем жизни записи ttl
+	LRU[V any] struct {
+		mu    sync.Mutex
+		size  int
+		ttl   time.Duration
+		now   func() time.Time
+		order *list.List
+		items map[uint64]*list.Element
+		// gens - поколения ключей, разбитых на genStripes групп по остатку от деления,
+		// поколение группы увеличивается при удалении любого её ключа, см. Generation
+		gens [genStripes]uint64
+	}
+)
+
+const genStripes = 256
+
+func NewLRU[V any](size int, ttl time.Duration) *LRU[V] {
+	return &LRU[V]{
+		size:  size,
+		ttl:   ttl,
+		now:   time.Now,
+		order: list.New(),
+		items: make(map[uint64]*list.Element, size),
+	}
+}
+
+func (c *LRU[V]) Get(key uint64) (value V, ok bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	el, ok := c.items[key]
+	if !ok {
+		return value, false
+	}
+
+	e := el.Value.(*entry[V])
+	if c.now().After(e.expiresAt) {
+		c.remove(el)
+		return value, false
+	}
+
+	c.order.MoveToFront(el)
+	return e.value, true
+}
+
+// Generation возвращает поколение ключа. Значение, прочитанное из хранилища
+// после Generation, передаётся в SetIfGeneration: если за время чтения ключ
+// был инвалидирован, значение могло устареть и не сохраняется
+func (c *LRU[V]) Generation(key uint64) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.gens[key%genStripes]
+}
+
+func (c *LRU[V]) SetIfGeneration(key uint64, value V, gen uint64) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if gen != c.gens[key%genStripes] {
+		return false
+	}
+
+	c.set(key, value)
+	return true
+}
+
+func (c *LRU[V]) Set(key uint64, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.set(key, value)
+}
+
+func (c *LRU[V]) set(key uint64, value V) {
+	expiresAt := c.now().Add(c.ttl)
+	if el, ok := c.items[key]; ok {
+		el.Value = &entry[V]{key: key, value: value, expiresAt: expiresAt}
+		c.order.MoveToFront(el)
+		return
+	}
+
+	c.items[key] = c.order.PushFront(&entry[V]{key: key, value: value, expiresAt: expiresAt})
+	if c.order.Len() > c.size {
+		c.remove(c.order.Back())
+	}
+}
+
+func (c *LRU[V]) Delete(keys ...uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, key := range keys {
+		c.gens[key%genStripes]++
+		if el, ok := c.items[key]; ok {
+			c.remove(el)
+		}
+	}
+}
+
+// Purge очищает кэш, например после потери канала инвалидации
+func (c *LRU[V]) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i := range c.gens {
+		c.gens[i]++
+	}
+	c.order.Init()
+	clear(c.items)
+}
+
+func (c *LRU[V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.order.Len()
+}
+
+func (c *LRU[V]) remove(el *list.Element) {
+	c.order.Remove(el)
+	delete(c.items, el.Value.(*entry[V]).key)
+}
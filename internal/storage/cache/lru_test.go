@@ -0,0 +1,57 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLRU_EvictsLeastRecentlyUsed(t *testing.T) {
+	c := NewLRU[string](2, time.Minute)
+	c.Set(1, "a")
+	c.Set(2, "b")
+
+	_, ok := c.Get(1)
+	require.True(t, ok)
+
+	c.Set(3, "c")
+	_, ok = c.Get(2)
+	require.False(t, ok)
+
+	v, ok := c.Get(1)
+	require.True(t, ok)
+	require.Equal(t, "a", v)
+	require.Equal(t, 2, c.Len())
+}
+
+func TestLRU_TTL(t *testing.T) {
+	now := time.Now()
+	c := NewLRU[string](2, time.Minute)
+	c.now = func() time.Time { return now }
+
+	c.Set(1, "a")
+	now = now.Add(time.Minute + time.Second)
+
+	_, ok := c.Get(1)
+	require.False(t, ok)
+	require.Zero(t, c.Len())
+}
+
+func TestLRU_SetIfGeneration(t *testing.T) {
+	c := NewLRU[string](2, time.Minute)
+
+	gen := c.Generation(1)
+	c.Delete(1)
+	require.False(t, c.SetIfGeneration(1, "stale", gen))
+
+	// удаление другого ключа не мешает сохранить значение
+	gen = c.Generation(1)
+	c.Delete(2)
+	require.True(t, c.SetIfGeneration(1, "a", gen))
+
+	gen = c.Generation(1)
+	c.Purge()
+	require.False(t, c.SetIfGeneration(1, "b", gen))
+	require.Zero(t, c.Len())
+}
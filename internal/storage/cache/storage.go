@@ -0,0 +1,371 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/metrics"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+const (
+	sourceLocal  = "local"
+	sourceNotify = "notify"
+)
+
+type (
+	Config struct {
+		Enabled bool          `mapstructure:"enabled"`
+		Size    int           `mapstructure:"size"`
+		TTL     time.Duration `mapstructure:"ttl"`
+		// ReconnectDelay - пауза перед повторной подпиской на инвалидации
+		ReconnectDelay time.Duration `mapstructure:"reconnect_delay"`
+	}
+
+	// Invalidator рассылает номера изменённых заказов всем репликам сервиса.
+	// Notify внутри транзакции должен доставляться только после её коммита
+	Invalidator interface {
+		Notify(ctx context.Context, ordersID []uint64) error
+		// Listen вызывает fn на каждое сообщение, пока не отменён ctx или не потеряно соединение
+		Listen(ctx context.Context, fn func(ordersID []uint64)) error
+	}
+
+	// Storage кэширует заказы для чтения по номеру заказа. Внутри транзакции
+	// заказ всегда читается из хранилища: версия, даты жизненного цикла и клиент
+	// меняются вместе со статусом
+	Storage struct {
+		storage.Storage
+		orders *LRU[*domain.OrderStatus]
+		inv    Invalidator
+		cfg    Config
+	}
+)
+
+type pendingKey struct{}
+
+// pending копит изменённые внутри транзакции заказы, чтобы ещё раз
+// инвалидировать их после коммита или отката
+type pending struct {
+	ordersID []uint64
+}
+
+// NewStorage оборачивает хранилище кэшем. inv может быть nil,
+// тогда кэш инвалидируется только изменениями этой реплики
+func NewStorage(st storage.Storage, cfg Config, inv Invalidator) *Storage {
+	return &Storage{
+		Storage: st,
+		orders:  NewLRU[*domain.OrderStatus](cfg.Size, cfg.TTL),
+		inv:     inv,
+		cfg:     cfg,
+	}
+}
+
+func copyStatus(stat *domain.OrderStatus) *domain.OrderStatus {
+	copied := *stat
+	order := *stat.Order
+	copied.Order = &order
+	return &copied
+}
+
+func inTx(ctx context.Context) bool {
+	_, ok := ctx.Value(pendingKey{}).(*pending)
+	return ok
+}
+
+// Run слушает инвалидации других реплик. После потери соединения кэш
+// очищается, так как сообщения за время переподключения потеряны
+func (s *Storage) Run(ctx context.Context, wg *sync.WaitGroup) {
+	if s.inv == nil {
+		return
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for ctx.Err() == nil {
+			s.listen(ctx)
+		}
+	}()
+}
+
+func (s *Storage) listen(ctx context.Context) {
+	err := s.inv.Listen(ctx, s.onNotify)
+	s.orders.Purge()
+	if ctx.Err() != nil {
+		return
+	}
+
+	log.Printf("[cache.Storage] invalidation listener stopped: %v\n", err)
+	sleep(ctx, s.cfg.ReconnectDelay)
+}
+
+func sleep(ctx context.Context, d time.Duration) {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+	case <-t.C:
+	}
+}
+
+func (s *Storage) onNotify(ordersID []uint64) {
+	s.orders.Delete(ordersID...)
+	metrics.AddCacheInvalidations(len(ordersID), sourceNotify)
+}
+
+// invalidate удаляет заказы из кэша. Вне транзакции изменения сразу
+// рассылаются другим репликам, в транзакции - в RunInTx перед коммитом
+func (s *Storage) invalidate(ctx context.Context, ordersID ...uint64) {
+	s.orders.Delete(ordersID...)
+	metrics.AddCacheInvalidations(len(ordersID), sourceLocal)
+
+	if p, ok := ctx.Value(pendingKey{}).(*pending); ok {
+		p.ordersID = append(p.ordersID, ordersID...)
+		return
+	}
+
+	if err := s.notify(ctx, ordersID); err != nil {
+		log.Printf("[cache.Storage] can't notify about orders %v: %v\n", ordersID, err)
+	}
+}
+
+func (s *Storage) notify(ctx context.Context, ordersID []uint64) error {
+	if s.inv == nil || len(ordersID) == 0 {
+		return nil
+	}
+	return s.inv.Notify(ctx, ordersID)
+}
+
+func (s *Storage) RunInTx(ctx context.Context, fn func(ctxTx context.Context) error) error {
+	p := &pending{}
+	defer func() {
+		// до коммита заказ мог попасть в кэш из параллельного чтения
+		s.orders.Delete(p.ordersID...)
+	}()
+
+	return s.Storage.RunInTx(ctx, func(ctxTx context.Context) error {
+		p.ordersID = p.ordersID[:0]
+		if err := fn(context.WithValue(ctxTx, pendingKey{}, p)); err != nil {
+			return err
+		}
+		return s.notify(ctxTx, p.ordersID)
+	})
+}
+
+// load возвращает заказ из кэша или читает его из хранилища и кэширует
+func (s *Storage) load(ctx context.Context, method string, orderID uint64) (*domain.OrderStatus, error) {
+	if stat, ok := s.orders.Get(orderID); ok {
+		metrics.IncCacheRequests(method, metrics.CacheHit)
+		return copyStatus(stat), nil
+	}
+	metrics.IncCacheRequests(method, metrics.CacheMiss)
+
+	// отстающая реплика закрепила бы в кэше устаревший статус до истечения ttl
+	gen := s.orders.Generation(orderID)
+	stat, err := s.Storage.GetOrderStatus(storage.ReadYourWrites(ctx), orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.orders.SetIfGeneration(orderID, copyStatus(stat), gen)
+	return stat, nil
+}
+
+// userOrder возвращает заказ, только если он принадлежит клиенту
+func (s *Storage) userOrder(ctx context.Context, method string, userID, orderID uint64) (*domain.OrderStatus, error) {
+	stat, err := s.load(ctx, method, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if stat.UserID != userID {
+		return nil, domain.ErrNotFound
+	}
+	return stat, nil
+}
+
+func (s *Storage) GetOrderStatus(ctx context.Context, orderID uint64) (*domain.OrderStatus, error) {
+	if inTx(ctx) {
+		return s.Storage.GetOrderStatus(ctx, orderID)
+	}
+	return s.load(ctx, "GetOrderStatus", orderID)
+}
+
+func (s *Storage) GetOrderOnlyStatus(ctx context.Context, orderID uint64) (string, error) {
+	if inTx(ctx) {
+		return s.Storage.GetOrderOnlyStatus(ctx, orderID)
+	}
+
+	stat, err := s.load(ctx, "GetOrderOnlyStatus", orderID)
+	if err != nil {
+		return "", err
+	}
+	return stat.Status, nil
+}
+
+func (s *Storage) GetOrder(ctx context.Context, userID, orderID uint64) (*domain.Order, error) {
+	if inTx(ctx) {
+		return s.Storage.GetOrder(ctx, userID, orderID)
+	}
+
+	stat, err := s.userOrder(ctx, "GetOrder", userID, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return stat.Order, nil
+}
+
+func (s *Storage) GetExpirationDate(ctx context.Context, userID, orderID uint64) (time.Time, error) {
+	if inTx(ctx) {
+		return s.Storage.GetExpirationDate(ctx, userID, orderID)
+	}
+
+	stat, err := s.userOrder(ctx, "GetExpirationDate", userID, orderID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return utils.StringToTime(stat.ExpirationDate)
+}
+
+func (s *Storage) AddOrder(ctx context.Context, userID, orderID uint64, order *domain.Order) error {
+	if err := s.Storage.AddOrder(ctx, userID, orderID, order); err != nil {
+		return err
+	}
+	s.invalidate(ctx, orderID)
+	return nil
+}
+
+func (s *Storage) AddOrderStatus(ctx context.Context, orderID, userID uint64, status string, order *domain.Order) error {
+	if err := s.Storage.AddOrderStatus(ctx, orderID, userID, status, order); err != nil {
+		return err
+	}
+	s.invalidate(ctx, orderID)
+	return nil
+}
+
+func (s *Storage) SetOrderStatus(ctx context.Context, orderID uint64, status string) error {
+	if err := s.Storage.SetOrderStatus(ctx, orderID, status); err != nil {
+		return err
+	}
+	s.invalidate(ctx, orderID)
+	return nil
+}
+
+func (s *Storage) RemoveOrder(ctx context.Context, orderID uint64, status string) error {
+	if err := s.Storage.RemoveOrder(ctx, orderID, status); err != nil {
+		return err
+	}
+	s.invalidate(ctx, orderID)
+	return nil
+}
+
+func (s *Storage) RemoveOrders(ctx context.Context, ordersID []uint64, status string) error {
+	if err := s.Storage.RemoveOrders(ctx, ordersID, status); err != nil {
+		return err
+	}
+	s.invalidate(ctx, ordersID...)
+	return nil
+}
+
+func (s *Storage) AddRefund(ctx context.Context, userID, orderID uint64, order *domain.Order) error {
+	if err := s.Storage.AddRefund(ctx, userID, orderID, order); err != nil {
+		return err
+	}
+	s.invalidate(ctx, orderID)
+	return nil
+}
+
+func (s *Storage) RemoveRefund(ctx context.Context, orderID uint64) error {
+	if err := s.Storage.RemoveRefund(ctx, orderID); err != nil {
+		return err
+	}
+	s.invalidate(ctx, orderID)
+	return nil
+}
+
+func (s *Storage) ExpireOrders(ctx context.Context, expiredBefore time.Time) ([]uint64, error) {
+	orders, err := s.Storage.ExpireOrders(ctx, expiredBefore)
+	if err != nil {
+		return nil, err
+	}
+	s.invalidate(ctx, orders...)
+	return orders, nil
+}
+
+func (s *Storage) userData() (storage.UserData, error) {
+	ud, ok := s.Storage.(storage.UserData)
+	if !ok {
+		return nil, fmt.Errorf("storage %T doesn't support user data requests", s.Storage)
+	}
+	return ud, nil
+}
+
+func (s *Storage) ExportUserData(ctx context.Context, userID uint64) (*domain.UserData, error) {
+	ud, err := s.userData()
+	if err != nil {
+		return nil, err
+	}
+	return ud.ExportUserData(ctx, userID)
+}
+
+// EraseUserData убирает из кэша заказы, в которых заменён клиент. Инвалидация
+// рассылается в транзакции стирания: если её не отправить, стирание откатится,
+// а не оставит данные клиента в кэше других реплик до истечения ttl
+func (s *Storage) EraseUserData(ctx context.Context, userID, pseudonym uint64) (orders []uint64, err error) {
+	ud, err := s.userData()
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.RunInTx(ctx, func(ctxTx context.Context) error {
+		orders, err = ud.EraseUserData(ctxTx, userID, pseudonym)
+		if err != nil {
+			return err
+		}
+		s.invalidate(ctxTx, orders...)
+		return nil
+	})
+	return
+}
+
+func (s *Storage) archiver() (storage.Archiver, error) {
+	ar, ok := s.Storage.(storage.Archiver)
+	if !ok {
+		return nil, fmt.Errorf("storage %T doesn't support archive", s.Storage)
+	}
+	return ar, nil
+}
+
+// ArchiveOrders не трогает кэш: из архива заказ читается таким же
+func (s *Storage) ArchiveOrders(ctx context.Context, before time.Time, limit uint64) ([]uint64, error) {
+	ar, err := s.archiver()
+	if err != nil {
+		return nil, err
+	}
+	return ar.ArchiveOrders(ctx, before, limit)
+}
+
+// DropArchive убирает из кэша заказы удалённых частей архива. Как и при
+// стирании, инвалидация рассылается в транзакции удаления
+func (s *Storage) DropArchive(ctx context.Context, before time.Time) (dropped []string, orders []uint64, err error) {
+	ar, err := s.archiver()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	err = s.RunInTx(ctx, func(ctxTx context.Context) error {
+		dropped, orders, err = ar.DropArchive(ctxTx, before)
+		if err != nil {
+			return err
+		}
+		s.invalidate(ctxTx, orders...)
+		return nil
+	})
+	return
+}
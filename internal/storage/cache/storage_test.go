@@ -0,0 +1,208 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain/strategy"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage/memory"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+// countingStorage считает чтения статусов из обёрнутого хранилища
+type countingStorage struct {
+	storage.Storage
+	mu    sync.Mutex
+	reads int
+}
+
+func (s *countingStorage) GetOrderStatus(ctx context.Context, orderID uint64) (*domain.OrderStatus, error) {
+	s.mu.Lock()
+	s.reads++
+	s.mu.Unlock()
+	return s.Storage.GetOrderStatus(ctx, orderID)
+}
+
+type fakeInvalidator struct {
+	notified [][]uint64
+	listen   chan []uint64
+}
+
+func (f *fakeInvalidator) Notify(ctx context.Context, ordersID []uint64) error {
+	f.notified = append(f.notified, ordersID)
+	return nil
+}
+
+func (f *fakeInvalidator) Listen(ctx context.Context, fn func(ordersID []uint64)) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ordersID := <-f.listen:
+			fn(ordersID)
+		}
+	}
+}
+
+func newTestStorage(t *testing.T) (*Storage, *countingStorage, *fakeInvalidator) {
+	backend := &countingStorage{Storage: memory.NewStorage()}
+	inv := &fakeInvalidator{listen: make(chan []uint64)}
+	st := NewStorage(backend, Config{Size: 10, TTL: time.Minute}, inv)
+
+	order, err := domain.NewOrder(100, 1, utils.CurrentDateString(), strategy.ContainerTypeMap[""])
+	require.NoError(t, err)
+	require.NoError(t, st.AddOrder(context.Background(), 1, 10, order))
+	return st, backend, inv
+}
+
+func TestStorage_ReadThrough(t *testing.T) {
+	ctx := context.Background()
+	st, backend, _ := newTestStorage(t)
+
+	stat, err := st.GetOrderStatus(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, domain.StatusAccepted, stat.Status)
+
+	_, err = st.GetExpirationDate(ctx, 1, 10)
+	require.NoError(t, err)
+	_, err = st.GetOrder(ctx, 1, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, backend.reads)
+
+	// заказ другого клиента не отдаётся и из кэша
+	_, err = st.GetExpirationDate(ctx, 2, 10)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+
+	// изменение копии не портит кэш
+	stat.Status = domain.StatusReturned
+	status, err := st.GetOrderOnlyStatus(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, domain.StatusAccepted, status)
+}
+
+func TestStorage_InvalidatesOnWrite(t *testing.T) {
+	ctx := context.Background()
+	st, backend, inv := newTestStorage(t)
+
+	_, err := st.GetOrderStatus(ctx, 10)
+	require.NoError(t, err)
+	require.NoError(t, st.RemoveOrder(ctx, 10, domain.StatusGiveClient))
+
+	stat, err := st.GetOrderStatus(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, domain.StatusGiveClient, stat.Status)
+	require.Equal(t, 2, backend.reads)
+	require.Equal(t, [][]uint64{{10}, {10}}, inv.notified)
+}
+
+func TestStorage_RunInTx(t *testing.T) {
+	ctx := context.Background()
+	st, backend, inv := newTestStorage(t)
+	inv.notified = nil
+
+	_, err := st.GetOrderStatus(ctx, 10)
+	require.NoError(t, err)
+
+	errRollback := errors.New("rollback")
+	err = st.RunInTx(ctx, func(ctxTx context.Context) error {
+		// статус в транзакции читается из хранилища и не кэшируется
+		stat, err := st.GetOrderStatus(ctxTx, 10)
+		require.NoError(t, err)
+		require.Equal(t, 2, backend.reads)
+
+		// данные заказа в транзакции тоже не берутся из кэша
+		_, err = st.GetExpirationDate(ctxTx, stat.UserID, 10)
+		require.NoError(t, err)
+		_, err = st.GetOrder(ctxTx, stat.UserID, 10)
+		require.NoError(t, err)
+		require.Equal(t, 2, backend.reads)
+
+		require.NoError(t, st.SetOrderStatus(ctxTx, 10, domain.StatusExpired))
+		_, err = st.GetExpirationDate(ctxTx, stat.UserID, 10)
+		require.NoError(t, err)
+		return errRollback
+	})
+	require.ErrorIs(t, err, errRollback)
+	require.Empty(t, inv.notified)
+
+	stat, err := st.GetOrderStatus(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, domain.StatusAccepted, stat.Status)
+
+	err = st.RunInTx(ctx, func(ctxTx context.Context) error {
+		return st.SetOrderStatus(ctxTx, 10, domain.StatusExpired)
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][]uint64{{10}}, inv.notified)
+
+	stat, err = st.GetOrderStatus(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, domain.StatusExpired, stat.Status)
+}
+
+func TestStorage_RemoteInvalidation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	st, backend, inv := newTestStorage(t)
+
+	wg := &sync.WaitGroup{}
+	st.Run(ctx, wg)
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	_, err := st.GetOrderStatus(ctx, 10)
+	require.NoError(t, err)
+
+	inv.listen <- []uint64{10}
+	// повторная отправка гарантирует, что первое сообщение уже обработано
+	inv.listen <- []uint64{11}
+
+	_, err = st.GetOrderStatus(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, backend.reads)
+}
+
+// droppingStorage удаляет из хранилища заказы, как удаление части архива
+type droppingStorage struct {
+	*countingStorage
+	orders []uint64
+}
+
+func (s *droppingStorage) ArchiveOrders(ctx context.Context, before time.Time, limit uint64) ([]uint64, error) {
+	return nil, nil
+}
+
+func (s *droppingStorage) DropArchive(ctx context.Context, before time.Time) ([]string, []uint64, error) {
+	if err := s.RemoveOrders(ctx, s.orders, domain.StatusGiveClient); err != nil {
+		return nil, nil, err
+	}
+	return []string{"orders_archive_2000_01"}, s.orders, nil
+}
+
+func TestStorage_DropArchiveInvalidates(t *testing.T) {
+	ctx := context.Background()
+	st, backend, inv := newTestStorage(t)
+	inv.notified = nil
+
+	_, err := st.GetOrderStatus(ctx, 10)
+	require.NoError(t, err)
+
+	st.Storage = &droppingStorage{countingStorage: backend, orders: []uint64{10}}
+	dropped, orders, err := st.DropArchive(ctx, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, []string{"orders_archive_2000_01"}, dropped)
+	require.Equal(t, []uint64{10}, orders)
+	require.Equal(t, [][]uint64{{10}}, inv.notified)
+
+	stat, err := st.GetOrderStatus(ctx, 10)
+	require.NoError(t, err)
+	require.Equal(t, domain.StatusGiveClient, stat.Status)
+	require.Equal(t, 2, backend.reads)
+}
@@ -0,0 +1,16 @@
+package storage
+
+import "context"
+
+type readYourWritesKey struct{}
+
+// ReadYourWrites требует, чтобы чтения с этим контекстом видели все уже
+// закоммиченные изменения. Хранилище с репликами выполняет их на основном сервере
+func ReadYourWrites(ctx context.Context) context.Context {
+	return context.WithValue(ctx, readYourWritesKey{}, true)
+}
+
+func IsReadYourWrites(ctx context.Context) bool {
+	ok, _ := ctx.Value(readYourWritesKey{}).(bool)
+	return ok
+}
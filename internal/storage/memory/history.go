@@ -0,0 +1,211 @@
+package memory
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+func (s *Storage) addOrderStatus(t *tx, orderID, userID uint64, status string, order *domain.Order) error {
+	if stat, ok := s.history[orderID]; ok {
+		return fmt.Errorf("order %d has already been %s: %w", orderID, stat.Status, domain.ErrAlreadyExist)
+	}
+
+	copied := *order
+	s.putStatus(t, orderID, &domain.OrderStatus{
+		Order:      &copied,
+		Status:     status,
+		AcceptedAt: utils.CurrentDateString(),
+		UpdatedAt:  utils.CurrentDateString(),
+		UserID:     userID,
+		Version:    1,
+	})
+	return nil
+}
+
+func (s *Storage) AddOrderStatus(ctx context.Context, orderID, userID uint64, status string, order *domain.Order) error {
+	return s.write(ctx, func(t *tx) error {
+		return s.addOrderStatus(t, orderID, userID, status, order)
+	})
+}
+
+func (s *Storage) GetOrderOnlyStatus(ctx context.Context, orderID uint64) (status string, err error) {
+	err = s.read(ctx, func() error {
+		stat, ok := s.history[orderID]
+		if !ok {
+			return domain.ErrNotFound
+		}
+
+		status = stat.Status
+		return nil
+	})
+	return
+}
+
+func (s *Storage) GetOrderStatus(ctx context.Context, orderID uint64) (order *domain.OrderStatus, err error) {
+	err = s.read(ctx, func() error {
+		stat, ok := s.history[orderID]
+		if !ok {
+			return domain.ErrNotFound
+		}
+
+		order = copyStatus(stat)
+		return nil
+	})
+	return
+}
+
+// GetOrderStatusForUpdate не отличается от GetOrderStatus: транзакция и так держит эксклюзивную блокировку
+func (s *Storage) GetOrderStatusForUpdate(ctx context.Context, orderID uint64) (*domain.OrderStatus, error) {
+	return s.GetOrderStatus(ctx, orderID)
+}
+
+func (s *Storage) setOrderStatus(t *tx, orderID uint64, status string) error {
+	stat, ok := s.history[orderID]
+	if !ok {
+		return domain.ErrNotFound
+	}
+
+	updated := copyStatus(stat)
+	updated.Status = status
+	updated.UpdatedAt = utils.CurrentDateString()
+	updated.Version++
+	s.putStatus(t, orderID, updated)
+	return nil
+}
+
+func (s *Storage) SetOrderStatus(ctx context.Context, orderID uint64, status string) error {
+	return s.write(ctx, func(t *tx) error {
+		return s.setOrderStatus(t, orderID, status)
+	})
+}
+
+func (s *Storage) appendAccepted(ordersID []uint64, orders set) []uint64 {
+	for orderID := range orders {
+		if s.history[orderID].Status == domain.StatusAccepted {
+			ordersID = append(ordersID, orderID)
+		}
+	}
+	return ordersID
+}
+
+// expiringBetween возвращает принятые заказы со сроком хранения в [from, to]
+func (s *Storage) expiringBetween(from, to int64) []uint64 {
+	ordersID := make([]uint64, 0)
+	for key, orders := range s.byExpiration {
+		if key >= from && key <= to {
+			ordersID = s.appendAccepted(ordersID, orders)
+		}
+	}
+
+	slices.Sort(ordersID)
+	return ordersID
+}
+
+func (s *Storage) ExpireOrders(ctx context.Context, expiredBefore time.Time) (orders []uint64, err error) {
+	err = s.write(ctx, func(t *tx) error {
+		// срок хранения строго меньше expiredBefore
+		orders = s.expiringBetween(0, expiredBefore.Unix()-1)
+		for _, orderID := range orders {
+			if err := s.setOrderStatus(t, orderID, domain.StatusExpired); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return
+}
+
+func (s *Storage) GetOrdersCountByStatus(ctx context.Context, status string) (count uint64, err error) {
+	err = s.read(ctx, func() error {
+		count = uint64(len(s.byStatus[status]))
+		return nil
+	})
+	return
+}
+
+func (s *Storage) isReminded(orderID, stage uint64) bool {
+	sent, ok := s.reminders[orderID]
+	return ok && sent <= stage
+}
+
+func (s *Storage) GetOrdersToRemind(ctx context.Context, stage uint64, expiresFrom, expiresTo time.Time) (orders []domain.OrderView, err error) {
+	err = s.read(ctx, func() error {
+		for _, orderID := range s.expiringBetween(expiresFrom.Unix(), expiresTo.Unix()) {
+			if !s.isReminded(orderID, stage) {
+				orders = append(orders, newView(orderID, s.history[orderID]))
+			}
+		}
+		return nil
+	})
+	return
+}
+
+func (s *Storage) addReminder(t *tx, orderID, stage uint64) {
+	if s.isReminded(orderID, stage) {
+		return
+	}
+
+	rollbackEntry(t, s.reminders, orderID)
+	rollbackEntry(t, s.pending, orderID)
+	s.reminders[orderID] = stage
+	s.pending[orderID] = stage
+}
+
+func (s *Storage) AddReminders(ctx context.Context, stage uint64, ordersID []uint64) error {
+	return s.write(ctx, func(t *tx) error {
+		for _, orderID := range ordersID {
+			s.addReminder(t, orderID, stage)
+		}
+		return nil
+	})
+}
+
+func (s *Storage) pendingReminders(stage uint64) []domain.OrderView {
+	var orders []domain.OrderView
+	for orderID, pending := range s.pending {
+		if stat := s.history[orderID]; pending == stage && isAccepted(stat) {
+			orders = append(orders, newView(orderID, stat))
+		}
+	}
+
+	slices.SortFunc(orders, func(a, b domain.OrderView) int {
+		return cmp.Compare(a.OrderID, b.OrderID)
+	})
+	return orders
+}
+
+func (s *Storage) GetPendingReminders(ctx context.Context, stage uint64) (orders []domain.OrderView, err error) {
+	err = s.read(ctx, func() error {
+		orders = s.pendingReminders(stage)
+		return nil
+	})
+	return
+}
+
+func isAccepted(stat *domain.OrderStatus) bool {
+	return stat != nil && stat.Status == domain.StatusAccepted
+}
+
+func (s *Storage) markSent(t *tx, orderID, stage uint64) {
+	if pending, ok := s.pending[orderID]; !ok || pending != stage {
+		return
+	}
+
+	rollbackEntry(t, s.pending, orderID)
+	delete(s.pending, orderID)
+}
+
+func (s *Storage) MarkRemindersSent(ctx context.Context, stage uint64, ordersID []uint64) error {
+	return s.write(ctx, func(t *tx) error {
+		for _, orderID := range ordersID {
+			s.markSent(t, orderID, stage)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,139 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+func (s *Storage) AddOrder(ctx context.Context, userID, orderID uint64, order *domain.Order) error {
+	return s.write(ctx, func(t *tx) error {
+		if stat, ok := s.history[orderID]; ok {
+			return fmt.Errorf("order %d has already been %s: %w", orderID, stat.Status, domain.ErrAlreadyExist)
+		}
+
+		s.putHeld(t, orderID, userID)
+		return s.addOrderStatus(t, orderID, userID, domain.StatusAccepted, order)
+	})
+}
+
+// userOrder возвращает заказ, только если он принадлежит клиенту
+func (s *Storage) userOrder(userID, orderID uint64) (*domain.OrderStatus, error) {
+	stat, ok := s.history[orderID]
+	if !ok || stat.UserID != userID {
+		return nil, domain.ErrNotFound
+	}
+	return stat, nil
+}
+
+func (s *Storage) GetOrder(ctx context.Context, userID, orderID uint64) (order *domain.Order, err error) {
+	err = s.read(ctx, func() error {
+		stat, err := s.userOrder(userID, orderID)
+		if err != nil {
+			return err
+		}
+
+		order = copyStatus(stat).Order
+		return nil
+	})
+	return
+}
+
+func (s *Storage) GetExpirationDate(ctx context.Context, userID, orderID uint64) (t time.Time, err error) {
+	err = s.read(ctx, func() error {
+		stat, err := s.userOrder(userID, orderID)
+		if err != nil {
+			return err
+		}
+
+		t, err = utils.StringToTime(stat.ExpirationDate)
+		return err
+	})
+	return
+}
+
+func (s *Storage) userOrdersFrom(userID, firstOrderID uint64) []uint64 {
+	ordersID := make([]uint64, 0, len(s.byUser[userID]))
+	for orderID := range s.byUser[userID] {
+		if orderID >= firstOrderID {
+			ordersID = append(ordersID, orderID)
+		}
+	}
+
+	slices.Sort(ordersID)
+	return ordersID
+}
+
+func (s *Storage) GetOrdersByUserID(ctx context.Context, userID, firstOrderID, limit uint64) (orders []domain.OrderView, err error) {
+	err = s.read(ctx, func() error {
+		ordersID := s.userOrdersFrom(userID, firstOrderID)
+		for _, orderID := range ordersID[:min(uint64(len(ordersID)), limit)] {
+			orders = append(orders, newView(orderID, s.history[orderID]))
+		}
+		return nil
+	})
+	return
+}
+
+func canRemoveOrderCheckStatus(status string, orderID uint64) error {
+	if status == domain.StatusGiveClient || status == domain.StatusGiveCourier {
+		return fmt.Errorf("order %d has already been %s: %w", orderID, domain.StatusGiveClient, domain.ErrAlreadyExist)
+	}
+	return nil
+}
+
+func (s *Storage) canRemoveOrder(orderID uint64) error {
+	stat, ok := s.history[orderID]
+	if !ok {
+		return domain.ErrNotFound
+	}
+
+	if err := canRemoveOrderCheckStatus(stat.Status, orderID); err != nil {
+		return err
+	}
+
+	if _, ok = s.held[orderID]; !ok {
+		return domain.ErrNotFound
+	}
+	return nil
+}
+
+func (s *Storage) CanRemoveOrder(ctx context.Context, orderID uint64) error {
+	return s.read(ctx, func() error {
+		return s.canRemoveOrder(orderID)
+	})
+}
+
+func (s *Storage) removeOrder(t *tx, orderID uint64, status string) error {
+	if _, ok := s.history[orderID]; !ok {
+		return domain.ErrNotFound
+	}
+
+	if _, ok := s.held[orderID]; !ok {
+		return domain.ErrNotFound
+	}
+
+	s.deleteHeld(t, orderID)
+	return s.setOrderStatus(t, orderID, status)
+}
+
+func (s *Storage) RemoveOrder(ctx context.Context, orderID uint64, status string) error {
+	return s.write(ctx, func(t *tx) error {
+		return s.removeOrder(t, orderID, status)
+	})
+}
+
+func (s *Storage) RemoveOrders(ctx context.Context, ordersID []uint64, status string) error {
+	return s.write(ctx, func(t *tx) error {
+		for _, orderID := range ordersID {
+			if err := s.removeOrder(t, orderID, status); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
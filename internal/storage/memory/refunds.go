@@ -0,0 +1,71 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+)
+
+func (s *Storage) addRefund(t *tx, orderID uint64) error {
+	i, found := slices.BinarySearch(s.refunds, orderID)
+	if found {
+		return domain.ErrAlreadyExist
+	}
+
+	t.onRollback(func() {
+		j, _ := slices.BinarySearch(s.refunds, orderID)
+		s.refunds = slices.Delete(s.refunds, j, j+1)
+	})
+	s.refunds = slices.Insert(s.refunds, i, orderID)
+	return nil
+}
+
+func (s *Storage) AddRefund(ctx context.Context, userID, orderID uint64, order *domain.Order) error {
+	return s.write(ctx, func(t *tx) error {
+		if err := s.addRefund(t, orderID); err != nil {
+			return err
+		}
+		return s.setOrderStatus(t, orderID, domain.StatusReturned)
+	})
+}
+
+func (s *Storage) removeRefund(t *tx, orderID uint64) error {
+	i, found := slices.BinarySearch(s.refunds, orderID)
+	if !found {
+		return fmt.Errorf("refund: %w", domain.ErrNotFound)
+	}
+
+	t.onRollback(func() {
+		j, _ := slices.BinarySearch(s.refunds, orderID)
+		s.refunds = slices.Insert(s.refunds, j, orderID)
+	})
+	s.refunds = slices.Delete(s.refunds, i, i+1)
+	return nil
+}
+
+func (s *Storage) RemoveRefund(ctx context.Context, orderID uint64) error {
+	return s.write(ctx, func(t *tx) error {
+		if err := s.removeRefund(t, orderID); err != nil {
+			return err
+		}
+		return s.setOrderStatus(t, orderID, domain.StatusGiveCourier)
+	})
+}
+
+func (s *Storage) GetRefunds(ctx context.Context, pageID, ordersPerPage uint64) (orders []domain.OrderView, err error) {
+	if pageID == 0 || ordersPerPage == 0 {
+		return nil, fmt.Errorf("GetRefunds: pageID and ordersPerPage must be greater than 0: %w", domain.ErrWrongInput)
+	}
+
+	err = s.read(ctx, func() error {
+		first := min(uint64(len(s.refunds)), (pageID-1)*ordersPerPage)
+		last := min(uint64(len(s.refunds)), first+ordersPerPage)
+		for _, orderID := range s.refunds[first:last] {
+			orders = append(orders, newView(orderID, s.history[orderID]))
+		}
+		return nil
+	})
+	return
+}
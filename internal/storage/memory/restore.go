@@ -0,0 +1,36 @@
+package memory
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+)
+
+func (s *Storage) restoreOrder(t *tx, r *domain.OrderRecord) error {
+	if _, ok := s.history[r.OrderID]; ok {
+		return fmt.Errorf("order %d: %w", r.OrderID, domain.ErrAlreadyExist)
+	}
+
+	if domain.IsHeld(r.Status) {
+		s.putHeld(t, r.OrderID, r.UserID)
+	}
+
+	s.putStatus(t, r.OrderID, copyStatus(r.OrderStatus()))
+	if r.Status == domain.StatusReturned {
+		return s.addRefund(t, r.OrderID)
+	}
+	return nil
+}
+
+// RestoreOrders загружает пачку заказов одной транзакцией
+func (s *Storage) RestoreOrders(ctx context.Context, orders []domain.OrderRecord) error {
+	return s.write(ctx, func(t *tx) error {
+		for i := range orders {
+			if err := s.restoreOrder(t, &orders[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
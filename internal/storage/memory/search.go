@@ -0,0 +1,95 @@
+package memory
+
+import (
+	"context"
+	"slices"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+)
+
+type searchItem struct {
+	record domain.OrderRecord
+	pos    domain.SearchCursor
+}
+
+func newRecord(orderID uint64, stat *domain.OrderStatus) domain.OrderRecord {
+	copied := copyStatus(stat)
+	return domain.OrderRecord{
+		Order:      copied.Order,
+		OrderID:    orderID,
+		UserID:     copied.UserID,
+		Status:     copied.Status,
+		AcceptedAt: copied.AcceptedAt,
+		UpdatedAt:  copied.UpdatedAt,
+		Version:    copied.Version,
+	}
+}
+
+// candidates сужает перебор по индексу клиента, если фильтр его задаёт
+func (s *Storage) candidates(filter *domain.OrderFilter) []uint64 {
+	ordersID := make([]uint64, 0)
+	if filter.UserID != 0 {
+		for orderID := range s.byUser[filter.UserID] {
+			ordersID = append(ordersID, orderID)
+		}
+		return ordersID
+	}
+
+	for orderID := range s.history {
+		ordersID = append(ordersID, orderID)
+	}
+	return ordersID
+}
+
+func (s *Storage) match(orderID uint64, filter *domain.OrderFilter) (*searchItem, error) {
+	item := &searchItem{record: newRecord(orderID, s.history[orderID])}
+	if !filter.Match(&item.record) {
+		return nil, nil
+	}
+
+	key, err := item.record.SortKey(filter.SortBy)
+	if err != nil {
+		return nil, err
+	}
+
+	item.pos = domain.SearchCursor{Value: key, OrderID: orderID}
+	if !filter.IsAfter(item.pos) {
+		return nil, nil
+	}
+	return item, nil
+}
+
+func (s *Storage) collect(filter *domain.OrderFilter) ([]searchItem, error) {
+	items := make([]searchItem, 0)
+	for _, orderID := range s.candidates(filter) {
+		item, err := s.match(orderID, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		if item != nil {
+			items = append(items, *item)
+		}
+	}
+	return items, nil
+}
+
+func (s *Storage) SearchOrders(ctx context.Context, filter *domain.OrderFilter) (orders []domain.OrderRecord, err error) {
+	err = s.read(ctx, func() error {
+		items, err := s.collect(filter)
+		if err != nil {
+			return err
+		}
+
+		slices.SortFunc(items, func(a, b searchItem) int {
+			return filter.Compare(a.pos, b.pos)
+		})
+
+		orders = make([]domain.OrderRecord, 0, min(uint64(len(items)), filter.Limit))
+		for i := 0; i < len(items) && uint64(i) < filter.Limit; i++ {
+			orders = append(orders, items[i].record)
+		}
+		return nil
+	})
+	return
+}
@@ -0,0 +1,205 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+type (
+	txKey struct{}
+
+	// tx копит операции отмены, которые применяются в обратном порядке при откате
+	tx struct {
+		undo []func()
+	}
+
+	set map[uint64]struct{}
+
+	// Storage - потокобезопасное хранилище в памяти. Транзакции выполняются по одной
+	// под эксклюзивной блокировкой, поэтому видят согласованное состояние;
+	// чтение вне транзакций идёт под разделяемой блокировкой
+	Storage struct {
+		mtx sync.RWMutex
+
+		// history - статусы всех заказов, аналог orders_history
+		history map[uint64]*domain.OrderStatus
+		// held - заказы на хранении в ПВЗ: orderID -> userID, аналог orders
+		held      map[uint64]uint64
+		refunds   []uint64
+		reminders map[uint64]uint64
+		// pending - этап записанного, но ещё не отправленного напоминания
+		pending map[uint64]uint64
+
+		byUser       map[uint64]set
+		byStatus     map[string]set
+		byExpiration map[int64]set
+	}
+)
+
+func NewStorage() *Storage {
+	return &Storage{
+		history:      make(map[uint64]*domain.OrderStatus),
+		held:         make(map[uint64]uint64),
+		refunds:      make([]uint64, 0),
+		reminders:    make(map[uint64]uint64),
+		pending:      make(map[uint64]uint64),
+		byUser:       make(map[uint64]set),
+		byStatus:     make(map[string]set),
+		byExpiration: make(map[int64]set),
+	}
+}
+
+func (t *tx) onRollback(fn func()) {
+	t.undo = append(t.undo, fn)
+}
+
+func (t *tx) rollback() {
+	for i := len(t.undo) - 1; i >= 0; i-- {
+		t.undo[i]()
+	}
+}
+
+func txFrom(ctx context.Context) *tx {
+	t, _ := ctx.Value(txKey{}).(*tx)
+	return t
+}
+
+// RunInTx выполняет fn под эксклюзивной блокировкой и откатывает изменения при ошибке.
+// Вложенный вызов выполняется в уже открытой транзакции
+func (s *Storage) RunInTx(ctx context.Context, fn func(ctxTx context.Context) error) error {
+	if txFrom(ctx) != nil {
+		return fn(ctx)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	t := &tx{}
+	if err := fn(context.WithValue(ctx, txKey{}, t)); err != nil {
+		t.rollback()
+		return err
+	}
+
+	return nil
+}
+
+// write выполняет изменение в текущей транзакции или в отдельной, если её нет
+func (s *Storage) write(ctx context.Context, fn func(t *tx) error) error {
+	return s.RunInTx(ctx, func(ctxTx context.Context) error {
+		return fn(txFrom(ctxTx))
+	})
+}
+
+func (s *Storage) read(ctx context.Context, fn func() error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if txFrom(ctx) == nil {
+		s.mtx.RLock()
+		defer s.mtx.RUnlock()
+	}
+
+	return fn()
+}
+
+func (x set) add(id uint64) {
+	x[id] = struct{}{}
+}
+
+func expirationKey(order *domain.Order) int64 {
+	t, _ := utils.StringToTime(order.ExpirationDate)
+	return t.Unix()
+}
+
+func addToIndex[K comparable](index map[K]set, key K, orderID uint64) {
+	if _, ok := index[key]; !ok {
+		index[key] = make(set)
+	}
+	index[key].add(orderID)
+}
+
+func removeFromIndex[K comparable](index map[K]set, key K, orderID uint64) {
+	delete(index[key], orderID)
+	if len(index[key]) == 0 {
+		delete(index, key)
+	}
+}
+
+func (s *Storage) index(orderID uint64, stat *domain.OrderStatus) {
+	addToIndex(s.byUser, stat.UserID, orderID)
+	addToIndex(s.byStatus, stat.Status, orderID)
+	addToIndex(s.byExpiration, expirationKey(stat.Order), orderID)
+}
+
+func (s *Storage) unindex(orderID uint64, stat *domain.OrderStatus) {
+	removeFromIndex(s.byUser, stat.UserID, orderID)
+	removeFromIndex(s.byStatus, stat.Status, orderID)
+	removeFromIndex(s.byExpiration, expirationKey(stat.Order), orderID)
+}
+
+// replaceStatus заменяет статус заказа вместе с индексами, nil удаляет заказ
+func (s *Storage) replaceStatus(orderID uint64, stat *domain.OrderStatus) {
+	if old, ok := s.history[orderID]; ok {
+		s.unindex(orderID, old)
+		delete(s.history, orderID)
+	}
+
+	if stat != nil {
+		s.history[orderID] = stat
+		s.index(orderID, stat)
+	}
+}
+
+func (s *Storage) putStatus(t *tx, orderID uint64, stat *domain.OrderStatus) {
+	old := s.history[orderID]
+	t.onRollback(func() { s.replaceStatus(orderID, old) })
+	s.replaceStatus(orderID, stat)
+}
+
+func (s *Storage) putHeld(t *tx, orderID, userID uint64) {
+	rollbackEntry(t, s.held, orderID)
+	s.held[orderID] = userID
+}
+
+func (s *Storage) deleteHeld(t *tx, orderID uint64) {
+	rollbackEntry(t, s.held, orderID)
+	delete(s.held, orderID)
+}
+
+// rollbackEntry при откате возвращает прежнее значение m[orderID] или его отсутствие
+func rollbackEntry(t *tx, m map[uint64]uint64, orderID uint64) {
+	value, ok := m[orderID]
+	t.onRollback(func() {
+		if ok {
+			m[orderID] = value
+		} else {
+			delete(m, orderID)
+		}
+	})
+}
+
+// copyStatus отдаёт наружу копию, чтобы вызывающий код не менял состояние хранилища
+func copyStatus(stat *domain.OrderStatus) *domain.OrderStatus {
+	copied := *stat
+	order := *stat.Order
+	copied.Order = &order
+	return &copied
+}
+
+func newView(orderID uint64, stat *domain.OrderStatus) domain.OrderView {
+	order := *stat.Order
+	return domain.OrderView{
+		Order:   &order,
+		UserID:  stat.UserID,
+		OrderID: orderID,
+		Version: stat.Version,
+	}
+}
@@ -0,0 +1,24 @@
+package memory
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeldRollbackRestoresPreviousValue(t *testing.T) {
+	s := NewStorage()
+	s.held[1] = 10
+
+	var t1 tx
+	s.putHeld(&t1, 1, 20)
+	s.putHeld(&t1, 2, 20)
+	t1.rollback()
+	require.Equal(t, map[uint64]uint64{1: 10}, s.held)
+
+	var t2 tx
+	s.deleteHeld(&t2, 1)
+	s.deleteHeld(&t2, 2)
+	t2.rollback()
+	require.Equal(t, map[uint64]uint64{1: 10}, s.held)
+}
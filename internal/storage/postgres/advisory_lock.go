@@ -0,0 +1,42 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type AdvisoryLocker struct {
+	pool *pgxpool.Pool
+}
+
+func NewAdvisoryLocker(pool *pgxpool.Pool) *AdvisoryLocker {
+	return &AdvisoryLocker{pool: pool}
+}
+
+// TryRun выполняет fn, только если удалось захватить сессионную advisory блокировку key.
+// Блокировка держится на отдельном соединении пула всё время выполнения fn,
+// поэтому среди всех реплик сервиса fn одновременно выполняется не более одного раза.
+func (l *AdvisoryLocker) TryRun(ctx context.Context, key int64, fn func(ctx context.Context) error) (bool, error) {
+	conn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return false, fmt.Errorf("TryRun Acquire: %w", err)
+	}
+	defer conn.Release()
+
+	var locked bool
+	if err = conn.QueryRow(ctx, `select pg_try_advisory_lock($1)`, key).Scan(&locked); err != nil {
+		return false, fmt.Errorf("TryRun pg_try_advisory_lock: %w", err)
+	}
+
+	if !locked {
+		return false, nil
+	}
+
+	defer func() {
+		_, _ = conn.Exec(context.Background(), `select pg_advisory_unlock($1)`, key)
+	}()
+
+	return true, fn(ctx)
+}
@@ -0,0 +1,167 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+)
+
+const (
+	archivePartitionPrefix = "orders_archive_"
+	archivePartitionLayout = "2006_01"
+)
+
+// completedStatuses - заказы в этих статусах больше не меняются и переносятся в архив
+var completedStatuses = []string{domain.StatusGiveClient, domain.StatusGiveCourier}
+
+// selectArchiveCandidates выбирает заказы для переноса: $1 - статусы, $2 - граница updated_at, $3 - размер пачки
+const selectArchiveCandidates = `select order_id, updated_at
+		from orders
+		where status = any($1) and updated_at < $2
+		order by order_id
+		limit $3`
+
+// archivePartitionName - секция архива за месяц month, например orders_archive_2024_09
+func archivePartitionName(month time.Time) string {
+	return archivePartitionPrefix + month.Format(archivePartitionLayout)
+}
+
+// archivePartitionMonth восстанавливает месяц секции по её имени
+func archivePartitionMonth(name string) (time.Time, bool) {
+	month, ok := strings.CutPrefix(name, archivePartitionPrefix)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	t, err := time.Parse(archivePartitionLayout, month)
+	return t, err == nil
+}
+
+// CreateArchivePartitions создаёт недостающие помесячные секции архива для следующей пачки переноса.
+// Создание секции блокирует orders_archive целиком, поэтому существующие секции пропускаются
+func (pg *PgRepository) CreateArchivePartitions(ctx context.Context, before time.Time, limit uint64) error {
+	months, err := pg.missingArchiveMonths(ctx, before, limit)
+	if err != nil {
+		return fmt.Errorf("CreateArchivePartitions: %w", err)
+	}
+
+	for _, month := range months {
+		if err := pg.createArchivePartition(ctx, month); err != nil {
+			return fmt.Errorf("CreateArchivePartitions %s: %w", archivePartitionName(month), err)
+		}
+	}
+
+	return nil
+}
+
+// missingArchiveMonths - месяцы заказов следующей пачки, для которых ещё нет секции
+func (pg *PgRepository) missingArchiveMonths(ctx context.Context, before time.Time, limit uint64) ([]time.Time, error) {
+	var months []time.Time
+
+	tx := pg.txManager.GetQueryEngine(ctx)
+	if err := pgxscan.Select(ctx, tx, &months,
+		`select distinct date_trunc('month', updated_at)::date
+		 from (`+selectArchiveCandidates+`) candidates`,
+		completedStatuses,
+		before,
+		limit,
+	); err != nil {
+		return nil, err
+	}
+
+	partitions, err := pg.GetArchivePartitions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(months, func(month time.Time) bool {
+		return slices.Contains(partitions, archivePartitionName(month))
+	}), nil
+}
+
+func (pg *PgRepository) createArchivePartition(ctx context.Context, month time.Time) error {
+	tx := pg.txManager.GetQueryEngine(ctx)
+
+	// в DDL нельзя передать параметры, границы форматируются из даты
+	_, err := tx.Exec(ctx, fmt.Sprintf(
+		`create table if not exists %s partition of orders_archive for values from ('%s') to ('%s')`,
+		pgx.Identifier{archivePartitionName(month)}.Sanitize(),
+		month.Format(time.DateOnly),
+		month.AddDate(0, 1, 0).Format(time.DateOnly),
+	))
+	return err
+}
+
+// ArchiveOrders переносит пачку завершённых заказов из orders в orders_archive
+// вместе с удалением их напоминаний. Секции архива должны быть уже созданы,
+// номера заказов остаются занятыми в order_ids
+func (pg *PgRepository) ArchiveOrders(ctx context.Context, before time.Time, limit uint64) ([]uint64, error) {
+	var orders []uint64
+
+	tx := pg.txManager.GetQueryEngine(ctx)
+	if err := pgxscan.Select(ctx, tx, &orders,
+		`with moved as (
+			delete from orders
+			-- статус проверяется повторно: параллельный возврат мог изменить заказ после выборки
+			where status = any($1) and order_id in (select order_id from (`+selectArchiveCandidates+`) candidates)
+			returning user_id, order_id, expiration_date, package_type, weight, cost, use_tape, status, accepted_at, updated_at, version, issued_at, returned_at, deleted_at
+		), reminders as (
+			delete from order_reminders where order_id in (select order_id from moved)
+		)
+		insert into orders_archive (user_id, order_id, expiration_date, package_type, weight, cost, use_tape, status, accepted_at, updated_at, version, issued_at, returned_at, deleted_at)
+		select * from moved
+		returning order_id`,
+		completedStatuses,
+		before,
+		limit,
+	); err != nil {
+		return nil, fmt.Errorf("ArchiveOrders: %w", err)
+	}
+
+	return orders, nil
+}
+
+func (pg *PgRepository) GetArchivePartitions(ctx context.Context) ([]string, error) {
+	var partitions []string
+
+	tx := pg.txManager.GetQueryEngine(ctx)
+	if err := pgxscan.Select(ctx, tx, &partitions,
+		`select c.relname
+		 from pg_inherits i
+		 join pg_class c on c.oid = i.inhrelid
+		 join pg_class p on p.oid = i.inhparent
+		 where p.relname = 'orders_archive'
+		 order by c.relname`,
+	); err != nil {
+		return nil, fmt.Errorf("GetArchivePartitions: %w", err)
+	}
+
+	return partitions, nil
+}
+
+// DropArchivePartition удаляет секцию архива, освобождает номера её заказов и возвращает их
+func (pg *PgRepository) DropArchivePartition(ctx context.Context, partition string) ([]uint64, error) {
+	table := pgx.Identifier{partition}.Sanitize()
+
+	var orders []uint64
+	tx := pg.txManager.GetQueryEngine(ctx)
+	if err := pgxscan.Select(ctx, tx, &orders,
+		`with dropped as (select order_id from `+table+`),
+		 freed as (delete from order_ids where order_id in (select order_id from dropped))
+		 select order_id from dropped`,
+	); err != nil {
+		return nil, fmt.Errorf("DropArchivePartition %s: %w", partition, err)
+	}
+
+	if _, err := tx.Exec(ctx, `drop table if exists `+table); err != nil {
+		return nil, fmt.Errorf("DropArchivePartition %s: %w", partition, err)
+	}
+
+	return orders, nil
+}
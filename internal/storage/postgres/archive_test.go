@@ -0,0 +1,31 @@
+package postgres
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestArchivePartitionName(t *testing.T) {
+	month := time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, "orders_archive_2024_09", archivePartitionName(month))
+
+	parsed, ok := archivePartitionMonth("orders_archive_2024_09")
+	require.True(t, ok)
+	require.Equal(t, month, parsed)
+
+	_, ok = archivePartitionMonth("orders_archive_default")
+	require.False(t, ok)
+}
+
+func TestExpiredPartitions(t *testing.T) {
+	partitions := []string{"orders_archive_2024_08", "orders_archive_2024_09", "orders_archive_2024_10", "orders_archive_default"}
+
+	// сентябрь ещё не закончился к 15 числу, поэтому не удаляется
+	before := time.Date(2024, 9, 15, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, []string{"orders_archive_2024_08"}, expiredPartitions(partitions, before))
+
+	before = time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, []string{"orders_archive_2024_08", "orders_archive_2024_09"}, expiredPartitions(partitions, before))
+}
@@ -0,0 +1,110 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"github.com/jackc/pgx/v5"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+)
+
+// auditLockKey - ключ advisory блокировки, под которой записи журнала добавляются по одной
+const auditLockKey = 0x61756469
+
+// LockAuditLog захватывает блокировку добавления в журнал до конца транзакции:
+// без неё две записи сослались бы на один и тот же предыдущий хэш.
+// SELECT ... FOR UPDATE последней записи не заменяет её: ожидающая транзакция
+// после снятия блокировки получит ту же, уже не последнюю запись
+func (pg *PgRepository) LockAuditLog(ctx context.Context) error {
+	tx := pg.txManager.GetQueryEngine(ctx)
+	if _, err := tx.Exec(ctx, `select pg_advisory_xact_lock($1)`, auditLockKey); err != nil {
+		return fmt.Errorf("LockAuditLog: %w", err)
+	}
+	return nil
+}
+
+// GetLastAuditHash возвращает хэш последней записи журнала, пустая строка - журнал пуст
+func (pg *PgRepository) GetLastAuditHash(ctx context.Context) (string, error) {
+	var hash string
+
+	tx := pg.txManager.GetQueryEngine(ctx)
+	err := pgxscan.Get(ctx, tx, &hash,
+		`select hash
+		 from audit_log
+		 order by id desc
+		 limit 1`,
+	)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", nil
+	} else if err != nil {
+		return "", fmt.Errorf("GetLastAuditHash: %w", err)
+	}
+
+	return hash, nil
+}
+
+func (pg *PgRepository) InsertAuditRecord(ctx context.Context, r *domain.AuditRecord) error {
+	tx := pg.txManager.GetQueryEngine(ctx)
+	err := pgxscan.Get(ctx, tx, &r.ID,
+		`insert into audit_log(
+		created_at,
+		actor,
+		pickup_point,
+		method,
+		order_ids,
+		payload_digest,
+		outcome,
+		code,
+		prev_hash,
+		hash)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		returning id`,
+		r.CreatedAt,
+		r.Actor,
+		r.PickupPoint,
+		r.Method,
+		r.OrderIDs,
+		r.PayloadDigest,
+		r.Outcome,
+		r.Code,
+		r.PrevHash,
+		r.Hash,
+	)
+
+	if err != nil {
+		return fmt.Errorf("InsertAuditRecord: %w", err)
+	}
+
+	return nil
+}
+
+// QueryAuditLog отбирает записи журнала по возрастанию номера
+func (pg *PgRepository) QueryAuditLog(ctx context.Context, filter *domain.AuditFilter) ([]domain.AuditRecord, error) {
+	var records []domain.AuditRecord
+
+	tx := pg.txManager.GetQueryEngine(ctx)
+	if err := pgxscan.Select(ctx, tx, &records,
+		`select id, created_at, actor, pickup_point, method, order_ids, payload_digest, outcome, code, prev_hash, hash
+		 from audit_log
+		 where id > $1
+		 and ($2 = '' or actor = $2)
+		 and ($3::bigint = 0 or order_ids @> array[$3::bigint])
+		 and ($4::timestamptz is null or created_at >= $4)
+		 and ($5::timestamptz is null or created_at < $5)
+		 order by id
+		 limit $6`,
+		filter.AfterID,
+		filter.Actor,
+		filter.OrderID,
+		filter.From,
+		filter.To,
+		filter.Limit,
+	); err != nil {
+		return nil, fmt.Errorf("QueryAuditLog: %w", err)
+	}
+
+	return records, nil
+}
@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+// Этапы жизненного цикла заказа в таблице orders. Хранение и возврат
+// определяются по тому, какие из дат уже проставлены
+const (
+	// heldPredicate - заказ лежит на хранении в ПВЗ. У возвращённых заказов,
+	// перенесённых из старых таблиц, дата выдачи неизвестна и не заполнена
+	heldPredicate = `issued_at is null and returned_at is null and deleted_at is null`
+	// refundPredicate - возврат принят от клиента и ждёт курьера
+	refundPredicate = `returned_at is not null and deleted_at is null`
+)
+
+// lifecycleColumn - дата этапа, которую проставляет переход в статус
+func lifecycleColumn(status string) string {
+	switch status {
+	case domain.StatusGiveClient:
+		return "issued_at"
+	case domain.StatusReturned:
+		return "returned_at"
+	case domain.StatusGiveCourier:
+		return "deleted_at"
+	default:
+		return ""
+	}
+}
+
+// lifecycleDates восстанавливает даты этапов по статусу заказа и дате его последнего изменения.
+// Дата выдачи возвращённого заказа не восстанавливается: последнее изменение - это возврат
+func lifecycleDates(status string, updatedAt time.Time) (issuedAt, returnedAt, deletedAt *time.Time) {
+	switch status {
+	case domain.StatusGiveClient:
+		return &updatedAt, nil, nil
+	case domain.StatusReturned:
+		return nil, &updatedAt, nil
+	case domain.StatusGiveCourier:
+		return nil, nil, &updatedAt
+	default:
+		return nil, nil, nil
+	}
+}
+
+// transition переводит заказ в статус, если выполнено условие guard.
+// Возвращает false, если подходящего заказа нет
+func (pg *PgRepository) transition(ctx context.Context, orderID uint64, status, guard string) (bool, error) {
+	set := `status = $2, updated_at = $3, version = version + 1`
+	if column := lifecycleColumn(status); column != "" {
+		set += `, ` + column + ` = $3`
+	}
+
+	query := `update orders set ` + set + ` where order_id = $1`
+	if guard != "" {
+		query += ` and ` + guard
+	}
+
+	tx := pg.txManager.GetQueryEngine(ctx)
+	result, err := tx.Exec(ctx, query, orderID, status, utils.CurrentDate())
+	if err != nil {
+		return false, err
+	}
+
+	return result.RowsAffected() > 0, nil
+}
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/pressly/goose/v3"
+	"github.com/pressly/goose/v3/lock"
+	"gitlab.ozon.dev/chppppr/homework/migrations"
+)
+
+var (
+	ErrSchemaOutdated = errors.New("database schema is older than the service expects")
+	ErrSchemaNewer    = errors.New("database schema is newer than the service expects")
+)
+
+// Migrator применяет миграции, встроенные в бинарник. Сессионная блокировка
+// не даёт нескольким репликам применять миграции одновременно
+type Migrator struct {
+	provider *goose.Provider
+}
+
+func NewMigrator(dsn string) (*Migrator, error) {
+	db, err := sql.Open("pgx", dsn)
+	if err != nil {
+		return nil, fmt.Errorf("NewMigrator: %w", err)
+	}
+
+	locker, err := lock.NewPostgresSessionLocker()
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("NewMigrator: %w", err)
+	}
+
+	provider, err := goose.NewProvider(goose.DialectPostgres, db, migrations.FS, goose.WithSessionLocker(locker))
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("NewMigrator: %w", err)
+	}
+
+	return &Migrator{provider: provider}, nil
+}
+
+func (m *Migrator) Up(ctx context.Context) ([]*goose.MigrationResult, error) {
+	return m.provider.Up(ctx)
+}
+
+// Down откатывает одну последнюю миграцию
+func (m *Migrator) Down(ctx context.Context) (*goose.MigrationResult, error) {
+	return m.provider.Down(ctx)
+}
+
+func (m *Migrator) Status(ctx context.Context) ([]*goose.MigrationStatus, error) {
+	return m.provider.Status(ctx)
+}
+
+// Check сверяет схему с последней встроенной миграцией. Схема старее, если
+// не применена хотя бы одна миграция, новее - если применена неизвестная бинарнику
+func (m *Migrator) Check(ctx context.Context) error {
+	current, target, err := m.provider.GetVersions(ctx)
+	if err != nil {
+		return fmt.Errorf("Check: %w", err)
+	}
+
+	if current > target {
+		return fmt.Errorf("%w: version %d, expected %d", ErrSchemaNewer, current, target)
+	}
+
+	pending, err := m.provider.HasPending(ctx)
+	if err != nil {
+		return fmt.Errorf("Check: %w", err)
+	}
+
+	if pending {
+		return fmt.Errorf("%w: version %d, expected %d", ErrSchemaOutdated, current, target)
+	}
+
+	return nil
+}
+
+// Close закрывает соединение с базой
+func (m *Migrator) Close() error {
+	return m.provider.Close()
+}
@@ -0,0 +1,27 @@
+package postgres
+
+import (
+	"io/fs"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/chppppr/homework/migrations"
+)
+
+func TestMigrator_EmbedsAllMigrations(t *testing.T) {
+	// соединение открывается лениво, база для списка миграций не нужна
+	m, err := NewMigrator("postgres://localhost:1/none")
+	require.NoError(t, err)
+	defer m.Close()
+
+	files, err := fs.Glob(migrations.FS, "*.sql")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	sources := m.provider.ListSources()
+	require.Len(t, sources, len(files))
+	for i, src := range sources {
+		require.Equal(t, files[i], filepath.Base(src.Path))
+	}
+}
@@ -0,0 +1,102 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// CacheChannel - канал LISTEN/NOTIFY для инвалидации кэша заказов
+const CacheChannel = "orders_cache_invalidation"
+
+// notifyBatch ограничивает число номеров в одном сообщении:
+// payload NOTIFY не может быть длиннее 8000 байт
+const notifyBatch = 300
+
+// Notifier рассылает номера изменённых заказов через NOTIFY. Внутри транзакции
+// Postgres доставляет сообщения только после коммита, при откате они отбрасываются
+type Notifier struct {
+	pool      *pgxpool.Pool
+	txManager TransactionManager
+	channel   string
+}
+
+func NewNotifier(pool *pgxpool.Pool, tx TransactionManager, channel string) *Notifier {
+	return &Notifier{
+		pool:      pool,
+		txManager: tx,
+		channel:   channel,
+	}
+}
+
+func formatPayload(ordersID []uint64) string {
+	ids := make([]string, 0, len(ordersID))
+	for _, orderID := range ordersID {
+		ids = append(ids, strconv.FormatUint(orderID, 10))
+	}
+	return strings.Join(ids, ",")
+}
+
+func parsePayload(payload string) ([]uint64, error) {
+	ids := strings.Split(payload, ",")
+	ordersID := make([]uint64, 0, len(ids))
+	for _, id := range ids {
+		orderID, err := strconv.ParseUint(id, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse payload %q: %w", payload, err)
+		}
+		ordersID = append(ordersID, orderID)
+	}
+	return ordersID, nil
+}
+
+func (n *Notifier) Notify(ctx context.Context, ordersID []uint64) error {
+	tx := n.txManager.GetQueryEngine(ctx)
+	for i := 0; i < len(ordersID); i += notifyBatch {
+		payload := formatPayload(ordersID[i:min(i+notifyBatch, len(ordersID))])
+		if _, err := tx.Exec(ctx, `select pg_notify($1, $2)`, n.channel, payload); err != nil {
+			return fmt.Errorf("Notify pg_notify: %w", err)
+		}
+	}
+	return nil
+}
+
+// Listen держит отдельное соединение пула, пока не отменён ctx или не произошла ошибка
+func (n *Notifier) Listen(ctx context.Context, fn func(ordersID []uint64)) error {
+	conn, err := n.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("Listen Acquire: %w", err)
+	}
+	defer conn.Release()
+
+	if _, err = conn.Exec(ctx, "listen "+pgx.Identifier{n.channel}.Sanitize()); err != nil {
+		return fmt.Errorf("Listen listen: %w", err)
+	}
+
+	defer func() {
+		_, _ = conn.Exec(context.Background(), "unlisten *")
+	}()
+
+	return receive(ctx, conn.Conn(), fn)
+}
+
+func receive(ctx context.Context, conn *pgx.Conn, fn func(ordersID []uint64)) error {
+	for {
+		msg, err := conn.WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("Listen WaitForNotification: %w", err)
+		}
+
+		ordersID, err := parsePayload(msg.Payload)
+		if err != nil {
+			log.Printf("[postgres.Notifier] %v\n", err)
+			continue
+		}
+		fn(ordersID)
+	}
+}
@@ -0,0 +1,21 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotifierPayload(t *testing.T) {
+	ordersID := []uint64{1, 42, 18446744073709551615}
+
+	payload := formatPayload(ordersID)
+	require.Equal(t, "1,42,18446744073709551615", payload)
+
+	parsed, err := parsePayload(payload)
+	require.NoError(t, err)
+	require.Equal(t, ordersID, parsed)
+
+	_, err = parsePayload("1,x")
+	require.Error(t, err)
+}
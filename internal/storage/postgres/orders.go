@@ -8,33 +8,10 @@ import (
 
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/pgconn"
 	"gitlab.ozon.dev/chppppr/homework/internal/domain"
 	"gitlab.ozon.dev/chppppr/homework/internal/utils"
 )
 
-func (pg *PgRepository) AddOrder(ctx context.Context, userID, orderID uint64) error {
-	tx := pg.txManager.GetQueryEngine(ctx)
-
-	_, err := tx.Exec(ctx, `insert into orders(
-		user_id,
-		order_id)
-		values ($1, $2)`,
-		userID,
-		orderID,
-	)
-
-	if err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-			return domain.ErrAlreadyExist
-		}
-		return fmt.Errorf("AddOrder: %w", err)
-	}
-
-	return nil
-}
-
 func (pg *PgRepository) GetOrder(ctx context.Context, userID, orderID uint64) (*domain.Order, error) {
 	var order domain.Order
 	tx := pg.txManager.GetQueryEngine(ctx)
@@ -46,7 +23,7 @@ func (pg *PgRepository) GetOrder(ctx context.Context, userID, orderID uint64) (*
 			cost,
 			weight,
 			use_tape
-		from orders_history
+		from orders_all
 		where order_id = $1 and user_id = $2`,
 		orderID,
 		userID,
@@ -68,7 +45,7 @@ func (pg *PgRepository) GetExpirationDate(ctx context.Context, userID, orderID u
 	err := pgxscan.Get(ctx, tx, &expDate, `
 		select 
 			to_char(expiration_date, 'DD-MM-YYYY') as expiration_date
-		from orders_history
+		from orders_all
 		where user_id = $2 and order_id = $1`,
 		orderID,
 		userID,
@@ -95,8 +72,9 @@ func (pg *PgRepository) GetOrdersByUserID(ctx context.Context, userID, firstOrde
 			package_type,
 			cost,
 			weight,
-			use_tape
-		from orders_history
+			use_tape,
+			version
+		from orders_all
 		where user_id = $1 and order_id >= $2 order by order_id limit $3`,
 		userID,
 		firstOrderID,
@@ -116,7 +94,7 @@ func (pg *PgRepository) CanRemoveOrder(ctx context.Context, userID, orderID uint
 		select exists (
 			select 1
 			from orders
-			where user_id = $1 and order_id = $2
+			where user_id = $1 and order_id = $2 and `+heldPredicate+`
 			)`,
 		userID,
 		orderID,
@@ -131,24 +109,16 @@ func (pg *PgRepository) CanRemoveOrder(ctx context.Context, userID, orderID uint
 	return nil
 }
 
-func (pg *PgRepository) RemoveOrder(ctx context.Context, userID, orderID uint64) error {
-	tx := pg.txManager.GetQueryEngine(ctx)
-
-	result, err := tx.Exec(ctx, `
-		delete from orders
-		where user_id = $1 and order_id = $2
-		`,
-		userID,
-		orderID,
-	)
-
+// RemoveOrder выдаёт заказ с хранения, переводя его в статус status
+func (pg *PgRepository) RemoveOrder(ctx context.Context, orderID uint64, status string) error {
+	ok, err := pg.transition(ctx, orderID, status, heldPredicate)
 	if err != nil {
 		return fmt.Errorf("RemoveOrder: %w", err)
 	}
 
-	if result.RowsAffected() == 0 {
+	if !ok {
 		return domain.ErrNotFound
 	}
 
-	return err
+	return nil
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/georgysavva/scany/v2/pgxscan"
 	"github.com/jackc/pgx/v5"
@@ -19,8 +20,14 @@ func (pg *PgRepository) AddOrderStatus(ctx context.Context, orderID, userID uint
 		return fmt.Errorf("AddOrderStatus: %w", err)
 	}
 
-	_, err = tx.Exec(ctx,
-		`insert into orders_history(
+	issuedAt, returnedAt, deletedAt := lifecycleDates(status, utils.CurrentDate())
+
+	// номер регистрируется в order_ids: занятый, в том числе архивным заказом, не вставляется
+	result, err := tx.Exec(ctx,
+		`with registered as (
+			insert into order_ids (order_id) values ($1) on conflict do nothing returning order_id
+		)
+		insert into orders(
 		order_id,
 		user_id,
 		expiration_date,
@@ -29,8 +36,13 @@ func (pg *PgRepository) AddOrderStatus(ctx context.Context, orderID, userID uint
 		cost,
 		use_tape,
 		status,
-		updated_at)
-		values ($1, $2, $3, $4, $5, $6, $7, $8, $9)`,
+		accepted_at,
+		updated_at,
+		issued_at,
+		returned_at,
+		deleted_at)
+		select $1::bigint, $2::bigint, $3::date, $4::text, $5::bigint, $6::bigint, $7::boolean, $8::text, $9::date, $9::date, $10::date, $11::date, $12::date
+		from registered`,
 		orderID,
 		userID,
 		expDate,
@@ -40,8 +52,16 @@ func (pg *PgRepository) AddOrderStatus(ctx context.Context, orderID, userID uint
 		order.UseTape,
 		status,
 		utils.CurrentDate(),
+		issuedAt,
+		returnedAt,
+		deletedAt,
 	)
 
+	return addOrderStatusErr(result, err)
+}
+
+// addOrderStatusErr: заказ уже есть, если нарушен первичный ключ или номер уже занят в order_ids
+func addOrderStatusErr(result pgconn.CommandTag, err error) error {
 	if err != nil {
 		var pgErr *pgconn.PgError
 		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
@@ -50,7 +70,11 @@ func (pg *PgRepository) AddOrderStatus(ctx context.Context, orderID, userID uint
 		return fmt.Errorf("AddOrderStatus: %w", err)
 	}
 
-	return err
+	if result.RowsAffected() == 0 {
+		return domain.ErrAlreadyExist
+	}
+
+	return nil
 }
 
 func (pg *PgRepository) GetOrderOnlyStatus(ctx context.Context, orderID uint64) (string, error) {
@@ -60,7 +84,7 @@ func (pg *PgRepository) GetOrderOnlyStatus(ctx context.Context, orderID uint64)
 	err := pgxscan.Get(ctx, tx, &status,
 		`select 
 		 	status
-		 from orders_history
+		 from orders_all
 		 where order_id = $1`,
 		orderID,
 	)
@@ -74,12 +98,9 @@ func (pg *PgRepository) GetOrderOnlyStatus(ctx context.Context, orderID uint64)
 	return status, nil
 }
 
-func (pg *PgRepository) GetOrderStatus(ctx context.Context, orderID uint64) (*domain.OrderStatus, error) {
-	var order domain.OrderStatus
-
-	tx := pg.txManager.GetQueryEngine(ctx)
-	err := pgxscan.Get(ctx, tx, &order,
-		`select 
+// selectOrderStatus читает заказ из таблицы или представления from
+func selectOrderStatus(from string) string {
+	return `select 
 		 user_id,
 		 to_char(expiration_date, 'DD-MM-YYYY') as expiration_date,
 		 package_type,
@@ -87,11 +108,29 @@ func (pg *PgRepository) GetOrderStatus(ctx context.Context, orderID uint64) (*do
 		 cost,
 		 use_tape,
 		 status,
-		 to_char(updated_at, 'DD-MM-YYYY') as updated_at
-		 from orders_history
-		 where order_id = $1`,
-		orderID,
-	)
+		 to_char(accepted_at, 'DD-MM-YYYY') as accepted_at,
+		 to_char(updated_at, 'DD-MM-YYYY') as updated_at,
+		 version
+		 from ` + from + `
+		 where order_id = $1`
+}
+
+// GetOrderStatus ищет заказ и среди активных, и в архиве
+func (pg *PgRepository) GetOrderStatus(ctx context.Context, orderID uint64) (*domain.OrderStatus, error) {
+	return pg.getOrderStatus(ctx, selectOrderStatus("orders_all"), orderID)
+}
+
+// GetOrderStatusForUpdate блокирует строку заказа до конца текущей транзакции.
+// Архивные заказы завершены и не меняются, поэтому ищутся только активные
+func (pg *PgRepository) GetOrderStatusForUpdate(ctx context.Context, orderID uint64) (*domain.OrderStatus, error) {
+	return pg.getOrderStatus(ctx, selectOrderStatus("orders")+"\n\t\t for update", orderID)
+}
+
+func (pg *PgRepository) getOrderStatus(ctx context.Context, query string, orderID uint64) (*domain.OrderStatus, error) {
+	var order domain.OrderStatus
+
+	tx := pg.txManager.GetQueryEngine(ctx)
+	err := pgxscan.Get(ctx, tx, &order, query, orderID)
 
 	if errors.Is(err, pgx.ErrNoRows) {
 		return nil, domain.ErrNotFound
@@ -102,23 +141,174 @@ func (pg *PgRepository) GetOrderStatus(ctx context.Context, orderID uint64) (*do
 	return &order, nil
 }
 
+// SetOrderStatus меняет статус заказа и проставляет дату соответствующего этапа
 func (pg *PgRepository) SetOrderStatus(ctx context.Context, orderID uint64, status string) error {
+	ok, err := pg.transition(ctx, orderID, status, "")
+	if err != nil {
+		return fmt.Errorf("SetOrderStatus: %w", err)
+	}
+
+	if !ok {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func (pg *PgRepository) ExpireOrders(ctx context.Context, expiredBefore time.Time) ([]uint64, error) {
+	var orders []uint64
+
 	tx := pg.txManager.GetQueryEngine(ctx)
-	result, err := tx.Exec(ctx,
-		`update orders_history
-		 set status = $2
-      	 where order_id = $1`,
-		orderID,
+	if err := pgxscan.Select(ctx, tx, &orders,
+		`update orders
+		 set status = $1, updated_at = $4, version = version + 1
+		 where status = $2 and expiration_date < $3
+		 returning order_id`,
+		domain.StatusExpired,
+		domain.StatusAccepted,
+		expiredBefore,
+		utils.CurrentDate(),
+	); err != nil {
+		return nil, fmt.Errorf("ExpireOrders: %w", err)
+	}
+
+	return orders, nil
+}
+
+func (pg *PgRepository) GetOrdersCountByStatus(ctx context.Context, status string) (uint64, error) {
+	var count uint64
+
+	tx := pg.txManager.GetQueryEngine(ctx)
+	if err := pgxscan.Get(ctx, tx, &count,
+		`select count(*)
+		 from orders
+		 where status = $1`,
 		status,
+	); err != nil {
+		return 0, fmt.Errorf("GetOrdersCountByStatus: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetOrdersToRemind возвращает принятые заказы, срок хранения которых истекает
+// в промежутке [expiresFrom, expiresTo] и по которым ещё не отправлялось
+// напоминание этого или более позднего этапа.
+func (pg *PgRepository) GetOrdersToRemind(ctx context.Context, stage uint64, expiresFrom, expiresTo time.Time) ([]domain.OrderView, error) {
+	var orders []domain.OrderView
+
+	tx := pg.txManager.GetQueryEngine(ctx)
+	if err := pgxscan.Select(ctx, tx, &orders,
+		`select
+		 oh.user_id,
+		 oh.order_id,
+		 to_char(oh.expiration_date, 'DD-MM-YYYY') as expiration_date,
+		 oh.package_type,
+		 oh.weight,
+		 oh.cost,
+		 oh.use_tape,
+		 oh.version
+		 from orders oh
+		 where oh.status = $1
+		 and oh.expiration_date between $2 and $3
+		 and not exists (
+			select 1
+			from order_reminders r
+			where r.order_id = oh.order_id and r.stage <= $4
+		 )
+		 order by oh.order_id`,
+		domain.StatusAccepted,
+		expiresFrom,
+		expiresTo,
+		stage,
+	); err != nil {
+		return nil, fmt.Errorf("GetOrdersToRemind: %w", err)
+	}
+
+	return orders, nil
+}
+
+func (pg *PgRepository) AddReminders(ctx context.Context, stage uint64, ordersID []uint64) error {
+	tx := pg.txManager.GetQueryEngine(ctx)
+	_, err := tx.Exec(ctx,
+		`insert into order_reminders(order_id, stage)
+		 select unnest($1::bigint[]), $2
+		 on conflict do nothing`,
+		ordersID,
+		stage,
 	)
 
 	if err != nil {
-		return fmt.Errorf("SetOrderStatus: %w", err)
+		return fmt.Errorf("AddReminders: %w", err)
 	}
 
-	if result.RowsAffected() == 0 {
-		return domain.ErrNotFound
+	return nil
+}
+
+// GetPendingReminders возвращает принятые заказы с неотправленным напоминанием этапа stage.
+// Напоминание, после которого уже записан более поздний этап, устарело и не отдаётся
+func (pg *PgRepository) GetPendingReminders(ctx context.Context, stage uint64) ([]domain.OrderView, error) {
+	var orders []domain.OrderView
+
+	tx := pg.txManager.GetQueryEngine(ctx)
+	if err := pgxscan.Select(ctx, tx, &orders,
+		`select
+		 oh.user_id,
+		 oh.order_id,
+		 to_char(oh.expiration_date, 'DD-MM-YYYY') as expiration_date,
+		 oh.package_type,
+		 oh.weight,
+		 oh.cost,
+		 oh.use_tape,
+		 oh.version
+		 from order_reminders r
+		 join orders oh on oh.order_id = r.order_id
+		 where r.stage = $1 and r.sent_at is null
+		 and oh.status = $2
+		 and not exists (
+			select 1
+			from order_reminders later
+			where later.order_id = r.order_id and later.stage < r.stage
+		 )
+		 order by oh.order_id`,
+		stage,
+		domain.StatusAccepted,
+	); err != nil {
+		return nil, fmt.Errorf("GetPendingReminders: %w", err)
+	}
+
+	return orders, nil
+}
+
+func (pg *PgRepository) MarkRemindersSent(ctx context.Context, stage uint64, ordersID []uint64) error {
+	tx := pg.txManager.GetQueryEngine(ctx)
+	_, err := tx.Exec(ctx,
+		`update order_reminders set sent_at = now()
+		 where stage = $1 and order_id = any($2) and sent_at is null`,
+		stage,
+		ordersID,
+	)
+
+	if err != nil {
+		return fmt.Errorf("MarkRemindersSent: %w", err)
 	}
 
 	return nil
 }
+
+func (pg *PgRepository) GetReminders(ctx context.Context, ordersID []uint64) ([]domain.Reminder, error) {
+	var reminders []domain.Reminder
+
+	tx := pg.txManager.GetQueryEngine(ctx)
+	if err := pgxscan.Select(ctx, tx, &reminders,
+		`select order_id, stage, sent_at
+		 from order_reminders
+		 where order_id = any($1)
+		 order by order_id, stage`,
+		ordersID,
+	); err != nil {
+		return nil, fmt.Errorf("GetReminders: %w", err)
+	}
+
+	return reminders, nil
+}
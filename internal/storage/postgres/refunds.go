@@ -6,73 +6,83 @@ import (
 	"fmt"
 
 	"github.com/georgysavva/scany/v2/pgxscan"
-	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5"
 	"gitlab.ozon.dev/chppppr/homework/internal/domain"
 )
 
 func (pg *PgRepository) AddRefund(ctx context.Context, userID, orderID uint64, order *domain.Order) error {
-	tx := pg.txManager.GetQueryEngine(ctx)
-
-	_, err := tx.Exec(ctx, `
-		insert into refunds(
-			order_id)
-		values ($1)`,
-		orderID,
-	)
-
+	ok, err := pg.transition(ctx, orderID, domain.StatusReturned, `issued_at is not null and returned_at is null`)
 	if err != nil {
-		var pgErr *pgconn.PgError
-		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
-			return domain.ErrAlreadyExist
-		}
 		return fmt.Errorf("AddRefund: %w", err)
 	}
 
-	return nil
+	if ok {
+		return nil
+	}
+
+	return fmt.Errorf("AddRefund: %w", pg.refundConflict(ctx, orderID))
 }
 
-func (pg *PgRepository) RemoveRefund(ctx context.Context, orderID uint64) error {
-	tx := pg.txManager.GetQueryEngine(ctx)
+// refundConflict объясняет по текущему состоянию заказа, почему его нельзя принять на возврат
+func (pg *PgRepository) refundConflict(ctx context.Context, orderID uint64) error {
+	var state struct {
+		Status  string `db:"status"`
+		Pending bool   `db:"pending"`
+	}
 
-	result, err := tx.Exec(ctx, `
-		delete from refunds
-		where order_id = $1`,
+	tx := pg.txManager.GetQueryEngine(ctx)
+	err := pgxscan.Get(ctx, tx, &state,
+		`select status, (`+refundPredicate+`) as pending from orders where order_id = $1`,
 		orderID,
 	)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		return domain.ErrNotFound
+	case err != nil:
+		return err
+	case state.Pending:
+		return domain.ErrAlreadyExist
+	default:
+		return fmt.Errorf("can't refund order %d: status = %s: %w", orderID, state.Status, domain.ErrWrongStatus)
+	}
+}
 
+func (pg *PgRepository) RemoveRefund(ctx context.Context, orderID uint64) error {
+	ok, err := pg.transition(ctx, orderID, domain.StatusGiveCourier, refundPredicate)
 	if err != nil {
 		return fmt.Errorf("RemoveRefund: %w", err)
 	}
 
-	if result.RowsAffected() == 0 {
+	if !ok {
 		return fmt.Errorf("refund: %w", domain.ErrNotFound)
 	}
 
-	return err
+	return nil
 }
 
 func (pg *PgRepository) GetRefunds(ctx context.Context, pageID, ordersPerPage uint64) ([]domain.OrderView, error) {
+	if pageID == 0 || ordersPerPage == 0 {
+		return nil, fmt.Errorf("GetRefunds: pageID and ordersPerPage must be greater than 0: %w", domain.ErrWrongInput)
+	}
+
 	var orders []domain.OrderView
 	limit := (pageID - 1) * ordersPerPage
 
 	tx := pg.txManager.GetQueryEngine(ctx)
 	if err := pgxscan.Select(ctx, tx, &orders, `
 		select
-			oh.user_id,
-			oh.order_id,
-			to_char(oh.expiration_date, 'DD-MM-YYYY') as expiration_date,
-			oh.package_type,
-			oh.weight,
-			oh.cost,
-			oh.use_tape
-		from orders_history oh
-		join (
-			select order_id
-			from refunds
-			order by order_id
-			limit $1 offset $2
-		) r on oh.order_id = r.order_id
-		order by oh.order_id`,
+			user_id,
+			order_id,
+			to_char(expiration_date, 'DD-MM-YYYY') as expiration_date,
+			package_type,
+			weight,
+			cost,
+			use_tape,
+			version
+		from orders
+		where `+refundPredicate+`
+		order by order_id
+		limit $1 offset $2`,
 		ordersPerPage,
 		limit,
 	); err != nil {
@@ -0,0 +1,141 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// классы SQLSTATE: 08 - ошибки соединения, 57 - вмешательство оператора,
+	// в том числе остановка сервера и отмена запроса из-за конфликта с восстановлением
+	sqlStateClassConnection = "08"
+	sqlStateClassOperator   = "57"
+)
+
+type (
+	ReplicaConfig struct {
+		HealthCheckInterval time.Duration `mapstructure:"health_check_interval"`
+		HealthCheckTimeout  time.Duration `mapstructure:"health_check_timeout"`
+	}
+
+	replica struct {
+		pool    *pgxpool.Pool
+		healthy atomic.Bool
+	}
+
+	// Replicas распределяет читающие транзакции по репликам по кругу.
+	// Недоступная реплика пропускается до следующей успешной проверки здоровья
+	Replicas struct {
+		replicas []*replica
+		next     atomic.Uint64
+		cfg      ReplicaConfig
+	}
+)
+
+func NewReplicas(cfg ReplicaConfig, pools ...*pgxpool.Pool) *Replicas {
+	r := &Replicas{
+		replicas: make([]*replica, 0, len(pools)),
+		cfg:      cfg,
+	}
+
+	for _, pool := range pools {
+		rep := &replica{pool: pool}
+		rep.healthy.Store(true)
+		r.replicas = append(r.replicas, rep)
+	}
+	return r
+}
+
+// pick возвращает следующую доступную реплику, nil - доступных нет
+func (r *Replicas) pick() *replica {
+	n := uint64(len(r.replicas))
+	start := r.next.Add(1)
+	for i := range n {
+		if rep := r.replicas[(start+i)%n]; rep.healthy.Load() {
+			return rep
+		}
+	}
+	return nil
+}
+
+func (rep *replica) markDown(err error) {
+	if rep.healthy.Swap(false) {
+		log.Printf("[postgres.Replicas] replica %s is down: %v\n", rep.pool.Config().ConnConfig.Host, err)
+	}
+}
+
+func (rep *replica) check(ctx context.Context, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	if err := rep.pool.Ping(ctx); err != nil {
+		rep.markDown(err)
+		return
+	}
+
+	if !rep.healthy.Swap(true) {
+		log.Printf("[postgres.Replicas] replica %s is up\n", rep.pool.Config().ConnConfig.Host)
+	}
+}
+
+// Run периодически проверяет реплики и возвращает восстановившиеся в работу
+func (r *Replicas) Run(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		ticker := time.NewTicker(r.cfg.HealthCheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				r.check(ctx)
+			}
+		}
+	}()
+}
+
+func (r *Replicas) check(ctx context.Context) {
+	for _, rep := range r.replicas {
+		rep.check(ctx, r.cfg.HealthCheckTimeout)
+	}
+}
+
+func (r *Replicas) Close() {
+	for _, rep := range r.replicas {
+		rep.pool.Close()
+	}
+}
+
+func isConnectionError(err error) bool {
+	var connErr *pgconn.ConnectError
+	var netErr net.Error
+	return errors.As(err, &connErr) || errors.As(err, &netErr) || errors.Is(err, io.EOF) || pgconn.SafeToRetry(err)
+}
+
+// isReplicaFailure отличает недоступность реплики от ошибок самого запроса:
+// только в первом случае транзакцию имеет смысл повторить на основном сервере
+func isReplicaFailure(ctx context.Context, err error) bool {
+	if err == nil || ctx.Err() != nil {
+		return false
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return strings.HasPrefix(pgErr.Code, sqlStateClassConnection) || strings.HasPrefix(pgErr.Code, sqlStateClassOperator)
+	}
+	return isConnectionError(err)
+}
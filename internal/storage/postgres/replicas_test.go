@@ -0,0 +1,64 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+)
+
+func TestReplicas_PickRoundRobin(t *testing.T) {
+	r := NewReplicas(ReplicaConfig{}, nil, nil, nil)
+
+	picked := make(map[*replica]int)
+	for range 6 {
+		picked[r.pick()]++
+	}
+	require.Len(t, picked, 3)
+	for _, n := range picked {
+		require.Equal(t, 2, n)
+	}
+}
+
+func TestReplicas_PickSkipsUnhealthy(t *testing.T) {
+	r := NewReplicas(ReplicaConfig{}, nil, nil)
+	r.replicas[0].healthy.Store(false)
+
+	for range 4 {
+		require.Same(t, r.replicas[1], r.pick())
+	}
+
+	r.replicas[1].healthy.Store(false)
+	require.Nil(t, r.pick())
+}
+
+func TestIsReplicaFailure(t *testing.T) {
+	ctx := context.Background()
+
+	require.False(t, isReplicaFailure(ctx, nil))
+	require.False(t, isReplicaFailure(ctx, domain.ErrNotFound))
+	require.False(t, isReplicaFailure(ctx, pgError(sqlStateSerializationFailure)))
+	require.True(t, isReplicaFailure(ctx, pgError("08006")))
+	require.True(t, isReplicaFailure(ctx, pgError("57P01")))
+	require.True(t, isReplicaFailure(ctx, io.EOF))
+	require.True(t, isReplicaFailure(ctx, &pgconn.ConnectError{}))
+
+	canceled, cancel := context.WithCancel(ctx)
+	cancel()
+	require.False(t, isReplicaFailure(canceled, errors.Join(context.Canceled, io.EOF)))
+}
+
+func TestTxManager_UsePrimary(t *testing.T) {
+	ctx := context.Background()
+
+	require.True(t, NewTxManager(nil).usePrimary(ctx))
+
+	m := NewTxManagerWithReplicas(nil, RetryConfig{}, NewReplicas(ReplicaConfig{}, nil))
+	require.False(t, m.usePrimary(ctx))
+	require.True(t, m.usePrimary(storage.ReadYourWrites(ctx)))
+}
@@ -0,0 +1,86 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+func parseDates(dates ...string) ([]time.Time, error) {
+	res := make([]time.Time, 0, len(dates))
+	for _, date := range dates {
+		t, err := utils.StringToTime(date)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, t)
+	}
+	return res, nil
+}
+
+func restoreErr(orderID uint64, err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+		return fmt.Errorf("order %d: %w", orderID, domain.ErrAlreadyExist)
+	}
+	return fmt.Errorf("RestoreOrder: %w", err)
+}
+
+// RestoreOrder записывает заказ со всеми датами и версией. Даты этапов
+// восстанавливаются по статусу: точное время выдачи и возврата не сохраняется
+func (pg *PgRepository) RestoreOrder(ctx context.Context, r *domain.OrderRecord) error {
+	dates, err := parseDates(r.ExpirationDate, r.AcceptedAt, r.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("RestoreOrder: %w", err)
+	}
+
+	issuedAt, returnedAt, deletedAt := lifecycleDates(r.Status, dates[2])
+
+	tx := pg.txManager.GetQueryEngine(ctx)
+	_, err = tx.Exec(ctx,
+		`with registered as (
+			insert into order_ids (order_id) values ($1) returning order_id
+		)
+		insert into orders(
+		order_id,
+		user_id,
+		expiration_date,
+		package_type,
+		weight,
+		cost,
+		use_tape,
+		status,
+		accepted_at,
+		updated_at,
+		version,
+		issued_at,
+		returned_at,
+		deleted_at)
+		select order_id, $2::bigint, $3::date, $4::text, $5::bigint, $6::bigint, $7::boolean, $8::text, $9::date, $10::date, $11::bigint, $12::date, $13::date, $14::date
+		from registered`,
+		r.OrderID,
+		r.UserID,
+		dates[0],
+		r.PackageType,
+		r.Weight,
+		r.Cost,
+		r.UseTape,
+		r.Status,
+		dates[1],
+		dates[2],
+		r.Version,
+		issuedAt,
+		returnedAt,
+		deletedAt,
+	)
+
+	if err != nil {
+		return restoreErr(r.OrderID, err)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+)
+
+// GetSchemaVersion возвращает последнюю применённую миграцию goose, 0 - миграций не было
+func (pg *PgRepository) GetSchemaVersion(ctx context.Context) (int64, error) {
+	var version int64
+
+	tx := pg.txManager.GetQueryEngine(ctx)
+	if err := pgxscan.Get(ctx, tx, &version,
+		`select coalesce(max(version_id), 0)
+		 from (
+			-- последняя запись по каждой миграции: откат тоже пишется строкой с is_applied = false
+			select distinct on (version_id) version_id, is_applied
+			from goose_db_version
+			order by version_id, id desc
+		 ) v
+		 where is_applied`,
+	); err != nil {
+		return 0, fmt.Errorf("GetSchemaVersion: %w", err)
+	}
+
+	return version, nil
+}
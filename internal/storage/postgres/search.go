@@ -0,0 +1,145 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+)
+
+// searchColumns — колонки, по которым разрешена сортировка, и признак того, что это дата
+var searchColumns = map[string]bool{
+	domain.SortByOrderID:        false,
+	domain.SortByExpirationDate: true,
+	domain.SortByAcceptedAt:     true,
+	domain.SortByUpdatedAt:      true,
+	domain.SortByCost:           false,
+	domain.SortByWeight:         false,
+}
+
+type searchQuery struct {
+	where []string
+	args  []any
+}
+
+// add добавляет условие cond, в котором %d заменяется на номер аргумента arg
+func (q *searchQuery) add(cond string, arg any) {
+	q.args = append(q.args, arg)
+	q.where = append(q.where, fmt.Sprintf(cond, len(q.args)))
+}
+
+func (q *searchQuery) addDateRange(column string, r domain.DateRange) {
+	if r.From != nil {
+		q.add(column+" >= $%d", *r.From)
+	}
+	if r.To != nil {
+		q.add(column+" <= $%d", *r.To)
+	}
+}
+
+func (q *searchQuery) addUintRange(column string, r domain.UintRange) {
+	if r.Min != nil {
+		q.add(column+" >= $%d", *r.Min)
+	}
+	if r.Max != nil {
+		q.add(column+" <= $%d", *r.Max)
+	}
+}
+
+func (q *searchQuery) addFilter(f *domain.OrderFilter) {
+	if f.UserID != 0 {
+		q.add("user_id = $%d", f.UserID)
+	}
+	if len(f.Statuses) > 0 {
+		q.add("status = any($%d)", f.Statuses)
+	}
+	if f.PackageType != "" {
+		q.add("package_type = $%d", f.PackageType)
+	}
+
+	q.addDateRange("accepted_at", f.Accepted)
+	q.addDateRange("expiration_date", f.Expiring)
+	q.addDateRange("updated_at", f.Updated)
+	q.addUintRange("cost", f.Cost)
+	q.addUintRange("weight", f.Weight)
+}
+
+// addAfter добавляет keyset-условие: запись должна идти строго после курсора
+func (q *searchQuery) addAfter(f *domain.OrderFilter, isDate bool) {
+	if f.After == nil {
+		return
+	}
+
+	op := ">"
+	if f.Descending {
+		op = "<"
+	}
+
+	value, placeholder := cursorValue(f.After, isDate)
+	q.args = append(q.args, value, f.After.OrderID)
+	q.where = append(q.where, fmt.Sprintf("(%s, order_id) %s ("+placeholder+", $%d)", f.SortBy, op, len(q.args)-1, len(q.args)))
+}
+
+// cursorValue передаёт дату курсора строкой с приведением к date: время через
+// timestamptz сравнивалось бы с датой в часовом поясе сессии
+func cursorValue(after *domain.SearchCursor, isDate bool) (any, string) {
+	if !isDate {
+		return after.Value, "$%d"
+	}
+	return time.Unix(int64(after.Value), 0).UTC().Format(time.DateOnly), "$%d::date"
+}
+
+func (q *searchQuery) build(f *domain.OrderFilter) string {
+	var sb strings.Builder
+	sb.WriteString(`select
+		order_id,
+		user_id,
+		to_char(expiration_date, 'DD-MM-YYYY') as expiration_date,
+		package_type,
+		weight,
+		cost,
+		use_tape,
+		status,
+		to_char(accepted_at, 'DD-MM-YYYY') as accepted_at,
+		to_char(updated_at, 'DD-MM-YYYY') as updated_at,
+		version
+		from orders_all`)
+
+	if len(q.where) > 0 {
+		sb.WriteString(" where ")
+		sb.WriteString(strings.Join(q.where, " and "))
+	}
+
+	dir := "asc"
+	if f.Descending {
+		dir = "desc"
+	}
+	fmt.Fprintf(&sb, " order by %s %s, order_id %s", f.SortBy, dir, dir)
+
+	q.args = append(q.args, f.Limit)
+	fmt.Fprintf(&sb, " limit $%d", len(q.args))
+	return sb.String()
+}
+
+func (pg *PgRepository) SearchOrders(ctx context.Context, filter *domain.OrderFilter) ([]domain.OrderRecord, error) {
+	isDate, ok := searchColumns[filter.SortBy]
+	if !ok {
+		return nil, fmt.Errorf("SearchOrders: unknown sort field %q: %w", filter.SortBy, domain.ErrWrongInput)
+	}
+
+	q := &searchQuery{}
+	q.addFilter(filter)
+	q.addAfter(filter, isDate)
+	sql := q.build(filter)
+
+	var orders []domain.OrderRecord
+	tx := pg.txManager.GetQueryEngine(ctx)
+	if err := pgxscan.Select(ctx, tx, &orders, sql, q.args...); err != nil {
+		return nil, fmt.Errorf("SearchOrders: %w", err)
+	}
+
+	return orders, nil
+}
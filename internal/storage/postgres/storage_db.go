@@ -18,20 +18,59 @@ type (
 	OrdersHistoryRepositoryDB interface {
 		AddOrderStatus(ctx context.Context, orderID, userID uint64, status string, order *domain.Order) error
 		GetOrderStatus(ctx context.Context, orderID uint64) (*domain.OrderStatus, error)
+		GetOrderStatusForUpdate(ctx context.Context, orderID uint64) (*domain.OrderStatus, error)
 		GetOrderOnlyStatus(ctx context.Context, orderID uint64) (string, error)
 		SetOrderStatus(ctx context.Context, orderID uint64, status string) error
+		ExpireOrders(ctx context.Context, expiredBefore time.Time) ([]uint64, error)
+		GetOrdersCountByStatus(ctx context.Context, status string) (uint64, error)
+		GetOrdersToRemind(ctx context.Context, stage uint64, expiresFrom, expiresTo time.Time) ([]domain.OrderView, error)
+		AddReminders(ctx context.Context, stage uint64, ordersID []uint64) error
+		GetPendingReminders(ctx context.Context, stage uint64) ([]domain.OrderView, error)
+		MarkRemindersSent(ctx context.Context, stage uint64, ordersID []uint64) error
+		GetReminders(ctx context.Context, ordersID []uint64) ([]domain.Reminder, error)
+		SearchOrders(ctx context.Context, filter *domain.OrderFilter) ([]domain.OrderRecord, error)
 	}
 
 	UsersRepositoryDB interface {
-		AddOrder(ctx context.Context, userID, orderID uint64) error
 		GetOrder(ctx context.Context, userID, orderID uint64) (*domain.Order, error)
 		GetExpirationDate(ctx context.Context, userID, orderID uint64) (time.Time, error)
 		GetOrdersByUserID(ctx context.Context, userID, firstOrderID, limit uint64) ([]domain.OrderView, error)
 		CanRemoveOrder(ctx context.Context, userID, orderID uint64) error
-		RemoveOrder(ctx context.Context, userID, orderID uint64) error
+		RemoveOrder(ctx context.Context, orderID uint64, status string) error
+	}
+
+	RestorerDB interface {
+		RestoreOrder(ctx context.Context, r *domain.OrderRecord) error
+		GetSchemaVersion(ctx context.Context) (int64, error)
+	}
+
+	ArchiverDB interface {
+		CreateArchivePartitions(ctx context.Context, before time.Time, limit uint64) error
+		ArchiveOrders(ctx context.Context, before time.Time, limit uint64) ([]uint64, error)
+		GetArchivePartitions(ctx context.Context) ([]string, error)
+		DropArchivePartition(ctx context.Context, partition string) ([]uint64, error)
+	}
+
+	UserDataDB interface {
+		GetUserOrders(ctx context.Context, userID uint64) ([]domain.OrderRecord, error)
+		GetUserRefunds(ctx context.Context, userID uint64) ([]uint64, error)
+		GetUserReminders(ctx context.Context, userID uint64) ([]domain.Reminder, error)
+		HasHeldOrders(ctx context.Context, userID uint64) (bool, error)
+		PseudonymizeUser(ctx context.Context, userID, pseudonym uint64) ([]uint64, error)
+	}
+
+	AuditDB interface {
+		LockAuditLog(ctx context.Context) error
+		GetLastAuditHash(ctx context.Context) (string, error)
+		InsertAuditRecord(ctx context.Context, r *domain.AuditRecord) error
+		QueryAuditLog(ctx context.Context, filter *domain.AuditFilter) ([]domain.AuditRecord, error)
 	}
 
 	RepositoryDB interface {
+		ArchiverDB
+		AuditDB
+		UserDataDB
+		RestorerDB
 		RefundsRepositoryDB
 		OrdersHistoryRepositoryDB
 		UsersRepositoryDB
@@ -40,49 +79,44 @@ type (
 	StorageDB struct {
 		txManager TransactionManager
 		db        RepositoryDB
-		ctx       context.Context
 	}
 )
 
-func NewStorageDB(ctx context.Context, tx TransactionManager, db RepositoryDB) *StorageDB {
+func NewStorageDB(tx TransactionManager, db RepositoryDB) *StorageDB {
 	return &StorageDB{
 		txManager: tx,
 		db:        db,
-		ctx:       ctx,
 	}
 }
 
-func (s *StorageDB) AddOrder(userID, orderID uint64, order *domain.Order) (err error) {
-	return s.txManager.RunReadCommitted(s.ctx, func(ctxTx context.Context) error {
+func (s *StorageDB) AddOrder(ctx context.Context, userID, orderID uint64, order *domain.Order) (err error) {
+	return s.txManager.RunReadCommitted(ctx, func(ctxTx context.Context) error {
 		if stat, err := s.db.GetOrderOnlyStatus(ctxTx, orderID); err == nil {
 			return fmt.Errorf("order %d has already been %s: %w", orderID, stat, domain.ErrAlreadyExist)
 		}
 
-		if err = s.db.AddOrder(ctxTx, userID, orderID); err != nil {
-			return err
-		}
 		return s.db.AddOrderStatus(ctxTx, orderID, userID, domain.StatusAccepted, order)
 	})
 }
 
-func (s *StorageDB) GetOrder(userID, orderID uint64) (order *domain.Order, err error) {
-	s.txManager.RunReadOnlyCommitted(s.ctx, func(ctxTx context.Context) error {
+func (s *StorageDB) GetOrder(ctx context.Context, userID, orderID uint64) (order *domain.Order, err error) {
+	err = s.txManager.RunReadOnlyCommitted(ctx, func(ctxTx context.Context) error {
 		order, err = s.db.GetOrder(ctxTx, userID, orderID)
 		return err
 	})
 	return
 }
 
-func (s *StorageDB) GetExpirationDate(userID, orderID uint64) (t time.Time, err error) {
-	s.txManager.RunReadOnlyCommitted(s.ctx, func(ctxTx context.Context) error {
+func (s *StorageDB) GetExpirationDate(ctx context.Context, userID, orderID uint64) (t time.Time, err error) {
+	err = s.txManager.RunReadOnlyCommitted(ctx, func(ctxTx context.Context) error {
 		t, err = s.db.GetExpirationDate(ctxTx, userID, orderID)
 		return err
 	})
 	return
 }
 
-func (s *StorageDB) GetOrdersByUserID(userID, firstOrderID, limit uint64) (orders []domain.OrderView, err error) {
-	s.txManager.RunReadOnlyCommitted(s.ctx, func(ctxTx context.Context) error {
+func (s *StorageDB) GetOrdersByUserID(ctx context.Context, userID, firstOrderID, limit uint64) (orders []domain.OrderView, err error) {
+	err = s.txManager.RunReadOnlyCommitted(ctx, func(ctxTx context.Context) error {
 		orders, err = s.db.GetOrdersByUserID(ctxTx, userID, firstOrderID, limit)
 		return err
 	})
@@ -96,9 +130,9 @@ func canRemoveOrderCheckStatus(status string, orderID uint64) error {
 	return nil
 }
 
-func (s *StorageDB) CanRemoveOrder(orderID uint64) error {
-	return s.txManager.RunReadOnlyCommitted(s.ctx, func(ctxTx context.Context) error {
-		stat, err := s.GetOrderStatus(orderID)
+func (s *StorageDB) CanRemoveOrder(ctx context.Context, orderID uint64) error {
+	return s.txManager.RunReadOnlyCommitted(ctx, func(ctxTx context.Context) error {
+		stat, err := s.db.GetOrderStatus(ctxTx, orderID)
 		if err != nil {
 			return err
 		}
@@ -111,32 +145,18 @@ func (s *StorageDB) CanRemoveOrder(orderID uint64) error {
 	})
 }
 
-func (s *StorageDB) RemoveOrder(orderID uint64, status string) error {
-	return s.txManager.RunReadCommitted(s.ctx, func(ctxTx context.Context) error {
-		stat, err := s.db.GetOrderStatus(ctxTx, orderID)
-		if err != nil {
-			return err
-		}
-
-		return s.db.RemoveOrder(ctxTx, stat.UserID, orderID)
+func (s *StorageDB) RemoveOrder(ctx context.Context, orderID uint64, status string) error {
+	return s.txManager.RunReadCommitted(ctx, func(ctxTx context.Context) error {
+		return s.removeOrder(ctxTx, orderID, status)
 	})
 }
 
 func (s *StorageDB) removeOrder(ctxTx context.Context, orderID uint64, status string) error {
-	stat, err := s.db.GetOrderStatus(ctxTx, orderID)
-	if err != nil {
-		return err
-	}
-
-	if err = s.db.RemoveOrder(ctxTx, stat.UserID, orderID); err != nil {
-		return err
-	}
-
-	return s.db.SetOrderStatus(ctxTx, orderID, status)
+	return s.db.RemoveOrder(ctxTx, orderID, status)
 }
 
-func (s *StorageDB) RemoveOrders(ordersID []uint64, status string) error {
-	return s.txManager.RunSerializable(s.ctx, func(ctxTx context.Context) error {
+func (s *StorageDB) RemoveOrders(ctx context.Context, ordersID []uint64, status string) error {
+	return s.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
 		for _, order := range ordersID {
 			if err := s.removeOrder(ctxTx, order, status); err != nil {
 				return err
@@ -147,58 +167,267 @@ func (s *StorageDB) RemoveOrders(ordersID []uint64, status string) error {
 	})
 }
 
-func (s *StorageDB) AddOrderStatus(orderID, userID uint64, status string, order *domain.Order) error {
-	return s.txManager.RunReadCommitted(s.ctx, func(ctxTx context.Context) error {
+func (s *StorageDB) AddOrderStatus(ctx context.Context, orderID, userID uint64, status string, order *domain.Order) error {
+	return s.txManager.RunReadCommitted(ctx, func(ctxTx context.Context) error {
 		return s.db.AddOrderStatus(ctxTx, orderID, userID, status, order)
 	})
 }
 
-func (s *StorageDB) GetOrderOnlyStatus(orderID uint64) (stat string, err error) {
-	err = s.txManager.RunReadOnlyCommitted(s.ctx, func(ctxTx context.Context) error {
+func (s *StorageDB) GetOrderOnlyStatus(ctx context.Context, orderID uint64) (stat string, err error) {
+	err = s.txManager.RunReadOnlyCommitted(ctx, func(ctxTx context.Context) error {
 		stat, err = s.db.GetOrderOnlyStatus(ctxTx, orderID)
 		return err
 	})
 	return
 }
 
-func (s *StorageDB) GetOrderStatus(orderID uint64) (order *domain.OrderStatus, err error) {
-	err = s.txManager.RunReadOnlyCommitted(s.ctx, func(ctxTx context.Context) error {
+func (s *StorageDB) GetOrderStatus(ctx context.Context, orderID uint64) (order *domain.OrderStatus, err error) {
+	err = s.txManager.RunReadOnlyCommitted(ctx, func(ctxTx context.Context) error {
 		order, err = s.db.GetOrderStatus(ctxTx, orderID)
 		return err
 	})
 	return
 }
 
-func (s *StorageDB) SetOrderStatus(orderID uint64, status string) error {
-	return s.txManager.RunSerializable(s.ctx, func(ctxTx context.Context) error {
+func (s *StorageDB) RunInTx(ctx context.Context, fn func(ctxTx context.Context) error) error {
+	return s.txManager.RunReadCommitted(ctx, fn)
+}
+
+func (s *StorageDB) GetOrderStatusForUpdate(ctx context.Context, orderID uint64) (order *domain.OrderStatus, err error) {
+	err = s.txManager.RunReadCommitted(ctx, func(ctxTx context.Context) error {
+		order, err = s.db.GetOrderStatusForUpdate(ctxTx, orderID)
+		return err
+	})
+	return
+}
+
+func (s *StorageDB) SetOrderStatus(ctx context.Context, orderID uint64, status string) error {
+	return s.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
 		return s.db.SetOrderStatus(ctxTx, orderID, status)
 	})
 }
 
-func (s *StorageDB) AddRefund(userID, orderID uint64, order *domain.Order) error {
-	return s.txManager.RunReadCommitted(s.ctx, func(ctxTx context.Context) error {
-		err := s.db.AddRefund(ctxTx, userID, orderID, order)
+func (s *StorageDB) ExpireOrders(ctx context.Context, expiredBefore time.Time) (orders []uint64, err error) {
+	err = s.txManager.RunReadCommitted(ctx, func(ctxTx context.Context) error {
+		orders, err = s.db.ExpireOrders(ctxTx, expiredBefore)
+		return err
+	})
+	return
+}
+
+func (s *StorageDB) GetOrdersCountByStatus(ctx context.Context, status string) (count uint64, err error) {
+	err = s.txManager.RunReadOnlyCommitted(ctx, func(ctxTx context.Context) error {
+		count, err = s.db.GetOrdersCountByStatus(ctxTx, status)
+		return err
+	})
+	return
+}
+
+func (s *StorageDB) GetOrdersToRemind(ctx context.Context, stage uint64, expiresFrom, expiresTo time.Time) (orders []domain.OrderView, err error) {
+	err = s.txManager.RunReadOnlyCommitted(ctx, func(ctxTx context.Context) error {
+		orders, err = s.db.GetOrdersToRemind(ctxTx, stage, expiresFrom, expiresTo)
+		return err
+	})
+	return
+}
+
+func (s *StorageDB) AddReminders(ctx context.Context, stage uint64, ordersID []uint64) error {
+	return s.txManager.RunReadCommitted(ctx, func(ctxTx context.Context) error {
+		return s.db.AddReminders(ctxTx, stage, ordersID)
+	})
+}
+
+func (s *StorageDB) GetPendingReminders(ctx context.Context, stage uint64) (orders []domain.OrderView, err error) {
+	err = s.txManager.RunReadOnlyCommitted(ctx, func(ctxTx context.Context) error {
+		orders, err = s.db.GetPendingReminders(ctxTx, stage)
+		return err
+	})
+	return
+}
+
+func (s *StorageDB) MarkRemindersSent(ctx context.Context, stage uint64, ordersID []uint64) error {
+	return s.txManager.RunReadCommitted(ctx, func(ctxTx context.Context) error {
+		return s.db.MarkRemindersSent(ctxTx, stage, ordersID)
+	})
+}
+
+func (s *StorageDB) GetReminders(ctx context.Context, ordersID []uint64) (reminders []domain.Reminder, err error) {
+	err = s.txManager.RunReadOnlyCommitted(ctx, func(ctxTx context.Context) error {
+		reminders, err = s.db.GetReminders(ctxTx, ordersID)
+		return err
+	})
+	return
+}
+
+func (s *StorageDB) SearchOrders(ctx context.Context, filter *domain.OrderFilter) (orders []domain.OrderRecord, err error) {
+	err = s.txManager.RunReadOnlyCommitted(ctx, func(ctxTx context.Context) error {
+		orders, err = s.db.SearchOrders(ctxTx, filter)
+		return err
+	})
+	return
+}
+
+func (s *StorageDB) AddRefund(ctx context.Context, userID, orderID uint64, order *domain.Order) error {
+	return s.txManager.RunReadCommitted(ctx, func(ctxTx context.Context) error {
+		return s.db.AddRefund(ctxTx, userID, orderID, order)
+	})
+}
+
+func (s *StorageDB) RemoveRefund(ctx context.Context, orderID uint64) error {
+	return s.txManager.RunSerializable(ctx, func(ctxTx context.Context) error {
+		return s.db.RemoveRefund(ctxTx, orderID)
+	})
+}
+
+func (s *StorageDB) GetRefunds(ctx context.Context, pageID, ordersPerPage uint64) (orders []domain.OrderView, err error) {
+	err = s.txManager.RunReadOnlyCommitted(ctx, func(ctxTx context.Context) error {
+		orders, err = s.db.GetRefunds(ctxTx, pageID, ordersPerPage)
+		return err
+	})
+	return orders, err
+}
+
+// RunInSnapshot выполняет fn в одной транзакции repeatable read
+func (s *StorageDB) RunInSnapshot(ctx context.Context, fn func(ctxTx context.Context) error) error {
+	return s.txManager.RunRepeatableRead(ctx, fn)
+}
+
+// SchemaVersion - версия схемы базы по миграциям goose
+func (s *StorageDB) SchemaVersion(ctx context.Context) (version int64, err error) {
+	err = s.txManager.RunReadOnlyCommitted(ctx, func(ctxTx context.Context) error {
+		version, err = s.db.GetSchemaVersion(ctxTx)
+		return err
+	})
+	return
+}
+
+// RestoreOrders загружает пачку заказов одной транзакцией
+func (s *StorageDB) RestoreOrders(ctx context.Context, orders []domain.OrderRecord) error {
+	return s.txManager.RunReadCommitted(ctx, func(ctxTx context.Context) error {
+		for i := range orders {
+			if err := s.db.RestoreOrder(ctxTx, &orders[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ArchiveOrders переносит в архив до limit заказов, завершённых раньше before.
+// Секции создаются заранее отдельной короткой транзакцией: DDL берёт
+// ACCESS EXCLUSIVE на orders_archive и не должен держать его на время переноса
+func (s *StorageDB) ArchiveOrders(ctx context.Context, before time.Time, limit uint64) (orders []uint64, err error) {
+	err = s.txManager.RunReadCommitted(ctx, func(ctxTx context.Context) error {
+		return s.db.CreateArchivePartitions(ctxTx, before, limit)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.txManager.RunReadCommitted(ctx, func(ctxTx context.Context) error {
+		orders, err = s.db.ArchiveOrders(ctxTx, before, limit)
+		return err
+	})
+	return
+}
+
+// expiredPartitions отбирает секции, все заказы которых обновлены раньше before
+func expiredPartitions(partitions []string, before time.Time) []string {
+	expired := make([]string, 0)
+	for _, partition := range partitions {
+		month, ok := archivePartitionMonth(partition)
+		if ok && !month.AddDate(0, 1, 0).After(before) {
+			expired = append(expired, partition)
+		}
+	}
+	return expired
+}
+
+// DropArchive удаляет помесячные секции архива, целиком лежащие раньше before
+func (s *StorageDB) DropArchive(ctx context.Context, before time.Time) (dropped []string, orders []uint64, err error) {
+	err = s.txManager.RunReadCommitted(ctx, func(ctxTx context.Context) error {
+		partitions, err := s.db.GetArchivePartitions(ctxTx)
 		if err != nil {
 			return err
 		}
-		return s.db.SetOrderStatus(ctxTx, orderID, domain.StatusReturned)
+
+		dropped = expiredPartitions(partitions, before)
+		for _, partition := range dropped {
+			ids, err := s.db.DropArchivePartition(ctxTx, partition)
+			if err != nil {
+				return err
+			}
+			orders = append(orders, ids...)
+		}
+		return nil
+	})
+	return
+}
+
+// ExportUserData читает все данные клиента из одного снимка базы
+func (s *StorageDB) ExportUserData(ctx context.Context, userID uint64) (data *domain.UserData, err error) {
+	err = s.txManager.RunRepeatableRead(ctx, func(ctxTx context.Context) error {
+		data, err = s.exportUserData(ctxTx, userID)
+		return err
 	})
+	return
+}
+
+func (s *StorageDB) exportUserData(ctxTx context.Context, userID uint64) (*domain.UserData, error) {
+	data := &domain.UserData{UserID: userID}
+
+	var err error
+	if data.Orders, err = s.db.GetUserOrders(ctxTx, userID); err != nil {
+		return nil, err
+	}
+
+	if data.Refunds, err = s.db.GetUserRefunds(ctxTx, userID); err != nil {
+		return nil, err
+	}
+
+	data.Reminders, err = s.db.GetUserReminders(ctxTx, userID)
+	return data, err
 }
 
-func (s *StorageDB) RemoveRefund(orderID uint64) error {
-	return s.txManager.RunSerializable(s.ctx, func(ctxTx context.Context) error {
-		err := s.db.RemoveRefund(ctxTx, orderID)
+// EraseUserData проверяет и заменяет клиента в одной транзакции под блокировкой его заказов
+func (s *StorageDB) EraseUserData(ctx context.Context, userID, pseudonym uint64) (orders []uint64, err error) {
+	err = s.txManager.RunReadCommitted(ctx, func(ctxTx context.Context) error {
+		held, err := s.db.HasHeldOrders(ctxTx, userID)
 		if err != nil {
 			return err
 		}
-		return s.db.SetOrderStatus(ctxTx, orderID, domain.StatusGiveCourier)
+
+		if held {
+			return fmt.Errorf("user %d has orders held in pickup point: %w", userID, domain.ErrWrongStatus)
+		}
+
+		orders, err = s.db.PseudonymizeUser(ctxTx, userID, pseudonym)
+		return err
 	})
+	return
 }
 
-func (s *StorageDB) GetRefunds(pageID, ordersPerPage uint64) (orders []domain.OrderView, err error) {
-	err = s.txManager.RunReadOnlyCommitted(s.ctx, func(ctxTx context.Context) error {
-		orders, err = s.db.GetRefunds(ctxTx, pageID, ordersPerPage)
-		return err
+// AppendAudit дописывает запись в конец журнала, связывая её с последней.
+// Цепочка хэшей допускает только одну добавляющую транзакцию в кластере сразу,
+// поэтому запись идёт отдельной короткой транзакцией после изменения, а не внутри
+// него: блокировка держится на время трёх запросов, не задерживая сами изменения.
+// Это ограничивает журнал примерно тысячей записей в секунду при задержке до базы в 1 мс
+func (s *StorageDB) AppendAudit(ctx context.Context, r *domain.AuditRecord) error {
+	return s.txManager.RunReadCommitted(ctx, func(ctxTx context.Context) error {
+		if err := s.db.LockAuditLog(ctxTx); err != nil {
+			return err
+		}
+
+		prevHash, err := s.db.GetLastAuditHash(ctxTx)
+		if err != nil {
+			return err
+		}
+
+		r.Chain(prevHash)
+		return s.db.InsertAuditRecord(ctxTx, r)
 	})
-	return orders, err
+}
+
+func (s *StorageDB) QueryAuditLog(ctx context.Context, filter *domain.AuditFilter) ([]domain.AuditRecord, error) {
+	return s.db.QueryAuditLog(ctx, filter)
 }
@@ -5,18 +5,37 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"gitlab.ozon.dev/chppppr/homework/internal/metrics"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+)
+
+const (
+	reasonNoReplica      = "no_healthy_replica"
+	reasonReplicaFailure = "replica_failure"
 )
 
 type txManagerKey struct{}
 
 type TxManager struct {
-	pool *pgxpool.Pool
+	pool     *pgxpool.Pool
+	retry    RetryConfig
+	replicas *Replicas
 }
 
 func NewTxManager(pool *pgxpool.Pool) *TxManager {
 	return &TxManager{pool: pool}
 }
 
+func NewTxManagerWithRetry(pool *pgxpool.Pool, retry RetryConfig) *TxManager {
+	return &TxManager{pool: pool, retry: retry}
+}
+
+// NewTxManagerWithReplicas выполняет читающие транзакции RunReadOnlyCommitted на репликах,
+// если контекст не требует ReadYourWrites. replicas может быть nil
+func NewTxManagerWithReplicas(pool *pgxpool.Pool, retry RetryConfig, replicas *Replicas) *TxManager {
+	return &TxManager{pool: pool, retry: retry, replicas: replicas}
+}
+
 func (m *TxManager) RunSerializable(ctx context.Context, fn func(ctxTx context.Context) error) error {
 	opts := pgx.TxOptions{
 		IsoLevel:   pgx.Serializable,
@@ -30,8 +49,7 @@ func (m *TxManager) RunReadOnlyCommitted(ctx context.Context, fn func(ctxTx cont
 		IsoLevel:   pgx.ReadCommitted,
 		AccessMode: pgx.ReadOnly,
 	}
-	return m.beginFunc(ctx, opts, fn)
-
+	return m.beginReplica(ctx, opts, fn)
 }
 
 func (m *TxManager) RunReadCommitted(ctx context.Context, fn func(ctxTx context.Context) error) error {
@@ -59,7 +77,49 @@ func (m *TxManager) RunReadUncommitted(ctx context.Context, fn func(ctxTx contex
 }
 
 func (m *TxManager) beginFunc(ctx context.Context, opts pgx.TxOptions, fn func(ctxTx context.Context) error) error {
-	tx, err := m.pool.BeginTx(ctx, opts)
+	// вложенный вызов выполняется в уже открытой транзакции
+	if _, ok := ctx.Value(txManagerKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	return withRetry(ctx, m.retry.policy(opts.IsoLevel), string(opts.IsoLevel), func() error {
+		return m.runTx(ctx, m.pool, opts, fn)
+	})
+}
+
+// usePrimary проверяет, что транзакцию нельзя отправить на реплику
+func (m *TxManager) usePrimary(ctx context.Context) bool {
+	_, inTx := ctx.Value(txManagerKey{}).(pgx.Tx)
+	return inTx || m.replicas == nil || storage.IsReadYourWrites(ctx)
+}
+
+// beginReplica выполняет читающую транзакцию на реплике с той же политикой повторов,
+// что и на основном сервере, а если реплика недоступна - на основном сервере
+func (m *TxManager) beginReplica(ctx context.Context, opts pgx.TxOptions, fn func(ctxTx context.Context) error) error {
+	if m.usePrimary(ctx) {
+		return m.beginFunc(ctx, opts, fn)
+	}
+
+	rep := m.replicas.pick()
+	if rep == nil {
+		metrics.IncReplicaFallbacks(reasonNoReplica)
+		return m.beginFunc(ctx, opts, fn)
+	}
+
+	err := withRetry(ctx, m.retry.policy(opts.IsoLevel), string(opts.IsoLevel), func() error {
+		return m.runTx(ctx, rep.pool, opts, fn)
+	})
+	if !isReplicaFailure(ctx, err) {
+		return err
+	}
+
+	rep.markDown(err)
+	metrics.IncReplicaFallbacks(reasonReplicaFailure)
+	return m.beginFunc(ctx, opts, fn)
+}
+
+func (m *TxManager) runTx(ctx context.Context, pool *pgxpool.Pool, opts pgx.TxOptions, fn func(ctxTx context.Context) error) error {
+	tx, err := pool.BeginTx(ctx, opts)
 	if err != nil {
 		return err
 	}
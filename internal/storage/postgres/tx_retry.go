@@ -0,0 +1,118 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gitlab.ozon.dev/chppppr/homework/internal/metrics"
+)
+
+const (
+	sqlStateSerializationFailure = "40001"
+	sqlStateDeadlockDetected     = "40P01"
+
+	reasonSerializationFailure = "serialization_failure"
+	reasonDeadlockDetected     = "deadlock_detected"
+)
+
+type (
+	// RetryPolicy - политика повтора транзакции. MaxAttempts учитывает первую попытку,
+	// значение <= 1 отключает повторы
+	RetryPolicy struct {
+		MaxAttempts int           `mapstructure:"max_attempts"`
+		BaseDelay   time.Duration `mapstructure:"base_delay"`
+		MaxDelay    time.Duration `mapstructure:"max_delay"`
+	}
+
+	RetryConfig struct {
+		Serializable    RetryPolicy `mapstructure:"serializable"`
+		RepeatableRead  RetryPolicy `mapstructure:"repeatable_read"`
+		ReadCommitted   RetryPolicy `mapstructure:"read_committed"`
+		ReadUncommitted RetryPolicy `mapstructure:"read_uncommitted"`
+	}
+)
+
+func (c RetryConfig) policy(level pgx.TxIsoLevel) RetryPolicy {
+	switch level {
+	case pgx.Serializable:
+		return c.Serializable
+	case pgx.RepeatableRead:
+		return c.RepeatableRead
+	case pgx.ReadCommitted:
+		return c.ReadCommitted
+	case pgx.ReadUncommitted:
+		return c.ReadUncommitted
+	default:
+		return RetryPolicy{}
+	}
+}
+
+// backoff - full jitter: случайная задержка в [0, min(MaxDelay, BaseDelay*2^(attempt-1)))
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.BaseDelay << min(attempt-1, 30)
+	if d <= 0 || (p.MaxDelay > 0 && d > p.MaxDelay) {
+		d = p.MaxDelay
+	}
+	if d <= 0 {
+		return 0
+	}
+	return rand.N(d)
+}
+
+func retryReason(err error) (string, bool) {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return "", false
+	}
+
+	switch pgErr.Code {
+	case sqlStateSerializationFailure:
+		return reasonSerializationFailure, true
+	case sqlStateDeadlockDetected:
+		return reasonDeadlockDetected, true
+	default:
+		return "", false
+	}
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// shouldRetry решает, повторять ли транзакцию после ошибки, и выжидает backoff
+func shouldRetry(ctx context.Context, p RetryPolicy, isolation string, attempt int, err error) bool {
+	reason, ok := retryReason(err)
+	if !ok {
+		return false
+	}
+
+	if attempt >= p.MaxAttempts {
+		metrics.IncTxRetriesExhausted(isolation, reason)
+		return false
+	}
+
+	metrics.IncTxRetries(isolation, reason)
+	return sleep(ctx, p.backoff(attempt)) == nil
+}
+
+// withRetry повторяет fn целиком, пока она падает на serialization failure или deadlock
+func withRetry(ctx context.Context, p RetryPolicy, isolation string, fn func() error) error {
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if !shouldRetry(ctx, p, isolation, attempt, err) {
+			return err
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/require"
+)
+
+func pgError(code string) error {
+	return fmt.Errorf("SetOrderStatus: %w", &pgconn.PgError{Code: code})
+}
+
+func TestWithRetry_RetriesUntilSuccess(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 5, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+	errs := []error{pgError(sqlStateSerializationFailure), pgError(sqlStateDeadlockDetected), nil}
+
+	calls := 0
+	err := withRetry(context.Background(), p, "serializable", func() error {
+		calls++
+		return errs[calls-1]
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, calls)
+}
+
+func TestWithRetry_Exhausted(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+
+	calls := 0
+	err := withRetry(context.Background(), p, "serializable", func() error {
+		calls++
+		return pgError(sqlStateSerializationFailure)
+	})
+	var pgErr *pgconn.PgError
+	require.ErrorAs(t, err, &pgErr)
+	require.Equal(t, 3, calls)
+}
+
+func TestWithRetry_NotRetryable(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 5}
+	someErr := errors.New("some error")
+
+	calls := 0
+	err := withRetry(context.Background(), p, "serializable", func() error {
+		calls++
+		return someErr
+	})
+	require.ErrorIs(t, err, someErr)
+	require.Equal(t, 1, calls)
+
+	calls = 0
+	err = withRetry(context.Background(), p, "serializable", func() error {
+		calls++
+		return pgError("23505")
+	})
+	require.Error(t, err)
+	require.Equal(t, 1, calls)
+}
+
+func TestWithRetry_Disabled(t *testing.T) {
+	calls := 0
+	err := withRetry(context.Background(), RetryPolicy{}, "read committed", func() error {
+		calls++
+		return pgError(sqlStateSerializationFailure)
+	})
+	require.Error(t, err)
+	require.Equal(t, 1, calls)
+}
+
+func TestWithRetry_ContextCanceled(t *testing.T) {
+	p := RetryPolicy{MaxAttempts: 5, BaseDelay: time.Hour, MaxDelay: time.Hour}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	calls := 0
+	err := withRetry(ctx, p, "serializable", func() error {
+		calls++
+		return pgError(sqlStateSerializationFailure)
+	})
+	require.Error(t, err)
+	require.Equal(t, 1, calls)
+}
+
+func TestRetryPolicy_Backoff(t *testing.T) {
+	p := RetryPolicy{BaseDelay: 10 * time.Millisecond, MaxDelay: 50 * time.Millisecond}
+
+	for attempt := 1; attempt < 40; attempt++ {
+		d := p.backoff(attempt)
+		require.GreaterOrEqual(t, d, time.Duration(0))
+		require.Less(t, d, 50*time.Millisecond)
+	}
+	require.Less(t, p.backoff(1), 10*time.Millisecond)
+	require.Zero(t, RetryPolicy{}.backoff(1))
+}
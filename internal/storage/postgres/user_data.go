@@ -0,0 +1,123 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/georgysavva/scany/v2/pgxscan"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+)
+
+// GetUserOrders возвращает все заказы клиента, включая архивные
+func (pg *PgRepository) GetUserOrders(ctx context.Context, userID uint64) ([]domain.OrderRecord, error) {
+	var orders []domain.OrderRecord
+
+	tx := pg.txManager.GetQueryEngine(ctx)
+	if err := pgxscan.Select(ctx, tx, &orders,
+		`select
+		 order_id,
+		 user_id,
+		 to_char(expiration_date, 'DD-MM-YYYY') as expiration_date,
+		 package_type,
+		 weight,
+		 cost,
+		 use_tape,
+		 status,
+		 to_char(accepted_at, 'DD-MM-YYYY') as accepted_at,
+		 to_char(updated_at, 'DD-MM-YYYY') as updated_at,
+		 version
+		 from orders_all
+		 where user_id = $1
+		 order by order_id`,
+		userID,
+	); err != nil {
+		return nil, fmt.Errorf("GetUserOrders: %w", err)
+	}
+
+	return orders, nil
+}
+
+func (pg *PgRepository) GetUserRefunds(ctx context.Context, userID uint64) ([]uint64, error) {
+	var orders []uint64
+
+	tx := pg.txManager.GetQueryEngine(ctx)
+	if err := pgxscan.Select(ctx, tx, &orders,
+		`select order_id
+		 from orders
+		 where user_id = $1 and `+refundPredicate+`
+		 order by order_id`,
+		userID,
+	); err != nil {
+		return nil, fmt.Errorf("GetUserRefunds: %w", err)
+	}
+
+	return orders, nil
+}
+
+// GetUserReminders возвращает напоминания по заказам клиента. Напоминания
+// архивных заказов удаляются при переносе в архив
+func (pg *PgRepository) GetUserReminders(ctx context.Context, userID uint64) ([]domain.Reminder, error) {
+	var reminders []domain.Reminder
+
+	tx := pg.txManager.GetQueryEngine(ctx)
+	if err := pgxscan.Select(ctx, tx, &reminders,
+		`select r.order_id, r.stage, r.sent_at
+		 from order_reminders r
+		 join orders o on o.order_id = r.order_id
+		 where o.user_id = $1
+		 order by r.order_id, r.stage`,
+		userID,
+	); err != nil {
+		return nil, fmt.Errorf("GetUserReminders: %w", err)
+	}
+
+	return reminders, nil
+}
+
+// HasHeldOrders блокирует все активные заказы клиента до конца транзакции,
+// поэтому их статусы не меняются между проверкой и заменой клиента
+func (pg *PgRepository) HasHeldOrders(ctx context.Context, userID uint64) (bool, error) {
+	var exist bool
+
+	tx := pg.txManager.GetQueryEngine(ctx)
+	if err := pgxscan.Get(ctx, tx, &exist,
+		`select coalesce(bool_or(held), false)
+		 from (
+			select (`+heldPredicate+`) as held
+			from orders
+			where user_id = $1
+			for update
+		 ) locked`,
+		userID,
+	); err != nil {
+		return false, fmt.Errorf("HasHeldOrders: %w", err)
+	}
+
+	return exist, nil
+}
+
+// PseudonymizeUser заменяет клиента псевдонимом в активных и архивных заказах.
+// Версия и даты заказов не меняются: это не смена статуса. Заказ на хранении,
+// принятый после проверки в HasHeldOrders, остаётся за клиентом
+func (pg *PgRepository) PseudonymizeUser(ctx context.Context, userID, pseudonym uint64) ([]uint64, error) {
+	var orders []uint64
+
+	tx := pg.txManager.GetQueryEngine(ctx)
+	if err := pgxscan.Select(ctx, tx, &orders,
+		`with active as (
+			update orders set user_id = $2 where user_id = $1 and not (`+heldPredicate+`) returning order_id
+		), archived as (
+			update orders_archive set user_id = $2 where user_id = $1 returning order_id
+		)
+		select order_id from active
+		union all
+		select order_id from archived
+		order by order_id`,
+		userID,
+		pseudonym,
+	); err != nil {
+		return nil, fmt.Errorf("PseudonymizeUser: %w", err)
+	}
+
+	return orders, nil
+}
@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"time"
 
 	"gitlab.ozon.dev/chppppr/homework/internal/domain"
@@ -8,29 +9,90 @@ import (
 
 type (
 	RefundsRepository interface {
-		AddRefund(userID, orderID uint64, order *domain.Order) error
-		RemoveRefund(orderID uint64) error
-		GetRefunds(pageID, ordersPerPage uint64) ([]domain.OrderView, error)
+		AddRefund(ctx context.Context, userID, orderID uint64, order *domain.Order) error
+		// RemoveRefund отдаёт возврат курьеру: снимает его и ставит статус
+		// StatusGiveCourier одним изменением, версия заказа растёт на один
+		RemoveRefund(ctx context.Context, orderID uint64) error
+		GetRefunds(ctx context.Context, pageID, ordersPerPage uint64) ([]domain.OrderView, error)
 	}
 
 	OrdersHistoryRepository interface {
-		AddOrderStatus(orderID, userID uint64, status string, order *domain.Order) error
-		GetOrderStatus(orderID uint64) (*domain.OrderStatus, error)
-		GetOrderOnlyStatus(orderID uint64) (stat string, err error)
-		SetOrderStatus(orderID uint64, status string) error
+		AddOrderStatus(ctx context.Context, orderID, userID uint64, status string, order *domain.Order) error
+		GetOrderStatus(ctx context.Context, orderID uint64) (*domain.OrderStatus, error)
+		GetOrderOnlyStatus(ctx context.Context, orderID uint64) (stat string, err error)
+		SetOrderStatus(ctx context.Context, orderID uint64, status string) error
+		ExpireOrders(ctx context.Context, expiredBefore time.Time) ([]uint64, error)
+		GetOrdersCountByStatus(ctx context.Context, status string) (uint64, error)
+		GetOrdersToRemind(ctx context.Context, stage uint64, expiresFrom, expiresTo time.Time) ([]domain.OrderView, error)
+		// AddReminders отмечает напоминания как ожидающие отправки: такие заказы больше
+		// не выбираются GetOrdersToRemind, а отдаются GetPendingReminders до MarkRemindersSent
+		AddReminders(ctx context.Context, stage uint64, ordersID []uint64) error
+		GetPendingReminders(ctx context.Context, stage uint64) ([]domain.OrderView, error)
+		MarkRemindersSent(ctx context.Context, stage uint64, ordersID []uint64) error
+		SearchOrders(ctx context.Context, filter *domain.OrderFilter) ([]domain.OrderRecord, error)
 	}
 
 	UsersRepository interface {
-		AddOrder(userID, orderID uint64, order *domain.Order) error
-		GetOrder(userID, orderID uint64) (*domain.Order, error)
-		RemoveOrder(orderID uint64, status string) error
-		RemoveOrders(ordersID []uint64, status string) error
-		CanRemoveOrder(orderID uint64) error
-		GetExpirationDate(userID, orderID uint64) (time.Time, error)
-		GetOrdersByUserID(userID, firstOrderID, limit uint64) ([]domain.OrderView, error)
+		AddOrder(ctx context.Context, userID, orderID uint64, order *domain.Order) error
+		GetOrder(ctx context.Context, userID, orderID uint64) (*domain.Order, error)
+		RemoveOrder(ctx context.Context, orderID uint64, status string) error
+		RemoveOrders(ctx context.Context, ordersID []uint64, status string) error
+		CanRemoveOrder(ctx context.Context, orderID uint64) error
+		GetExpirationDate(ctx context.Context, userID, orderID uint64) (time.Time, error)
+		GetOrdersByUserID(ctx context.Context, userID, firstOrderID, limit uint64) ([]domain.OrderView, error)
+	}
+
+	// Transactor позволяет выполнить проверку и смену статуса заказа атомарно:
+	// GetOrderStatusForUpdate внутри RunInTx блокирует заказ до конца транзакции.
+	// Postgres и memory откатывают изменения, если fn вернула ошибку; storage_json
+	// только сериализует транзакции, и сделанные до ошибки изменения в нём остаются
+	Transactor interface {
+		RunInTx(ctx context.Context, fn func(ctxTx context.Context) error) error
+		GetOrderStatusForUpdate(ctx context.Context, orderID uint64) (*domain.OrderStatus, error)
+	}
+
+	// Restorer загружает заказы как есть, с датами и версией, например при переносе
+	// между хранилищами. Принятый или просроченный заказ попадает на хранение в ПВЗ,
+	// возвращённый - в список возвратов
+	Restorer interface {
+		RestoreOrders(ctx context.Context, orders []domain.OrderRecord) error
+	}
+
+	// Archiver переносит завершённые заказы в архив, откуда они по-прежнему читаются
+	// по номеру заказа и в истории клиента, и удаляет устаревшие части архива.
+	// DropArchive возвращает удалённые части и номера удалённых с ними заказов
+	Archiver interface {
+		ArchiveOrders(ctx context.Context, before time.Time, limit uint64) ([]uint64, error)
+		DropArchive(ctx context.Context, before time.Time) ([]string, []uint64, error)
+	}
+
+	// RemindersReader отдаёт отправленные напоминания по заказам, например для резервной копии
+	RemindersReader interface {
+		GetReminders(ctx context.Context, ordersID []uint64) ([]domain.Reminder, error)
+	}
+
+	// Snapshotter выполняет fn так, что все чтения внутри видят одно и то же состояние хранилища
+	Snapshotter interface {
+		RunInSnapshot(ctx context.Context, fn func(ctxTx context.Context) error) error
+	}
+
+	// UserData отвечает на запросы клиента о его данных. EraseUserData заменяет
+	// клиента псевдонимом во всех заказах, оставляя их суммы и статусы, и возвращает
+	// изменённые заказы. Пока у клиента есть заказы на хранении, стирать нельзя
+	UserData interface {
+		ExportUserData(ctx context.Context, userID uint64) (*domain.UserData, error)
+		EraseUserData(ctx context.Context, userID, pseudonym uint64) ([]uint64, error)
+	}
+
+	// AuditLog - журнал изменяющих вызовов API. Записи только добавляются
+	// и связаны хэшами в цепочку, записи отдаются по возрастанию номера
+	AuditLog interface {
+		AppendAudit(ctx context.Context, r *domain.AuditRecord) error
+		QueryAuditLog(ctx context.Context, filter *domain.AuditFilter) ([]domain.AuditRecord, error)
 	}
 
 	Storage interface {
+		Transactor
 		RefundsRepository
 		OrdersHistoryRepository
 		UsersRepository
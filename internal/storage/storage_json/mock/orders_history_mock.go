@@ -2,52 +2,110 @@
 
 package mock
 
-//go:generate minimock -i gitlab.ozon.dev/chppppr/homework/internal/storage.OrdersHistoryRepository -o orders_history_mock.go -n OrdersHistoryRepositoryMock -p mock
+//go:generate minimock -i gitlab.ozon.dev/chppppr/homework/internal/storage/storage_json.OrdersHistoryRepository -o orders_history_mock.go -n OrdersHistoryRepositoryMock -p mock
 
 import (
+	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/gojuno/minimock/v3"
 	"gitlab.ozon.dev/chppppr/homework/internal/domain"
 )
 
-// OrdersHistoryRepositoryMock implements mm_storage.OrdersHistoryRepository
+// OrdersHistoryRepositoryMock implements mm_storage_json.OrdersHistoryRepository
 type OrdersHistoryRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcAddOrderStatus          func(orderID uint64, userID uint64, status string, order *domain.Order) (err error)
+	funcAddOrderStatus          func(ctx context.Context, orderID uint64, userID uint64, status string, order *domain.Order) (err error)
 	funcAddOrderStatusOrigin    string
-	inspectFuncAddOrderStatus   func(orderID uint64, userID uint64, status string, order *domain.Order)
+	inspectFuncAddOrderStatus   func(ctx context.Context, orderID uint64, userID uint64, status string, order *domain.Order)
 	afterAddOrderStatusCounter  uint64
 	beforeAddOrderStatusCounter uint64
 	AddOrderStatusMock          mOrdersHistoryRepositoryMockAddOrderStatus
 
-	funcGetOrderOnlyStatus          func(orderID uint64) (stat string, err error)
+	funcAddReminders          func(ctx context.Context, stage uint64, ordersID []uint64) (err error)
+	funcAddRemindersOrigin    string
+	inspectFuncAddReminders   func(ctx context.Context, stage uint64, ordersID []uint64)
+	afterAddRemindersCounter  uint64
+	beforeAddRemindersCounter uint64
+	AddRemindersMock          mOrdersHistoryRepositoryMockAddReminders
+
+	funcExpireOrders          func(ctx context.Context, expiredBefore time.Time) (ua1 []uint64, err error)
+	funcExpireOrdersOrigin    string
+	inspectFuncExpireOrders   func(ctx context.Context, expiredBefore time.Time)
+	afterExpireOrdersCounter  uint64
+	beforeExpireOrdersCounter uint64
+	ExpireOrdersMock          mOrdersHistoryRepositoryMockExpireOrders
+
+	funcGetOrderOnlyStatus          func(ctx context.Context, orderID uint64) (stat string, err error)
 	funcGetOrderOnlyStatusOrigin    string
-	inspectFuncGetOrderOnlyStatus   func(orderID uint64)
+	inspectFuncGetOrderOnlyStatus   func(ctx context.Context, orderID uint64)
 	afterGetOrderOnlyStatusCounter  uint64
 	beforeGetOrderOnlyStatusCounter uint64
 	GetOrderOnlyStatusMock          mOrdersHistoryRepositoryMockGetOrderOnlyStatus
 
-	funcGetOrderStatus          func(orderID uint64) (op1 *domain.OrderStatus, err error)
+	funcGetOrderStatus          func(ctx context.Context, orderID uint64) (op1 *domain.OrderStatus, err error)
 	funcGetOrderStatusOrigin    string
-	inspectFuncGetOrderStatus   func(orderID uint64)
+	inspectFuncGetOrderStatus   func(ctx context.Context, orderID uint64)
 	afterGetOrderStatusCounter  uint64
 	beforeGetOrderStatusCounter uint64
 	GetOrderStatusMock          mOrdersHistoryRepositoryMockGetOrderStatus
 
-	funcSetOrderStatus          func(orderID uint64, status string) (err error)
+	funcGetOrdersCountByStatus          func(ctx context.Context, status string) (u1 uint64, err error)
+	funcGetOrdersCountByStatusOrigin    string
+	inspectFuncGetOrdersCountByStatus   func(ctx context.Context, status string)
+	afterGetOrdersCountByStatusCounter  uint64
+	beforeGetOrdersCountByStatusCounter uint64
+	GetOrdersCountByStatusMock          mOrdersHistoryRepositoryMockGetOrdersCountByStatus
+
+	funcGetOrdersToRemind          func(ctx context.Context, stage uint64, expiresFrom time.Time, expiresTo time.Time) (oa1 []domain.OrderView, err error)
+	funcGetOrdersToRemindOrigin    string
+	inspectFuncGetOrdersToRemind   func(ctx context.Context, stage uint64, expiresFrom time.Time, expiresTo time.Time)
+	afterGetOrdersToRemindCounter  uint64
+	beforeGetOrdersToRemindCounter uint64
+	GetOrdersToRemindMock          mOrdersHistoryRepositoryMockGetOrdersToRemind
+
+	funcGetPendingReminders          func(ctx context.Context, stage uint64) (oa1 []domain.OrderView, err error)
+	funcGetPendingRemindersOrigin    string
+	inspectFuncGetPendingReminders   func(ctx context.Context, stage uint64)
+	afterGetPendingRemindersCounter  uint64
+	beforeGetPendingRemindersCounter uint64
+	GetPendingRemindersMock          mOrdersHistoryRepositoryMockGetPendingReminders
+
+	funcMarkRemindersSent          func(ctx context.Context, stage uint64, ordersID []uint64) (err error)
+	funcMarkRemindersSentOrigin    string
+	inspectFuncMarkRemindersSent   func(ctx context.Context, stage uint64, ordersID []uint64)
+	afterMarkRemindersSentCounter  uint64
+	beforeMarkRemindersSentCounter uint64
+	MarkRemindersSentMock          mOrdersHistoryRepositoryMockMarkRemindersSent
+
+	funcRestoreOrderStatus          func(orderID uint64, stat *domain.OrderStatus)
+	funcRestoreOrderStatusOrigin    string
+	inspectFuncRestoreOrderStatus   func(orderID uint64, stat *domain.OrderStatus)
+	afterRestoreOrderStatusCounter  uint64
+	beforeRestoreOrderStatusCounter uint64
+	RestoreOrderStatusMock          mOrdersHistoryRepositoryMockRestoreOrderStatus
+
+	funcSearchOrders          func(ctx context.Context, filter *domain.OrderFilter) (oa1 []domain.OrderRecord, err error)
+	funcSearchOrdersOrigin    string
+	inspectFuncSearchOrders   func(ctx context.Context, filter *domain.OrderFilter)
+	afterSearchOrdersCounter  uint64
+	beforeSearchOrdersCounter uint64
+	SearchOrdersMock          mOrdersHistoryRepositoryMockSearchOrders
+
+	funcSetOrderStatus          func(ctx context.Context, orderID uint64, status string) (err error)
 	funcSetOrderStatusOrigin    string
-	inspectFuncSetOrderStatus   func(orderID uint64, status string)
+	inspectFuncSetOrderStatus   func(ctx context.Context, orderID uint64, status string)
 	afterSetOrderStatusCounter  uint64
 	beforeSetOrderStatusCounter uint64
 	SetOrderStatusMock          mOrdersHistoryRepositoryMockSetOrderStatus
 }
 
-// NewOrdersHistoryRepositoryMock returns a mock for mm_storage.OrdersHistoryRepository
+// NewOrdersHistoryRepositoryMock returns a mock for mm_storage_json.OrdersHistoryRepository
 func NewOrdersHistoryRepositoryMock(t minimock.Tester) *OrdersHistoryRepositoryMock {
 	m := &OrdersHistoryRepositoryMock{t: t}
 
@@ -58,12 +116,36 @@ func NewOrdersHistoryRepositoryMock(t minimock.Tester) *OrdersHistoryRepositoryM
 	m.AddOrderStatusMock = mOrdersHistoryRepositoryMockAddOrderStatus{mock: m}
 	m.AddOrderStatusMock.callArgs = []*OrdersHistoryRepositoryMockAddOrderStatusParams{}
 
+	m.AddRemindersMock = mOrdersHistoryRepositoryMockAddReminders{mock: m}
+	m.AddRemindersMock.callArgs = []*OrdersHistoryRepositoryMockAddRemindersParams{}
+
+	m.ExpireOrdersMock = mOrdersHistoryRepositoryMockExpireOrders{mock: m}
+	m.ExpireOrdersMock.callArgs = []*OrdersHistoryRepositoryMockExpireOrdersParams{}
+
 	m.GetOrderOnlyStatusMock = mOrdersHistoryRepositoryMockGetOrderOnlyStatus{mock: m}
 	m.GetOrderOnlyStatusMock.callArgs = []*OrdersHistoryRepositoryMockGetOrderOnlyStatusParams{}
 
 	m.GetOrderStatusMock = mOrdersHistoryRepositoryMockGetOrderStatus{mock: m}
 	m.GetOrderStatusMock.callArgs = []*OrdersHistoryRepositoryMockGetOrderStatusParams{}
 
+	m.GetOrdersCountByStatusMock = mOrdersHistoryRepositoryMockGetOrdersCountByStatus{mock: m}
+	m.GetOrdersCountByStatusMock.callArgs = []*OrdersHistoryRepositoryMockGetOrdersCountByStatusParams{}
+
+	m.GetOrdersToRemindMock = mOrdersHistoryRepositoryMockGetOrdersToRemind{mock: m}
+	m.GetOrdersToRemindMock.callArgs = []*OrdersHistoryRepositoryMockGetOrdersToRemindParams{}
+
+	m.GetPendingRemindersMock = mOrdersHistoryRepositoryMockGetPendingReminders{mock: m}
+	m.GetPendingRemindersMock.callArgs = []*OrdersHistoryRepositoryMockGetPendingRemindersParams{}
+
+	m.MarkRemindersSentMock = mOrdersHistoryRepositoryMockMarkRemindersSent{mock: m}
+	m.MarkRemindersSentMock.callArgs = []*OrdersHistoryRepositoryMockMarkRemindersSentParams{}
+
+	m.RestoreOrderStatusMock = mOrdersHistoryRepositoryMockRestoreOrderStatus{mock: m}
+	m.RestoreOrderStatusMock.callArgs = []*OrdersHistoryRepositoryMockRestoreOrderStatusParams{}
+
+	m.SearchOrdersMock = mOrdersHistoryRepositoryMockSearchOrders{mock: m}
+	m.SearchOrdersMock.callArgs = []*OrdersHistoryRepositoryMockSearchOrdersParams{}
+
 	m.SetOrderStatusMock = mOrdersHistoryRepositoryMockSetOrderStatus{mock: m}
 	m.SetOrderStatusMock.callArgs = []*OrdersHistoryRepositoryMockSetOrderStatusParams{}
 
@@ -98,6 +180,7 @@ type OrdersHistoryRepositoryMockAddOrderStatusExpectation struct {
 
 // OrdersHistoryRepositoryMockAddOrderStatusParams contains parameters of the OrdersHistoryRepository.AddOrderStatus
 type OrdersHistoryRepositoryMockAddOrderStatusParams struct {
+	ctx     context.Context
 	orderID uint64
 	userID  uint64
 	status  string
@@ -106,6 +189,7 @@ type OrdersHistoryRepositoryMockAddOrderStatusParams struct {
 
 // OrdersHistoryRepositoryMockAddOrderStatusParamPtrs contains pointers to parameters of the OrdersHistoryRepository.AddOrderStatus
 type OrdersHistoryRepositoryMockAddOrderStatusParamPtrs struct {
+	ctx     *context.Context
 	orderID *uint64
 	userID  *uint64
 	status  *string
@@ -120,6 +204,7 @@ type OrdersHistoryRepositoryMockAddOrderStatusResults struct {
 // OrdersHistoryRepositoryMockAddOrderStatusOrigins contains origins of expectations of the OrdersHistoryRepository.AddOrderStatus
 type OrdersHistoryRepositoryMockAddOrderStatusExpectationOrigins struct {
 	origin        string
+	originCtx     string
 	originOrderID string
 	originUserID  string
 	originStatus  string
@@ -137,7 +222,7 @@ func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) Optional() *
 }
 
 // Expect sets up expected params for OrdersHistoryRepository.AddOrderStatus
-func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) Expect(orderID uint64, userID uint64, status string, order *domain.Order) *mOrdersHistoryRepositoryMockAddOrderStatus {
+func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) Expect(ctx context.Context, orderID uint64, userID uint64, status string, order *domain.Order) *mOrdersHistoryRepositoryMockAddOrderStatus {
 	if mmAddOrderStatus.mock.funcAddOrderStatus != nil {
 		mmAddOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.AddOrderStatus mock is already set by Set")
 	}
@@ -150,7 +235,7 @@ func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) Expect(order
 		mmAddOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.AddOrderStatus mock is already set by ExpectParams functions")
 	}
 
-	mmAddOrderStatus.defaultExpectation.params = &OrdersHistoryRepositoryMockAddOrderStatusParams{orderID, userID, status, order}
+	mmAddOrderStatus.defaultExpectation.params = &OrdersHistoryRepositoryMockAddOrderStatusParams{ctx, orderID, userID, status, order}
 	mmAddOrderStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmAddOrderStatus.expectations {
 		if minimock.Equal(e.params, mmAddOrderStatus.defaultExpectation.params) {
@@ -161,8 +246,31 @@ func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) Expect(order
 	return mmAddOrderStatus
 }
 
-// ExpectOrderIDParam1 sets up expected param orderID for OrdersHistoryRepository.AddOrderStatus
-func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) ExpectOrderIDParam1(orderID uint64) *mOrdersHistoryRepositoryMockAddOrderStatus {
+// ExpectCtxParam1 sets up expected param ctx for OrdersHistoryRepository.AddOrderStatus
+func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) ExpectCtxParam1(ctx context.Context) *mOrdersHistoryRepositoryMockAddOrderStatus {
+	if mmAddOrderStatus.mock.funcAddOrderStatus != nil {
+		mmAddOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.AddOrderStatus mock is already set by Set")
+	}
+
+	if mmAddOrderStatus.defaultExpectation == nil {
+		mmAddOrderStatus.defaultExpectation = &OrdersHistoryRepositoryMockAddOrderStatusExpectation{}
+	}
+
+	if mmAddOrderStatus.defaultExpectation.params != nil {
+		mmAddOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.AddOrderStatus mock is already set by Expect")
+	}
+
+	if mmAddOrderStatus.defaultExpectation.paramPtrs == nil {
+		mmAddOrderStatus.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockAddOrderStatusParamPtrs{}
+	}
+	mmAddOrderStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddOrderStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddOrderStatus
+}
+
+// ExpectOrderIDParam2 sets up expected param orderID for OrdersHistoryRepository.AddOrderStatus
+func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) ExpectOrderIDParam2(orderID uint64) *mOrdersHistoryRepositoryMockAddOrderStatus {
 	if mmAddOrderStatus.mock.funcAddOrderStatus != nil {
 		mmAddOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.AddOrderStatus mock is already set by Set")
 	}
@@ -184,8 +292,8 @@ func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) ExpectOrderI
 	return mmAddOrderStatus
 }
 
-// ExpectUserIDParam2 sets up expected param userID for OrdersHistoryRepository.AddOrderStatus
-func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) ExpectUserIDParam2(userID uint64) *mOrdersHistoryRepositoryMockAddOrderStatus {
+// ExpectUserIDParam3 sets up expected param userID for OrdersHistoryRepository.AddOrderStatus
+func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) ExpectUserIDParam3(userID uint64) *mOrdersHistoryRepositoryMockAddOrderStatus {
 	if mmAddOrderStatus.mock.funcAddOrderStatus != nil {
 		mmAddOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.AddOrderStatus mock is already set by Set")
 	}
@@ -207,8 +315,8 @@ func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) ExpectUserID
 	return mmAddOrderStatus
 }
 
-// ExpectStatusParam3 sets up expected param status for OrdersHistoryRepository.AddOrderStatus
-func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) ExpectStatusParam3(status string) *mOrdersHistoryRepositoryMockAddOrderStatus {
+// ExpectStatusParam4 sets up expected param status for OrdersHistoryRepository.AddOrderStatus
+func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) ExpectStatusParam4(status string) *mOrdersHistoryRepositoryMockAddOrderStatus {
 	if mmAddOrderStatus.mock.funcAddOrderStatus != nil {
 		mmAddOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.AddOrderStatus mock is already set by Set")
 	}
@@ -230,8 +338,8 @@ func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) ExpectStatus
 	return mmAddOrderStatus
 }
 
-// ExpectOrderParam4 sets up expected param order for OrdersHistoryRepository.AddOrderStatus
-func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) ExpectOrderParam4(order *domain.Order) *mOrdersHistoryRepositoryMockAddOrderStatus {
+// ExpectOrderParam5 sets up expected param order for OrdersHistoryRepository.AddOrderStatus
+func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) ExpectOrderParam5(order *domain.Order) *mOrdersHistoryRepositoryMockAddOrderStatus {
 	if mmAddOrderStatus.mock.funcAddOrderStatus != nil {
 		mmAddOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.AddOrderStatus mock is already set by Set")
 	}
@@ -254,7 +362,7 @@ func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) ExpectOrderP
 }
 
 // Inspect accepts an inspector function that has same arguments as the OrdersHistoryRepository.AddOrderStatus
-func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) Inspect(f func(orderID uint64, userID uint64, status string, order *domain.Order)) *mOrdersHistoryRepositoryMockAddOrderStatus {
+func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) Inspect(f func(ctx context.Context, orderID uint64, userID uint64, status string, order *domain.Order)) *mOrdersHistoryRepositoryMockAddOrderStatus {
 	if mmAddOrderStatus.mock.inspectFuncAddOrderStatus != nil {
 		mmAddOrderStatus.mock.t.Fatalf("Inspect function is already set for OrdersHistoryRepositoryMock.AddOrderStatus")
 	}
@@ -279,7 +387,7 @@ func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) Return(err e
 }
 
 // Set uses given function f to mock the OrdersHistoryRepository.AddOrderStatus method
-func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) Set(f func(orderID uint64, userID uint64, status string, order *domain.Order) (err error)) *OrdersHistoryRepositoryMock {
+func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) Set(f func(ctx context.Context, orderID uint64, userID uint64, status string, order *domain.Order) (err error)) *OrdersHistoryRepositoryMock {
 	if mmAddOrderStatus.defaultExpectation != nil {
 		mmAddOrderStatus.mock.t.Fatalf("Default expectation is already set for the OrdersHistoryRepository.AddOrderStatus method")
 	}
@@ -295,14 +403,14 @@ func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) Set(f func(o
 
 // When sets expectation for the OrdersHistoryRepository.AddOrderStatus which will trigger the result defined by the following
 // Then helper
-func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) When(orderID uint64, userID uint64, status string, order *domain.Order) *OrdersHistoryRepositoryMockAddOrderStatusExpectation {
+func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) When(ctx context.Context, orderID uint64, userID uint64, status string, order *domain.Order) *OrdersHistoryRepositoryMockAddOrderStatusExpectation {
 	if mmAddOrderStatus.mock.funcAddOrderStatus != nil {
 		mmAddOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.AddOrderStatus mock is already set by Set")
 	}
 
 	expectation := &OrdersHistoryRepositoryMockAddOrderStatusExpectation{
 		mock:               mmAddOrderStatus.mock,
-		params:             &OrdersHistoryRepositoryMockAddOrderStatusParams{orderID, userID, status, order},
+		params:             &OrdersHistoryRepositoryMockAddOrderStatusParams{ctx, orderID, userID, status, order},
 		expectationOrigins: OrdersHistoryRepositoryMockAddOrderStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmAddOrderStatus.expectations = append(mmAddOrderStatus.expectations, expectation)
@@ -336,18 +444,18 @@ func (mmAddOrderStatus *mOrdersHistoryRepositoryMockAddOrderStatus) invocationsD
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// AddOrderStatus implements mm_storage.OrdersHistoryRepository
-func (mmAddOrderStatus *OrdersHistoryRepositoryMock) AddOrderStatus(orderID uint64, userID uint64, status string, order *domain.Order) (err error) {
+// AddOrderStatus implements mm_storage_json.OrdersHistoryRepository
+func (mmAddOrderStatus *OrdersHistoryRepositoryMock) AddOrderStatus(ctx context.Context, orderID uint64, userID uint64, status string, order *domain.Order) (err error) {
 	mm_atomic.AddUint64(&mmAddOrderStatus.beforeAddOrderStatusCounter, 1)
 	defer mm_atomic.AddUint64(&mmAddOrderStatus.afterAddOrderStatusCounter, 1)
 
 	mmAddOrderStatus.t.Helper()
 
 	if mmAddOrderStatus.inspectFuncAddOrderStatus != nil {
-		mmAddOrderStatus.inspectFuncAddOrderStatus(orderID, userID, status, order)
+		mmAddOrderStatus.inspectFuncAddOrderStatus(ctx, orderID, userID, status, order)
 	}
 
-	mm_params := OrdersHistoryRepositoryMockAddOrderStatusParams{orderID, userID, status, order}
+	mm_params := OrdersHistoryRepositoryMockAddOrderStatusParams{ctx, orderID, userID, status, order}
 
 	// Record call args
 	mmAddOrderStatus.AddOrderStatusMock.mutex.Lock()
@@ -366,10 +474,15 @@ func (mmAddOrderStatus *OrdersHistoryRepositoryMock) AddOrderStatus(orderID uint
 		mm_want := mmAddOrderStatus.AddOrderStatusMock.defaultExpectation.params
 		mm_want_ptrs := mmAddOrderStatus.AddOrderStatusMock.defaultExpectation.paramPtrs
 
-		mm_got := OrdersHistoryRepositoryMockAddOrderStatusParams{orderID, userID, status, order}
+		mm_got := OrdersHistoryRepositoryMockAddOrderStatusParams{ctx, orderID, userID, status, order}
 
 		if mm_want_ptrs != nil {
 
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddOrderStatus.t.Errorf("OrdersHistoryRepositoryMock.AddOrderStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddOrderStatus.AddOrderStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
 			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
 				mmAddOrderStatus.t.Errorf("OrdersHistoryRepositoryMock.AddOrderStatus got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 					mmAddOrderStatus.AddOrderStatusMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
@@ -402,9 +515,9 @@ func (mmAddOrderStatus *OrdersHistoryRepositoryMock) AddOrderStatus(orderID uint
 		return (*mm_results).err
 	}
 	if mmAddOrderStatus.funcAddOrderStatus != nil {
-		return mmAddOrderStatus.funcAddOrderStatus(orderID, userID, status, order)
+		return mmAddOrderStatus.funcAddOrderStatus(ctx, orderID, userID, status, order)
 	}
-	mmAddOrderStatus.t.Fatalf("Unexpected call to OrdersHistoryRepositoryMock.AddOrderStatus. %v %v %v %v", orderID, userID, status, order)
+	mmAddOrderStatus.t.Fatalf("Unexpected call to OrdersHistoryRepositoryMock.AddOrderStatus. %v %v %v %v %v", ctx, orderID, userID, status, order)
 	return
 }
 
@@ -476,50 +589,55 @@ func (m *OrdersHistoryRepositoryMock) MinimockAddOrderStatusInspect() {
 	}
 }
 
-type mOrdersHistoryRepositoryMockGetOrderOnlyStatus struct {
+type mOrdersHistoryRepositoryMockAddReminders struct {
 	optional           bool
 	mock               *OrdersHistoryRepositoryMock
-	defaultExpectation *OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectation
-	expectations       []*OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectation
+	defaultExpectation *OrdersHistoryRepositoryMockAddRemindersExpectation
+	expectations       []*OrdersHistoryRepositoryMockAddRemindersExpectation
 
-	callArgs []*OrdersHistoryRepositoryMockGetOrderOnlyStatusParams
+	callArgs []*OrdersHistoryRepositoryMockAddRemindersParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectation specifies expectation struct of the OrdersHistoryRepository.GetOrderOnlyStatus
-type OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectation struct {
+// OrdersHistoryRepositoryMockAddRemindersExpectation specifies expectation struct of the OrdersHistoryRepository.AddReminders
+type OrdersHistoryRepositoryMockAddRemindersExpectation struct {
 	mock               *OrdersHistoryRepositoryMock
-	params             *OrdersHistoryRepositoryMockGetOrderOnlyStatusParams
-	paramPtrs          *OrdersHistoryRepositoryMockGetOrderOnlyStatusParamPtrs
-	expectationOrigins OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectationOrigins
-	results            *OrdersHistoryRepositoryMockGetOrderOnlyStatusResults
+	params             *OrdersHistoryRepositoryMockAddRemindersParams
+	paramPtrs          *OrdersHistoryRepositoryMockAddRemindersParamPtrs
+	expectationOrigins OrdersHistoryRepositoryMockAddRemindersExpectationOrigins
+	results            *OrdersHistoryRepositoryMockAddRemindersResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// OrdersHistoryRepositoryMockGetOrderOnlyStatusParams contains parameters of the OrdersHistoryRepository.GetOrderOnlyStatus
-type OrdersHistoryRepositoryMockGetOrderOnlyStatusParams struct {
-	orderID uint64
+// OrdersHistoryRepositoryMockAddRemindersParams contains parameters of the OrdersHistoryRepository.AddReminders
+type OrdersHistoryRepositoryMockAddRemindersParams struct {
+	ctx      context.Context
+	stage    uint64
+	ordersID []uint64
 }
 
-// OrdersHistoryRepositoryMockGetOrderOnlyStatusParamPtrs contains pointers to parameters of the OrdersHistoryRepository.GetOrderOnlyStatus
-type OrdersHistoryRepositoryMockGetOrderOnlyStatusParamPtrs struct {
-	orderID *uint64
+// OrdersHistoryRepositoryMockAddRemindersParamPtrs contains pointers to parameters of the OrdersHistoryRepository.AddReminders
+type OrdersHistoryRepositoryMockAddRemindersParamPtrs struct {
+	ctx      *context.Context
+	stage    *uint64
+	ordersID *[]uint64
 }
 
-// OrdersHistoryRepositoryMockGetOrderOnlyStatusResults contains results of the OrdersHistoryRepository.GetOrderOnlyStatus
-type OrdersHistoryRepositoryMockGetOrderOnlyStatusResults struct {
-	stat string
-	err  error
+// OrdersHistoryRepositoryMockAddRemindersResults contains results of the OrdersHistoryRepository.AddReminders
+type OrdersHistoryRepositoryMockAddRemindersResults struct {
+	err error
 }
 
-// OrdersHistoryRepositoryMockGetOrderOnlyStatusOrigins contains origins of expectations of the OrdersHistoryRepository.GetOrderOnlyStatus
-type OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectationOrigins struct {
-	origin        string
-	originOrderID string
+// OrdersHistoryRepositoryMockAddRemindersOrigins contains origins of expectations of the OrdersHistoryRepository.AddReminders
+type OrdersHistoryRepositoryMockAddRemindersExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originStage    string
+	originOrdersID string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -527,311 +645,370 @@ type OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetOrderOnlyStatus *mOrdersHistoryRepositoryMockGetOrderOnlyStatus) Optional() *mOrdersHistoryRepositoryMockGetOrderOnlyStatus {
-	mmGetOrderOnlyStatus.optional = true
-	return mmGetOrderOnlyStatus
+func (mmAddReminders *mOrdersHistoryRepositoryMockAddReminders) Optional() *mOrdersHistoryRepositoryMockAddReminders {
+	mmAddReminders.optional = true
+	return mmAddReminders
 }
 
-// Expect sets up expected params for OrdersHistoryRepository.GetOrderOnlyStatus
-func (mmGetOrderOnlyStatus *mOrdersHistoryRepositoryMockGetOrderOnlyStatus) Expect(orderID uint64) *mOrdersHistoryRepositoryMockGetOrderOnlyStatus {
-	if mmGetOrderOnlyStatus.mock.funcGetOrderOnlyStatus != nil {
-		mmGetOrderOnlyStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderOnlyStatus mock is already set by Set")
+// Expect sets up expected params for OrdersHistoryRepository.AddReminders
+func (mmAddReminders *mOrdersHistoryRepositoryMockAddReminders) Expect(ctx context.Context, stage uint64, ordersID []uint64) *mOrdersHistoryRepositoryMockAddReminders {
+	if mmAddReminders.mock.funcAddReminders != nil {
+		mmAddReminders.mock.t.Fatalf("OrdersHistoryRepositoryMock.AddReminders mock is already set by Set")
 	}
 
-	if mmGetOrderOnlyStatus.defaultExpectation == nil {
-		mmGetOrderOnlyStatus.defaultExpectation = &OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectation{}
+	if mmAddReminders.defaultExpectation == nil {
+		mmAddReminders.defaultExpectation = &OrdersHistoryRepositoryMockAddRemindersExpectation{}
 	}
 
-	if mmGetOrderOnlyStatus.defaultExpectation.paramPtrs != nil {
-		mmGetOrderOnlyStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderOnlyStatus mock is already set by ExpectParams functions")
+	if mmAddReminders.defaultExpectation.paramPtrs != nil {
+		mmAddReminders.mock.t.Fatalf("OrdersHistoryRepositoryMock.AddReminders mock is already set by ExpectParams functions")
 	}
 
-	mmGetOrderOnlyStatus.defaultExpectation.params = &OrdersHistoryRepositoryMockGetOrderOnlyStatusParams{orderID}
-	mmGetOrderOnlyStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetOrderOnlyStatus.expectations {
-		if minimock.Equal(e.params, mmGetOrderOnlyStatus.defaultExpectation.params) {
-			mmGetOrderOnlyStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrderOnlyStatus.defaultExpectation.params)
+	mmAddReminders.defaultExpectation.params = &OrdersHistoryRepositoryMockAddRemindersParams{ctx, stage, ordersID}
+	mmAddReminders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmAddReminders.expectations {
+		if minimock.Equal(e.params, mmAddReminders.defaultExpectation.params) {
+			mmAddReminders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmAddReminders.defaultExpectation.params)
 		}
 	}
 
-	return mmGetOrderOnlyStatus
+	return mmAddReminders
 }
 
-// ExpectOrderIDParam1 sets up expected param orderID for OrdersHistoryRepository.GetOrderOnlyStatus
-func (mmGetOrderOnlyStatus *mOrdersHistoryRepositoryMockGetOrderOnlyStatus) ExpectOrderIDParam1(orderID uint64) *mOrdersHistoryRepositoryMockGetOrderOnlyStatus {
-	if mmGetOrderOnlyStatus.mock.funcGetOrderOnlyStatus != nil {
-		mmGetOrderOnlyStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderOnlyStatus mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for OrdersHistoryRepository.AddReminders
+func (mmAddReminders *mOrdersHistoryRepositoryMockAddReminders) ExpectCtxParam1(ctx context.Context) *mOrdersHistoryRepositoryMockAddReminders {
+	if mmAddReminders.mock.funcAddReminders != nil {
+		mmAddReminders.mock.t.Fatalf("OrdersHistoryRepositoryMock.AddReminders mock is already set by Set")
 	}
 
-	if mmGetOrderOnlyStatus.defaultExpectation == nil {
-		mmGetOrderOnlyStatus.defaultExpectation = &OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectation{}
+	if mmAddReminders.defaultExpectation == nil {
+		mmAddReminders.defaultExpectation = &OrdersHistoryRepositoryMockAddRemindersExpectation{}
 	}
 
-	if mmGetOrderOnlyStatus.defaultExpectation.params != nil {
-		mmGetOrderOnlyStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderOnlyStatus mock is already set by Expect")
+	if mmAddReminders.defaultExpectation.params != nil {
+		mmAddReminders.mock.t.Fatalf("OrdersHistoryRepositoryMock.AddReminders mock is already set by Expect")
 	}
 
-	if mmGetOrderOnlyStatus.defaultExpectation.paramPtrs == nil {
-		mmGetOrderOnlyStatus.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockGetOrderOnlyStatusParamPtrs{}
+	if mmAddReminders.defaultExpectation.paramPtrs == nil {
+		mmAddReminders.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockAddRemindersParamPtrs{}
 	}
-	mmGetOrderOnlyStatus.defaultExpectation.paramPtrs.orderID = &orderID
-	mmGetOrderOnlyStatus.defaultExpectation.expectationOrigins.originOrderID = minimock.CallerInfo(1)
+	mmAddReminders.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddReminders.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetOrderOnlyStatus
+	return mmAddReminders
 }
 
-// Inspect accepts an inspector function that has same arguments as the OrdersHistoryRepository.GetOrderOnlyStatus
-func (mmGetOrderOnlyStatus *mOrdersHistoryRepositoryMockGetOrderOnlyStatus) Inspect(f func(orderID uint64)) *mOrdersHistoryRepositoryMockGetOrderOnlyStatus {
-	if mmGetOrderOnlyStatus.mock.inspectFuncGetOrderOnlyStatus != nil {
-		mmGetOrderOnlyStatus.mock.t.Fatalf("Inspect function is already set for OrdersHistoryRepositoryMock.GetOrderOnlyStatus")
+// ExpectStageParam2 sets up expected param stage for OrdersHistoryRepository.AddReminders
+func (mmAddReminders *mOrdersHistoryRepositoryMockAddReminders) ExpectStageParam2(stage uint64) *mOrdersHistoryRepositoryMockAddReminders {
+	if mmAddReminders.mock.funcAddReminders != nil {
+		mmAddReminders.mock.t.Fatalf("OrdersHistoryRepositoryMock.AddReminders mock is already set by Set")
 	}
 
-	mmGetOrderOnlyStatus.mock.inspectFuncGetOrderOnlyStatus = f
+	if mmAddReminders.defaultExpectation == nil {
+		mmAddReminders.defaultExpectation = &OrdersHistoryRepositoryMockAddRemindersExpectation{}
+	}
 
-	return mmGetOrderOnlyStatus
+	if mmAddReminders.defaultExpectation.params != nil {
+		mmAddReminders.mock.t.Fatalf("OrdersHistoryRepositoryMock.AddReminders mock is already set by Expect")
+	}
+
+	if mmAddReminders.defaultExpectation.paramPtrs == nil {
+		mmAddReminders.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockAddRemindersParamPtrs{}
+	}
+	mmAddReminders.defaultExpectation.paramPtrs.stage = &stage
+	mmAddReminders.defaultExpectation.expectationOrigins.originStage = minimock.CallerInfo(1)
+
+	return mmAddReminders
 }
 
-// Return sets up results that will be returned by OrdersHistoryRepository.GetOrderOnlyStatus
-func (mmGetOrderOnlyStatus *mOrdersHistoryRepositoryMockGetOrderOnlyStatus) Return(stat string, err error) *OrdersHistoryRepositoryMock {
-	if mmGetOrderOnlyStatus.mock.funcGetOrderOnlyStatus != nil {
-		mmGetOrderOnlyStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderOnlyStatus mock is already set by Set")
+// ExpectOrdersIDParam3 sets up expected param ordersID for OrdersHistoryRepository.AddReminders
+func (mmAddReminders *mOrdersHistoryRepositoryMockAddReminders) ExpectOrdersIDParam3(ordersID []uint64) *mOrdersHistoryRepositoryMockAddReminders {
+	if mmAddReminders.mock.funcAddReminders != nil {
+		mmAddReminders.mock.t.Fatalf("OrdersHistoryRepositoryMock.AddReminders mock is already set by Set")
 	}
 
-	if mmGetOrderOnlyStatus.defaultExpectation == nil {
-		mmGetOrderOnlyStatus.defaultExpectation = &OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectation{mock: mmGetOrderOnlyStatus.mock}
+	if mmAddReminders.defaultExpectation == nil {
+		mmAddReminders.defaultExpectation = &OrdersHistoryRepositoryMockAddRemindersExpectation{}
 	}
-	mmGetOrderOnlyStatus.defaultExpectation.results = &OrdersHistoryRepositoryMockGetOrderOnlyStatusResults{stat, err}
-	mmGetOrderOnlyStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetOrderOnlyStatus.mock
+
+	if mmAddReminders.defaultExpectation.params != nil {
+		mmAddReminders.mock.t.Fatalf("OrdersHistoryRepositoryMock.AddReminders mock is already set by Expect")
+	}
+
+	if mmAddReminders.defaultExpectation.paramPtrs == nil {
+		mmAddReminders.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockAddRemindersParamPtrs{}
+	}
+	mmAddReminders.defaultExpectation.paramPtrs.ordersID = &ordersID
+	mmAddReminders.defaultExpectation.expectationOrigins.originOrdersID = minimock.CallerInfo(1)
+
+	return mmAddReminders
 }
 
-// Set uses given function f to mock the OrdersHistoryRepository.GetOrderOnlyStatus method
-func (mmGetOrderOnlyStatus *mOrdersHistoryRepositoryMockGetOrderOnlyStatus) Set(f func(orderID uint64) (stat string, err error)) *OrdersHistoryRepositoryMock {
-	if mmGetOrderOnlyStatus.defaultExpectation != nil {
-		mmGetOrderOnlyStatus.mock.t.Fatalf("Default expectation is already set for the OrdersHistoryRepository.GetOrderOnlyStatus method")
+// Inspect accepts an inspector function that has same arguments as the OrdersHistoryRepository.AddReminders
+func (mmAddReminders *mOrdersHistoryRepositoryMockAddReminders) Inspect(f func(ctx context.Context, stage uint64, ordersID []uint64)) *mOrdersHistoryRepositoryMockAddReminders {
+	if mmAddReminders.mock.inspectFuncAddReminders != nil {
+		mmAddReminders.mock.t.Fatalf("Inspect function is already set for OrdersHistoryRepositoryMock.AddReminders")
 	}
 
-	if len(mmGetOrderOnlyStatus.expectations) > 0 {
-		mmGetOrderOnlyStatus.mock.t.Fatalf("Some expectations are already set for the OrdersHistoryRepository.GetOrderOnlyStatus method")
+	mmAddReminders.mock.inspectFuncAddReminders = f
+
+	return mmAddReminders
+}
+
+// Return sets up results that will be returned by OrdersHistoryRepository.AddReminders
+func (mmAddReminders *mOrdersHistoryRepositoryMockAddReminders) Return(err error) *OrdersHistoryRepositoryMock {
+	if mmAddReminders.mock.funcAddReminders != nil {
+		mmAddReminders.mock.t.Fatalf("OrdersHistoryRepositoryMock.AddReminders mock is already set by Set")
 	}
 
-	mmGetOrderOnlyStatus.mock.funcGetOrderOnlyStatus = f
-	mmGetOrderOnlyStatus.mock.funcGetOrderOnlyStatusOrigin = minimock.CallerInfo(1)
-	return mmGetOrderOnlyStatus.mock
+	if mmAddReminders.defaultExpectation == nil {
+		mmAddReminders.defaultExpectation = &OrdersHistoryRepositoryMockAddRemindersExpectation{mock: mmAddReminders.mock}
+	}
+	mmAddReminders.defaultExpectation.results = &OrdersHistoryRepositoryMockAddRemindersResults{err}
+	mmAddReminders.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmAddReminders.mock
 }
 
-// When sets expectation for the OrdersHistoryRepository.GetOrderOnlyStatus which will trigger the result defined by the following
+// Set uses given function f to mock the OrdersHistoryRepository.AddReminders method
+func (mmAddReminders *mOrdersHistoryRepositoryMockAddReminders) Set(f func(ctx context.Context, stage uint64, ordersID []uint64) (err error)) *OrdersHistoryRepositoryMock {
+	if mmAddReminders.defaultExpectation != nil {
+		mmAddReminders.mock.t.Fatalf("Default expectation is already set for the OrdersHistoryRepository.AddReminders method")
+	}
+
+	if len(mmAddReminders.expectations) > 0 {
+		mmAddReminders.mock.t.Fatalf("Some expectations are already set for the OrdersHistoryRepository.AddReminders method")
+	}
+
+	mmAddReminders.mock.funcAddReminders = f
+	mmAddReminders.mock.funcAddRemindersOrigin = minimock.CallerInfo(1)
+	return mmAddReminders.mock
+}
+
+// When sets expectation for the OrdersHistoryRepository.AddReminders which will trigger the result defined by the following
 // Then helper
-func (mmGetOrderOnlyStatus *mOrdersHistoryRepositoryMockGetOrderOnlyStatus) When(orderID uint64) *OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectation {
-	if mmGetOrderOnlyStatus.mock.funcGetOrderOnlyStatus != nil {
-		mmGetOrderOnlyStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderOnlyStatus mock is already set by Set")
+func (mmAddReminders *mOrdersHistoryRepositoryMockAddReminders) When(ctx context.Context, stage uint64, ordersID []uint64) *OrdersHistoryRepositoryMockAddRemindersExpectation {
+	if mmAddReminders.mock.funcAddReminders != nil {
+		mmAddReminders.mock.t.Fatalf("OrdersHistoryRepositoryMock.AddReminders mock is already set by Set")
 	}
 
-	expectation := &OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectation{
-		mock:               mmGetOrderOnlyStatus.mock,
-		params:             &OrdersHistoryRepositoryMockGetOrderOnlyStatusParams{orderID},
-		expectationOrigins: OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &OrdersHistoryRepositoryMockAddRemindersExpectation{
+		mock:               mmAddReminders.mock,
+		params:             &OrdersHistoryRepositoryMockAddRemindersParams{ctx, stage, ordersID},
+		expectationOrigins: OrdersHistoryRepositoryMockAddRemindersExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetOrderOnlyStatus.expectations = append(mmGetOrderOnlyStatus.expectations, expectation)
+	mmAddReminders.expectations = append(mmAddReminders.expectations, expectation)
 	return expectation
 }
 
-// Then sets up OrdersHistoryRepository.GetOrderOnlyStatus return parameters for the expectation previously defined by the When method
-func (e *OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectation) Then(stat string, err error) *OrdersHistoryRepositoryMock {
-	e.results = &OrdersHistoryRepositoryMockGetOrderOnlyStatusResults{stat, err}
+// Then sets up OrdersHistoryRepository.AddReminders return parameters for the expectation previously defined by the When method
+func (e *OrdersHistoryRepositoryMockAddRemindersExpectation) Then(err error) *OrdersHistoryRepositoryMock {
+	e.results = &OrdersHistoryRepositoryMockAddRemindersResults{err}
 	return e.mock
 }
 
-// Times sets number of times OrdersHistoryRepository.GetOrderOnlyStatus should be invoked
-func (mmGetOrderOnlyStatus *mOrdersHistoryRepositoryMockGetOrderOnlyStatus) Times(n uint64) *mOrdersHistoryRepositoryMockGetOrderOnlyStatus {
+// Times sets number of times OrdersHistoryRepository.AddReminders should be invoked
+func (mmAddReminders *mOrdersHistoryRepositoryMockAddReminders) Times(n uint64) *mOrdersHistoryRepositoryMockAddReminders {
 	if n == 0 {
-		mmGetOrderOnlyStatus.mock.t.Fatalf("Times of OrdersHistoryRepositoryMock.GetOrderOnlyStatus mock can not be zero")
+		mmAddReminders.mock.t.Fatalf("Times of OrdersHistoryRepositoryMock.AddReminders mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetOrderOnlyStatus.expectedInvocations, n)
-	mmGetOrderOnlyStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetOrderOnlyStatus
+	mm_atomic.StoreUint64(&mmAddReminders.expectedInvocations, n)
+	mmAddReminders.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmAddReminders
 }
 
-func (mmGetOrderOnlyStatus *mOrdersHistoryRepositoryMockGetOrderOnlyStatus) invocationsDone() bool {
-	if len(mmGetOrderOnlyStatus.expectations) == 0 && mmGetOrderOnlyStatus.defaultExpectation == nil && mmGetOrderOnlyStatus.mock.funcGetOrderOnlyStatus == nil {
+func (mmAddReminders *mOrdersHistoryRepositoryMockAddReminders) invocationsDone() bool {
+	if len(mmAddReminders.expectations) == 0 && mmAddReminders.defaultExpectation == nil && mmAddReminders.mock.funcAddReminders == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetOrderOnlyStatus.mock.afterGetOrderOnlyStatusCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetOrderOnlyStatus.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmAddReminders.mock.afterAddRemindersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmAddReminders.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetOrderOnlyStatus implements mm_storage.OrdersHistoryRepository
-func (mmGetOrderOnlyStatus *OrdersHistoryRepositoryMock) GetOrderOnlyStatus(orderID uint64) (stat string, err error) {
-	mm_atomic.AddUint64(&mmGetOrderOnlyStatus.beforeGetOrderOnlyStatusCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetOrderOnlyStatus.afterGetOrderOnlyStatusCounter, 1)
+// AddReminders implements mm_storage_json.OrdersHistoryRepository
+func (mmAddReminders *OrdersHistoryRepositoryMock) AddReminders(ctx context.Context, stage uint64, ordersID []uint64) (err error) {
+	mm_atomic.AddUint64(&mmAddReminders.beforeAddRemindersCounter, 1)
+	defer mm_atomic.AddUint64(&mmAddReminders.afterAddRemindersCounter, 1)
 
-	mmGetOrderOnlyStatus.t.Helper()
+	mmAddReminders.t.Helper()
 
-	if mmGetOrderOnlyStatus.inspectFuncGetOrderOnlyStatus != nil {
-		mmGetOrderOnlyStatus.inspectFuncGetOrderOnlyStatus(orderID)
+	if mmAddReminders.inspectFuncAddReminders != nil {
+		mmAddReminders.inspectFuncAddReminders(ctx, stage, ordersID)
 	}
 
-	mm_params := OrdersHistoryRepositoryMockGetOrderOnlyStatusParams{orderID}
+	mm_params := OrdersHistoryRepositoryMockAddRemindersParams{ctx, stage, ordersID}
 
 	// Record call args
-	mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.mutex.Lock()
-	mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.callArgs = append(mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.callArgs, &mm_params)
-	mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.mutex.Unlock()
+	mmAddReminders.AddRemindersMock.mutex.Lock()
+	mmAddReminders.AddRemindersMock.callArgs = append(mmAddReminders.AddRemindersMock.callArgs, &mm_params)
+	mmAddReminders.AddRemindersMock.mutex.Unlock()
 
-	for _, e := range mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.expectations {
+	for _, e := range mmAddReminders.AddRemindersMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.stat, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.defaultExpectation.params
-		mm_want_ptrs := mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.defaultExpectation.paramPtrs
+	if mmAddReminders.AddRemindersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmAddReminders.AddRemindersMock.defaultExpectation.Counter, 1)
+		mm_want := mmAddReminders.AddRemindersMock.defaultExpectation.params
+		mm_want_ptrs := mmAddReminders.AddRemindersMock.defaultExpectation.paramPtrs
 
-		mm_got := OrdersHistoryRepositoryMockGetOrderOnlyStatusParams{orderID}
+		mm_got := OrdersHistoryRepositoryMockAddRemindersParams{ctx, stage, ordersID}
 
 		if mm_want_ptrs != nil {
 
-			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
-				mmGetOrderOnlyStatus.t.Errorf("OrdersHistoryRepositoryMock.GetOrderOnlyStatus got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddReminders.t.Errorf("OrdersHistoryRepositoryMock.AddReminders got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReminders.AddRemindersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.stage != nil && !minimock.Equal(*mm_want_ptrs.stage, mm_got.stage) {
+				mmAddReminders.t.Errorf("OrdersHistoryRepositoryMock.AddReminders got unexpected parameter stage, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReminders.AddRemindersMock.defaultExpectation.expectationOrigins.originStage, *mm_want_ptrs.stage, mm_got.stage, minimock.Diff(*mm_want_ptrs.stage, mm_got.stage))
+			}
+
+			if mm_want_ptrs.ordersID != nil && !minimock.Equal(*mm_want_ptrs.ordersID, mm_got.ordersID) {
+				mmAddReminders.t.Errorf("OrdersHistoryRepositoryMock.AddReminders got unexpected parameter ordersID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddReminders.AddRemindersMock.defaultExpectation.expectationOrigins.originOrdersID, *mm_want_ptrs.ordersID, mm_got.ordersID, minimock.Diff(*mm_want_ptrs.ordersID, mm_got.ordersID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetOrderOnlyStatus.t.Errorf("OrdersHistoryRepositoryMock.GetOrderOnlyStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmAddReminders.t.Errorf("OrdersHistoryRepositoryMock.AddReminders got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmAddReminders.AddRemindersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.defaultExpectation.results
+		mm_results := mmAddReminders.AddRemindersMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetOrderOnlyStatus.t.Fatal("No results are set for the OrdersHistoryRepositoryMock.GetOrderOnlyStatus")
+			mmAddReminders.t.Fatal("No results are set for the OrdersHistoryRepositoryMock.AddReminders")
 		}
-		return (*mm_results).stat, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetOrderOnlyStatus.funcGetOrderOnlyStatus != nil {
-		return mmGetOrderOnlyStatus.funcGetOrderOnlyStatus(orderID)
+	if mmAddReminders.funcAddReminders != nil {
+		return mmAddReminders.funcAddReminders(ctx, stage, ordersID)
 	}
-	mmGetOrderOnlyStatus.t.Fatalf("Unexpected call to OrdersHistoryRepositoryMock.GetOrderOnlyStatus. %v", orderID)
+	mmAddReminders.t.Fatalf("Unexpected call to OrdersHistoryRepositoryMock.AddReminders. %v %v %v", ctx, stage, ordersID)
 	return
 }
 
-// GetOrderOnlyStatusAfterCounter returns a count of finished OrdersHistoryRepositoryMock.GetOrderOnlyStatus invocations
-func (mmGetOrderOnlyStatus *OrdersHistoryRepositoryMock) GetOrderOnlyStatusAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetOrderOnlyStatus.afterGetOrderOnlyStatusCounter)
+// AddRemindersAfterCounter returns a count of finished OrdersHistoryRepositoryMock.AddReminders invocations
+func (mmAddReminders *OrdersHistoryRepositoryMock) AddRemindersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReminders.afterAddRemindersCounter)
 }
 
-// GetOrderOnlyStatusBeforeCounter returns a count of OrdersHistoryRepositoryMock.GetOrderOnlyStatus invocations
-func (mmGetOrderOnlyStatus *OrdersHistoryRepositoryMock) GetOrderOnlyStatusBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetOrderOnlyStatus.beforeGetOrderOnlyStatusCounter)
+// AddRemindersBeforeCounter returns a count of OrdersHistoryRepositoryMock.AddReminders invocations
+func (mmAddReminders *OrdersHistoryRepositoryMock) AddRemindersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmAddReminders.beforeAddRemindersCounter)
 }
 
-// Calls returns a list of arguments used in each call to OrdersHistoryRepositoryMock.GetOrderOnlyStatus.
+// Calls returns a list of arguments used in each call to OrdersHistoryRepositoryMock.AddReminders.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetOrderOnlyStatus *mOrdersHistoryRepositoryMockGetOrderOnlyStatus) Calls() []*OrdersHistoryRepositoryMockGetOrderOnlyStatusParams {
-	mmGetOrderOnlyStatus.mutex.RLock()
+func (mmAddReminders *mOrdersHistoryRepositoryMockAddReminders) Calls() []*OrdersHistoryRepositoryMockAddRemindersParams {
+	mmAddReminders.mutex.RLock()
 
-	argCopy := make([]*OrdersHistoryRepositoryMockGetOrderOnlyStatusParams, len(mmGetOrderOnlyStatus.callArgs))
-	copy(argCopy, mmGetOrderOnlyStatus.callArgs)
+	argCopy := make([]*OrdersHistoryRepositoryMockAddRemindersParams, len(mmAddReminders.callArgs))
+	copy(argCopy, mmAddReminders.callArgs)
 
-	mmGetOrderOnlyStatus.mutex.RUnlock()
+	mmAddReminders.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetOrderOnlyStatusDone returns true if the count of the GetOrderOnlyStatus invocations corresponds
+// MinimockAddRemindersDone returns true if the count of the AddReminders invocations corresponds
 // the number of defined expectations
-func (m *OrdersHistoryRepositoryMock) MinimockGetOrderOnlyStatusDone() bool {
-	if m.GetOrderOnlyStatusMock.optional {
+func (m *OrdersHistoryRepositoryMock) MinimockAddRemindersDone() bool {
+	if m.AddRemindersMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetOrderOnlyStatusMock.expectations {
+	for _, e := range m.AddRemindersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetOrderOnlyStatusMock.invocationsDone()
+	return m.AddRemindersMock.invocationsDone()
 }
 
-// MinimockGetOrderOnlyStatusInspect logs each unmet expectation
-func (m *OrdersHistoryRepositoryMock) MinimockGetOrderOnlyStatusInspect() {
-	for _, e := range m.GetOrderOnlyStatusMock.expectations {
+// MinimockAddRemindersInspect logs each unmet expectation
+func (m *OrdersHistoryRepositoryMock) MinimockAddRemindersInspect() {
+	for _, e := range m.AddRemindersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetOrderOnlyStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.AddReminders at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetOrderOnlyStatusCounter := mm_atomic.LoadUint64(&m.afterGetOrderOnlyStatusCounter)
+	afterAddRemindersCounter := mm_atomic.LoadUint64(&m.afterAddRemindersCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetOrderOnlyStatusMock.defaultExpectation != nil && afterGetOrderOnlyStatusCounter < 1 {
-		if m.GetOrderOnlyStatusMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetOrderOnlyStatus at\n%s", m.GetOrderOnlyStatusMock.defaultExpectation.returnOrigin)
+	if m.AddRemindersMock.defaultExpectation != nil && afterAddRemindersCounter < 1 {
+		if m.AddRemindersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.AddReminders at\n%s", m.AddRemindersMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetOrderOnlyStatus at\n%s with params: %#v", m.GetOrderOnlyStatusMock.defaultExpectation.expectationOrigins.origin, *m.GetOrderOnlyStatusMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.AddReminders at\n%s with params: %#v", m.AddRemindersMock.defaultExpectation.expectationOrigins.origin, *m.AddRemindersMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetOrderOnlyStatus != nil && afterGetOrderOnlyStatusCounter < 1 {
-		m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetOrderOnlyStatus at\n%s", m.funcGetOrderOnlyStatusOrigin)
+	if m.funcAddReminders != nil && afterAddRemindersCounter < 1 {
+		m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.AddReminders at\n%s", m.funcAddRemindersOrigin)
 	}
 
-	if !m.GetOrderOnlyStatusMock.invocationsDone() && afterGetOrderOnlyStatusCounter > 0 {
-		m.t.Errorf("Expected %d calls to OrdersHistoryRepositoryMock.GetOrderOnlyStatus at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetOrderOnlyStatusMock.expectedInvocations), m.GetOrderOnlyStatusMock.expectedInvocationsOrigin, afterGetOrderOnlyStatusCounter)
+	if !m.AddRemindersMock.invocationsDone() && afterAddRemindersCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrdersHistoryRepositoryMock.AddReminders at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.AddRemindersMock.expectedInvocations), m.AddRemindersMock.expectedInvocationsOrigin, afterAddRemindersCounter)
 	}
 }
 
-type mOrdersHistoryRepositoryMockGetOrderStatus struct {
+type mOrdersHistoryRepositoryMockExpireOrders struct {
 	optional           bool
 	mock               *OrdersHistoryRepositoryMock
-	defaultExpectation *OrdersHistoryRepositoryMockGetOrderStatusExpectation
-	expectations       []*OrdersHistoryRepositoryMockGetOrderStatusExpectation
+	defaultExpectation *OrdersHistoryRepositoryMockExpireOrdersExpectation
+	expectations       []*OrdersHistoryRepositoryMockExpireOrdersExpectation
 
-	callArgs []*OrdersHistoryRepositoryMockGetOrderStatusParams
+	callArgs []*OrdersHistoryRepositoryMockExpireOrdersParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// OrdersHistoryRepositoryMockGetOrderStatusExpectation specifies expectation struct of the OrdersHistoryRepository.GetOrderStatus
-type OrdersHistoryRepositoryMockGetOrderStatusExpectation struct {
+// OrdersHistoryRepositoryMockExpireOrdersExpectation specifies expectation struct of the OrdersHistoryRepository.ExpireOrders
+type OrdersHistoryRepositoryMockExpireOrdersExpectation struct {
 	mock               *OrdersHistoryRepositoryMock
-	params             *OrdersHistoryRepositoryMockGetOrderStatusParams
-	paramPtrs          *OrdersHistoryRepositoryMockGetOrderStatusParamPtrs
-	expectationOrigins OrdersHistoryRepositoryMockGetOrderStatusExpectationOrigins
-	results            *OrdersHistoryRepositoryMockGetOrderStatusResults
+	params             *OrdersHistoryRepositoryMockExpireOrdersParams
+	paramPtrs          *OrdersHistoryRepositoryMockExpireOrdersParamPtrs
+	expectationOrigins OrdersHistoryRepositoryMockExpireOrdersExpectationOrigins
+	results            *OrdersHistoryRepositoryMockExpireOrdersResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// OrdersHistoryRepositoryMockGetOrderStatusParams contains parameters of the OrdersHistoryRepository.GetOrderStatus
-type OrdersHistoryRepositoryMockGetOrderStatusParams struct {
-	orderID uint64
+// OrdersHistoryRepositoryMockExpireOrdersParams contains parameters of the OrdersHistoryRepository.ExpireOrders
+type OrdersHistoryRepositoryMockExpireOrdersParams struct {
+	ctx           context.Context
+	expiredBefore time.Time
 }
 
-// OrdersHistoryRepositoryMockGetOrderStatusParamPtrs contains pointers to parameters of the OrdersHistoryRepository.GetOrderStatus
-type OrdersHistoryRepositoryMockGetOrderStatusParamPtrs struct {
-	orderID *uint64
+// OrdersHistoryRepositoryMockExpireOrdersParamPtrs contains pointers to parameters of the OrdersHistoryRepository.ExpireOrders
+type OrdersHistoryRepositoryMockExpireOrdersParamPtrs struct {
+	ctx           *context.Context
+	expiredBefore *time.Time
 }
 
-// OrdersHistoryRepositoryMockGetOrderStatusResults contains results of the OrdersHistoryRepository.GetOrderStatus
-type OrdersHistoryRepositoryMockGetOrderStatusResults struct {
-	op1 *domain.OrderStatus
+// OrdersHistoryRepositoryMockExpireOrdersResults contains results of the OrdersHistoryRepository.ExpireOrders
+type OrdersHistoryRepositoryMockExpireOrdersResults struct {
+	ua1 []uint64
 	err error
 }
 
-// OrdersHistoryRepositoryMockGetOrderStatusOrigins contains origins of expectations of the OrdersHistoryRepository.GetOrderStatus
-type OrdersHistoryRepositoryMockGetOrderStatusExpectationOrigins struct {
-	origin        string
-	originOrderID string
+// OrdersHistoryRepositoryMockExpireOrdersOrigins contains origins of expectations of the OrdersHistoryRepository.ExpireOrders
+type OrdersHistoryRepositoryMockExpireOrdersExpectationOrigins struct {
+	origin              string
+	originCtx           string
+	originExpiredBefore string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -839,264 +1016,3098 @@ type OrdersHistoryRepositoryMockGetOrderStatusExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetOrderStatus *mOrdersHistoryRepositoryMockGetOrderStatus) Optional() *mOrdersHistoryRepositoryMockGetOrderStatus {
-	mmGetOrderStatus.optional = true
-	return mmGetOrderStatus
+func (mmExpireOrders *mOrdersHistoryRepositoryMockExpireOrders) Optional() *mOrdersHistoryRepositoryMockExpireOrders {
+	mmExpireOrders.optional = true
+	return mmExpireOrders
 }
 
-// Expect sets up expected params for OrdersHistoryRepository.GetOrderStatus
-func (mmGetOrderStatus *mOrdersHistoryRepositoryMockGetOrderStatus) Expect(orderID uint64) *mOrdersHistoryRepositoryMockGetOrderStatus {
-	if mmGetOrderStatus.mock.funcGetOrderStatus != nil {
-		mmGetOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderStatus mock is already set by Set")
+// Expect sets up expected params for OrdersHistoryRepository.ExpireOrders
+func (mmExpireOrders *mOrdersHistoryRepositoryMockExpireOrders) Expect(ctx context.Context, expiredBefore time.Time) *mOrdersHistoryRepositoryMockExpireOrders {
+	if mmExpireOrders.mock.funcExpireOrders != nil {
+		mmExpireOrders.mock.t.Fatalf("OrdersHistoryRepositoryMock.ExpireOrders mock is already set by Set")
 	}
 
-	if mmGetOrderStatus.defaultExpectation == nil {
-		mmGetOrderStatus.defaultExpectation = &OrdersHistoryRepositoryMockGetOrderStatusExpectation{}
+	if mmExpireOrders.defaultExpectation == nil {
+		mmExpireOrders.defaultExpectation = &OrdersHistoryRepositoryMockExpireOrdersExpectation{}
 	}
 
-	if mmGetOrderStatus.defaultExpectation.paramPtrs != nil {
-		mmGetOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderStatus mock is already set by ExpectParams functions")
+	if mmExpireOrders.defaultExpectation.paramPtrs != nil {
+		mmExpireOrders.mock.t.Fatalf("OrdersHistoryRepositoryMock.ExpireOrders mock is already set by ExpectParams functions")
 	}
 
-	mmGetOrderStatus.defaultExpectation.params = &OrdersHistoryRepositoryMockGetOrderStatusParams{orderID}
-	mmGetOrderStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetOrderStatus.expectations {
-		if minimock.Equal(e.params, mmGetOrderStatus.defaultExpectation.params) {
-			mmGetOrderStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrderStatus.defaultExpectation.params)
+	mmExpireOrders.defaultExpectation.params = &OrdersHistoryRepositoryMockExpireOrdersParams{ctx, expiredBefore}
+	mmExpireOrders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmExpireOrders.expectations {
+		if minimock.Equal(e.params, mmExpireOrders.defaultExpectation.params) {
+			mmExpireOrders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmExpireOrders.defaultExpectation.params)
 		}
 	}
 
-	return mmGetOrderStatus
+	return mmExpireOrders
 }
 
-// ExpectOrderIDParam1 sets up expected param orderID for OrdersHistoryRepository.GetOrderStatus
-func (mmGetOrderStatus *mOrdersHistoryRepositoryMockGetOrderStatus) ExpectOrderIDParam1(orderID uint64) *mOrdersHistoryRepositoryMockGetOrderStatus {
-	if mmGetOrderStatus.mock.funcGetOrderStatus != nil {
-		mmGetOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderStatus mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for OrdersHistoryRepository.ExpireOrders
+func (mmExpireOrders *mOrdersHistoryRepositoryMockExpireOrders) ExpectCtxParam1(ctx context.Context) *mOrdersHistoryRepositoryMockExpireOrders {
+	if mmExpireOrders.mock.funcExpireOrders != nil {
+		mmExpireOrders.mock.t.Fatalf("OrdersHistoryRepositoryMock.ExpireOrders mock is already set by Set")
 	}
 
-	if mmGetOrderStatus.defaultExpectation == nil {
-		mmGetOrderStatus.defaultExpectation = &OrdersHistoryRepositoryMockGetOrderStatusExpectation{}
+	if mmExpireOrders.defaultExpectation == nil {
+		mmExpireOrders.defaultExpectation = &OrdersHistoryRepositoryMockExpireOrdersExpectation{}
 	}
 
-	if mmGetOrderStatus.defaultExpectation.params != nil {
-		mmGetOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderStatus mock is already set by Expect")
+	if mmExpireOrders.defaultExpectation.params != nil {
+		mmExpireOrders.mock.t.Fatalf("OrdersHistoryRepositoryMock.ExpireOrders mock is already set by Expect")
 	}
 
-	if mmGetOrderStatus.defaultExpectation.paramPtrs == nil {
-		mmGetOrderStatus.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockGetOrderStatusParamPtrs{}
+	if mmExpireOrders.defaultExpectation.paramPtrs == nil {
+		mmExpireOrders.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockExpireOrdersParamPtrs{}
 	}
-	mmGetOrderStatus.defaultExpectation.paramPtrs.orderID = &orderID
-	mmGetOrderStatus.defaultExpectation.expectationOrigins.originOrderID = minimock.CallerInfo(1)
+	mmExpireOrders.defaultExpectation.paramPtrs.ctx = &ctx
+	mmExpireOrders.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetOrderStatus
+	return mmExpireOrders
 }
 
-// Inspect accepts an inspector function that has same arguments as the OrdersHistoryRepository.GetOrderStatus
-func (mmGetOrderStatus *mOrdersHistoryRepositoryMockGetOrderStatus) Inspect(f func(orderID uint64)) *mOrdersHistoryRepositoryMockGetOrderStatus {
-	if mmGetOrderStatus.mock.inspectFuncGetOrderStatus != nil {
-		mmGetOrderStatus.mock.t.Fatalf("Inspect function is already set for OrdersHistoryRepositoryMock.GetOrderStatus")
+// ExpectExpiredBeforeParam2 sets up expected param expiredBefore for OrdersHistoryRepository.ExpireOrders
+func (mmExpireOrders *mOrdersHistoryRepositoryMockExpireOrders) ExpectExpiredBeforeParam2(expiredBefore time.Time) *mOrdersHistoryRepositoryMockExpireOrders {
+	if mmExpireOrders.mock.funcExpireOrders != nil {
+		mmExpireOrders.mock.t.Fatalf("OrdersHistoryRepositoryMock.ExpireOrders mock is already set by Set")
 	}
 
-	mmGetOrderStatus.mock.inspectFuncGetOrderStatus = f
+	if mmExpireOrders.defaultExpectation == nil {
+		mmExpireOrders.defaultExpectation = &OrdersHistoryRepositoryMockExpireOrdersExpectation{}
+	}
 
-	return mmGetOrderStatus
+	if mmExpireOrders.defaultExpectation.params != nil {
+		mmExpireOrders.mock.t.Fatalf("OrdersHistoryRepositoryMock.ExpireOrders mock is already set by Expect")
+	}
+
+	if mmExpireOrders.defaultExpectation.paramPtrs == nil {
+		mmExpireOrders.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockExpireOrdersParamPtrs{}
+	}
+	mmExpireOrders.defaultExpectation.paramPtrs.expiredBefore = &expiredBefore
+	mmExpireOrders.defaultExpectation.expectationOrigins.originExpiredBefore = minimock.CallerInfo(1)
+
+	return mmExpireOrders
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersHistoryRepository.ExpireOrders
+func (mmExpireOrders *mOrdersHistoryRepositoryMockExpireOrders) Inspect(f func(ctx context.Context, expiredBefore time.Time)) *mOrdersHistoryRepositoryMockExpireOrders {
+	if mmExpireOrders.mock.inspectFuncExpireOrders != nil {
+		mmExpireOrders.mock.t.Fatalf("Inspect function is already set for OrdersHistoryRepositoryMock.ExpireOrders")
+	}
+
+	mmExpireOrders.mock.inspectFuncExpireOrders = f
+
+	return mmExpireOrders
+}
+
+// Return sets up results that will be returned by OrdersHistoryRepository.ExpireOrders
+func (mmExpireOrders *mOrdersHistoryRepositoryMockExpireOrders) Return(ua1 []uint64, err error) *OrdersHistoryRepositoryMock {
+	if mmExpireOrders.mock.funcExpireOrders != nil {
+		mmExpireOrders.mock.t.Fatalf("OrdersHistoryRepositoryMock.ExpireOrders mock is already set by Set")
+	}
+
+	if mmExpireOrders.defaultExpectation == nil {
+		mmExpireOrders.defaultExpectation = &OrdersHistoryRepositoryMockExpireOrdersExpectation{mock: mmExpireOrders.mock}
+	}
+	mmExpireOrders.defaultExpectation.results = &OrdersHistoryRepositoryMockExpireOrdersResults{ua1, err}
+	mmExpireOrders.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmExpireOrders.mock
+}
+
+// Set uses given function f to mock the OrdersHistoryRepository.ExpireOrders method
+func (mmExpireOrders *mOrdersHistoryRepositoryMockExpireOrders) Set(f func(ctx context.Context, expiredBefore time.Time) (ua1 []uint64, err error)) *OrdersHistoryRepositoryMock {
+	if mmExpireOrders.defaultExpectation != nil {
+		mmExpireOrders.mock.t.Fatalf("Default expectation is already set for the OrdersHistoryRepository.ExpireOrders method")
+	}
+
+	if len(mmExpireOrders.expectations) > 0 {
+		mmExpireOrders.mock.t.Fatalf("Some expectations are already set for the OrdersHistoryRepository.ExpireOrders method")
+	}
+
+	mmExpireOrders.mock.funcExpireOrders = f
+	mmExpireOrders.mock.funcExpireOrdersOrigin = minimock.CallerInfo(1)
+	return mmExpireOrders.mock
+}
+
+// When sets expectation for the OrdersHistoryRepository.ExpireOrders which will trigger the result defined by the following
+// Then helper
+func (mmExpireOrders *mOrdersHistoryRepositoryMockExpireOrders) When(ctx context.Context, expiredBefore time.Time) *OrdersHistoryRepositoryMockExpireOrdersExpectation {
+	if mmExpireOrders.mock.funcExpireOrders != nil {
+		mmExpireOrders.mock.t.Fatalf("OrdersHistoryRepositoryMock.ExpireOrders mock is already set by Set")
+	}
+
+	expectation := &OrdersHistoryRepositoryMockExpireOrdersExpectation{
+		mock:               mmExpireOrders.mock,
+		params:             &OrdersHistoryRepositoryMockExpireOrdersParams{ctx, expiredBefore},
+		expectationOrigins: OrdersHistoryRepositoryMockExpireOrdersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmExpireOrders.expectations = append(mmExpireOrders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersHistoryRepository.ExpireOrders return parameters for the expectation previously defined by the When method
+func (e *OrdersHistoryRepositoryMockExpireOrdersExpectation) Then(ua1 []uint64, err error) *OrdersHistoryRepositoryMock {
+	e.results = &OrdersHistoryRepositoryMockExpireOrdersResults{ua1, err}
+	return e.mock
+}
+
+// Times sets number of times OrdersHistoryRepository.ExpireOrders should be invoked
+func (mmExpireOrders *mOrdersHistoryRepositoryMockExpireOrders) Times(n uint64) *mOrdersHistoryRepositoryMockExpireOrders {
+	if n == 0 {
+		mmExpireOrders.mock.t.Fatalf("Times of OrdersHistoryRepositoryMock.ExpireOrders mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmExpireOrders.expectedInvocations, n)
+	mmExpireOrders.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmExpireOrders
+}
+
+func (mmExpireOrders *mOrdersHistoryRepositoryMockExpireOrders) invocationsDone() bool {
+	if len(mmExpireOrders.expectations) == 0 && mmExpireOrders.defaultExpectation == nil && mmExpireOrders.mock.funcExpireOrders == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmExpireOrders.mock.afterExpireOrdersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmExpireOrders.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ExpireOrders implements mm_storage_json.OrdersHistoryRepository
+func (mmExpireOrders *OrdersHistoryRepositoryMock) ExpireOrders(ctx context.Context, expiredBefore time.Time) (ua1 []uint64, err error) {
+	mm_atomic.AddUint64(&mmExpireOrders.beforeExpireOrdersCounter, 1)
+	defer mm_atomic.AddUint64(&mmExpireOrders.afterExpireOrdersCounter, 1)
+
+	mmExpireOrders.t.Helper()
+
+	if mmExpireOrders.inspectFuncExpireOrders != nil {
+		mmExpireOrders.inspectFuncExpireOrders(ctx, expiredBefore)
+	}
+
+	mm_params := OrdersHistoryRepositoryMockExpireOrdersParams{ctx, expiredBefore}
+
+	// Record call args
+	mmExpireOrders.ExpireOrdersMock.mutex.Lock()
+	mmExpireOrders.ExpireOrdersMock.callArgs = append(mmExpireOrders.ExpireOrdersMock.callArgs, &mm_params)
+	mmExpireOrders.ExpireOrdersMock.mutex.Unlock()
+
+	for _, e := range mmExpireOrders.ExpireOrdersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ua1, e.results.err
+		}
+	}
+
+	if mmExpireOrders.ExpireOrdersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmExpireOrders.ExpireOrdersMock.defaultExpectation.Counter, 1)
+		mm_want := mmExpireOrders.ExpireOrdersMock.defaultExpectation.params
+		mm_want_ptrs := mmExpireOrders.ExpireOrdersMock.defaultExpectation.paramPtrs
+
+		mm_got := OrdersHistoryRepositoryMockExpireOrdersParams{ctx, expiredBefore}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmExpireOrders.t.Errorf("OrdersHistoryRepositoryMock.ExpireOrders got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExpireOrders.ExpireOrdersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.expiredBefore != nil && !minimock.Equal(*mm_want_ptrs.expiredBefore, mm_got.expiredBefore) {
+				mmExpireOrders.t.Errorf("OrdersHistoryRepositoryMock.ExpireOrders got unexpected parameter expiredBefore, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmExpireOrders.ExpireOrdersMock.defaultExpectation.expectationOrigins.originExpiredBefore, *mm_want_ptrs.expiredBefore, mm_got.expiredBefore, minimock.Diff(*mm_want_ptrs.expiredBefore, mm_got.expiredBefore))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmExpireOrders.t.Errorf("OrdersHistoryRepositoryMock.ExpireOrders got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmExpireOrders.ExpireOrdersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmExpireOrders.ExpireOrdersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmExpireOrders.t.Fatal("No results are set for the OrdersHistoryRepositoryMock.ExpireOrders")
+		}
+		return (*mm_results).ua1, (*mm_results).err
+	}
+	if mmExpireOrders.funcExpireOrders != nil {
+		return mmExpireOrders.funcExpireOrders(ctx, expiredBefore)
+	}
+	mmExpireOrders.t.Fatalf("Unexpected call to OrdersHistoryRepositoryMock.ExpireOrders. %v %v", ctx, expiredBefore)
+	return
+}
+
+// ExpireOrdersAfterCounter returns a count of finished OrdersHistoryRepositoryMock.ExpireOrders invocations
+func (mmExpireOrders *OrdersHistoryRepositoryMock) ExpireOrdersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpireOrders.afterExpireOrdersCounter)
+}
+
+// ExpireOrdersBeforeCounter returns a count of OrdersHistoryRepositoryMock.ExpireOrders invocations
+func (mmExpireOrders *OrdersHistoryRepositoryMock) ExpireOrdersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmExpireOrders.beforeExpireOrdersCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersHistoryRepositoryMock.ExpireOrders.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmExpireOrders *mOrdersHistoryRepositoryMockExpireOrders) Calls() []*OrdersHistoryRepositoryMockExpireOrdersParams {
+	mmExpireOrders.mutex.RLock()
+
+	argCopy := make([]*OrdersHistoryRepositoryMockExpireOrdersParams, len(mmExpireOrders.callArgs))
+	copy(argCopy, mmExpireOrders.callArgs)
+
+	mmExpireOrders.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockExpireOrdersDone returns true if the count of the ExpireOrders invocations corresponds
+// the number of defined expectations
+func (m *OrdersHistoryRepositoryMock) MinimockExpireOrdersDone() bool {
+	if m.ExpireOrdersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ExpireOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ExpireOrdersMock.invocationsDone()
+}
+
+// MinimockExpireOrdersInspect logs each unmet expectation
+func (m *OrdersHistoryRepositoryMock) MinimockExpireOrdersInspect() {
+	for _, e := range m.ExpireOrdersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.ExpireOrders at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterExpireOrdersCounter := mm_atomic.LoadUint64(&m.afterExpireOrdersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ExpireOrdersMock.defaultExpectation != nil && afterExpireOrdersCounter < 1 {
+		if m.ExpireOrdersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.ExpireOrders at\n%s", m.ExpireOrdersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.ExpireOrders at\n%s with params: %#v", m.ExpireOrdersMock.defaultExpectation.expectationOrigins.origin, *m.ExpireOrdersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcExpireOrders != nil && afterExpireOrdersCounter < 1 {
+		m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.ExpireOrders at\n%s", m.funcExpireOrdersOrigin)
+	}
+
+	if !m.ExpireOrdersMock.invocationsDone() && afterExpireOrdersCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrdersHistoryRepositoryMock.ExpireOrders at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ExpireOrdersMock.expectedInvocations), m.ExpireOrdersMock.expectedInvocationsOrigin, afterExpireOrdersCounter)
+	}
+}
+
+type mOrdersHistoryRepositoryMockGetOrderOnlyStatus struct {
+	optional           bool
+	mock               *OrdersHistoryRepositoryMock
+	defaultExpectation *OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectation
+	expectations       []*OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectation
+
+	callArgs []*OrdersHistoryRepositoryMockGetOrderOnlyStatusParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectation specifies expectation struct of the OrdersHistoryRepository.GetOrderOnlyStatus
+type OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectation struct {
+	mock               *OrdersHistoryRepositoryMock
+	params             *OrdersHistoryRepositoryMockGetOrderOnlyStatusParams
+	paramPtrs          *OrdersHistoryRepositoryMockGetOrderOnlyStatusParamPtrs
+	expectationOrigins OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectationOrigins
+	results            *OrdersHistoryRepositoryMockGetOrderOnlyStatusResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrdersHistoryRepositoryMockGetOrderOnlyStatusParams contains parameters of the OrdersHistoryRepository.GetOrderOnlyStatus
+type OrdersHistoryRepositoryMockGetOrderOnlyStatusParams struct {
+	ctx     context.Context
+	orderID uint64
+}
+
+// OrdersHistoryRepositoryMockGetOrderOnlyStatusParamPtrs contains pointers to parameters of the OrdersHistoryRepository.GetOrderOnlyStatus
+type OrdersHistoryRepositoryMockGetOrderOnlyStatusParamPtrs struct {
+	ctx     *context.Context
+	orderID *uint64
+}
+
+// OrdersHistoryRepositoryMockGetOrderOnlyStatusResults contains results of the OrdersHistoryRepository.GetOrderOnlyStatus
+type OrdersHistoryRepositoryMockGetOrderOnlyStatusResults struct {
+	stat string
+	err  error
+}
+
+// OrdersHistoryRepositoryMockGetOrderOnlyStatusOrigins contains origins of expectations of the OrdersHistoryRepository.GetOrderOnlyStatus
+type OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originOrderID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetOrderOnlyStatus *mOrdersHistoryRepositoryMockGetOrderOnlyStatus) Optional() *mOrdersHistoryRepositoryMockGetOrderOnlyStatus {
+	mmGetOrderOnlyStatus.optional = true
+	return mmGetOrderOnlyStatus
+}
+
+// Expect sets up expected params for OrdersHistoryRepository.GetOrderOnlyStatus
+func (mmGetOrderOnlyStatus *mOrdersHistoryRepositoryMockGetOrderOnlyStatus) Expect(ctx context.Context, orderID uint64) *mOrdersHistoryRepositoryMockGetOrderOnlyStatus {
+	if mmGetOrderOnlyStatus.mock.funcGetOrderOnlyStatus != nil {
+		mmGetOrderOnlyStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderOnlyStatus mock is already set by Set")
+	}
+
+	if mmGetOrderOnlyStatus.defaultExpectation == nil {
+		mmGetOrderOnlyStatus.defaultExpectation = &OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectation{}
+	}
+
+	if mmGetOrderOnlyStatus.defaultExpectation.paramPtrs != nil {
+		mmGetOrderOnlyStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderOnlyStatus mock is already set by ExpectParams functions")
+	}
+
+	mmGetOrderOnlyStatus.defaultExpectation.params = &OrdersHistoryRepositoryMockGetOrderOnlyStatusParams{ctx, orderID}
+	mmGetOrderOnlyStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOrderOnlyStatus.expectations {
+		if minimock.Equal(e.params, mmGetOrderOnlyStatus.defaultExpectation.params) {
+			mmGetOrderOnlyStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrderOnlyStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOrderOnlyStatus
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrdersHistoryRepository.GetOrderOnlyStatus
+func (mmGetOrderOnlyStatus *mOrdersHistoryRepositoryMockGetOrderOnlyStatus) ExpectCtxParam1(ctx context.Context) *mOrdersHistoryRepositoryMockGetOrderOnlyStatus {
+	if mmGetOrderOnlyStatus.mock.funcGetOrderOnlyStatus != nil {
+		mmGetOrderOnlyStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderOnlyStatus mock is already set by Set")
+	}
+
+	if mmGetOrderOnlyStatus.defaultExpectation == nil {
+		mmGetOrderOnlyStatus.defaultExpectation = &OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectation{}
+	}
+
+	if mmGetOrderOnlyStatus.defaultExpectation.params != nil {
+		mmGetOrderOnlyStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderOnlyStatus mock is already set by Expect")
+	}
+
+	if mmGetOrderOnlyStatus.defaultExpectation.paramPtrs == nil {
+		mmGetOrderOnlyStatus.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockGetOrderOnlyStatusParamPtrs{}
+	}
+	mmGetOrderOnlyStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOrderOnlyStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetOrderOnlyStatus
+}
+
+// ExpectOrderIDParam2 sets up expected param orderID for OrdersHistoryRepository.GetOrderOnlyStatus
+func (mmGetOrderOnlyStatus *mOrdersHistoryRepositoryMockGetOrderOnlyStatus) ExpectOrderIDParam2(orderID uint64) *mOrdersHistoryRepositoryMockGetOrderOnlyStatus {
+	if mmGetOrderOnlyStatus.mock.funcGetOrderOnlyStatus != nil {
+		mmGetOrderOnlyStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderOnlyStatus mock is already set by Set")
+	}
+
+	if mmGetOrderOnlyStatus.defaultExpectation == nil {
+		mmGetOrderOnlyStatus.defaultExpectation = &OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectation{}
+	}
+
+	if mmGetOrderOnlyStatus.defaultExpectation.params != nil {
+		mmGetOrderOnlyStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderOnlyStatus mock is already set by Expect")
+	}
+
+	if mmGetOrderOnlyStatus.defaultExpectation.paramPtrs == nil {
+		mmGetOrderOnlyStatus.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockGetOrderOnlyStatusParamPtrs{}
+	}
+	mmGetOrderOnlyStatus.defaultExpectation.paramPtrs.orderID = &orderID
+	mmGetOrderOnlyStatus.defaultExpectation.expectationOrigins.originOrderID = minimock.CallerInfo(1)
+
+	return mmGetOrderOnlyStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersHistoryRepository.GetOrderOnlyStatus
+func (mmGetOrderOnlyStatus *mOrdersHistoryRepositoryMockGetOrderOnlyStatus) Inspect(f func(ctx context.Context, orderID uint64)) *mOrdersHistoryRepositoryMockGetOrderOnlyStatus {
+	if mmGetOrderOnlyStatus.mock.inspectFuncGetOrderOnlyStatus != nil {
+		mmGetOrderOnlyStatus.mock.t.Fatalf("Inspect function is already set for OrdersHistoryRepositoryMock.GetOrderOnlyStatus")
+	}
+
+	mmGetOrderOnlyStatus.mock.inspectFuncGetOrderOnlyStatus = f
+
+	return mmGetOrderOnlyStatus
+}
+
+// Return sets up results that will be returned by OrdersHistoryRepository.GetOrderOnlyStatus
+func (mmGetOrderOnlyStatus *mOrdersHistoryRepositoryMockGetOrderOnlyStatus) Return(stat string, err error) *OrdersHistoryRepositoryMock {
+	if mmGetOrderOnlyStatus.mock.funcGetOrderOnlyStatus != nil {
+		mmGetOrderOnlyStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderOnlyStatus mock is already set by Set")
+	}
+
+	if mmGetOrderOnlyStatus.defaultExpectation == nil {
+		mmGetOrderOnlyStatus.defaultExpectation = &OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectation{mock: mmGetOrderOnlyStatus.mock}
+	}
+	mmGetOrderOnlyStatus.defaultExpectation.results = &OrdersHistoryRepositoryMockGetOrderOnlyStatusResults{stat, err}
+	mmGetOrderOnlyStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOrderOnlyStatus.mock
+}
+
+// Set uses given function f to mock the OrdersHistoryRepository.GetOrderOnlyStatus method
+func (mmGetOrderOnlyStatus *mOrdersHistoryRepositoryMockGetOrderOnlyStatus) Set(f func(ctx context.Context, orderID uint64) (stat string, err error)) *OrdersHistoryRepositoryMock {
+	if mmGetOrderOnlyStatus.defaultExpectation != nil {
+		mmGetOrderOnlyStatus.mock.t.Fatalf("Default expectation is already set for the OrdersHistoryRepository.GetOrderOnlyStatus method")
+	}
+
+	if len(mmGetOrderOnlyStatus.expectations) > 0 {
+		mmGetOrderOnlyStatus.mock.t.Fatalf("Some expectations are already set for the OrdersHistoryRepository.GetOrderOnlyStatus method")
+	}
+
+	mmGetOrderOnlyStatus.mock.funcGetOrderOnlyStatus = f
+	mmGetOrderOnlyStatus.mock.funcGetOrderOnlyStatusOrigin = minimock.CallerInfo(1)
+	return mmGetOrderOnlyStatus.mock
+}
+
+// When sets expectation for the OrdersHistoryRepository.GetOrderOnlyStatus which will trigger the result defined by the following
+// Then helper
+func (mmGetOrderOnlyStatus *mOrdersHistoryRepositoryMockGetOrderOnlyStatus) When(ctx context.Context, orderID uint64) *OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectation {
+	if mmGetOrderOnlyStatus.mock.funcGetOrderOnlyStatus != nil {
+		mmGetOrderOnlyStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderOnlyStatus mock is already set by Set")
+	}
+
+	expectation := &OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectation{
+		mock:               mmGetOrderOnlyStatus.mock,
+		params:             &OrdersHistoryRepositoryMockGetOrderOnlyStatusParams{ctx, orderID},
+		expectationOrigins: OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetOrderOnlyStatus.expectations = append(mmGetOrderOnlyStatus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersHistoryRepository.GetOrderOnlyStatus return parameters for the expectation previously defined by the When method
+func (e *OrdersHistoryRepositoryMockGetOrderOnlyStatusExpectation) Then(stat string, err error) *OrdersHistoryRepositoryMock {
+	e.results = &OrdersHistoryRepositoryMockGetOrderOnlyStatusResults{stat, err}
+	return e.mock
+}
+
+// Times sets number of times OrdersHistoryRepository.GetOrderOnlyStatus should be invoked
+func (mmGetOrderOnlyStatus *mOrdersHistoryRepositoryMockGetOrderOnlyStatus) Times(n uint64) *mOrdersHistoryRepositoryMockGetOrderOnlyStatus {
+	if n == 0 {
+		mmGetOrderOnlyStatus.mock.t.Fatalf("Times of OrdersHistoryRepositoryMock.GetOrderOnlyStatus mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetOrderOnlyStatus.expectedInvocations, n)
+	mmGetOrderOnlyStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOrderOnlyStatus
+}
+
+func (mmGetOrderOnlyStatus *mOrdersHistoryRepositoryMockGetOrderOnlyStatus) invocationsDone() bool {
+	if len(mmGetOrderOnlyStatus.expectations) == 0 && mmGetOrderOnlyStatus.defaultExpectation == nil && mmGetOrderOnlyStatus.mock.funcGetOrderOnlyStatus == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOrderOnlyStatus.mock.afterGetOrderOnlyStatusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOrderOnlyStatus.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetOrderOnlyStatus implements mm_storage_json.OrdersHistoryRepository
+func (mmGetOrderOnlyStatus *OrdersHistoryRepositoryMock) GetOrderOnlyStatus(ctx context.Context, orderID uint64) (stat string, err error) {
+	mm_atomic.AddUint64(&mmGetOrderOnlyStatus.beforeGetOrderOnlyStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOrderOnlyStatus.afterGetOrderOnlyStatusCounter, 1)
+
+	mmGetOrderOnlyStatus.t.Helper()
+
+	if mmGetOrderOnlyStatus.inspectFuncGetOrderOnlyStatus != nil {
+		mmGetOrderOnlyStatus.inspectFuncGetOrderOnlyStatus(ctx, orderID)
+	}
+
+	mm_params := OrdersHistoryRepositoryMockGetOrderOnlyStatusParams{ctx, orderID}
+
+	// Record call args
+	mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.mutex.Lock()
+	mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.callArgs = append(mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.callArgs, &mm_params)
+	mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.mutex.Unlock()
+
+	for _, e := range mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.stat, e.results.err
+		}
+	}
+
+	if mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.defaultExpectation.paramPtrs
+
+		mm_got := OrdersHistoryRepositoryMockGetOrderOnlyStatusParams{ctx, orderID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetOrderOnlyStatus.t.Errorf("OrdersHistoryRepositoryMock.GetOrderOnlyStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmGetOrderOnlyStatus.t.Errorf("OrdersHistoryRepositoryMock.GetOrderOnlyStatus got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOrderOnlyStatus.t.Errorf("OrdersHistoryRepositoryMock.GetOrderOnlyStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOrderOnlyStatus.GetOrderOnlyStatusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOrderOnlyStatus.t.Fatal("No results are set for the OrdersHistoryRepositoryMock.GetOrderOnlyStatus")
+		}
+		return (*mm_results).stat, (*mm_results).err
+	}
+	if mmGetOrderOnlyStatus.funcGetOrderOnlyStatus != nil {
+		return mmGetOrderOnlyStatus.funcGetOrderOnlyStatus(ctx, orderID)
+	}
+	mmGetOrderOnlyStatus.t.Fatalf("Unexpected call to OrdersHistoryRepositoryMock.GetOrderOnlyStatus. %v %v", ctx, orderID)
+	return
+}
+
+// GetOrderOnlyStatusAfterCounter returns a count of finished OrdersHistoryRepositoryMock.GetOrderOnlyStatus invocations
+func (mmGetOrderOnlyStatus *OrdersHistoryRepositoryMock) GetOrderOnlyStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrderOnlyStatus.afterGetOrderOnlyStatusCounter)
+}
+
+// GetOrderOnlyStatusBeforeCounter returns a count of OrdersHistoryRepositoryMock.GetOrderOnlyStatus invocations
+func (mmGetOrderOnlyStatus *OrdersHistoryRepositoryMock) GetOrderOnlyStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrderOnlyStatus.beforeGetOrderOnlyStatusCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersHistoryRepositoryMock.GetOrderOnlyStatus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOrderOnlyStatus *mOrdersHistoryRepositoryMockGetOrderOnlyStatus) Calls() []*OrdersHistoryRepositoryMockGetOrderOnlyStatusParams {
+	mmGetOrderOnlyStatus.mutex.RLock()
+
+	argCopy := make([]*OrdersHistoryRepositoryMockGetOrderOnlyStatusParams, len(mmGetOrderOnlyStatus.callArgs))
+	copy(argCopy, mmGetOrderOnlyStatus.callArgs)
+
+	mmGetOrderOnlyStatus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOrderOnlyStatusDone returns true if the count of the GetOrderOnlyStatus invocations corresponds
+// the number of defined expectations
+func (m *OrdersHistoryRepositoryMock) MinimockGetOrderOnlyStatusDone() bool {
+	if m.GetOrderOnlyStatusMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetOrderOnlyStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetOrderOnlyStatusMock.invocationsDone()
+}
+
+// MinimockGetOrderOnlyStatusInspect logs each unmet expectation
+func (m *OrdersHistoryRepositoryMock) MinimockGetOrderOnlyStatusInspect() {
+	for _, e := range m.GetOrderOnlyStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetOrderOnlyStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetOrderOnlyStatusCounter := mm_atomic.LoadUint64(&m.afterGetOrderOnlyStatusCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrderOnlyStatusMock.defaultExpectation != nil && afterGetOrderOnlyStatusCounter < 1 {
+		if m.GetOrderOnlyStatusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetOrderOnlyStatus at\n%s", m.GetOrderOnlyStatusMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetOrderOnlyStatus at\n%s with params: %#v", m.GetOrderOnlyStatusMock.defaultExpectation.expectationOrigins.origin, *m.GetOrderOnlyStatusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrderOnlyStatus != nil && afterGetOrderOnlyStatusCounter < 1 {
+		m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetOrderOnlyStatus at\n%s", m.funcGetOrderOnlyStatusOrigin)
+	}
+
+	if !m.GetOrderOnlyStatusMock.invocationsDone() && afterGetOrderOnlyStatusCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrdersHistoryRepositoryMock.GetOrderOnlyStatus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetOrderOnlyStatusMock.expectedInvocations), m.GetOrderOnlyStatusMock.expectedInvocationsOrigin, afterGetOrderOnlyStatusCounter)
+	}
+}
+
+type mOrdersHistoryRepositoryMockGetOrderStatus struct {
+	optional           bool
+	mock               *OrdersHistoryRepositoryMock
+	defaultExpectation *OrdersHistoryRepositoryMockGetOrderStatusExpectation
+	expectations       []*OrdersHistoryRepositoryMockGetOrderStatusExpectation
+
+	callArgs []*OrdersHistoryRepositoryMockGetOrderStatusParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrdersHistoryRepositoryMockGetOrderStatusExpectation specifies expectation struct of the OrdersHistoryRepository.GetOrderStatus
+type OrdersHistoryRepositoryMockGetOrderStatusExpectation struct {
+	mock               *OrdersHistoryRepositoryMock
+	params             *OrdersHistoryRepositoryMockGetOrderStatusParams
+	paramPtrs          *OrdersHistoryRepositoryMockGetOrderStatusParamPtrs
+	expectationOrigins OrdersHistoryRepositoryMockGetOrderStatusExpectationOrigins
+	results            *OrdersHistoryRepositoryMockGetOrderStatusResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrdersHistoryRepositoryMockGetOrderStatusParams contains parameters of the OrdersHistoryRepository.GetOrderStatus
+type OrdersHistoryRepositoryMockGetOrderStatusParams struct {
+	ctx     context.Context
+	orderID uint64
+}
+
+// OrdersHistoryRepositoryMockGetOrderStatusParamPtrs contains pointers to parameters of the OrdersHistoryRepository.GetOrderStatus
+type OrdersHistoryRepositoryMockGetOrderStatusParamPtrs struct {
+	ctx     *context.Context
+	orderID *uint64
+}
+
+// OrdersHistoryRepositoryMockGetOrderStatusResults contains results of the OrdersHistoryRepository.GetOrderStatus
+type OrdersHistoryRepositoryMockGetOrderStatusResults struct {
+	op1 *domain.OrderStatus
+	err error
+}
+
+// OrdersHistoryRepositoryMockGetOrderStatusOrigins contains origins of expectations of the OrdersHistoryRepository.GetOrderStatus
+type OrdersHistoryRepositoryMockGetOrderStatusExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originOrderID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetOrderStatus *mOrdersHistoryRepositoryMockGetOrderStatus) Optional() *mOrdersHistoryRepositoryMockGetOrderStatus {
+	mmGetOrderStatus.optional = true
+	return mmGetOrderStatus
+}
+
+// Expect sets up expected params for OrdersHistoryRepository.GetOrderStatus
+func (mmGetOrderStatus *mOrdersHistoryRepositoryMockGetOrderStatus) Expect(ctx context.Context, orderID uint64) *mOrdersHistoryRepositoryMockGetOrderStatus {
+	if mmGetOrderStatus.mock.funcGetOrderStatus != nil {
+		mmGetOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderStatus mock is already set by Set")
+	}
+
+	if mmGetOrderStatus.defaultExpectation == nil {
+		mmGetOrderStatus.defaultExpectation = &OrdersHistoryRepositoryMockGetOrderStatusExpectation{}
+	}
+
+	if mmGetOrderStatus.defaultExpectation.paramPtrs != nil {
+		mmGetOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderStatus mock is already set by ExpectParams functions")
+	}
+
+	mmGetOrderStatus.defaultExpectation.params = &OrdersHistoryRepositoryMockGetOrderStatusParams{ctx, orderID}
+	mmGetOrderStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOrderStatus.expectations {
+		if minimock.Equal(e.params, mmGetOrderStatus.defaultExpectation.params) {
+			mmGetOrderStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrderStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOrderStatus
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrdersHistoryRepository.GetOrderStatus
+func (mmGetOrderStatus *mOrdersHistoryRepositoryMockGetOrderStatus) ExpectCtxParam1(ctx context.Context) *mOrdersHistoryRepositoryMockGetOrderStatus {
+	if mmGetOrderStatus.mock.funcGetOrderStatus != nil {
+		mmGetOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderStatus mock is already set by Set")
+	}
+
+	if mmGetOrderStatus.defaultExpectation == nil {
+		mmGetOrderStatus.defaultExpectation = &OrdersHistoryRepositoryMockGetOrderStatusExpectation{}
+	}
+
+	if mmGetOrderStatus.defaultExpectation.params != nil {
+		mmGetOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderStatus mock is already set by Expect")
+	}
+
+	if mmGetOrderStatus.defaultExpectation.paramPtrs == nil {
+		mmGetOrderStatus.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockGetOrderStatusParamPtrs{}
+	}
+	mmGetOrderStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOrderStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetOrderStatus
+}
+
+// ExpectOrderIDParam2 sets up expected param orderID for OrdersHistoryRepository.GetOrderStatus
+func (mmGetOrderStatus *mOrdersHistoryRepositoryMockGetOrderStatus) ExpectOrderIDParam2(orderID uint64) *mOrdersHistoryRepositoryMockGetOrderStatus {
+	if mmGetOrderStatus.mock.funcGetOrderStatus != nil {
+		mmGetOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderStatus mock is already set by Set")
+	}
+
+	if mmGetOrderStatus.defaultExpectation == nil {
+		mmGetOrderStatus.defaultExpectation = &OrdersHistoryRepositoryMockGetOrderStatusExpectation{}
+	}
+
+	if mmGetOrderStatus.defaultExpectation.params != nil {
+		mmGetOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderStatus mock is already set by Expect")
+	}
+
+	if mmGetOrderStatus.defaultExpectation.paramPtrs == nil {
+		mmGetOrderStatus.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockGetOrderStatusParamPtrs{}
+	}
+	mmGetOrderStatus.defaultExpectation.paramPtrs.orderID = &orderID
+	mmGetOrderStatus.defaultExpectation.expectationOrigins.originOrderID = minimock.CallerInfo(1)
+
+	return mmGetOrderStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersHistoryRepository.GetOrderStatus
+func (mmGetOrderStatus *mOrdersHistoryRepositoryMockGetOrderStatus) Inspect(f func(ctx context.Context, orderID uint64)) *mOrdersHistoryRepositoryMockGetOrderStatus {
+	if mmGetOrderStatus.mock.inspectFuncGetOrderStatus != nil {
+		mmGetOrderStatus.mock.t.Fatalf("Inspect function is already set for OrdersHistoryRepositoryMock.GetOrderStatus")
+	}
+
+	mmGetOrderStatus.mock.inspectFuncGetOrderStatus = f
+
+	return mmGetOrderStatus
+}
+
+// Return sets up results that will be returned by OrdersHistoryRepository.GetOrderStatus
+func (mmGetOrderStatus *mOrdersHistoryRepositoryMockGetOrderStatus) Return(op1 *domain.OrderStatus, err error) *OrdersHistoryRepositoryMock {
+	if mmGetOrderStatus.mock.funcGetOrderStatus != nil {
+		mmGetOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderStatus mock is already set by Set")
+	}
+
+	if mmGetOrderStatus.defaultExpectation == nil {
+		mmGetOrderStatus.defaultExpectation = &OrdersHistoryRepositoryMockGetOrderStatusExpectation{mock: mmGetOrderStatus.mock}
+	}
+	mmGetOrderStatus.defaultExpectation.results = &OrdersHistoryRepositoryMockGetOrderStatusResults{op1, err}
+	mmGetOrderStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOrderStatus.mock
+}
+
+// Set uses given function f to mock the OrdersHistoryRepository.GetOrderStatus method
+func (mmGetOrderStatus *mOrdersHistoryRepositoryMockGetOrderStatus) Set(f func(ctx context.Context, orderID uint64) (op1 *domain.OrderStatus, err error)) *OrdersHistoryRepositoryMock {
+	if mmGetOrderStatus.defaultExpectation != nil {
+		mmGetOrderStatus.mock.t.Fatalf("Default expectation is already set for the OrdersHistoryRepository.GetOrderStatus method")
+	}
+
+	if len(mmGetOrderStatus.expectations) > 0 {
+		mmGetOrderStatus.mock.t.Fatalf("Some expectations are already set for the OrdersHistoryRepository.GetOrderStatus method")
+	}
+
+	mmGetOrderStatus.mock.funcGetOrderStatus = f
+	mmGetOrderStatus.mock.funcGetOrderStatusOrigin = minimock.CallerInfo(1)
+	return mmGetOrderStatus.mock
+}
+
+// When sets expectation for the OrdersHistoryRepository.GetOrderStatus which will trigger the result defined by the following
+// Then helper
+func (mmGetOrderStatus *mOrdersHistoryRepositoryMockGetOrderStatus) When(ctx context.Context, orderID uint64) *OrdersHistoryRepositoryMockGetOrderStatusExpectation {
+	if mmGetOrderStatus.mock.funcGetOrderStatus != nil {
+		mmGetOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderStatus mock is already set by Set")
+	}
+
+	expectation := &OrdersHistoryRepositoryMockGetOrderStatusExpectation{
+		mock:               mmGetOrderStatus.mock,
+		params:             &OrdersHistoryRepositoryMockGetOrderStatusParams{ctx, orderID},
+		expectationOrigins: OrdersHistoryRepositoryMockGetOrderStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetOrderStatus.expectations = append(mmGetOrderStatus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersHistoryRepository.GetOrderStatus return parameters for the expectation previously defined by the When method
+func (e *OrdersHistoryRepositoryMockGetOrderStatusExpectation) Then(op1 *domain.OrderStatus, err error) *OrdersHistoryRepositoryMock {
+	e.results = &OrdersHistoryRepositoryMockGetOrderStatusResults{op1, err}
+	return e.mock
+}
+
+// Times sets number of times OrdersHistoryRepository.GetOrderStatus should be invoked
+func (mmGetOrderStatus *mOrdersHistoryRepositoryMockGetOrderStatus) Times(n uint64) *mOrdersHistoryRepositoryMockGetOrderStatus {
+	if n == 0 {
+		mmGetOrderStatus.mock.t.Fatalf("Times of OrdersHistoryRepositoryMock.GetOrderStatus mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetOrderStatus.expectedInvocations, n)
+	mmGetOrderStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOrderStatus
+}
+
+func (mmGetOrderStatus *mOrdersHistoryRepositoryMockGetOrderStatus) invocationsDone() bool {
+	if len(mmGetOrderStatus.expectations) == 0 && mmGetOrderStatus.defaultExpectation == nil && mmGetOrderStatus.mock.funcGetOrderStatus == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOrderStatus.mock.afterGetOrderStatusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOrderStatus.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetOrderStatus implements mm_storage_json.OrdersHistoryRepository
+func (mmGetOrderStatus *OrdersHistoryRepositoryMock) GetOrderStatus(ctx context.Context, orderID uint64) (op1 *domain.OrderStatus, err error) {
+	mm_atomic.AddUint64(&mmGetOrderStatus.beforeGetOrderStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOrderStatus.afterGetOrderStatusCounter, 1)
+
+	mmGetOrderStatus.t.Helper()
+
+	if mmGetOrderStatus.inspectFuncGetOrderStatus != nil {
+		mmGetOrderStatus.inspectFuncGetOrderStatus(ctx, orderID)
+	}
+
+	mm_params := OrdersHistoryRepositoryMockGetOrderStatusParams{ctx, orderID}
+
+	// Record call args
+	mmGetOrderStatus.GetOrderStatusMock.mutex.Lock()
+	mmGetOrderStatus.GetOrderStatusMock.callArgs = append(mmGetOrderStatus.GetOrderStatusMock.callArgs, &mm_params)
+	mmGetOrderStatus.GetOrderStatusMock.mutex.Unlock()
+
+	for _, e := range mmGetOrderStatus.GetOrderStatusMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.op1, e.results.err
+		}
+	}
+
+	if mmGetOrderStatus.GetOrderStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOrderStatus.GetOrderStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOrderStatus.GetOrderStatusMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOrderStatus.GetOrderStatusMock.defaultExpectation.paramPtrs
+
+		mm_got := OrdersHistoryRepositoryMockGetOrderStatusParams{ctx, orderID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetOrderStatus.t.Errorf("OrdersHistoryRepositoryMock.GetOrderStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrderStatus.GetOrderStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmGetOrderStatus.t.Errorf("OrdersHistoryRepositoryMock.GetOrderStatus got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrderStatus.GetOrderStatusMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOrderStatus.t.Errorf("OrdersHistoryRepositoryMock.GetOrderStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetOrderStatus.GetOrderStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOrderStatus.GetOrderStatusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOrderStatus.t.Fatal("No results are set for the OrdersHistoryRepositoryMock.GetOrderStatus")
+		}
+		return (*mm_results).op1, (*mm_results).err
+	}
+	if mmGetOrderStatus.funcGetOrderStatus != nil {
+		return mmGetOrderStatus.funcGetOrderStatus(ctx, orderID)
+	}
+	mmGetOrderStatus.t.Fatalf("Unexpected call to OrdersHistoryRepositoryMock.GetOrderStatus. %v %v", ctx, orderID)
+	return
+}
+
+// GetOrderStatusAfterCounter returns a count of finished OrdersHistoryRepositoryMock.GetOrderStatus invocations
+func (mmGetOrderStatus *OrdersHistoryRepositoryMock) GetOrderStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrderStatus.afterGetOrderStatusCounter)
+}
+
+// GetOrderStatusBeforeCounter returns a count of OrdersHistoryRepositoryMock.GetOrderStatus invocations
+func (mmGetOrderStatus *OrdersHistoryRepositoryMock) GetOrderStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrderStatus.beforeGetOrderStatusCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersHistoryRepositoryMock.GetOrderStatus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOrderStatus *mOrdersHistoryRepositoryMockGetOrderStatus) Calls() []*OrdersHistoryRepositoryMockGetOrderStatusParams {
+	mmGetOrderStatus.mutex.RLock()
+
+	argCopy := make([]*OrdersHistoryRepositoryMockGetOrderStatusParams, len(mmGetOrderStatus.callArgs))
+	copy(argCopy, mmGetOrderStatus.callArgs)
+
+	mmGetOrderStatus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOrderStatusDone returns true if the count of the GetOrderStatus invocations corresponds
+// the number of defined expectations
+func (m *OrdersHistoryRepositoryMock) MinimockGetOrderStatusDone() bool {
+	if m.GetOrderStatusMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetOrderStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetOrderStatusMock.invocationsDone()
+}
+
+// MinimockGetOrderStatusInspect logs each unmet expectation
+func (m *OrdersHistoryRepositoryMock) MinimockGetOrderStatusInspect() {
+	for _, e := range m.GetOrderStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetOrderStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetOrderStatusCounter := mm_atomic.LoadUint64(&m.afterGetOrderStatusCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrderStatusMock.defaultExpectation != nil && afterGetOrderStatusCounter < 1 {
+		if m.GetOrderStatusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetOrderStatus at\n%s", m.GetOrderStatusMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetOrderStatus at\n%s with params: %#v", m.GetOrderStatusMock.defaultExpectation.expectationOrigins.origin, *m.GetOrderStatusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrderStatus != nil && afterGetOrderStatusCounter < 1 {
+		m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetOrderStatus at\n%s", m.funcGetOrderStatusOrigin)
+	}
+
+	if !m.GetOrderStatusMock.invocationsDone() && afterGetOrderStatusCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrdersHistoryRepositoryMock.GetOrderStatus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetOrderStatusMock.expectedInvocations), m.GetOrderStatusMock.expectedInvocationsOrigin, afterGetOrderStatusCounter)
+	}
+}
+
+type mOrdersHistoryRepositoryMockGetOrdersCountByStatus struct {
+	optional           bool
+	mock               *OrdersHistoryRepositoryMock
+	defaultExpectation *OrdersHistoryRepositoryMockGetOrdersCountByStatusExpectation
+	expectations       []*OrdersHistoryRepositoryMockGetOrdersCountByStatusExpectation
+
+	callArgs []*OrdersHistoryRepositoryMockGetOrdersCountByStatusParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrdersHistoryRepositoryMockGetOrdersCountByStatusExpectation specifies expectation struct of the OrdersHistoryRepository.GetOrdersCountByStatus
+type OrdersHistoryRepositoryMockGetOrdersCountByStatusExpectation struct {
+	mock               *OrdersHistoryRepositoryMock
+	params             *OrdersHistoryRepositoryMockGetOrdersCountByStatusParams
+	paramPtrs          *OrdersHistoryRepositoryMockGetOrdersCountByStatusParamPtrs
+	expectationOrigins OrdersHistoryRepositoryMockGetOrdersCountByStatusExpectationOrigins
+	results            *OrdersHistoryRepositoryMockGetOrdersCountByStatusResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrdersHistoryRepositoryMockGetOrdersCountByStatusParams contains parameters of the OrdersHistoryRepository.GetOrdersCountByStatus
+type OrdersHistoryRepositoryMockGetOrdersCountByStatusParams struct {
+	ctx    context.Context
+	status string
+}
+
+// OrdersHistoryRepositoryMockGetOrdersCountByStatusParamPtrs contains pointers to parameters of the OrdersHistoryRepository.GetOrdersCountByStatus
+type OrdersHistoryRepositoryMockGetOrdersCountByStatusParamPtrs struct {
+	ctx    *context.Context
+	status *string
+}
+
+// OrdersHistoryRepositoryMockGetOrdersCountByStatusResults contains results of the OrdersHistoryRepository.GetOrdersCountByStatus
+type OrdersHistoryRepositoryMockGetOrdersCountByStatusResults struct {
+	u1  uint64
+	err error
+}
+
+// OrdersHistoryRepositoryMockGetOrdersCountByStatusOrigins contains origins of expectations of the OrdersHistoryRepository.GetOrdersCountByStatus
+type OrdersHistoryRepositoryMockGetOrdersCountByStatusExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originStatus string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetOrdersCountByStatus *mOrdersHistoryRepositoryMockGetOrdersCountByStatus) Optional() *mOrdersHistoryRepositoryMockGetOrdersCountByStatus {
+	mmGetOrdersCountByStatus.optional = true
+	return mmGetOrdersCountByStatus
+}
+
+// Expect sets up expected params for OrdersHistoryRepository.GetOrdersCountByStatus
+func (mmGetOrdersCountByStatus *mOrdersHistoryRepositoryMockGetOrdersCountByStatus) Expect(ctx context.Context, status string) *mOrdersHistoryRepositoryMockGetOrdersCountByStatus {
+	if mmGetOrdersCountByStatus.mock.funcGetOrdersCountByStatus != nil {
+		mmGetOrdersCountByStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrdersCountByStatus mock is already set by Set")
+	}
+
+	if mmGetOrdersCountByStatus.defaultExpectation == nil {
+		mmGetOrdersCountByStatus.defaultExpectation = &OrdersHistoryRepositoryMockGetOrdersCountByStatusExpectation{}
+	}
+
+	if mmGetOrdersCountByStatus.defaultExpectation.paramPtrs != nil {
+		mmGetOrdersCountByStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrdersCountByStatus mock is already set by ExpectParams functions")
+	}
+
+	mmGetOrdersCountByStatus.defaultExpectation.params = &OrdersHistoryRepositoryMockGetOrdersCountByStatusParams{ctx, status}
+	mmGetOrdersCountByStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOrdersCountByStatus.expectations {
+		if minimock.Equal(e.params, mmGetOrdersCountByStatus.defaultExpectation.params) {
+			mmGetOrdersCountByStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrdersCountByStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOrdersCountByStatus
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrdersHistoryRepository.GetOrdersCountByStatus
+func (mmGetOrdersCountByStatus *mOrdersHistoryRepositoryMockGetOrdersCountByStatus) ExpectCtxParam1(ctx context.Context) *mOrdersHistoryRepositoryMockGetOrdersCountByStatus {
+	if mmGetOrdersCountByStatus.mock.funcGetOrdersCountByStatus != nil {
+		mmGetOrdersCountByStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrdersCountByStatus mock is already set by Set")
+	}
+
+	if mmGetOrdersCountByStatus.defaultExpectation == nil {
+		mmGetOrdersCountByStatus.defaultExpectation = &OrdersHistoryRepositoryMockGetOrdersCountByStatusExpectation{}
+	}
+
+	if mmGetOrdersCountByStatus.defaultExpectation.params != nil {
+		mmGetOrdersCountByStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrdersCountByStatus mock is already set by Expect")
+	}
+
+	if mmGetOrdersCountByStatus.defaultExpectation.paramPtrs == nil {
+		mmGetOrdersCountByStatus.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockGetOrdersCountByStatusParamPtrs{}
+	}
+	mmGetOrdersCountByStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOrdersCountByStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetOrdersCountByStatus
+}
+
+// ExpectStatusParam2 sets up expected param status for OrdersHistoryRepository.GetOrdersCountByStatus
+func (mmGetOrdersCountByStatus *mOrdersHistoryRepositoryMockGetOrdersCountByStatus) ExpectStatusParam2(status string) *mOrdersHistoryRepositoryMockGetOrdersCountByStatus {
+	if mmGetOrdersCountByStatus.mock.funcGetOrdersCountByStatus != nil {
+		mmGetOrdersCountByStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrdersCountByStatus mock is already set by Set")
+	}
+
+	if mmGetOrdersCountByStatus.defaultExpectation == nil {
+		mmGetOrdersCountByStatus.defaultExpectation = &OrdersHistoryRepositoryMockGetOrdersCountByStatusExpectation{}
+	}
+
+	if mmGetOrdersCountByStatus.defaultExpectation.params != nil {
+		mmGetOrdersCountByStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrdersCountByStatus mock is already set by Expect")
+	}
+
+	if mmGetOrdersCountByStatus.defaultExpectation.paramPtrs == nil {
+		mmGetOrdersCountByStatus.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockGetOrdersCountByStatusParamPtrs{}
+	}
+	mmGetOrdersCountByStatus.defaultExpectation.paramPtrs.status = &status
+	mmGetOrdersCountByStatus.defaultExpectation.expectationOrigins.originStatus = minimock.CallerInfo(1)
+
+	return mmGetOrdersCountByStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersHistoryRepository.GetOrdersCountByStatus
+func (mmGetOrdersCountByStatus *mOrdersHistoryRepositoryMockGetOrdersCountByStatus) Inspect(f func(ctx context.Context, status string)) *mOrdersHistoryRepositoryMockGetOrdersCountByStatus {
+	if mmGetOrdersCountByStatus.mock.inspectFuncGetOrdersCountByStatus != nil {
+		mmGetOrdersCountByStatus.mock.t.Fatalf("Inspect function is already set for OrdersHistoryRepositoryMock.GetOrdersCountByStatus")
+	}
+
+	mmGetOrdersCountByStatus.mock.inspectFuncGetOrdersCountByStatus = f
+
+	return mmGetOrdersCountByStatus
+}
+
+// Return sets up results that will be returned by OrdersHistoryRepository.GetOrdersCountByStatus
+func (mmGetOrdersCountByStatus *mOrdersHistoryRepositoryMockGetOrdersCountByStatus) Return(u1 uint64, err error) *OrdersHistoryRepositoryMock {
+	if mmGetOrdersCountByStatus.mock.funcGetOrdersCountByStatus != nil {
+		mmGetOrdersCountByStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrdersCountByStatus mock is already set by Set")
+	}
+
+	if mmGetOrdersCountByStatus.defaultExpectation == nil {
+		mmGetOrdersCountByStatus.defaultExpectation = &OrdersHistoryRepositoryMockGetOrdersCountByStatusExpectation{mock: mmGetOrdersCountByStatus.mock}
+	}
+	mmGetOrdersCountByStatus.defaultExpectation.results = &OrdersHistoryRepositoryMockGetOrdersCountByStatusResults{u1, err}
+	mmGetOrdersCountByStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOrdersCountByStatus.mock
+}
+
+// Set uses given function f to mock the OrdersHistoryRepository.GetOrdersCountByStatus method
+func (mmGetOrdersCountByStatus *mOrdersHistoryRepositoryMockGetOrdersCountByStatus) Set(f func(ctx context.Context, status string) (u1 uint64, err error)) *OrdersHistoryRepositoryMock {
+	if mmGetOrdersCountByStatus.defaultExpectation != nil {
+		mmGetOrdersCountByStatus.mock.t.Fatalf("Default expectation is already set for the OrdersHistoryRepository.GetOrdersCountByStatus method")
+	}
+
+	if len(mmGetOrdersCountByStatus.expectations) > 0 {
+		mmGetOrdersCountByStatus.mock.t.Fatalf("Some expectations are already set for the OrdersHistoryRepository.GetOrdersCountByStatus method")
+	}
+
+	mmGetOrdersCountByStatus.mock.funcGetOrdersCountByStatus = f
+	mmGetOrdersCountByStatus.mock.funcGetOrdersCountByStatusOrigin = minimock.CallerInfo(1)
+	return mmGetOrdersCountByStatus.mock
+}
+
+// When sets expectation for the OrdersHistoryRepository.GetOrdersCountByStatus which will trigger the result defined by the following
+// Then helper
+func (mmGetOrdersCountByStatus *mOrdersHistoryRepositoryMockGetOrdersCountByStatus) When(ctx context.Context, status string) *OrdersHistoryRepositoryMockGetOrdersCountByStatusExpectation {
+	if mmGetOrdersCountByStatus.mock.funcGetOrdersCountByStatus != nil {
+		mmGetOrdersCountByStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrdersCountByStatus mock is already set by Set")
+	}
+
+	expectation := &OrdersHistoryRepositoryMockGetOrdersCountByStatusExpectation{
+		mock:               mmGetOrdersCountByStatus.mock,
+		params:             &OrdersHistoryRepositoryMockGetOrdersCountByStatusParams{ctx, status},
+		expectationOrigins: OrdersHistoryRepositoryMockGetOrdersCountByStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetOrdersCountByStatus.expectations = append(mmGetOrdersCountByStatus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersHistoryRepository.GetOrdersCountByStatus return parameters for the expectation previously defined by the When method
+func (e *OrdersHistoryRepositoryMockGetOrdersCountByStatusExpectation) Then(u1 uint64, err error) *OrdersHistoryRepositoryMock {
+	e.results = &OrdersHistoryRepositoryMockGetOrdersCountByStatusResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times OrdersHistoryRepository.GetOrdersCountByStatus should be invoked
+func (mmGetOrdersCountByStatus *mOrdersHistoryRepositoryMockGetOrdersCountByStatus) Times(n uint64) *mOrdersHistoryRepositoryMockGetOrdersCountByStatus {
+	if n == 0 {
+		mmGetOrdersCountByStatus.mock.t.Fatalf("Times of OrdersHistoryRepositoryMock.GetOrdersCountByStatus mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetOrdersCountByStatus.expectedInvocations, n)
+	mmGetOrdersCountByStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOrdersCountByStatus
+}
+
+func (mmGetOrdersCountByStatus *mOrdersHistoryRepositoryMockGetOrdersCountByStatus) invocationsDone() bool {
+	if len(mmGetOrdersCountByStatus.expectations) == 0 && mmGetOrdersCountByStatus.defaultExpectation == nil && mmGetOrdersCountByStatus.mock.funcGetOrdersCountByStatus == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOrdersCountByStatus.mock.afterGetOrdersCountByStatusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOrdersCountByStatus.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetOrdersCountByStatus implements mm_storage_json.OrdersHistoryRepository
+func (mmGetOrdersCountByStatus *OrdersHistoryRepositoryMock) GetOrdersCountByStatus(ctx context.Context, status string) (u1 uint64, err error) {
+	mm_atomic.AddUint64(&mmGetOrdersCountByStatus.beforeGetOrdersCountByStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOrdersCountByStatus.afterGetOrdersCountByStatusCounter, 1)
+
+	mmGetOrdersCountByStatus.t.Helper()
+
+	if mmGetOrdersCountByStatus.inspectFuncGetOrdersCountByStatus != nil {
+		mmGetOrdersCountByStatus.inspectFuncGetOrdersCountByStatus(ctx, status)
+	}
+
+	mm_params := OrdersHistoryRepositoryMockGetOrdersCountByStatusParams{ctx, status}
+
+	// Record call args
+	mmGetOrdersCountByStatus.GetOrdersCountByStatusMock.mutex.Lock()
+	mmGetOrdersCountByStatus.GetOrdersCountByStatusMock.callArgs = append(mmGetOrdersCountByStatus.GetOrdersCountByStatusMock.callArgs, &mm_params)
+	mmGetOrdersCountByStatus.GetOrdersCountByStatusMock.mutex.Unlock()
+
+	for _, e := range mmGetOrdersCountByStatus.GetOrdersCountByStatusMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmGetOrdersCountByStatus.GetOrdersCountByStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOrdersCountByStatus.GetOrdersCountByStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOrdersCountByStatus.GetOrdersCountByStatusMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOrdersCountByStatus.GetOrdersCountByStatusMock.defaultExpectation.paramPtrs
+
+		mm_got := OrdersHistoryRepositoryMockGetOrdersCountByStatusParams{ctx, status}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetOrdersCountByStatus.t.Errorf("OrdersHistoryRepositoryMock.GetOrdersCountByStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrdersCountByStatus.GetOrdersCountByStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.status != nil && !minimock.Equal(*mm_want_ptrs.status, mm_got.status) {
+				mmGetOrdersCountByStatus.t.Errorf("OrdersHistoryRepositoryMock.GetOrdersCountByStatus got unexpected parameter status, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrdersCountByStatus.GetOrdersCountByStatusMock.defaultExpectation.expectationOrigins.originStatus, *mm_want_ptrs.status, mm_got.status, minimock.Diff(*mm_want_ptrs.status, mm_got.status))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOrdersCountByStatus.t.Errorf("OrdersHistoryRepositoryMock.GetOrdersCountByStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetOrdersCountByStatus.GetOrdersCountByStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOrdersCountByStatus.GetOrdersCountByStatusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOrdersCountByStatus.t.Fatal("No results are set for the OrdersHistoryRepositoryMock.GetOrdersCountByStatus")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmGetOrdersCountByStatus.funcGetOrdersCountByStatus != nil {
+		return mmGetOrdersCountByStatus.funcGetOrdersCountByStatus(ctx, status)
+	}
+	mmGetOrdersCountByStatus.t.Fatalf("Unexpected call to OrdersHistoryRepositoryMock.GetOrdersCountByStatus. %v %v", ctx, status)
+	return
+}
+
+// GetOrdersCountByStatusAfterCounter returns a count of finished OrdersHistoryRepositoryMock.GetOrdersCountByStatus invocations
+func (mmGetOrdersCountByStatus *OrdersHistoryRepositoryMock) GetOrdersCountByStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrdersCountByStatus.afterGetOrdersCountByStatusCounter)
+}
+
+// GetOrdersCountByStatusBeforeCounter returns a count of OrdersHistoryRepositoryMock.GetOrdersCountByStatus invocations
+func (mmGetOrdersCountByStatus *OrdersHistoryRepositoryMock) GetOrdersCountByStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrdersCountByStatus.beforeGetOrdersCountByStatusCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersHistoryRepositoryMock.GetOrdersCountByStatus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOrdersCountByStatus *mOrdersHistoryRepositoryMockGetOrdersCountByStatus) Calls() []*OrdersHistoryRepositoryMockGetOrdersCountByStatusParams {
+	mmGetOrdersCountByStatus.mutex.RLock()
+
+	argCopy := make([]*OrdersHistoryRepositoryMockGetOrdersCountByStatusParams, len(mmGetOrdersCountByStatus.callArgs))
+	copy(argCopy, mmGetOrdersCountByStatus.callArgs)
+
+	mmGetOrdersCountByStatus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOrdersCountByStatusDone returns true if the count of the GetOrdersCountByStatus invocations corresponds
+// the number of defined expectations
+func (m *OrdersHistoryRepositoryMock) MinimockGetOrdersCountByStatusDone() bool {
+	if m.GetOrdersCountByStatusMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetOrdersCountByStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetOrdersCountByStatusMock.invocationsDone()
+}
+
+// MinimockGetOrdersCountByStatusInspect logs each unmet expectation
+func (m *OrdersHistoryRepositoryMock) MinimockGetOrdersCountByStatusInspect() {
+	for _, e := range m.GetOrdersCountByStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetOrdersCountByStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetOrdersCountByStatusCounter := mm_atomic.LoadUint64(&m.afterGetOrdersCountByStatusCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrdersCountByStatusMock.defaultExpectation != nil && afterGetOrdersCountByStatusCounter < 1 {
+		if m.GetOrdersCountByStatusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetOrdersCountByStatus at\n%s", m.GetOrdersCountByStatusMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetOrdersCountByStatus at\n%s with params: %#v", m.GetOrdersCountByStatusMock.defaultExpectation.expectationOrigins.origin, *m.GetOrdersCountByStatusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrdersCountByStatus != nil && afterGetOrdersCountByStatusCounter < 1 {
+		m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetOrdersCountByStatus at\n%s", m.funcGetOrdersCountByStatusOrigin)
+	}
+
+	if !m.GetOrdersCountByStatusMock.invocationsDone() && afterGetOrdersCountByStatusCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrdersHistoryRepositoryMock.GetOrdersCountByStatus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetOrdersCountByStatusMock.expectedInvocations), m.GetOrdersCountByStatusMock.expectedInvocationsOrigin, afterGetOrdersCountByStatusCounter)
+	}
+}
+
+type mOrdersHistoryRepositoryMockGetOrdersToRemind struct {
+	optional           bool
+	mock               *OrdersHistoryRepositoryMock
+	defaultExpectation *OrdersHistoryRepositoryMockGetOrdersToRemindExpectation
+	expectations       []*OrdersHistoryRepositoryMockGetOrdersToRemindExpectation
+
+	callArgs []*OrdersHistoryRepositoryMockGetOrdersToRemindParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrdersHistoryRepositoryMockGetOrdersToRemindExpectation specifies expectation struct of the OrdersHistoryRepository.GetOrdersToRemind
+type OrdersHistoryRepositoryMockGetOrdersToRemindExpectation struct {
+	mock               *OrdersHistoryRepositoryMock
+	params             *OrdersHistoryRepositoryMockGetOrdersToRemindParams
+	paramPtrs          *OrdersHistoryRepositoryMockGetOrdersToRemindParamPtrs
+	expectationOrigins OrdersHistoryRepositoryMockGetOrdersToRemindExpectationOrigins
+	results            *OrdersHistoryRepositoryMockGetOrdersToRemindResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrdersHistoryRepositoryMockGetOrdersToRemindParams contains parameters of the OrdersHistoryRepository.GetOrdersToRemind
+type OrdersHistoryRepositoryMockGetOrdersToRemindParams struct {
+	ctx         context.Context
+	stage       uint64
+	expiresFrom time.Time
+	expiresTo   time.Time
+}
+
+// OrdersHistoryRepositoryMockGetOrdersToRemindParamPtrs contains pointers to parameters of the OrdersHistoryRepository.GetOrdersToRemind
+type OrdersHistoryRepositoryMockGetOrdersToRemindParamPtrs struct {
+	ctx         *context.Context
+	stage       *uint64
+	expiresFrom *time.Time
+	expiresTo   *time.Time
+}
+
+// OrdersHistoryRepositoryMockGetOrdersToRemindResults contains results of the OrdersHistoryRepository.GetOrdersToRemind
+type OrdersHistoryRepositoryMockGetOrdersToRemindResults struct {
+	oa1 []domain.OrderView
+	err error
+}
+
+// OrdersHistoryRepositoryMockGetOrdersToRemindOrigins contains origins of expectations of the OrdersHistoryRepository.GetOrdersToRemind
+type OrdersHistoryRepositoryMockGetOrdersToRemindExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originStage       string
+	originExpiresFrom string
+	originExpiresTo   string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetOrdersToRemind *mOrdersHistoryRepositoryMockGetOrdersToRemind) Optional() *mOrdersHistoryRepositoryMockGetOrdersToRemind {
+	mmGetOrdersToRemind.optional = true
+	return mmGetOrdersToRemind
+}
+
+// Expect sets up expected params for OrdersHistoryRepository.GetOrdersToRemind
+func (mmGetOrdersToRemind *mOrdersHistoryRepositoryMockGetOrdersToRemind) Expect(ctx context.Context, stage uint64, expiresFrom time.Time, expiresTo time.Time) *mOrdersHistoryRepositoryMockGetOrdersToRemind {
+	if mmGetOrdersToRemind.mock.funcGetOrdersToRemind != nil {
+		mmGetOrdersToRemind.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrdersToRemind mock is already set by Set")
+	}
+
+	if mmGetOrdersToRemind.defaultExpectation == nil {
+		mmGetOrdersToRemind.defaultExpectation = &OrdersHistoryRepositoryMockGetOrdersToRemindExpectation{}
+	}
+
+	if mmGetOrdersToRemind.defaultExpectation.paramPtrs != nil {
+		mmGetOrdersToRemind.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrdersToRemind mock is already set by ExpectParams functions")
+	}
+
+	mmGetOrdersToRemind.defaultExpectation.params = &OrdersHistoryRepositoryMockGetOrdersToRemindParams{ctx, stage, expiresFrom, expiresTo}
+	mmGetOrdersToRemind.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetOrdersToRemind.expectations {
+		if minimock.Equal(e.params, mmGetOrdersToRemind.defaultExpectation.params) {
+			mmGetOrdersToRemind.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetOrdersToRemind.defaultExpectation.params)
+		}
+	}
+
+	return mmGetOrdersToRemind
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrdersHistoryRepository.GetOrdersToRemind
+func (mmGetOrdersToRemind *mOrdersHistoryRepositoryMockGetOrdersToRemind) ExpectCtxParam1(ctx context.Context) *mOrdersHistoryRepositoryMockGetOrdersToRemind {
+	if mmGetOrdersToRemind.mock.funcGetOrdersToRemind != nil {
+		mmGetOrdersToRemind.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrdersToRemind mock is already set by Set")
+	}
+
+	if mmGetOrdersToRemind.defaultExpectation == nil {
+		mmGetOrdersToRemind.defaultExpectation = &OrdersHistoryRepositoryMockGetOrdersToRemindExpectation{}
+	}
+
+	if mmGetOrdersToRemind.defaultExpectation.params != nil {
+		mmGetOrdersToRemind.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrdersToRemind mock is already set by Expect")
+	}
+
+	if mmGetOrdersToRemind.defaultExpectation.paramPtrs == nil {
+		mmGetOrdersToRemind.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockGetOrdersToRemindParamPtrs{}
+	}
+	mmGetOrdersToRemind.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOrdersToRemind.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetOrdersToRemind
+}
+
+// ExpectStageParam2 sets up expected param stage for OrdersHistoryRepository.GetOrdersToRemind
+func (mmGetOrdersToRemind *mOrdersHistoryRepositoryMockGetOrdersToRemind) ExpectStageParam2(stage uint64) *mOrdersHistoryRepositoryMockGetOrdersToRemind {
+	if mmGetOrdersToRemind.mock.funcGetOrdersToRemind != nil {
+		mmGetOrdersToRemind.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrdersToRemind mock is already set by Set")
+	}
+
+	if mmGetOrdersToRemind.defaultExpectation == nil {
+		mmGetOrdersToRemind.defaultExpectation = &OrdersHistoryRepositoryMockGetOrdersToRemindExpectation{}
+	}
+
+	if mmGetOrdersToRemind.defaultExpectation.params != nil {
+		mmGetOrdersToRemind.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrdersToRemind mock is already set by Expect")
+	}
+
+	if mmGetOrdersToRemind.defaultExpectation.paramPtrs == nil {
+		mmGetOrdersToRemind.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockGetOrdersToRemindParamPtrs{}
+	}
+	mmGetOrdersToRemind.defaultExpectation.paramPtrs.stage = &stage
+	mmGetOrdersToRemind.defaultExpectation.expectationOrigins.originStage = minimock.CallerInfo(1)
+
+	return mmGetOrdersToRemind
+}
+
+// ExpectExpiresFromParam3 sets up expected param expiresFrom for OrdersHistoryRepository.GetOrdersToRemind
+func (mmGetOrdersToRemind *mOrdersHistoryRepositoryMockGetOrdersToRemind) ExpectExpiresFromParam3(expiresFrom time.Time) *mOrdersHistoryRepositoryMockGetOrdersToRemind {
+	if mmGetOrdersToRemind.mock.funcGetOrdersToRemind != nil {
+		mmGetOrdersToRemind.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrdersToRemind mock is already set by Set")
+	}
+
+	if mmGetOrdersToRemind.defaultExpectation == nil {
+		mmGetOrdersToRemind.defaultExpectation = &OrdersHistoryRepositoryMockGetOrdersToRemindExpectation{}
+	}
+
+	if mmGetOrdersToRemind.defaultExpectation.params != nil {
+		mmGetOrdersToRemind.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrdersToRemind mock is already set by Expect")
+	}
+
+	if mmGetOrdersToRemind.defaultExpectation.paramPtrs == nil {
+		mmGetOrdersToRemind.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockGetOrdersToRemindParamPtrs{}
+	}
+	mmGetOrdersToRemind.defaultExpectation.paramPtrs.expiresFrom = &expiresFrom
+	mmGetOrdersToRemind.defaultExpectation.expectationOrigins.originExpiresFrom = minimock.CallerInfo(1)
+
+	return mmGetOrdersToRemind
+}
+
+// ExpectExpiresToParam4 sets up expected param expiresTo for OrdersHistoryRepository.GetOrdersToRemind
+func (mmGetOrdersToRemind *mOrdersHistoryRepositoryMockGetOrdersToRemind) ExpectExpiresToParam4(expiresTo time.Time) *mOrdersHistoryRepositoryMockGetOrdersToRemind {
+	if mmGetOrdersToRemind.mock.funcGetOrdersToRemind != nil {
+		mmGetOrdersToRemind.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrdersToRemind mock is already set by Set")
+	}
+
+	if mmGetOrdersToRemind.defaultExpectation == nil {
+		mmGetOrdersToRemind.defaultExpectation = &OrdersHistoryRepositoryMockGetOrdersToRemindExpectation{}
+	}
+
+	if mmGetOrdersToRemind.defaultExpectation.params != nil {
+		mmGetOrdersToRemind.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrdersToRemind mock is already set by Expect")
+	}
+
+	if mmGetOrdersToRemind.defaultExpectation.paramPtrs == nil {
+		mmGetOrdersToRemind.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockGetOrdersToRemindParamPtrs{}
+	}
+	mmGetOrdersToRemind.defaultExpectation.paramPtrs.expiresTo = &expiresTo
+	mmGetOrdersToRemind.defaultExpectation.expectationOrigins.originExpiresTo = minimock.CallerInfo(1)
+
+	return mmGetOrdersToRemind
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersHistoryRepository.GetOrdersToRemind
+func (mmGetOrdersToRemind *mOrdersHistoryRepositoryMockGetOrdersToRemind) Inspect(f func(ctx context.Context, stage uint64, expiresFrom time.Time, expiresTo time.Time)) *mOrdersHistoryRepositoryMockGetOrdersToRemind {
+	if mmGetOrdersToRemind.mock.inspectFuncGetOrdersToRemind != nil {
+		mmGetOrdersToRemind.mock.t.Fatalf("Inspect function is already set for OrdersHistoryRepositoryMock.GetOrdersToRemind")
+	}
+
+	mmGetOrdersToRemind.mock.inspectFuncGetOrdersToRemind = f
+
+	return mmGetOrdersToRemind
+}
+
+// Return sets up results that will be returned by OrdersHistoryRepository.GetOrdersToRemind
+func (mmGetOrdersToRemind *mOrdersHistoryRepositoryMockGetOrdersToRemind) Return(oa1 []domain.OrderView, err error) *OrdersHistoryRepositoryMock {
+	if mmGetOrdersToRemind.mock.funcGetOrdersToRemind != nil {
+		mmGetOrdersToRemind.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrdersToRemind mock is already set by Set")
+	}
+
+	if mmGetOrdersToRemind.defaultExpectation == nil {
+		mmGetOrdersToRemind.defaultExpectation = &OrdersHistoryRepositoryMockGetOrdersToRemindExpectation{mock: mmGetOrdersToRemind.mock}
+	}
+	mmGetOrdersToRemind.defaultExpectation.results = &OrdersHistoryRepositoryMockGetOrdersToRemindResults{oa1, err}
+	mmGetOrdersToRemind.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetOrdersToRemind.mock
+}
+
+// Set uses given function f to mock the OrdersHistoryRepository.GetOrdersToRemind method
+func (mmGetOrdersToRemind *mOrdersHistoryRepositoryMockGetOrdersToRemind) Set(f func(ctx context.Context, stage uint64, expiresFrom time.Time, expiresTo time.Time) (oa1 []domain.OrderView, err error)) *OrdersHistoryRepositoryMock {
+	if mmGetOrdersToRemind.defaultExpectation != nil {
+		mmGetOrdersToRemind.mock.t.Fatalf("Default expectation is already set for the OrdersHistoryRepository.GetOrdersToRemind method")
+	}
+
+	if len(mmGetOrdersToRemind.expectations) > 0 {
+		mmGetOrdersToRemind.mock.t.Fatalf("Some expectations are already set for the OrdersHistoryRepository.GetOrdersToRemind method")
+	}
+
+	mmGetOrdersToRemind.mock.funcGetOrdersToRemind = f
+	mmGetOrdersToRemind.mock.funcGetOrdersToRemindOrigin = minimock.CallerInfo(1)
+	return mmGetOrdersToRemind.mock
+}
+
+// When sets expectation for the OrdersHistoryRepository.GetOrdersToRemind which will trigger the result defined by the following
+// Then helper
+func (mmGetOrdersToRemind *mOrdersHistoryRepositoryMockGetOrdersToRemind) When(ctx context.Context, stage uint64, expiresFrom time.Time, expiresTo time.Time) *OrdersHistoryRepositoryMockGetOrdersToRemindExpectation {
+	if mmGetOrdersToRemind.mock.funcGetOrdersToRemind != nil {
+		mmGetOrdersToRemind.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrdersToRemind mock is already set by Set")
+	}
+
+	expectation := &OrdersHistoryRepositoryMockGetOrdersToRemindExpectation{
+		mock:               mmGetOrdersToRemind.mock,
+		params:             &OrdersHistoryRepositoryMockGetOrdersToRemindParams{ctx, stage, expiresFrom, expiresTo},
+		expectationOrigins: OrdersHistoryRepositoryMockGetOrdersToRemindExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetOrdersToRemind.expectations = append(mmGetOrdersToRemind.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersHistoryRepository.GetOrdersToRemind return parameters for the expectation previously defined by the When method
+func (e *OrdersHistoryRepositoryMockGetOrdersToRemindExpectation) Then(oa1 []domain.OrderView, err error) *OrdersHistoryRepositoryMock {
+	e.results = &OrdersHistoryRepositoryMockGetOrdersToRemindResults{oa1, err}
+	return e.mock
+}
+
+// Times sets number of times OrdersHistoryRepository.GetOrdersToRemind should be invoked
+func (mmGetOrdersToRemind *mOrdersHistoryRepositoryMockGetOrdersToRemind) Times(n uint64) *mOrdersHistoryRepositoryMockGetOrdersToRemind {
+	if n == 0 {
+		mmGetOrdersToRemind.mock.t.Fatalf("Times of OrdersHistoryRepositoryMock.GetOrdersToRemind mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetOrdersToRemind.expectedInvocations, n)
+	mmGetOrdersToRemind.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetOrdersToRemind
+}
+
+func (mmGetOrdersToRemind *mOrdersHistoryRepositoryMockGetOrdersToRemind) invocationsDone() bool {
+	if len(mmGetOrdersToRemind.expectations) == 0 && mmGetOrdersToRemind.defaultExpectation == nil && mmGetOrdersToRemind.mock.funcGetOrdersToRemind == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetOrdersToRemind.mock.afterGetOrdersToRemindCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetOrdersToRemind.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetOrdersToRemind implements mm_storage_json.OrdersHistoryRepository
+func (mmGetOrdersToRemind *OrdersHistoryRepositoryMock) GetOrdersToRemind(ctx context.Context, stage uint64, expiresFrom time.Time, expiresTo time.Time) (oa1 []domain.OrderView, err error) {
+	mm_atomic.AddUint64(&mmGetOrdersToRemind.beforeGetOrdersToRemindCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetOrdersToRemind.afterGetOrdersToRemindCounter, 1)
+
+	mmGetOrdersToRemind.t.Helper()
+
+	if mmGetOrdersToRemind.inspectFuncGetOrdersToRemind != nil {
+		mmGetOrdersToRemind.inspectFuncGetOrdersToRemind(ctx, stage, expiresFrom, expiresTo)
+	}
+
+	mm_params := OrdersHistoryRepositoryMockGetOrdersToRemindParams{ctx, stage, expiresFrom, expiresTo}
+
+	// Record call args
+	mmGetOrdersToRemind.GetOrdersToRemindMock.mutex.Lock()
+	mmGetOrdersToRemind.GetOrdersToRemindMock.callArgs = append(mmGetOrdersToRemind.GetOrdersToRemindMock.callArgs, &mm_params)
+	mmGetOrdersToRemind.GetOrdersToRemindMock.mutex.Unlock()
+
+	for _, e := range mmGetOrdersToRemind.GetOrdersToRemindMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.oa1, e.results.err
+		}
+	}
+
+	if mmGetOrdersToRemind.GetOrdersToRemindMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetOrdersToRemind.GetOrdersToRemindMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetOrdersToRemind.GetOrdersToRemindMock.defaultExpectation.params
+		mm_want_ptrs := mmGetOrdersToRemind.GetOrdersToRemindMock.defaultExpectation.paramPtrs
+
+		mm_got := OrdersHistoryRepositoryMockGetOrdersToRemindParams{ctx, stage, expiresFrom, expiresTo}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetOrdersToRemind.t.Errorf("OrdersHistoryRepositoryMock.GetOrdersToRemind got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrdersToRemind.GetOrdersToRemindMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.stage != nil && !minimock.Equal(*mm_want_ptrs.stage, mm_got.stage) {
+				mmGetOrdersToRemind.t.Errorf("OrdersHistoryRepositoryMock.GetOrdersToRemind got unexpected parameter stage, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrdersToRemind.GetOrdersToRemindMock.defaultExpectation.expectationOrigins.originStage, *mm_want_ptrs.stage, mm_got.stage, minimock.Diff(*mm_want_ptrs.stage, mm_got.stage))
+			}
+
+			if mm_want_ptrs.expiresFrom != nil && !minimock.Equal(*mm_want_ptrs.expiresFrom, mm_got.expiresFrom) {
+				mmGetOrdersToRemind.t.Errorf("OrdersHistoryRepositoryMock.GetOrdersToRemind got unexpected parameter expiresFrom, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrdersToRemind.GetOrdersToRemindMock.defaultExpectation.expectationOrigins.originExpiresFrom, *mm_want_ptrs.expiresFrom, mm_got.expiresFrom, minimock.Diff(*mm_want_ptrs.expiresFrom, mm_got.expiresFrom))
+			}
+
+			if mm_want_ptrs.expiresTo != nil && !minimock.Equal(*mm_want_ptrs.expiresTo, mm_got.expiresTo) {
+				mmGetOrdersToRemind.t.Errorf("OrdersHistoryRepositoryMock.GetOrdersToRemind got unexpected parameter expiresTo, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrdersToRemind.GetOrdersToRemindMock.defaultExpectation.expectationOrigins.originExpiresTo, *mm_want_ptrs.expiresTo, mm_got.expiresTo, minimock.Diff(*mm_want_ptrs.expiresTo, mm_got.expiresTo))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetOrdersToRemind.t.Errorf("OrdersHistoryRepositoryMock.GetOrdersToRemind got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetOrdersToRemind.GetOrdersToRemindMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetOrdersToRemind.GetOrdersToRemindMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetOrdersToRemind.t.Fatal("No results are set for the OrdersHistoryRepositoryMock.GetOrdersToRemind")
+		}
+		return (*mm_results).oa1, (*mm_results).err
+	}
+	if mmGetOrdersToRemind.funcGetOrdersToRemind != nil {
+		return mmGetOrdersToRemind.funcGetOrdersToRemind(ctx, stage, expiresFrom, expiresTo)
+	}
+	mmGetOrdersToRemind.t.Fatalf("Unexpected call to OrdersHistoryRepositoryMock.GetOrdersToRemind. %v %v %v %v", ctx, stage, expiresFrom, expiresTo)
+	return
+}
+
+// GetOrdersToRemindAfterCounter returns a count of finished OrdersHistoryRepositoryMock.GetOrdersToRemind invocations
+func (mmGetOrdersToRemind *OrdersHistoryRepositoryMock) GetOrdersToRemindAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrdersToRemind.afterGetOrdersToRemindCounter)
+}
+
+// GetOrdersToRemindBeforeCounter returns a count of OrdersHistoryRepositoryMock.GetOrdersToRemind invocations
+func (mmGetOrdersToRemind *OrdersHistoryRepositoryMock) GetOrdersToRemindBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetOrdersToRemind.beforeGetOrdersToRemindCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersHistoryRepositoryMock.GetOrdersToRemind.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetOrdersToRemind *mOrdersHistoryRepositoryMockGetOrdersToRemind) Calls() []*OrdersHistoryRepositoryMockGetOrdersToRemindParams {
+	mmGetOrdersToRemind.mutex.RLock()
+
+	argCopy := make([]*OrdersHistoryRepositoryMockGetOrdersToRemindParams, len(mmGetOrdersToRemind.callArgs))
+	copy(argCopy, mmGetOrdersToRemind.callArgs)
+
+	mmGetOrdersToRemind.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetOrdersToRemindDone returns true if the count of the GetOrdersToRemind invocations corresponds
+// the number of defined expectations
+func (m *OrdersHistoryRepositoryMock) MinimockGetOrdersToRemindDone() bool {
+	if m.GetOrdersToRemindMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetOrdersToRemindMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetOrdersToRemindMock.invocationsDone()
+}
+
+// MinimockGetOrdersToRemindInspect logs each unmet expectation
+func (m *OrdersHistoryRepositoryMock) MinimockGetOrdersToRemindInspect() {
+	for _, e := range m.GetOrdersToRemindMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetOrdersToRemind at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetOrdersToRemindCounter := mm_atomic.LoadUint64(&m.afterGetOrdersToRemindCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetOrdersToRemindMock.defaultExpectation != nil && afterGetOrdersToRemindCounter < 1 {
+		if m.GetOrdersToRemindMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetOrdersToRemind at\n%s", m.GetOrdersToRemindMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetOrdersToRemind at\n%s with params: %#v", m.GetOrdersToRemindMock.defaultExpectation.expectationOrigins.origin, *m.GetOrdersToRemindMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetOrdersToRemind != nil && afterGetOrdersToRemindCounter < 1 {
+		m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetOrdersToRemind at\n%s", m.funcGetOrdersToRemindOrigin)
+	}
+
+	if !m.GetOrdersToRemindMock.invocationsDone() && afterGetOrdersToRemindCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrdersHistoryRepositoryMock.GetOrdersToRemind at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetOrdersToRemindMock.expectedInvocations), m.GetOrdersToRemindMock.expectedInvocationsOrigin, afterGetOrdersToRemindCounter)
+	}
+}
+
+type mOrdersHistoryRepositoryMockGetPendingReminders struct {
+	optional           bool
+	mock               *OrdersHistoryRepositoryMock
+	defaultExpectation *OrdersHistoryRepositoryMockGetPendingRemindersExpectation
+	expectations       []*OrdersHistoryRepositoryMockGetPendingRemindersExpectation
+
+	callArgs []*OrdersHistoryRepositoryMockGetPendingRemindersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrdersHistoryRepositoryMockGetPendingRemindersExpectation specifies expectation struct of the OrdersHistoryRepository.GetPendingReminders
+type OrdersHistoryRepositoryMockGetPendingRemindersExpectation struct {
+	mock               *OrdersHistoryRepositoryMock
+	params             *OrdersHistoryRepositoryMockGetPendingRemindersParams
+	paramPtrs          *OrdersHistoryRepositoryMockGetPendingRemindersParamPtrs
+	expectationOrigins OrdersHistoryRepositoryMockGetPendingRemindersExpectationOrigins
+	results            *OrdersHistoryRepositoryMockGetPendingRemindersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrdersHistoryRepositoryMockGetPendingRemindersParams contains parameters of the OrdersHistoryRepository.GetPendingReminders
+type OrdersHistoryRepositoryMockGetPendingRemindersParams struct {
+	ctx   context.Context
+	stage uint64
+}
+
+// OrdersHistoryRepositoryMockGetPendingRemindersParamPtrs contains pointers to parameters of the OrdersHistoryRepository.GetPendingReminders
+type OrdersHistoryRepositoryMockGetPendingRemindersParamPtrs struct {
+	ctx   *context.Context
+	stage *uint64
+}
+
+// OrdersHistoryRepositoryMockGetPendingRemindersResults contains results of the OrdersHistoryRepository.GetPendingReminders
+type OrdersHistoryRepositoryMockGetPendingRemindersResults struct {
+	oa1 []domain.OrderView
+	err error
+}
+
+// OrdersHistoryRepositoryMockGetPendingRemindersOrigins contains origins of expectations of the OrdersHistoryRepository.GetPendingReminders
+type OrdersHistoryRepositoryMockGetPendingRemindersExpectationOrigins struct {
+	origin      string
+	originCtx   string
+	originStage string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPendingReminders *mOrdersHistoryRepositoryMockGetPendingReminders) Optional() *mOrdersHistoryRepositoryMockGetPendingReminders {
+	mmGetPendingReminders.optional = true
+	return mmGetPendingReminders
+}
+
+// Expect sets up expected params for OrdersHistoryRepository.GetPendingReminders
+func (mmGetPendingReminders *mOrdersHistoryRepositoryMockGetPendingReminders) Expect(ctx context.Context, stage uint64) *mOrdersHistoryRepositoryMockGetPendingReminders {
+	if mmGetPendingReminders.mock.funcGetPendingReminders != nil {
+		mmGetPendingReminders.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetPendingReminders mock is already set by Set")
+	}
+
+	if mmGetPendingReminders.defaultExpectation == nil {
+		mmGetPendingReminders.defaultExpectation = &OrdersHistoryRepositoryMockGetPendingRemindersExpectation{}
+	}
+
+	if mmGetPendingReminders.defaultExpectation.paramPtrs != nil {
+		mmGetPendingReminders.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetPendingReminders mock is already set by ExpectParams functions")
+	}
+
+	mmGetPendingReminders.defaultExpectation.params = &OrdersHistoryRepositoryMockGetPendingRemindersParams{ctx, stage}
+	mmGetPendingReminders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPendingReminders.expectations {
+		if minimock.Equal(e.params, mmGetPendingReminders.defaultExpectation.params) {
+			mmGetPendingReminders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPendingReminders.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPendingReminders
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrdersHistoryRepository.GetPendingReminders
+func (mmGetPendingReminders *mOrdersHistoryRepositoryMockGetPendingReminders) ExpectCtxParam1(ctx context.Context) *mOrdersHistoryRepositoryMockGetPendingReminders {
+	if mmGetPendingReminders.mock.funcGetPendingReminders != nil {
+		mmGetPendingReminders.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetPendingReminders mock is already set by Set")
+	}
+
+	if mmGetPendingReminders.defaultExpectation == nil {
+		mmGetPendingReminders.defaultExpectation = &OrdersHistoryRepositoryMockGetPendingRemindersExpectation{}
+	}
+
+	if mmGetPendingReminders.defaultExpectation.params != nil {
+		mmGetPendingReminders.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetPendingReminders mock is already set by Expect")
+	}
+
+	if mmGetPendingReminders.defaultExpectation.paramPtrs == nil {
+		mmGetPendingReminders.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockGetPendingRemindersParamPtrs{}
+	}
+	mmGetPendingReminders.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPendingReminders.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPendingReminders
+}
+
+// ExpectStageParam2 sets up expected param stage for OrdersHistoryRepository.GetPendingReminders
+func (mmGetPendingReminders *mOrdersHistoryRepositoryMockGetPendingReminders) ExpectStageParam2(stage uint64) *mOrdersHistoryRepositoryMockGetPendingReminders {
+	if mmGetPendingReminders.mock.funcGetPendingReminders != nil {
+		mmGetPendingReminders.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetPendingReminders mock is already set by Set")
+	}
+
+	if mmGetPendingReminders.defaultExpectation == nil {
+		mmGetPendingReminders.defaultExpectation = &OrdersHistoryRepositoryMockGetPendingRemindersExpectation{}
+	}
+
+	if mmGetPendingReminders.defaultExpectation.params != nil {
+		mmGetPendingReminders.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetPendingReminders mock is already set by Expect")
+	}
+
+	if mmGetPendingReminders.defaultExpectation.paramPtrs == nil {
+		mmGetPendingReminders.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockGetPendingRemindersParamPtrs{}
+	}
+	mmGetPendingReminders.defaultExpectation.paramPtrs.stage = &stage
+	mmGetPendingReminders.defaultExpectation.expectationOrigins.originStage = minimock.CallerInfo(1)
+
+	return mmGetPendingReminders
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersHistoryRepository.GetPendingReminders
+func (mmGetPendingReminders *mOrdersHistoryRepositoryMockGetPendingReminders) Inspect(f func(ctx context.Context, stage uint64)) *mOrdersHistoryRepositoryMockGetPendingReminders {
+	if mmGetPendingReminders.mock.inspectFuncGetPendingReminders != nil {
+		mmGetPendingReminders.mock.t.Fatalf("Inspect function is already set for OrdersHistoryRepositoryMock.GetPendingReminders")
+	}
+
+	mmGetPendingReminders.mock.inspectFuncGetPendingReminders = f
+
+	return mmGetPendingReminders
+}
+
+// Return sets up results that will be returned by OrdersHistoryRepository.GetPendingReminders
+func (mmGetPendingReminders *mOrdersHistoryRepositoryMockGetPendingReminders) Return(oa1 []domain.OrderView, err error) *OrdersHistoryRepositoryMock {
+	if mmGetPendingReminders.mock.funcGetPendingReminders != nil {
+		mmGetPendingReminders.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetPendingReminders mock is already set by Set")
+	}
+
+	if mmGetPendingReminders.defaultExpectation == nil {
+		mmGetPendingReminders.defaultExpectation = &OrdersHistoryRepositoryMockGetPendingRemindersExpectation{mock: mmGetPendingReminders.mock}
+	}
+	mmGetPendingReminders.defaultExpectation.results = &OrdersHistoryRepositoryMockGetPendingRemindersResults{oa1, err}
+	mmGetPendingReminders.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPendingReminders.mock
+}
+
+// Set uses given function f to mock the OrdersHistoryRepository.GetPendingReminders method
+func (mmGetPendingReminders *mOrdersHistoryRepositoryMockGetPendingReminders) Set(f func(ctx context.Context, stage uint64) (oa1 []domain.OrderView, err error)) *OrdersHistoryRepositoryMock {
+	if mmGetPendingReminders.defaultExpectation != nil {
+		mmGetPendingReminders.mock.t.Fatalf("Default expectation is already set for the OrdersHistoryRepository.GetPendingReminders method")
+	}
+
+	if len(mmGetPendingReminders.expectations) > 0 {
+		mmGetPendingReminders.mock.t.Fatalf("Some expectations are already set for the OrdersHistoryRepository.GetPendingReminders method")
+	}
+
+	mmGetPendingReminders.mock.funcGetPendingReminders = f
+	mmGetPendingReminders.mock.funcGetPendingRemindersOrigin = minimock.CallerInfo(1)
+	return mmGetPendingReminders.mock
+}
+
+// When sets expectation for the OrdersHistoryRepository.GetPendingReminders which will trigger the result defined by the following
+// Then helper
+func (mmGetPendingReminders *mOrdersHistoryRepositoryMockGetPendingReminders) When(ctx context.Context, stage uint64) *OrdersHistoryRepositoryMockGetPendingRemindersExpectation {
+	if mmGetPendingReminders.mock.funcGetPendingReminders != nil {
+		mmGetPendingReminders.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetPendingReminders mock is already set by Set")
+	}
+
+	expectation := &OrdersHistoryRepositoryMockGetPendingRemindersExpectation{
+		mock:               mmGetPendingReminders.mock,
+		params:             &OrdersHistoryRepositoryMockGetPendingRemindersParams{ctx, stage},
+		expectationOrigins: OrdersHistoryRepositoryMockGetPendingRemindersExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPendingReminders.expectations = append(mmGetPendingReminders.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersHistoryRepository.GetPendingReminders return parameters for the expectation previously defined by the When method
+func (e *OrdersHistoryRepositoryMockGetPendingRemindersExpectation) Then(oa1 []domain.OrderView, err error) *OrdersHistoryRepositoryMock {
+	e.results = &OrdersHistoryRepositoryMockGetPendingRemindersResults{oa1, err}
+	return e.mock
+}
+
+// Times sets number of times OrdersHistoryRepository.GetPendingReminders should be invoked
+func (mmGetPendingReminders *mOrdersHistoryRepositoryMockGetPendingReminders) Times(n uint64) *mOrdersHistoryRepositoryMockGetPendingReminders {
+	if n == 0 {
+		mmGetPendingReminders.mock.t.Fatalf("Times of OrdersHistoryRepositoryMock.GetPendingReminders mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPendingReminders.expectedInvocations, n)
+	mmGetPendingReminders.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPendingReminders
+}
+
+func (mmGetPendingReminders *mOrdersHistoryRepositoryMockGetPendingReminders) invocationsDone() bool {
+	if len(mmGetPendingReminders.expectations) == 0 && mmGetPendingReminders.defaultExpectation == nil && mmGetPendingReminders.mock.funcGetPendingReminders == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPendingReminders.mock.afterGetPendingRemindersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPendingReminders.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPendingReminders implements mm_storage_json.OrdersHistoryRepository
+func (mmGetPendingReminders *OrdersHistoryRepositoryMock) GetPendingReminders(ctx context.Context, stage uint64) (oa1 []domain.OrderView, err error) {
+	mm_atomic.AddUint64(&mmGetPendingReminders.beforeGetPendingRemindersCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPendingReminders.afterGetPendingRemindersCounter, 1)
+
+	mmGetPendingReminders.t.Helper()
+
+	if mmGetPendingReminders.inspectFuncGetPendingReminders != nil {
+		mmGetPendingReminders.inspectFuncGetPendingReminders(ctx, stage)
+	}
+
+	mm_params := OrdersHistoryRepositoryMockGetPendingRemindersParams{ctx, stage}
+
+	// Record call args
+	mmGetPendingReminders.GetPendingRemindersMock.mutex.Lock()
+	mmGetPendingReminders.GetPendingRemindersMock.callArgs = append(mmGetPendingReminders.GetPendingRemindersMock.callArgs, &mm_params)
+	mmGetPendingReminders.GetPendingRemindersMock.mutex.Unlock()
+
+	for _, e := range mmGetPendingReminders.GetPendingRemindersMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.oa1, e.results.err
+		}
+	}
+
+	if mmGetPendingReminders.GetPendingRemindersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPendingReminders.GetPendingRemindersMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPendingReminders.GetPendingRemindersMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPendingReminders.GetPendingRemindersMock.defaultExpectation.paramPtrs
+
+		mm_got := OrdersHistoryRepositoryMockGetPendingRemindersParams{ctx, stage}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPendingReminders.t.Errorf("OrdersHistoryRepositoryMock.GetPendingReminders got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPendingReminders.GetPendingRemindersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.stage != nil && !minimock.Equal(*mm_want_ptrs.stage, mm_got.stage) {
+				mmGetPendingReminders.t.Errorf("OrdersHistoryRepositoryMock.GetPendingReminders got unexpected parameter stage, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPendingReminders.GetPendingRemindersMock.defaultExpectation.expectationOrigins.originStage, *mm_want_ptrs.stage, mm_got.stage, minimock.Diff(*mm_want_ptrs.stage, mm_got.stage))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPendingReminders.t.Errorf("OrdersHistoryRepositoryMock.GetPendingReminders got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPendingReminders.GetPendingRemindersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPendingReminders.GetPendingRemindersMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPendingReminders.t.Fatal("No results are set for the OrdersHistoryRepositoryMock.GetPendingReminders")
+		}
+		return (*mm_results).oa1, (*mm_results).err
+	}
+	if mmGetPendingReminders.funcGetPendingReminders != nil {
+		return mmGetPendingReminders.funcGetPendingReminders(ctx, stage)
+	}
+	mmGetPendingReminders.t.Fatalf("Unexpected call to OrdersHistoryRepositoryMock.GetPendingReminders. %v %v", ctx, stage)
+	return
+}
+
+// GetPendingRemindersAfterCounter returns a count of finished OrdersHistoryRepositoryMock.GetPendingReminders invocations
+func (mmGetPendingReminders *OrdersHistoryRepositoryMock) GetPendingRemindersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPendingReminders.afterGetPendingRemindersCounter)
+}
+
+// GetPendingRemindersBeforeCounter returns a count of OrdersHistoryRepositoryMock.GetPendingReminders invocations
+func (mmGetPendingReminders *OrdersHistoryRepositoryMock) GetPendingRemindersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPendingReminders.beforeGetPendingRemindersCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersHistoryRepositoryMock.GetPendingReminders.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPendingReminders *mOrdersHistoryRepositoryMockGetPendingReminders) Calls() []*OrdersHistoryRepositoryMockGetPendingRemindersParams {
+	mmGetPendingReminders.mutex.RLock()
+
+	argCopy := make([]*OrdersHistoryRepositoryMockGetPendingRemindersParams, len(mmGetPendingReminders.callArgs))
+	copy(argCopy, mmGetPendingReminders.callArgs)
+
+	mmGetPendingReminders.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPendingRemindersDone returns true if the count of the GetPendingReminders invocations corresponds
+// the number of defined expectations
+func (m *OrdersHistoryRepositoryMock) MinimockGetPendingRemindersDone() bool {
+	if m.GetPendingRemindersMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPendingRemindersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPendingRemindersMock.invocationsDone()
+}
+
+// MinimockGetPendingRemindersInspect logs each unmet expectation
+func (m *OrdersHistoryRepositoryMock) MinimockGetPendingRemindersInspect() {
+	for _, e := range m.GetPendingRemindersMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetPendingReminders at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPendingRemindersCounter := mm_atomic.LoadUint64(&m.afterGetPendingRemindersCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPendingRemindersMock.defaultExpectation != nil && afterGetPendingRemindersCounter < 1 {
+		if m.GetPendingRemindersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetPendingReminders at\n%s", m.GetPendingRemindersMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetPendingReminders at\n%s with params: %#v", m.GetPendingRemindersMock.defaultExpectation.expectationOrigins.origin, *m.GetPendingRemindersMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPendingReminders != nil && afterGetPendingRemindersCounter < 1 {
+		m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetPendingReminders at\n%s", m.funcGetPendingRemindersOrigin)
+	}
+
+	if !m.GetPendingRemindersMock.invocationsDone() && afterGetPendingRemindersCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrdersHistoryRepositoryMock.GetPendingReminders at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPendingRemindersMock.expectedInvocations), m.GetPendingRemindersMock.expectedInvocationsOrigin, afterGetPendingRemindersCounter)
+	}
+}
+
+type mOrdersHistoryRepositoryMockMarkRemindersSent struct {
+	optional           bool
+	mock               *OrdersHistoryRepositoryMock
+	defaultExpectation *OrdersHistoryRepositoryMockMarkRemindersSentExpectation
+	expectations       []*OrdersHistoryRepositoryMockMarkRemindersSentExpectation
+
+	callArgs []*OrdersHistoryRepositoryMockMarkRemindersSentParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrdersHistoryRepositoryMockMarkRemindersSentExpectation specifies expectation struct of the OrdersHistoryRepository.MarkRemindersSent
+type OrdersHistoryRepositoryMockMarkRemindersSentExpectation struct {
+	mock               *OrdersHistoryRepositoryMock
+	params             *OrdersHistoryRepositoryMockMarkRemindersSentParams
+	paramPtrs          *OrdersHistoryRepositoryMockMarkRemindersSentParamPtrs
+	expectationOrigins OrdersHistoryRepositoryMockMarkRemindersSentExpectationOrigins
+	results            *OrdersHistoryRepositoryMockMarkRemindersSentResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrdersHistoryRepositoryMockMarkRemindersSentParams contains parameters of the OrdersHistoryRepository.MarkRemindersSent
+type OrdersHistoryRepositoryMockMarkRemindersSentParams struct {
+	ctx      context.Context
+	stage    uint64
+	ordersID []uint64
+}
+
+// OrdersHistoryRepositoryMockMarkRemindersSentParamPtrs contains pointers to parameters of the OrdersHistoryRepository.MarkRemindersSent
+type OrdersHistoryRepositoryMockMarkRemindersSentParamPtrs struct {
+	ctx      *context.Context
+	stage    *uint64
+	ordersID *[]uint64
+}
+
+// OrdersHistoryRepositoryMockMarkRemindersSentResults contains results of the OrdersHistoryRepository.MarkRemindersSent
+type OrdersHistoryRepositoryMockMarkRemindersSentResults struct {
+	err error
+}
+
+// OrdersHistoryRepositoryMockMarkRemindersSentOrigins contains origins of expectations of the OrdersHistoryRepository.MarkRemindersSent
+type OrdersHistoryRepositoryMockMarkRemindersSentExpectationOrigins struct {
+	origin         string
+	originCtx      string
+	originStage    string
+	originOrdersID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkRemindersSent *mOrdersHistoryRepositoryMockMarkRemindersSent) Optional() *mOrdersHistoryRepositoryMockMarkRemindersSent {
+	mmMarkRemindersSent.optional = true
+	return mmMarkRemindersSent
+}
+
+// Expect sets up expected params for OrdersHistoryRepository.MarkRemindersSent
+func (mmMarkRemindersSent *mOrdersHistoryRepositoryMockMarkRemindersSent) Expect(ctx context.Context, stage uint64, ordersID []uint64) *mOrdersHistoryRepositoryMockMarkRemindersSent {
+	if mmMarkRemindersSent.mock.funcMarkRemindersSent != nil {
+		mmMarkRemindersSent.mock.t.Fatalf("OrdersHistoryRepositoryMock.MarkRemindersSent mock is already set by Set")
+	}
+
+	if mmMarkRemindersSent.defaultExpectation == nil {
+		mmMarkRemindersSent.defaultExpectation = &OrdersHistoryRepositoryMockMarkRemindersSentExpectation{}
+	}
+
+	if mmMarkRemindersSent.defaultExpectation.paramPtrs != nil {
+		mmMarkRemindersSent.mock.t.Fatalf("OrdersHistoryRepositoryMock.MarkRemindersSent mock is already set by ExpectParams functions")
+	}
+
+	mmMarkRemindersSent.defaultExpectation.params = &OrdersHistoryRepositoryMockMarkRemindersSentParams{ctx, stage, ordersID}
+	mmMarkRemindersSent.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkRemindersSent.expectations {
+		if minimock.Equal(e.params, mmMarkRemindersSent.defaultExpectation.params) {
+			mmMarkRemindersSent.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkRemindersSent.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkRemindersSent
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrdersHistoryRepository.MarkRemindersSent
+func (mmMarkRemindersSent *mOrdersHistoryRepositoryMockMarkRemindersSent) ExpectCtxParam1(ctx context.Context) *mOrdersHistoryRepositoryMockMarkRemindersSent {
+	if mmMarkRemindersSent.mock.funcMarkRemindersSent != nil {
+		mmMarkRemindersSent.mock.t.Fatalf("OrdersHistoryRepositoryMock.MarkRemindersSent mock is already set by Set")
+	}
+
+	if mmMarkRemindersSent.defaultExpectation == nil {
+		mmMarkRemindersSent.defaultExpectation = &OrdersHistoryRepositoryMockMarkRemindersSentExpectation{}
+	}
+
+	if mmMarkRemindersSent.defaultExpectation.params != nil {
+		mmMarkRemindersSent.mock.t.Fatalf("OrdersHistoryRepositoryMock.MarkRemindersSent mock is already set by Expect")
+	}
+
+	if mmMarkRemindersSent.defaultExpectation.paramPtrs == nil {
+		mmMarkRemindersSent.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockMarkRemindersSentParamPtrs{}
+	}
+	mmMarkRemindersSent.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkRemindersSent.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkRemindersSent
+}
+
+// ExpectStageParam2 sets up expected param stage for OrdersHistoryRepository.MarkRemindersSent
+func (mmMarkRemindersSent *mOrdersHistoryRepositoryMockMarkRemindersSent) ExpectStageParam2(stage uint64) *mOrdersHistoryRepositoryMockMarkRemindersSent {
+	if mmMarkRemindersSent.mock.funcMarkRemindersSent != nil {
+		mmMarkRemindersSent.mock.t.Fatalf("OrdersHistoryRepositoryMock.MarkRemindersSent mock is already set by Set")
+	}
+
+	if mmMarkRemindersSent.defaultExpectation == nil {
+		mmMarkRemindersSent.defaultExpectation = &OrdersHistoryRepositoryMockMarkRemindersSentExpectation{}
+	}
+
+	if mmMarkRemindersSent.defaultExpectation.params != nil {
+		mmMarkRemindersSent.mock.t.Fatalf("OrdersHistoryRepositoryMock.MarkRemindersSent mock is already set by Expect")
+	}
+
+	if mmMarkRemindersSent.defaultExpectation.paramPtrs == nil {
+		mmMarkRemindersSent.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockMarkRemindersSentParamPtrs{}
+	}
+	mmMarkRemindersSent.defaultExpectation.paramPtrs.stage = &stage
+	mmMarkRemindersSent.defaultExpectation.expectationOrigins.originStage = minimock.CallerInfo(1)
+
+	return mmMarkRemindersSent
+}
+
+// ExpectOrdersIDParam3 sets up expected param ordersID for OrdersHistoryRepository.MarkRemindersSent
+func (mmMarkRemindersSent *mOrdersHistoryRepositoryMockMarkRemindersSent) ExpectOrdersIDParam3(ordersID []uint64) *mOrdersHistoryRepositoryMockMarkRemindersSent {
+	if mmMarkRemindersSent.mock.funcMarkRemindersSent != nil {
+		mmMarkRemindersSent.mock.t.Fatalf("OrdersHistoryRepositoryMock.MarkRemindersSent mock is already set by Set")
+	}
+
+	if mmMarkRemindersSent.defaultExpectation == nil {
+		mmMarkRemindersSent.defaultExpectation = &OrdersHistoryRepositoryMockMarkRemindersSentExpectation{}
+	}
+
+	if mmMarkRemindersSent.defaultExpectation.params != nil {
+		mmMarkRemindersSent.mock.t.Fatalf("OrdersHistoryRepositoryMock.MarkRemindersSent mock is already set by Expect")
+	}
+
+	if mmMarkRemindersSent.defaultExpectation.paramPtrs == nil {
+		mmMarkRemindersSent.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockMarkRemindersSentParamPtrs{}
+	}
+	mmMarkRemindersSent.defaultExpectation.paramPtrs.ordersID = &ordersID
+	mmMarkRemindersSent.defaultExpectation.expectationOrigins.originOrdersID = minimock.CallerInfo(1)
+
+	return mmMarkRemindersSent
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersHistoryRepository.MarkRemindersSent
+func (mmMarkRemindersSent *mOrdersHistoryRepositoryMockMarkRemindersSent) Inspect(f func(ctx context.Context, stage uint64, ordersID []uint64)) *mOrdersHistoryRepositoryMockMarkRemindersSent {
+	if mmMarkRemindersSent.mock.inspectFuncMarkRemindersSent != nil {
+		mmMarkRemindersSent.mock.t.Fatalf("Inspect function is already set for OrdersHistoryRepositoryMock.MarkRemindersSent")
+	}
+
+	mmMarkRemindersSent.mock.inspectFuncMarkRemindersSent = f
+
+	return mmMarkRemindersSent
+}
+
+// Return sets up results that will be returned by OrdersHistoryRepository.MarkRemindersSent
+func (mmMarkRemindersSent *mOrdersHistoryRepositoryMockMarkRemindersSent) Return(err error) *OrdersHistoryRepositoryMock {
+	if mmMarkRemindersSent.mock.funcMarkRemindersSent != nil {
+		mmMarkRemindersSent.mock.t.Fatalf("OrdersHistoryRepositoryMock.MarkRemindersSent mock is already set by Set")
+	}
+
+	if mmMarkRemindersSent.defaultExpectation == nil {
+		mmMarkRemindersSent.defaultExpectation = &OrdersHistoryRepositoryMockMarkRemindersSentExpectation{mock: mmMarkRemindersSent.mock}
+	}
+	mmMarkRemindersSent.defaultExpectation.results = &OrdersHistoryRepositoryMockMarkRemindersSentResults{err}
+	mmMarkRemindersSent.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkRemindersSent.mock
+}
+
+// Set uses given function f to mock the OrdersHistoryRepository.MarkRemindersSent method
+func (mmMarkRemindersSent *mOrdersHistoryRepositoryMockMarkRemindersSent) Set(f func(ctx context.Context, stage uint64, ordersID []uint64) (err error)) *OrdersHistoryRepositoryMock {
+	if mmMarkRemindersSent.defaultExpectation != nil {
+		mmMarkRemindersSent.mock.t.Fatalf("Default expectation is already set for the OrdersHistoryRepository.MarkRemindersSent method")
+	}
+
+	if len(mmMarkRemindersSent.expectations) > 0 {
+		mmMarkRemindersSent.mock.t.Fatalf("Some expectations are already set for the OrdersHistoryRepository.MarkRemindersSent method")
+	}
+
+	mmMarkRemindersSent.mock.funcMarkRemindersSent = f
+	mmMarkRemindersSent.mock.funcMarkRemindersSentOrigin = minimock.CallerInfo(1)
+	return mmMarkRemindersSent.mock
+}
+
+// When sets expectation for the OrdersHistoryRepository.MarkRemindersSent which will trigger the result defined by the following
+// Then helper
+func (mmMarkRemindersSent *mOrdersHistoryRepositoryMockMarkRemindersSent) When(ctx context.Context, stage uint64, ordersID []uint64) *OrdersHistoryRepositoryMockMarkRemindersSentExpectation {
+	if mmMarkRemindersSent.mock.funcMarkRemindersSent != nil {
+		mmMarkRemindersSent.mock.t.Fatalf("OrdersHistoryRepositoryMock.MarkRemindersSent mock is already set by Set")
+	}
+
+	expectation := &OrdersHistoryRepositoryMockMarkRemindersSentExpectation{
+		mock:               mmMarkRemindersSent.mock,
+		params:             &OrdersHistoryRepositoryMockMarkRemindersSentParams{ctx, stage, ordersID},
+		expectationOrigins: OrdersHistoryRepositoryMockMarkRemindersSentExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkRemindersSent.expectations = append(mmMarkRemindersSent.expectations, expectation)
+	return expectation
+}
+
+// Then sets up OrdersHistoryRepository.MarkRemindersSent return parameters for the expectation previously defined by the When method
+func (e *OrdersHistoryRepositoryMockMarkRemindersSentExpectation) Then(err error) *OrdersHistoryRepositoryMock {
+	e.results = &OrdersHistoryRepositoryMockMarkRemindersSentResults{err}
+	return e.mock
+}
+
+// Times sets number of times OrdersHistoryRepository.MarkRemindersSent should be invoked
+func (mmMarkRemindersSent *mOrdersHistoryRepositoryMockMarkRemindersSent) Times(n uint64) *mOrdersHistoryRepositoryMockMarkRemindersSent {
+	if n == 0 {
+		mmMarkRemindersSent.mock.t.Fatalf("Times of OrdersHistoryRepositoryMock.MarkRemindersSent mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkRemindersSent.expectedInvocations, n)
+	mmMarkRemindersSent.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkRemindersSent
+}
+
+func (mmMarkRemindersSent *mOrdersHistoryRepositoryMockMarkRemindersSent) invocationsDone() bool {
+	if len(mmMarkRemindersSent.expectations) == 0 && mmMarkRemindersSent.defaultExpectation == nil && mmMarkRemindersSent.mock.funcMarkRemindersSent == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkRemindersSent.mock.afterMarkRemindersSentCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkRemindersSent.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkRemindersSent implements mm_storage_json.OrdersHistoryRepository
+func (mmMarkRemindersSent *OrdersHistoryRepositoryMock) MarkRemindersSent(ctx context.Context, stage uint64, ordersID []uint64) (err error) {
+	mm_atomic.AddUint64(&mmMarkRemindersSent.beforeMarkRemindersSentCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkRemindersSent.afterMarkRemindersSentCounter, 1)
+
+	mmMarkRemindersSent.t.Helper()
+
+	if mmMarkRemindersSent.inspectFuncMarkRemindersSent != nil {
+		mmMarkRemindersSent.inspectFuncMarkRemindersSent(ctx, stage, ordersID)
+	}
+
+	mm_params := OrdersHistoryRepositoryMockMarkRemindersSentParams{ctx, stage, ordersID}
+
+	// Record call args
+	mmMarkRemindersSent.MarkRemindersSentMock.mutex.Lock()
+	mmMarkRemindersSent.MarkRemindersSentMock.callArgs = append(mmMarkRemindersSent.MarkRemindersSentMock.callArgs, &mm_params)
+	mmMarkRemindersSent.MarkRemindersSentMock.mutex.Unlock()
+
+	for _, e := range mmMarkRemindersSent.MarkRemindersSentMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkRemindersSent.MarkRemindersSentMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkRemindersSent.MarkRemindersSentMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkRemindersSent.MarkRemindersSentMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkRemindersSent.MarkRemindersSentMock.defaultExpectation.paramPtrs
+
+		mm_got := OrdersHistoryRepositoryMockMarkRemindersSentParams{ctx, stage, ordersID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkRemindersSent.t.Errorf("OrdersHistoryRepositoryMock.MarkRemindersSent got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRemindersSent.MarkRemindersSentMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.stage != nil && !minimock.Equal(*mm_want_ptrs.stage, mm_got.stage) {
+				mmMarkRemindersSent.t.Errorf("OrdersHistoryRepositoryMock.MarkRemindersSent got unexpected parameter stage, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRemindersSent.MarkRemindersSentMock.defaultExpectation.expectationOrigins.originStage, *mm_want_ptrs.stage, mm_got.stage, minimock.Diff(*mm_want_ptrs.stage, mm_got.stage))
+			}
+
+			if mm_want_ptrs.ordersID != nil && !minimock.Equal(*mm_want_ptrs.ordersID, mm_got.ordersID) {
+				mmMarkRemindersSent.t.Errorf("OrdersHistoryRepositoryMock.MarkRemindersSent got unexpected parameter ordersID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkRemindersSent.MarkRemindersSentMock.defaultExpectation.expectationOrigins.originOrdersID, *mm_want_ptrs.ordersID, mm_got.ordersID, minimock.Diff(*mm_want_ptrs.ordersID, mm_got.ordersID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkRemindersSent.t.Errorf("OrdersHistoryRepositoryMock.MarkRemindersSent got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkRemindersSent.MarkRemindersSentMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkRemindersSent.MarkRemindersSentMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkRemindersSent.t.Fatal("No results are set for the OrdersHistoryRepositoryMock.MarkRemindersSent")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkRemindersSent.funcMarkRemindersSent != nil {
+		return mmMarkRemindersSent.funcMarkRemindersSent(ctx, stage, ordersID)
+	}
+	mmMarkRemindersSent.t.Fatalf("Unexpected call to OrdersHistoryRepositoryMock.MarkRemindersSent. %v %v %v", ctx, stage, ordersID)
+	return
+}
+
+// MarkRemindersSentAfterCounter returns a count of finished OrdersHistoryRepositoryMock.MarkRemindersSent invocations
+func (mmMarkRemindersSent *OrdersHistoryRepositoryMock) MarkRemindersSentAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRemindersSent.afterMarkRemindersSentCounter)
+}
+
+// MarkRemindersSentBeforeCounter returns a count of OrdersHistoryRepositoryMock.MarkRemindersSent invocations
+func (mmMarkRemindersSent *OrdersHistoryRepositoryMock) MarkRemindersSentBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkRemindersSent.beforeMarkRemindersSentCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersHistoryRepositoryMock.MarkRemindersSent.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkRemindersSent *mOrdersHistoryRepositoryMockMarkRemindersSent) Calls() []*OrdersHistoryRepositoryMockMarkRemindersSentParams {
+	mmMarkRemindersSent.mutex.RLock()
+
+	argCopy := make([]*OrdersHistoryRepositoryMockMarkRemindersSentParams, len(mmMarkRemindersSent.callArgs))
+	copy(argCopy, mmMarkRemindersSent.callArgs)
+
+	mmMarkRemindersSent.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkRemindersSentDone returns true if the count of the MarkRemindersSent invocations corresponds
+// the number of defined expectations
+func (m *OrdersHistoryRepositoryMock) MinimockMarkRemindersSentDone() bool {
+	if m.MarkRemindersSentMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkRemindersSentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkRemindersSentMock.invocationsDone()
+}
+
+// MinimockMarkRemindersSentInspect logs each unmet expectation
+func (m *OrdersHistoryRepositoryMock) MinimockMarkRemindersSentInspect() {
+	for _, e := range m.MarkRemindersSentMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.MarkRemindersSent at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkRemindersSentCounter := mm_atomic.LoadUint64(&m.afterMarkRemindersSentCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkRemindersSentMock.defaultExpectation != nil && afterMarkRemindersSentCounter < 1 {
+		if m.MarkRemindersSentMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.MarkRemindersSent at\n%s", m.MarkRemindersSentMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.MarkRemindersSent at\n%s with params: %#v", m.MarkRemindersSentMock.defaultExpectation.expectationOrigins.origin, *m.MarkRemindersSentMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkRemindersSent != nil && afterMarkRemindersSentCounter < 1 {
+		m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.MarkRemindersSent at\n%s", m.funcMarkRemindersSentOrigin)
+	}
+
+	if !m.MarkRemindersSentMock.invocationsDone() && afterMarkRemindersSentCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrdersHistoryRepositoryMock.MarkRemindersSent at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkRemindersSentMock.expectedInvocations), m.MarkRemindersSentMock.expectedInvocationsOrigin, afterMarkRemindersSentCounter)
+	}
+}
+
+type mOrdersHistoryRepositoryMockRestoreOrderStatus struct {
+	optional           bool
+	mock               *OrdersHistoryRepositoryMock
+	defaultExpectation *OrdersHistoryRepositoryMockRestoreOrderStatusExpectation
+	expectations       []*OrdersHistoryRepositoryMockRestoreOrderStatusExpectation
+
+	callArgs []*OrdersHistoryRepositoryMockRestoreOrderStatusParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrdersHistoryRepositoryMockRestoreOrderStatusExpectation specifies expectation struct of the OrdersHistoryRepository.RestoreOrderStatus
+type OrdersHistoryRepositoryMockRestoreOrderStatusExpectation struct {
+	mock               *OrdersHistoryRepositoryMock
+	params             *OrdersHistoryRepositoryMockRestoreOrderStatusParams
+	paramPtrs          *OrdersHistoryRepositoryMockRestoreOrderStatusParamPtrs
+	expectationOrigins OrdersHistoryRepositoryMockRestoreOrderStatusExpectationOrigins
+
+	returnOrigin string
+	Counter      uint64
+}
+
+// OrdersHistoryRepositoryMockRestoreOrderStatusParams contains parameters of the OrdersHistoryRepository.RestoreOrderStatus
+type OrdersHistoryRepositoryMockRestoreOrderStatusParams struct {
+	orderID uint64
+	stat    *domain.OrderStatus
+}
+
+// OrdersHistoryRepositoryMockRestoreOrderStatusParamPtrs contains pointers to parameters of the OrdersHistoryRepository.RestoreOrderStatus
+type OrdersHistoryRepositoryMockRestoreOrderStatusParamPtrs struct {
+	orderID *uint64
+	stat    **domain.OrderStatus
+}
+
+// OrdersHistoryRepositoryMockRestoreOrderStatusOrigins contains origins of expectations of the OrdersHistoryRepository.RestoreOrderStatus
+type OrdersHistoryRepositoryMockRestoreOrderStatusExpectationOrigins struct {
+	origin        string
+	originOrderID string
+	originStat    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRestoreOrderStatus *mOrdersHistoryRepositoryMockRestoreOrderStatus) Optional() *mOrdersHistoryRepositoryMockRestoreOrderStatus {
+	mmRestoreOrderStatus.optional = true
+	return mmRestoreOrderStatus
+}
+
+// Expect sets up expected params for OrdersHistoryRepository.RestoreOrderStatus
+func (mmRestoreOrderStatus *mOrdersHistoryRepositoryMockRestoreOrderStatus) Expect(orderID uint64, stat *domain.OrderStatus) *mOrdersHistoryRepositoryMockRestoreOrderStatus {
+	if mmRestoreOrderStatus.mock.funcRestoreOrderStatus != nil {
+		mmRestoreOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.RestoreOrderStatus mock is already set by Set")
+	}
+
+	if mmRestoreOrderStatus.defaultExpectation == nil {
+		mmRestoreOrderStatus.defaultExpectation = &OrdersHistoryRepositoryMockRestoreOrderStatusExpectation{}
+	}
+
+	if mmRestoreOrderStatus.defaultExpectation.paramPtrs != nil {
+		mmRestoreOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.RestoreOrderStatus mock is already set by ExpectParams functions")
+	}
+
+	mmRestoreOrderStatus.defaultExpectation.params = &OrdersHistoryRepositoryMockRestoreOrderStatusParams{orderID, stat}
+	mmRestoreOrderStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRestoreOrderStatus.expectations {
+		if minimock.Equal(e.params, mmRestoreOrderStatus.defaultExpectation.params) {
+			mmRestoreOrderStatus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRestoreOrderStatus.defaultExpectation.params)
+		}
+	}
+
+	return mmRestoreOrderStatus
+}
+
+// ExpectOrderIDParam1 sets up expected param orderID for OrdersHistoryRepository.RestoreOrderStatus
+func (mmRestoreOrderStatus *mOrdersHistoryRepositoryMockRestoreOrderStatus) ExpectOrderIDParam1(orderID uint64) *mOrdersHistoryRepositoryMockRestoreOrderStatus {
+	if mmRestoreOrderStatus.mock.funcRestoreOrderStatus != nil {
+		mmRestoreOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.RestoreOrderStatus mock is already set by Set")
+	}
+
+	if mmRestoreOrderStatus.defaultExpectation == nil {
+		mmRestoreOrderStatus.defaultExpectation = &OrdersHistoryRepositoryMockRestoreOrderStatusExpectation{}
+	}
+
+	if mmRestoreOrderStatus.defaultExpectation.params != nil {
+		mmRestoreOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.RestoreOrderStatus mock is already set by Expect")
+	}
+
+	if mmRestoreOrderStatus.defaultExpectation.paramPtrs == nil {
+		mmRestoreOrderStatus.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockRestoreOrderStatusParamPtrs{}
+	}
+	mmRestoreOrderStatus.defaultExpectation.paramPtrs.orderID = &orderID
+	mmRestoreOrderStatus.defaultExpectation.expectationOrigins.originOrderID = minimock.CallerInfo(1)
+
+	return mmRestoreOrderStatus
+}
+
+// ExpectStatParam2 sets up expected param stat for OrdersHistoryRepository.RestoreOrderStatus
+func (mmRestoreOrderStatus *mOrdersHistoryRepositoryMockRestoreOrderStatus) ExpectStatParam2(stat *domain.OrderStatus) *mOrdersHistoryRepositoryMockRestoreOrderStatus {
+	if mmRestoreOrderStatus.mock.funcRestoreOrderStatus != nil {
+		mmRestoreOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.RestoreOrderStatus mock is already set by Set")
+	}
+
+	if mmRestoreOrderStatus.defaultExpectation == nil {
+		mmRestoreOrderStatus.defaultExpectation = &OrdersHistoryRepositoryMockRestoreOrderStatusExpectation{}
+	}
+
+	if mmRestoreOrderStatus.defaultExpectation.params != nil {
+		mmRestoreOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.RestoreOrderStatus mock is already set by Expect")
+	}
+
+	if mmRestoreOrderStatus.defaultExpectation.paramPtrs == nil {
+		mmRestoreOrderStatus.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockRestoreOrderStatusParamPtrs{}
+	}
+	mmRestoreOrderStatus.defaultExpectation.paramPtrs.stat = &stat
+	mmRestoreOrderStatus.defaultExpectation.expectationOrigins.originStat = minimock.CallerInfo(1)
+
+	return mmRestoreOrderStatus
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersHistoryRepository.RestoreOrderStatus
+func (mmRestoreOrderStatus *mOrdersHistoryRepositoryMockRestoreOrderStatus) Inspect(f func(orderID uint64, stat *domain.OrderStatus)) *mOrdersHistoryRepositoryMockRestoreOrderStatus {
+	if mmRestoreOrderStatus.mock.inspectFuncRestoreOrderStatus != nil {
+		mmRestoreOrderStatus.mock.t.Fatalf("Inspect function is already set for OrdersHistoryRepositoryMock.RestoreOrderStatus")
+	}
+
+	mmRestoreOrderStatus.mock.inspectFuncRestoreOrderStatus = f
+
+	return mmRestoreOrderStatus
+}
+
+// Return sets up results that will be returned by OrdersHistoryRepository.RestoreOrderStatus
+func (mmRestoreOrderStatus *mOrdersHistoryRepositoryMockRestoreOrderStatus) Return() *OrdersHistoryRepositoryMock {
+	if mmRestoreOrderStatus.mock.funcRestoreOrderStatus != nil {
+		mmRestoreOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.RestoreOrderStatus mock is already set by Set")
+	}
+
+	if mmRestoreOrderStatus.defaultExpectation == nil {
+		mmRestoreOrderStatus.defaultExpectation = &OrdersHistoryRepositoryMockRestoreOrderStatusExpectation{mock: mmRestoreOrderStatus.mock}
+	}
+
+	mmRestoreOrderStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRestoreOrderStatus.mock
+}
+
+// Set uses given function f to mock the OrdersHistoryRepository.RestoreOrderStatus method
+func (mmRestoreOrderStatus *mOrdersHistoryRepositoryMockRestoreOrderStatus) Set(f func(orderID uint64, stat *domain.OrderStatus)) *OrdersHistoryRepositoryMock {
+	if mmRestoreOrderStatus.defaultExpectation != nil {
+		mmRestoreOrderStatus.mock.t.Fatalf("Default expectation is already set for the OrdersHistoryRepository.RestoreOrderStatus method")
+	}
+
+	if len(mmRestoreOrderStatus.expectations) > 0 {
+		mmRestoreOrderStatus.mock.t.Fatalf("Some expectations are already set for the OrdersHistoryRepository.RestoreOrderStatus method")
+	}
+
+	mmRestoreOrderStatus.mock.funcRestoreOrderStatus = f
+	mmRestoreOrderStatus.mock.funcRestoreOrderStatusOrigin = minimock.CallerInfo(1)
+	return mmRestoreOrderStatus.mock
+}
+
+// Times sets number of times OrdersHistoryRepository.RestoreOrderStatus should be invoked
+func (mmRestoreOrderStatus *mOrdersHistoryRepositoryMockRestoreOrderStatus) Times(n uint64) *mOrdersHistoryRepositoryMockRestoreOrderStatus {
+	if n == 0 {
+		mmRestoreOrderStatus.mock.t.Fatalf("Times of OrdersHistoryRepositoryMock.RestoreOrderStatus mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmRestoreOrderStatus.expectedInvocations, n)
+	mmRestoreOrderStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRestoreOrderStatus
+}
+
+func (mmRestoreOrderStatus *mOrdersHistoryRepositoryMockRestoreOrderStatus) invocationsDone() bool {
+	if len(mmRestoreOrderStatus.expectations) == 0 && mmRestoreOrderStatus.defaultExpectation == nil && mmRestoreOrderStatus.mock.funcRestoreOrderStatus == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmRestoreOrderStatus.mock.afterRestoreOrderStatusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRestoreOrderStatus.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// Return sets up results that will be returned by OrdersHistoryRepository.GetOrderStatus
-func (mmGetOrderStatus *mOrdersHistoryRepositoryMockGetOrderStatus) Return(op1 *domain.OrderStatus, err error) *OrdersHistoryRepositoryMock {
-	if mmGetOrderStatus.mock.funcGetOrderStatus != nil {
-		mmGetOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderStatus mock is already set by Set")
+// RestoreOrderStatus implements mm_storage_json.OrdersHistoryRepository
+func (mmRestoreOrderStatus *OrdersHistoryRepositoryMock) RestoreOrderStatus(orderID uint64, stat *domain.OrderStatus) {
+	mm_atomic.AddUint64(&mmRestoreOrderStatus.beforeRestoreOrderStatusCounter, 1)
+	defer mm_atomic.AddUint64(&mmRestoreOrderStatus.afterRestoreOrderStatusCounter, 1)
+
+	mmRestoreOrderStatus.t.Helper()
+
+	if mmRestoreOrderStatus.inspectFuncRestoreOrderStatus != nil {
+		mmRestoreOrderStatus.inspectFuncRestoreOrderStatus(orderID, stat)
 	}
 
-	if mmGetOrderStatus.defaultExpectation == nil {
-		mmGetOrderStatus.defaultExpectation = &OrdersHistoryRepositoryMockGetOrderStatusExpectation{mock: mmGetOrderStatus.mock}
+	mm_params := OrdersHistoryRepositoryMockRestoreOrderStatusParams{orderID, stat}
+
+	// Record call args
+	mmRestoreOrderStatus.RestoreOrderStatusMock.mutex.Lock()
+	mmRestoreOrderStatus.RestoreOrderStatusMock.callArgs = append(mmRestoreOrderStatus.RestoreOrderStatusMock.callArgs, &mm_params)
+	mmRestoreOrderStatus.RestoreOrderStatusMock.mutex.Unlock()
+
+	for _, e := range mmRestoreOrderStatus.RestoreOrderStatusMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
 	}
-	mmGetOrderStatus.defaultExpectation.results = &OrdersHistoryRepositoryMockGetOrderStatusResults{op1, err}
-	mmGetOrderStatus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetOrderStatus.mock
+
+	if mmRestoreOrderStatus.RestoreOrderStatusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRestoreOrderStatus.RestoreOrderStatusMock.defaultExpectation.Counter, 1)
+		mm_want := mmRestoreOrderStatus.RestoreOrderStatusMock.defaultExpectation.params
+		mm_want_ptrs := mmRestoreOrderStatus.RestoreOrderStatusMock.defaultExpectation.paramPtrs
+
+		mm_got := OrdersHistoryRepositoryMockRestoreOrderStatusParams{orderID, stat}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmRestoreOrderStatus.t.Errorf("OrdersHistoryRepositoryMock.RestoreOrderStatus got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreOrderStatus.RestoreOrderStatusMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			}
+
+			if mm_want_ptrs.stat != nil && !minimock.Equal(*mm_want_ptrs.stat, mm_got.stat) {
+				mmRestoreOrderStatus.t.Errorf("OrdersHistoryRepositoryMock.RestoreOrderStatus got unexpected parameter stat, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRestoreOrderStatus.RestoreOrderStatusMock.defaultExpectation.expectationOrigins.originStat, *mm_want_ptrs.stat, mm_got.stat, minimock.Diff(*mm_want_ptrs.stat, mm_got.stat))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmRestoreOrderStatus.t.Errorf("OrdersHistoryRepositoryMock.RestoreOrderStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRestoreOrderStatus.RestoreOrderStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmRestoreOrderStatus.funcRestoreOrderStatus != nil {
+		mmRestoreOrderStatus.funcRestoreOrderStatus(orderID, stat)
+		return
+	}
+	mmRestoreOrderStatus.t.Fatalf("Unexpected call to OrdersHistoryRepositoryMock.RestoreOrderStatus. %v %v", orderID, stat)
+
 }
 
-// Set uses given function f to mock the OrdersHistoryRepository.GetOrderStatus method
-func (mmGetOrderStatus *mOrdersHistoryRepositoryMockGetOrderStatus) Set(f func(orderID uint64) (op1 *domain.OrderStatus, err error)) *OrdersHistoryRepositoryMock {
-	if mmGetOrderStatus.defaultExpectation != nil {
-		mmGetOrderStatus.mock.t.Fatalf("Default expectation is already set for the OrdersHistoryRepository.GetOrderStatus method")
+// RestoreOrderStatusAfterCounter returns a count of finished OrdersHistoryRepositoryMock.RestoreOrderStatus invocations
+func (mmRestoreOrderStatus *OrdersHistoryRepositoryMock) RestoreOrderStatusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestoreOrderStatus.afterRestoreOrderStatusCounter)
+}
+
+// RestoreOrderStatusBeforeCounter returns a count of OrdersHistoryRepositoryMock.RestoreOrderStatus invocations
+func (mmRestoreOrderStatus *OrdersHistoryRepositoryMock) RestoreOrderStatusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRestoreOrderStatus.beforeRestoreOrderStatusCounter)
+}
+
+// Calls returns a list of arguments used in each call to OrdersHistoryRepositoryMock.RestoreOrderStatus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmRestoreOrderStatus *mOrdersHistoryRepositoryMockRestoreOrderStatus) Calls() []*OrdersHistoryRepositoryMockRestoreOrderStatusParams {
+	mmRestoreOrderStatus.mutex.RLock()
+
+	argCopy := make([]*OrdersHistoryRepositoryMockRestoreOrderStatusParams, len(mmRestoreOrderStatus.callArgs))
+	copy(argCopy, mmRestoreOrderStatus.callArgs)
+
+	mmRestoreOrderStatus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockRestoreOrderStatusDone returns true if the count of the RestoreOrderStatus invocations corresponds
+// the number of defined expectations
+func (m *OrdersHistoryRepositoryMock) MinimockRestoreOrderStatusDone() bool {
+	if m.RestoreOrderStatusMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
 	}
 
-	if len(mmGetOrderStatus.expectations) > 0 {
-		mmGetOrderStatus.mock.t.Fatalf("Some expectations are already set for the OrdersHistoryRepository.GetOrderStatus method")
+	for _, e := range m.RestoreOrderStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
 	}
 
-	mmGetOrderStatus.mock.funcGetOrderStatus = f
-	mmGetOrderStatus.mock.funcGetOrderStatusOrigin = minimock.CallerInfo(1)
-	return mmGetOrderStatus.mock
+	return m.RestoreOrderStatusMock.invocationsDone()
 }
 
-// When sets expectation for the OrdersHistoryRepository.GetOrderStatus which will trigger the result defined by the following
+// MinimockRestoreOrderStatusInspect logs each unmet expectation
+func (m *OrdersHistoryRepositoryMock) MinimockRestoreOrderStatusInspect() {
+	for _, e := range m.RestoreOrderStatusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.RestoreOrderStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterRestoreOrderStatusCounter := mm_atomic.LoadUint64(&m.afterRestoreOrderStatusCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.RestoreOrderStatusMock.defaultExpectation != nil && afterRestoreOrderStatusCounter < 1 {
+		if m.RestoreOrderStatusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.RestoreOrderStatus at\n%s", m.RestoreOrderStatusMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.RestoreOrderStatus at\n%s with params: %#v", m.RestoreOrderStatusMock.defaultExpectation.expectationOrigins.origin, *m.RestoreOrderStatusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcRestoreOrderStatus != nil && afterRestoreOrderStatusCounter < 1 {
+		m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.RestoreOrderStatus at\n%s", m.funcRestoreOrderStatusOrigin)
+	}
+
+	if !m.RestoreOrderStatusMock.invocationsDone() && afterRestoreOrderStatusCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrdersHistoryRepositoryMock.RestoreOrderStatus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RestoreOrderStatusMock.expectedInvocations), m.RestoreOrderStatusMock.expectedInvocationsOrigin, afterRestoreOrderStatusCounter)
+	}
+}
+
+type mOrdersHistoryRepositoryMockSearchOrders struct {
+	optional           bool
+	mock               *OrdersHistoryRepositoryMock
+	defaultExpectation *OrdersHistoryRepositoryMockSearchOrdersExpectation
+	expectations       []*OrdersHistoryRepositoryMockSearchOrdersExpectation
+
+	callArgs []*OrdersHistoryRepositoryMockSearchOrdersParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// OrdersHistoryRepositoryMockSearchOrdersExpectation specifies expectation struct of the OrdersHistoryRepository.SearchOrders
+type OrdersHistoryRepositoryMockSearchOrdersExpectation struct {
+	mock               *OrdersHistoryRepositoryMock
+	params             *OrdersHistoryRepositoryMockSearchOrdersParams
+	paramPtrs          *OrdersHistoryRepositoryMockSearchOrdersParamPtrs
+	expectationOrigins OrdersHistoryRepositoryMockSearchOrdersExpectationOrigins
+	results            *OrdersHistoryRepositoryMockSearchOrdersResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// OrdersHistoryRepositoryMockSearchOrdersParams contains parameters of the OrdersHistoryRepository.SearchOrders
+type OrdersHistoryRepositoryMockSearchOrdersParams struct {
+	ctx    context.Context
+	filter *domain.OrderFilter
+}
+
+// OrdersHistoryRepositoryMockSearchOrdersParamPtrs contains pointers to parameters of the OrdersHistoryRepository.SearchOrders
+type OrdersHistoryRepositoryMockSearchOrdersParamPtrs struct {
+	ctx    *context.Context
+	filter **domain.OrderFilter
+}
+
+// OrdersHistoryRepositoryMockSearchOrdersResults contains results of the OrdersHistoryRepository.SearchOrders
+type OrdersHistoryRepositoryMockSearchOrdersResults struct {
+	oa1 []domain.OrderRecord
+	err error
+}
+
+// OrdersHistoryRepositoryMockSearchOrdersOrigins contains origins of expectations of the OrdersHistoryRepository.SearchOrders
+type OrdersHistoryRepositoryMockSearchOrdersExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originFilter string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSearchOrders *mOrdersHistoryRepositoryMockSearchOrders) Optional() *mOrdersHistoryRepositoryMockSearchOrders {
+	mmSearchOrders.optional = true
+	return mmSearchOrders
+}
+
+// Expect sets up expected params for OrdersHistoryRepository.SearchOrders
+func (mmSearchOrders *mOrdersHistoryRepositoryMockSearchOrders) Expect(ctx context.Context, filter *domain.OrderFilter) *mOrdersHistoryRepositoryMockSearchOrders {
+	if mmSearchOrders.mock.funcSearchOrders != nil {
+		mmSearchOrders.mock.t.Fatalf("OrdersHistoryRepositoryMock.SearchOrders mock is already set by Set")
+	}
+
+	if mmSearchOrders.defaultExpectation == nil {
+		mmSearchOrders.defaultExpectation = &OrdersHistoryRepositoryMockSearchOrdersExpectation{}
+	}
+
+	if mmSearchOrders.defaultExpectation.paramPtrs != nil {
+		mmSearchOrders.mock.t.Fatalf("OrdersHistoryRepositoryMock.SearchOrders mock is already set by ExpectParams functions")
+	}
+
+	mmSearchOrders.defaultExpectation.params = &OrdersHistoryRepositoryMockSearchOrdersParams{ctx, filter}
+	mmSearchOrders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSearchOrders.expectations {
+		if minimock.Equal(e.params, mmSearchOrders.defaultExpectation.params) {
+			mmSearchOrders.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSearchOrders.defaultExpectation.params)
+		}
+	}
+
+	return mmSearchOrders
+}
+
+// ExpectCtxParam1 sets up expected param ctx for OrdersHistoryRepository.SearchOrders
+func (mmSearchOrders *mOrdersHistoryRepositoryMockSearchOrders) ExpectCtxParam1(ctx context.Context) *mOrdersHistoryRepositoryMockSearchOrders {
+	if mmSearchOrders.mock.funcSearchOrders != nil {
+		mmSearchOrders.mock.t.Fatalf("OrdersHistoryRepositoryMock.SearchOrders mock is already set by Set")
+	}
+
+	if mmSearchOrders.defaultExpectation == nil {
+		mmSearchOrders.defaultExpectation = &OrdersHistoryRepositoryMockSearchOrdersExpectation{}
+	}
+
+	if mmSearchOrders.defaultExpectation.params != nil {
+		mmSearchOrders.mock.t.Fatalf("OrdersHistoryRepositoryMock.SearchOrders mock is already set by Expect")
+	}
+
+	if mmSearchOrders.defaultExpectation.paramPtrs == nil {
+		mmSearchOrders.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockSearchOrdersParamPtrs{}
+	}
+	mmSearchOrders.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSearchOrders.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSearchOrders
+}
+
+// ExpectFilterParam2 sets up expected param filter for OrdersHistoryRepository.SearchOrders
+func (mmSearchOrders *mOrdersHistoryRepositoryMockSearchOrders) ExpectFilterParam2(filter *domain.OrderFilter) *mOrdersHistoryRepositoryMockSearchOrders {
+	if mmSearchOrders.mock.funcSearchOrders != nil {
+		mmSearchOrders.mock.t.Fatalf("OrdersHistoryRepositoryMock.SearchOrders mock is already set by Set")
+	}
+
+	if mmSearchOrders.defaultExpectation == nil {
+		mmSearchOrders.defaultExpectation = &OrdersHistoryRepositoryMockSearchOrdersExpectation{}
+	}
+
+	if mmSearchOrders.defaultExpectation.params != nil {
+		mmSearchOrders.mock.t.Fatalf("OrdersHistoryRepositoryMock.SearchOrders mock is already set by Expect")
+	}
+
+	if mmSearchOrders.defaultExpectation.paramPtrs == nil {
+		mmSearchOrders.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockSearchOrdersParamPtrs{}
+	}
+	mmSearchOrders.defaultExpectation.paramPtrs.filter = &filter
+	mmSearchOrders.defaultExpectation.expectationOrigins.originFilter = minimock.CallerInfo(1)
+
+	return mmSearchOrders
+}
+
+// Inspect accepts an inspector function that has same arguments as the OrdersHistoryRepository.SearchOrders
+func (mmSearchOrders *mOrdersHistoryRepositoryMockSearchOrders) Inspect(f func(ctx context.Context, filter *domain.OrderFilter)) *mOrdersHistoryRepositoryMockSearchOrders {
+	if mmSearchOrders.mock.inspectFuncSearchOrders != nil {
+		mmSearchOrders.mock.t.Fatalf("Inspect function is already set for OrdersHistoryRepositoryMock.SearchOrders")
+	}
+
+	mmSearchOrders.mock.inspectFuncSearchOrders = f
+
+	return mmSearchOrders
+}
+
+// Return sets up results that will be returned by OrdersHistoryRepository.SearchOrders
+func (mmSearchOrders *mOrdersHistoryRepositoryMockSearchOrders) Return(oa1 []domain.OrderRecord, err error) *OrdersHistoryRepositoryMock {
+	if mmSearchOrders.mock.funcSearchOrders != nil {
+		mmSearchOrders.mock.t.Fatalf("OrdersHistoryRepositoryMock.SearchOrders mock is already set by Set")
+	}
+
+	if mmSearchOrders.defaultExpectation == nil {
+		mmSearchOrders.defaultExpectation = &OrdersHistoryRepositoryMockSearchOrdersExpectation{mock: mmSearchOrders.mock}
+	}
+	mmSearchOrders.defaultExpectation.results = &OrdersHistoryRepositoryMockSearchOrdersResults{oa1, err}
+	mmSearchOrders.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSearchOrders.mock
+}
+
+// Set uses given function f to mock the OrdersHistoryRepository.SearchOrders method
+func (mmSearchOrders *mOrdersHistoryRepositoryMockSearchOrders) Set(f func(ctx context.Context, filter *domain.OrderFilter) (oa1 []domain.OrderRecord, err error)) *OrdersHistoryRepositoryMock {
+	if mmSearchOrders.defaultExpectation != nil {
+		mmSearchOrders.mock.t.Fatalf("Default expectation is already set for the OrdersHistoryRepository.SearchOrders method")
+	}
+
+	if len(mmSearchOrders.expectations) > 0 {
+		mmSearchOrders.mock.t.Fatalf("Some expectations are already set for the OrdersHistoryRepository.SearchOrders method")
+	}
+
+	mmSearchOrders.mock.funcSearchOrders = f
+	mmSearchOrders.mock.funcSearchOrdersOrigin = minimock.CallerInfo(1)
+	return mmSearchOrders.mock
+}
+
+// When sets expectation for the OrdersHistoryRepository.SearchOrders which will trigger the result defined by the following
 // Then helper
-func (mmGetOrderStatus *mOrdersHistoryRepositoryMockGetOrderStatus) When(orderID uint64) *OrdersHistoryRepositoryMockGetOrderStatusExpectation {
-	if mmGetOrderStatus.mock.funcGetOrderStatus != nil {
-		mmGetOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.GetOrderStatus mock is already set by Set")
+func (mmSearchOrders *mOrdersHistoryRepositoryMockSearchOrders) When(ctx context.Context, filter *domain.OrderFilter) *OrdersHistoryRepositoryMockSearchOrdersExpectation {
+	if mmSearchOrders.mock.funcSearchOrders != nil {
+		mmSearchOrders.mock.t.Fatalf("OrdersHistoryRepositoryMock.SearchOrders mock is already set by Set")
 	}
 
-	expectation := &OrdersHistoryRepositoryMockGetOrderStatusExpectation{
-		mock:               mmGetOrderStatus.mock,
-		params:             &OrdersHistoryRepositoryMockGetOrderStatusParams{orderID},
-		expectationOrigins: OrdersHistoryRepositoryMockGetOrderStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &OrdersHistoryRepositoryMockSearchOrdersExpectation{
+		mock:               mmSearchOrders.mock,
+		params:             &OrdersHistoryRepositoryMockSearchOrdersParams{ctx, filter},
+		expectationOrigins: OrdersHistoryRepositoryMockSearchOrdersExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetOrderStatus.expectations = append(mmGetOrderStatus.expectations, expectation)
+	mmSearchOrders.expectations = append(mmSearchOrders.expectations, expectation)
 	return expectation
 }
 
-// Then sets up OrdersHistoryRepository.GetOrderStatus return parameters for the expectation previously defined by the When method
-func (e *OrdersHistoryRepositoryMockGetOrderStatusExpectation) Then(op1 *domain.OrderStatus, err error) *OrdersHistoryRepositoryMock {
-	e.results = &OrdersHistoryRepositoryMockGetOrderStatusResults{op1, err}
+// Then sets up OrdersHistoryRepository.SearchOrders return parameters for the expectation previously defined by the When method
+func (e *OrdersHistoryRepositoryMockSearchOrdersExpectation) Then(oa1 []domain.OrderRecord, err error) *OrdersHistoryRepositoryMock {
+	e.results = &OrdersHistoryRepositoryMockSearchOrdersResults{oa1, err}
 	return e.mock
 }
 
-// Times sets number of times OrdersHistoryRepository.GetOrderStatus should be invoked
-func (mmGetOrderStatus *mOrdersHistoryRepositoryMockGetOrderStatus) Times(n uint64) *mOrdersHistoryRepositoryMockGetOrderStatus {
+// Times sets number of times OrdersHistoryRepository.SearchOrders should be invoked
+func (mmSearchOrders *mOrdersHistoryRepositoryMockSearchOrders) Times(n uint64) *mOrdersHistoryRepositoryMockSearchOrders {
 	if n == 0 {
-		mmGetOrderStatus.mock.t.Fatalf("Times of OrdersHistoryRepositoryMock.GetOrderStatus mock can not be zero")
+		mmSearchOrders.mock.t.Fatalf("Times of OrdersHistoryRepositoryMock.SearchOrders mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetOrderStatus.expectedInvocations, n)
-	mmGetOrderStatus.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetOrderStatus
+	mm_atomic.StoreUint64(&mmSearchOrders.expectedInvocations, n)
+	mmSearchOrders.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSearchOrders
 }
 
-func (mmGetOrderStatus *mOrdersHistoryRepositoryMockGetOrderStatus) invocationsDone() bool {
-	if len(mmGetOrderStatus.expectations) == 0 && mmGetOrderStatus.defaultExpectation == nil && mmGetOrderStatus.mock.funcGetOrderStatus == nil {
+func (mmSearchOrders *mOrdersHistoryRepositoryMockSearchOrders) invocationsDone() bool {
+	if len(mmSearchOrders.expectations) == 0 && mmSearchOrders.defaultExpectation == nil && mmSearchOrders.mock.funcSearchOrders == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetOrderStatus.mock.afterGetOrderStatusCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetOrderStatus.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSearchOrders.mock.afterSearchOrdersCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSearchOrders.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetOrderStatus implements mm_storage.OrdersHistoryRepository
-func (mmGetOrderStatus *OrdersHistoryRepositoryMock) GetOrderStatus(orderID uint64) (op1 *domain.OrderStatus, err error) {
-	mm_atomic.AddUint64(&mmGetOrderStatus.beforeGetOrderStatusCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetOrderStatus.afterGetOrderStatusCounter, 1)
+// SearchOrders implements mm_storage_json.OrdersHistoryRepository
+func (mmSearchOrders *OrdersHistoryRepositoryMock) SearchOrders(ctx context.Context, filter *domain.OrderFilter) (oa1 []domain.OrderRecord, err error) {
+	mm_atomic.AddUint64(&mmSearchOrders.beforeSearchOrdersCounter, 1)
+	defer mm_atomic.AddUint64(&mmSearchOrders.afterSearchOrdersCounter, 1)
 
-	mmGetOrderStatus.t.Helper()
+	mmSearchOrders.t.Helper()
 
-	if mmGetOrderStatus.inspectFuncGetOrderStatus != nil {
-		mmGetOrderStatus.inspectFuncGetOrderStatus(orderID)
+	if mmSearchOrders.inspectFuncSearchOrders != nil {
+		mmSearchOrders.inspectFuncSearchOrders(ctx, filter)
 	}
 
-	mm_params := OrdersHistoryRepositoryMockGetOrderStatusParams{orderID}
+	mm_params := OrdersHistoryRepositoryMockSearchOrdersParams{ctx, filter}
 
 	// Record call args
-	mmGetOrderStatus.GetOrderStatusMock.mutex.Lock()
-	mmGetOrderStatus.GetOrderStatusMock.callArgs = append(mmGetOrderStatus.GetOrderStatusMock.callArgs, &mm_params)
-	mmGetOrderStatus.GetOrderStatusMock.mutex.Unlock()
+	mmSearchOrders.SearchOrdersMock.mutex.Lock()
+	mmSearchOrders.SearchOrdersMock.callArgs = append(mmSearchOrders.SearchOrdersMock.callArgs, &mm_params)
+	mmSearchOrders.SearchOrdersMock.mutex.Unlock()
 
-	for _, e := range mmGetOrderStatus.GetOrderStatusMock.expectations {
+	for _, e := range mmSearchOrders.SearchOrdersMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.op1, e.results.err
+			return e.results.oa1, e.results.err
 		}
 	}
 
-	if mmGetOrderStatus.GetOrderStatusMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetOrderStatus.GetOrderStatusMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetOrderStatus.GetOrderStatusMock.defaultExpectation.params
-		mm_want_ptrs := mmGetOrderStatus.GetOrderStatusMock.defaultExpectation.paramPtrs
+	if mmSearchOrders.SearchOrdersMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSearchOrders.SearchOrdersMock.defaultExpectation.Counter, 1)
+		mm_want := mmSearchOrders.SearchOrdersMock.defaultExpectation.params
+		mm_want_ptrs := mmSearchOrders.SearchOrdersMock.defaultExpectation.paramPtrs
 
-		mm_got := OrdersHistoryRepositoryMockGetOrderStatusParams{orderID}
+		mm_got := OrdersHistoryRepositoryMockSearchOrdersParams{ctx, filter}
 
 		if mm_want_ptrs != nil {
 
-			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
-				mmGetOrderStatus.t.Errorf("OrdersHistoryRepositoryMock.GetOrderStatus got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetOrderStatus.GetOrderStatusMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSearchOrders.t.Errorf("OrdersHistoryRepositoryMock.SearchOrders got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchOrders.SearchOrdersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.filter != nil && !minimock.Equal(*mm_want_ptrs.filter, mm_got.filter) {
+				mmSearchOrders.t.Errorf("OrdersHistoryRepositoryMock.SearchOrders got unexpected parameter filter, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSearchOrders.SearchOrdersMock.defaultExpectation.expectationOrigins.originFilter, *mm_want_ptrs.filter, mm_got.filter, minimock.Diff(*mm_want_ptrs.filter, mm_got.filter))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetOrderStatus.t.Errorf("OrdersHistoryRepositoryMock.GetOrderStatus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetOrderStatus.GetOrderStatusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSearchOrders.t.Errorf("OrdersHistoryRepositoryMock.SearchOrders got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSearchOrders.SearchOrdersMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetOrderStatus.GetOrderStatusMock.defaultExpectation.results
+		mm_results := mmSearchOrders.SearchOrdersMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetOrderStatus.t.Fatal("No results are set for the OrdersHistoryRepositoryMock.GetOrderStatus")
+			mmSearchOrders.t.Fatal("No results are set for the OrdersHistoryRepositoryMock.SearchOrders")
 		}
-		return (*mm_results).op1, (*mm_results).err
+		return (*mm_results).oa1, (*mm_results).err
 	}
-	if mmGetOrderStatus.funcGetOrderStatus != nil {
-		return mmGetOrderStatus.funcGetOrderStatus(orderID)
+	if mmSearchOrders.funcSearchOrders != nil {
+		return mmSearchOrders.funcSearchOrders(ctx, filter)
 	}
-	mmGetOrderStatus.t.Fatalf("Unexpected call to OrdersHistoryRepositoryMock.GetOrderStatus. %v", orderID)
+	mmSearchOrders.t.Fatalf("Unexpected call to OrdersHistoryRepositoryMock.SearchOrders. %v %v", ctx, filter)
 	return
 }
 
-// GetOrderStatusAfterCounter returns a count of finished OrdersHistoryRepositoryMock.GetOrderStatus invocations
-func (mmGetOrderStatus *OrdersHistoryRepositoryMock) GetOrderStatusAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetOrderStatus.afterGetOrderStatusCounter)
+// SearchOrdersAfterCounter returns a count of finished OrdersHistoryRepositoryMock.SearchOrders invocations
+func (mmSearchOrders *OrdersHistoryRepositoryMock) SearchOrdersAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchOrders.afterSearchOrdersCounter)
 }
 
-// GetOrderStatusBeforeCounter returns a count of OrdersHistoryRepositoryMock.GetOrderStatus invocations
-func (mmGetOrderStatus *OrdersHistoryRepositoryMock) GetOrderStatusBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetOrderStatus.beforeGetOrderStatusCounter)
+// SearchOrdersBeforeCounter returns a count of OrdersHistoryRepositoryMock.SearchOrders invocations
+func (mmSearchOrders *OrdersHistoryRepositoryMock) SearchOrdersBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSearchOrders.beforeSearchOrdersCounter)
 }
 
-// Calls returns a list of arguments used in each call to OrdersHistoryRepositoryMock.GetOrderStatus.
+// Calls returns a list of arguments used in each call to OrdersHistoryRepositoryMock.SearchOrders.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetOrderStatus *mOrdersHistoryRepositoryMockGetOrderStatus) Calls() []*OrdersHistoryRepositoryMockGetOrderStatusParams {
-	mmGetOrderStatus.mutex.RLock()
+func (mmSearchOrders *mOrdersHistoryRepositoryMockSearchOrders) Calls() []*OrdersHistoryRepositoryMockSearchOrdersParams {
+	mmSearchOrders.mutex.RLock()
 
-	argCopy := make([]*OrdersHistoryRepositoryMockGetOrderStatusParams, len(mmGetOrderStatus.callArgs))
-	copy(argCopy, mmGetOrderStatus.callArgs)
+	argCopy := make([]*OrdersHistoryRepositoryMockSearchOrdersParams, len(mmSearchOrders.callArgs))
+	copy(argCopy, mmSearchOrders.callArgs)
 
-	mmGetOrderStatus.mutex.RUnlock()
+	mmSearchOrders.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetOrderStatusDone returns true if the count of the GetOrderStatus invocations corresponds
+// MinimockSearchOrdersDone returns true if the count of the SearchOrders invocations corresponds
 // the number of defined expectations
-func (m *OrdersHistoryRepositoryMock) MinimockGetOrderStatusDone() bool {
-	if m.GetOrderStatusMock.optional {
+func (m *OrdersHistoryRepositoryMock) MinimockSearchOrdersDone() bool {
+	if m.SearchOrdersMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetOrderStatusMock.expectations {
+	for _, e := range m.SearchOrdersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetOrderStatusMock.invocationsDone()
+	return m.SearchOrdersMock.invocationsDone()
 }
 
-// MinimockGetOrderStatusInspect logs each unmet expectation
-func (m *OrdersHistoryRepositoryMock) MinimockGetOrderStatusInspect() {
-	for _, e := range m.GetOrderStatusMock.expectations {
+// MinimockSearchOrdersInspect logs each unmet expectation
+func (m *OrdersHistoryRepositoryMock) MinimockSearchOrdersInspect() {
+	for _, e := range m.SearchOrdersMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetOrderStatus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.SearchOrders at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetOrderStatusCounter := mm_atomic.LoadUint64(&m.afterGetOrderStatusCounter)
+	afterSearchOrdersCounter := mm_atomic.LoadUint64(&m.afterSearchOrdersCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetOrderStatusMock.defaultExpectation != nil && afterGetOrderStatusCounter < 1 {
-		if m.GetOrderStatusMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetOrderStatus at\n%s", m.GetOrderStatusMock.defaultExpectation.returnOrigin)
+	if m.SearchOrdersMock.defaultExpectation != nil && afterSearchOrdersCounter < 1 {
+		if m.SearchOrdersMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.SearchOrders at\n%s", m.SearchOrdersMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetOrderStatus at\n%s with params: %#v", m.GetOrderStatusMock.defaultExpectation.expectationOrigins.origin, *m.GetOrderStatusMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.SearchOrders at\n%s with params: %#v", m.SearchOrdersMock.defaultExpectation.expectationOrigins.origin, *m.SearchOrdersMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetOrderStatus != nil && afterGetOrderStatusCounter < 1 {
-		m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.GetOrderStatus at\n%s", m.funcGetOrderStatusOrigin)
+	if m.funcSearchOrders != nil && afterSearchOrdersCounter < 1 {
+		m.t.Errorf("Expected call to OrdersHistoryRepositoryMock.SearchOrders at\n%s", m.funcSearchOrdersOrigin)
 	}
 
-	if !m.GetOrderStatusMock.invocationsDone() && afterGetOrderStatusCounter > 0 {
-		m.t.Errorf("Expected %d calls to OrdersHistoryRepositoryMock.GetOrderStatus at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetOrderStatusMock.expectedInvocations), m.GetOrderStatusMock.expectedInvocationsOrigin, afterGetOrderStatusCounter)
+	if !m.SearchOrdersMock.invocationsDone() && afterSearchOrdersCounter > 0 {
+		m.t.Errorf("Expected %d calls to OrdersHistoryRepositoryMock.SearchOrders at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SearchOrdersMock.expectedInvocations), m.SearchOrdersMock.expectedInvocationsOrigin, afterSearchOrdersCounter)
 	}
 }
 
@@ -1126,12 +4137,14 @@ type OrdersHistoryRepositoryMockSetOrderStatusExpectation struct {
 
 // OrdersHistoryRepositoryMockSetOrderStatusParams contains parameters of the OrdersHistoryRepository.SetOrderStatus
 type OrdersHistoryRepositoryMockSetOrderStatusParams struct {
+	ctx     context.Context
 	orderID uint64
 	status  string
 }
 
 // OrdersHistoryRepositoryMockSetOrderStatusParamPtrs contains pointers to parameters of the OrdersHistoryRepository.SetOrderStatus
 type OrdersHistoryRepositoryMockSetOrderStatusParamPtrs struct {
+	ctx     *context.Context
 	orderID *uint64
 	status  *string
 }
@@ -1144,6 +4157,7 @@ type OrdersHistoryRepositoryMockSetOrderStatusResults struct {
 // OrdersHistoryRepositoryMockSetOrderStatusOrigins contains origins of expectations of the OrdersHistoryRepository.SetOrderStatus
 type OrdersHistoryRepositoryMockSetOrderStatusExpectationOrigins struct {
 	origin        string
+	originCtx     string
 	originOrderID string
 	originStatus  string
 }
@@ -1159,7 +4173,7 @@ func (mmSetOrderStatus *mOrdersHistoryRepositoryMockSetOrderStatus) Optional() *
 }
 
 // Expect sets up expected params for OrdersHistoryRepository.SetOrderStatus
-func (mmSetOrderStatus *mOrdersHistoryRepositoryMockSetOrderStatus) Expect(orderID uint64, status string) *mOrdersHistoryRepositoryMockSetOrderStatus {
+func (mmSetOrderStatus *mOrdersHistoryRepositoryMockSetOrderStatus) Expect(ctx context.Context, orderID uint64, status string) *mOrdersHistoryRepositoryMockSetOrderStatus {
 	if mmSetOrderStatus.mock.funcSetOrderStatus != nil {
 		mmSetOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.SetOrderStatus mock is already set by Set")
 	}
@@ -1172,7 +4186,7 @@ func (mmSetOrderStatus *mOrdersHistoryRepositoryMockSetOrderStatus) Expect(order
 		mmSetOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.SetOrderStatus mock is already set by ExpectParams functions")
 	}
 
-	mmSetOrderStatus.defaultExpectation.params = &OrdersHistoryRepositoryMockSetOrderStatusParams{orderID, status}
+	mmSetOrderStatus.defaultExpectation.params = &OrdersHistoryRepositoryMockSetOrderStatusParams{ctx, orderID, status}
 	mmSetOrderStatus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmSetOrderStatus.expectations {
 		if minimock.Equal(e.params, mmSetOrderStatus.defaultExpectation.params) {
@@ -1183,8 +4197,31 @@ func (mmSetOrderStatus *mOrdersHistoryRepositoryMockSetOrderStatus) Expect(order
 	return mmSetOrderStatus
 }
 
-// ExpectOrderIDParam1 sets up expected param orderID for OrdersHistoryRepository.SetOrderStatus
-func (mmSetOrderStatus *mOrdersHistoryRepositoryMockSetOrderStatus) ExpectOrderIDParam1(orderID uint64) *mOrdersHistoryRepositoryMockSetOrderStatus {
+// ExpectCtxParam1 sets up expected param ctx for OrdersHistoryRepository.SetOrderStatus
+func (mmSetOrderStatus *mOrdersHistoryRepositoryMockSetOrderStatus) ExpectCtxParam1(ctx context.Context) *mOrdersHistoryRepositoryMockSetOrderStatus {
+	if mmSetOrderStatus.mock.funcSetOrderStatus != nil {
+		mmSetOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.SetOrderStatus mock is already set by Set")
+	}
+
+	if mmSetOrderStatus.defaultExpectation == nil {
+		mmSetOrderStatus.defaultExpectation = &OrdersHistoryRepositoryMockSetOrderStatusExpectation{}
+	}
+
+	if mmSetOrderStatus.defaultExpectation.params != nil {
+		mmSetOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.SetOrderStatus mock is already set by Expect")
+	}
+
+	if mmSetOrderStatus.defaultExpectation.paramPtrs == nil {
+		mmSetOrderStatus.defaultExpectation.paramPtrs = &OrdersHistoryRepositoryMockSetOrderStatusParamPtrs{}
+	}
+	mmSetOrderStatus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetOrderStatus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmSetOrderStatus
+}
+
+// ExpectOrderIDParam2 sets up expected param orderID for OrdersHistoryRepository.SetOrderStatus
+func (mmSetOrderStatus *mOrdersHistoryRepositoryMockSetOrderStatus) ExpectOrderIDParam2(orderID uint64) *mOrdersHistoryRepositoryMockSetOrderStatus {
 	if mmSetOrderStatus.mock.funcSetOrderStatus != nil {
 		mmSetOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.SetOrderStatus mock is already set by Set")
 	}
@@ -1206,8 +4243,8 @@ func (mmSetOrderStatus *mOrdersHistoryRepositoryMockSetOrderStatus) ExpectOrderI
 	return mmSetOrderStatus
 }
 
-// ExpectStatusParam2 sets up expected param status for OrdersHistoryRepository.SetOrderStatus
-func (mmSetOrderStatus *mOrdersHistoryRepositoryMockSetOrderStatus) ExpectStatusParam2(status string) *mOrdersHistoryRepositoryMockSetOrderStatus {
+// ExpectStatusParam3 sets up expected param status for OrdersHistoryRepository.SetOrderStatus
+func (mmSetOrderStatus *mOrdersHistoryRepositoryMockSetOrderStatus) ExpectStatusParam3(status string) *mOrdersHistoryRepositoryMockSetOrderStatus {
 	if mmSetOrderStatus.mock.funcSetOrderStatus != nil {
 		mmSetOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.SetOrderStatus mock is already set by Set")
 	}
@@ -1230,7 +4267,7 @@ func (mmSetOrderStatus *mOrdersHistoryRepositoryMockSetOrderStatus) ExpectStatus
 }
 
 // Inspect accepts an inspector function that has same arguments as the OrdersHistoryRepository.SetOrderStatus
-func (mmSetOrderStatus *mOrdersHistoryRepositoryMockSetOrderStatus) Inspect(f func(orderID uint64, status string)) *mOrdersHistoryRepositoryMockSetOrderStatus {
+func (mmSetOrderStatus *mOrdersHistoryRepositoryMockSetOrderStatus) Inspect(f func(ctx context.Context, orderID uint64, status string)) *mOrdersHistoryRepositoryMockSetOrderStatus {
 	if mmSetOrderStatus.mock.inspectFuncSetOrderStatus != nil {
 		mmSetOrderStatus.mock.t.Fatalf("Inspect function is already set for OrdersHistoryRepositoryMock.SetOrderStatus")
 	}
@@ -1255,7 +4292,7 @@ func (mmSetOrderStatus *mOrdersHistoryRepositoryMockSetOrderStatus) Return(err e
 }
 
 // Set uses given function f to mock the OrdersHistoryRepository.SetOrderStatus method
-func (mmSetOrderStatus *mOrdersHistoryRepositoryMockSetOrderStatus) Set(f func(orderID uint64, status string) (err error)) *OrdersHistoryRepositoryMock {
+func (mmSetOrderStatus *mOrdersHistoryRepositoryMockSetOrderStatus) Set(f func(ctx context.Context, orderID uint64, status string) (err error)) *OrdersHistoryRepositoryMock {
 	if mmSetOrderStatus.defaultExpectation != nil {
 		mmSetOrderStatus.mock.t.Fatalf("Default expectation is already set for the OrdersHistoryRepository.SetOrderStatus method")
 	}
@@ -1271,14 +4308,14 @@ func (mmSetOrderStatus *mOrdersHistoryRepositoryMockSetOrderStatus) Set(f func(o
 
 // When sets expectation for the OrdersHistoryRepository.SetOrderStatus which will trigger the result defined by the following
 // Then helper
-func (mmSetOrderStatus *mOrdersHistoryRepositoryMockSetOrderStatus) When(orderID uint64, status string) *OrdersHistoryRepositoryMockSetOrderStatusExpectation {
+func (mmSetOrderStatus *mOrdersHistoryRepositoryMockSetOrderStatus) When(ctx context.Context, orderID uint64, status string) *OrdersHistoryRepositoryMockSetOrderStatusExpectation {
 	if mmSetOrderStatus.mock.funcSetOrderStatus != nil {
 		mmSetOrderStatus.mock.t.Fatalf("OrdersHistoryRepositoryMock.SetOrderStatus mock is already set by Set")
 	}
 
 	expectation := &OrdersHistoryRepositoryMockSetOrderStatusExpectation{
 		mock:               mmSetOrderStatus.mock,
-		params:             &OrdersHistoryRepositoryMockSetOrderStatusParams{orderID, status},
+		params:             &OrdersHistoryRepositoryMockSetOrderStatusParams{ctx, orderID, status},
 		expectationOrigins: OrdersHistoryRepositoryMockSetOrderStatusExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmSetOrderStatus.expectations = append(mmSetOrderStatus.expectations, expectation)
@@ -1312,18 +4349,18 @@ func (mmSetOrderStatus *mOrdersHistoryRepositoryMockSetOrderStatus) invocationsD
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// SetOrderStatus implements mm_storage.OrdersHistoryRepository
-func (mmSetOrderStatus *OrdersHistoryRepositoryMock) SetOrderStatus(orderID uint64, status string) (err error) {
+// SetOrderStatus implements mm_storage_json.OrdersHistoryRepository
+func (mmSetOrderStatus *OrdersHistoryRepositoryMock) SetOrderStatus(ctx context.Context, orderID uint64, status string) (err error) {
 	mm_atomic.AddUint64(&mmSetOrderStatus.beforeSetOrderStatusCounter, 1)
 	defer mm_atomic.AddUint64(&mmSetOrderStatus.afterSetOrderStatusCounter, 1)
 
 	mmSetOrderStatus.t.Helper()
 
 	if mmSetOrderStatus.inspectFuncSetOrderStatus != nil {
-		mmSetOrderStatus.inspectFuncSetOrderStatus(orderID, status)
+		mmSetOrderStatus.inspectFuncSetOrderStatus(ctx, orderID, status)
 	}
 
-	mm_params := OrdersHistoryRepositoryMockSetOrderStatusParams{orderID, status}
+	mm_params := OrdersHistoryRepositoryMockSetOrderStatusParams{ctx, orderID, status}
 
 	// Record call args
 	mmSetOrderStatus.SetOrderStatusMock.mutex.Lock()
@@ -1342,10 +4379,15 @@ func (mmSetOrderStatus *OrdersHistoryRepositoryMock) SetOrderStatus(orderID uint
 		mm_want := mmSetOrderStatus.SetOrderStatusMock.defaultExpectation.params
 		mm_want_ptrs := mmSetOrderStatus.SetOrderStatusMock.defaultExpectation.paramPtrs
 
-		mm_got := OrdersHistoryRepositoryMockSetOrderStatusParams{orderID, status}
+		mm_got := OrdersHistoryRepositoryMockSetOrderStatusParams{ctx, orderID, status}
 
 		if mm_want_ptrs != nil {
 
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmSetOrderStatus.t.Errorf("OrdersHistoryRepositoryMock.SetOrderStatus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetOrderStatus.SetOrderStatusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
 			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
 				mmSetOrderStatus.t.Errorf("OrdersHistoryRepositoryMock.SetOrderStatus got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 					mmSetOrderStatus.SetOrderStatusMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
@@ -1368,9 +4410,9 @@ func (mmSetOrderStatus *OrdersHistoryRepositoryMock) SetOrderStatus(orderID uint
 		return (*mm_results).err
 	}
 	if mmSetOrderStatus.funcSetOrderStatus != nil {
-		return mmSetOrderStatus.funcSetOrderStatus(orderID, status)
+		return mmSetOrderStatus.funcSetOrderStatus(ctx, orderID, status)
 	}
-	mmSetOrderStatus.t.Fatalf("Unexpected call to OrdersHistoryRepositoryMock.SetOrderStatus. %v %v", orderID, status)
+	mmSetOrderStatus.t.Fatalf("Unexpected call to OrdersHistoryRepositoryMock.SetOrderStatus. %v %v %v", ctx, orderID, status)
 	return
 }
 
@@ -1448,10 +4490,26 @@ func (m *OrdersHistoryRepositoryMock) MinimockFinish() {
 		if !m.minimockDone() {
 			m.MinimockAddOrderStatusInspect()
 
+			m.MinimockAddRemindersInspect()
+
+			m.MinimockExpireOrdersInspect()
+
 			m.MinimockGetOrderOnlyStatusInspect()
 
 			m.MinimockGetOrderStatusInspect()
 
+			m.MinimockGetOrdersCountByStatusInspect()
+
+			m.MinimockGetOrdersToRemindInspect()
+
+			m.MinimockGetPendingRemindersInspect()
+
+			m.MinimockMarkRemindersSentInspect()
+
+			m.MinimockRestoreOrderStatusInspect()
+
+			m.MinimockSearchOrdersInspect()
+
 			m.MinimockSetOrderStatusInspect()
 		}
 	})
@@ -1477,7 +4535,15 @@ func (m *OrdersHistoryRepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockAddOrderStatusDone() &&
+		m.MinimockAddRemindersDone() &&
+		m.MinimockExpireOrdersDone() &&
 		m.MinimockGetOrderOnlyStatusDone() &&
 		m.MinimockGetOrderStatusDone() &&
+		m.MinimockGetOrdersCountByStatusDone() &&
+		m.MinimockGetOrdersToRemindDone() &&
+		m.MinimockGetPendingRemindersDone() &&
+		m.MinimockMarkRemindersSentDone() &&
+		m.MinimockRestoreOrderStatusDone() &&
+		m.MinimockSearchOrdersDone() &&
 		m.MinimockSetOrderStatusDone()
 }
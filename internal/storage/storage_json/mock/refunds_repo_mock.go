@@ -2,9 +2,10 @@
 
 package mock
 
-//go:generate minimock -i gitlab.ozon.dev/chppppr/homework/internal/storage.RefundsRepository -o refunds_repo_mock.go -n RefundsRepositoryMock -p mock
+//go:generate minimock -i gitlab.ozon.dev/chppppr/homework/internal/storage/storage_json.RefundsRepository -o refunds_repo_mock.go -n RefundsRepositoryMock -p mock
 
 import (
+	"context"
 	"sync"
 	mm_atomic "sync/atomic"
 	mm_time "time"
@@ -13,34 +14,41 @@ import (
 	"gitlab.ozon.dev/chppppr/homework/internal/domain"
 )
 
-// RefundsRepositoryMock implements mm_storage.RefundsRepository
+// RefundsRepositoryMock implements mm_storage_json.RefundsRepository
 type RefundsRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcAddRefund          func(userID uint64, orderID uint64, order *domain.Order) (err error)
+	funcAddRefund          func(ctx context.Context, userID uint64, orderID uint64, order *domain.Order) (err error)
 	funcAddRefundOrigin    string
-	inspectFuncAddRefund   func(userID uint64, orderID uint64, order *domain.Order)
+	inspectFuncAddRefund   func(ctx context.Context, userID uint64, orderID uint64, order *domain.Order)
 	afterAddRefundCounter  uint64
 	beforeAddRefundCounter uint64
 	AddRefundMock          mRefundsRepositoryMockAddRefund
 
-	funcGetRefunds          func(pageID uint64, ordersPerPage uint64) (oa1 []domain.OrderView, err error)
+	funcGetRefunds          func(ctx context.Context, pageID uint64, ordersPerPage uint64) (oa1 []domain.OrderView, err error)
 	funcGetRefundsOrigin    string
-	inspectFuncGetRefunds   func(pageID uint64, ordersPerPage uint64)
+	inspectFuncGetRefunds   func(ctx context.Context, pageID uint64, ordersPerPage uint64)
 	afterGetRefundsCounter  uint64
 	beforeGetRefundsCounter uint64
 	GetRefundsMock          mRefundsRepositoryMockGetRefunds
 
-	funcRemoveRefund          func(orderID uint64) (err error)
+	funcHasRefund          func(orderID uint64) (b1 bool)
+	funcHasRefundOrigin    string
+	inspectFuncHasRefund   func(orderID uint64)
+	afterHasRefundCounter  uint64
+	beforeHasRefundCounter uint64
+	HasRefundMock          mRefundsRepositoryMockHasRefund
+
+	funcRemoveRefund          func(ctx context.Context, orderID uint64) (err error)
 	funcRemoveRefundOrigin    string
-	inspectFuncRemoveRefund   func(orderID uint64)
+	inspectFuncRemoveRefund   func(ctx context.Context, orderID uint64)
 	afterRemoveRefundCounter  uint64
 	beforeRemoveRefundCounter uint64
 	RemoveRefundMock          mRefundsRepositoryMockRemoveRefund
 }
 
-// NewRefundsRepositoryMock returns a mock for mm_storage.RefundsRepository
+// NewRefundsRepositoryMock returns a mock for mm_storage_json.RefundsRepository
 func NewRefundsRepositoryMock(t minimock.Tester) *RefundsRepositoryMock {
 	m := &RefundsRepositoryMock{t: t}
 
@@ -54,6 +62,9 @@ func NewRefundsRepositoryMock(t minimock.Tester) *RefundsRepositoryMock {
 	m.GetRefundsMock = mRefundsRepositoryMockGetRefunds{mock: m}
 	m.GetRefundsMock.callArgs = []*RefundsRepositoryMockGetRefundsParams{}
 
+	m.HasRefundMock = mRefundsRepositoryMockHasRefund{mock: m}
+	m.HasRefundMock.callArgs = []*RefundsRepositoryMockHasRefundParams{}
+
 	m.RemoveRefundMock = mRefundsRepositoryMockRemoveRefund{mock: m}
 	m.RemoveRefundMock.callArgs = []*RefundsRepositoryMockRemoveRefundParams{}
 
@@ -88,6 +99,7 @@ type RefundsRepositoryMockAddRefundExpectation struct {
 
 // RefundsRepositoryMockAddRefundParams contains parameters of the RefundsRepository.AddRefund
 type RefundsRepositoryMockAddRefundParams struct {
+	ctx     context.Context
 	userID  uint64
 	orderID uint64
 	order   *domain.Order
@@ -95,6 +107,7 @@ type RefundsRepositoryMockAddRefundParams struct {
 
 // RefundsRepositoryMockAddRefundParamPtrs contains pointers to parameters of the RefundsRepository.AddRefund
 type RefundsRepositoryMockAddRefundParamPtrs struct {
+	ctx     *context.Context
 	userID  *uint64
 	orderID *uint64
 	order   **domain.Order
@@ -108,6 +121,7 @@ type RefundsRepositoryMockAddRefundResults struct {
 // RefundsRepositoryMockAddRefundOrigins contains origins of expectations of the RefundsRepository.AddRefund
 type RefundsRepositoryMockAddRefundExpectationOrigins struct {
 	origin        string
+	originCtx     string
 	originUserID  string
 	originOrderID string
 	originOrder   string
@@ -124,7 +138,7 @@ func (mmAddRefund *mRefundsRepositoryMockAddRefund) Optional() *mRefundsReposito
 }
 
 // Expect sets up expected params for RefundsRepository.AddRefund
-func (mmAddRefund *mRefundsRepositoryMockAddRefund) Expect(userID uint64, orderID uint64, order *domain.Order) *mRefundsRepositoryMockAddRefund {
+func (mmAddRefund *mRefundsRepositoryMockAddRefund) Expect(ctx context.Context, userID uint64, orderID uint64, order *domain.Order) *mRefundsRepositoryMockAddRefund {
 	if mmAddRefund.mock.funcAddRefund != nil {
 		mmAddRefund.mock.t.Fatalf("RefundsRepositoryMock.AddRefund mock is already set by Set")
 	}
@@ -137,7 +151,7 @@ func (mmAddRefund *mRefundsRepositoryMockAddRefund) Expect(userID uint64, orderI
 		mmAddRefund.mock.t.Fatalf("RefundsRepositoryMock.AddRefund mock is already set by ExpectParams functions")
 	}
 
-	mmAddRefund.defaultExpectation.params = &RefundsRepositoryMockAddRefundParams{userID, orderID, order}
+	mmAddRefund.defaultExpectation.params = &RefundsRepositoryMockAddRefundParams{ctx, userID, orderID, order}
 	mmAddRefund.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmAddRefund.expectations {
 		if minimock.Equal(e.params, mmAddRefund.defaultExpectation.params) {
@@ -148,8 +162,31 @@ func (mmAddRefund *mRefundsRepositoryMockAddRefund) Expect(userID uint64, orderI
 	return mmAddRefund
 }
 
-// ExpectUserIDParam1 sets up expected param userID for RefundsRepository.AddRefund
-func (mmAddRefund *mRefundsRepositoryMockAddRefund) ExpectUserIDParam1(userID uint64) *mRefundsRepositoryMockAddRefund {
+// ExpectCtxParam1 sets up expected param ctx for RefundsRepository.AddRefund
+func (mmAddRefund *mRefundsRepositoryMockAddRefund) ExpectCtxParam1(ctx context.Context) *mRefundsRepositoryMockAddRefund {
+	if mmAddRefund.mock.funcAddRefund != nil {
+		mmAddRefund.mock.t.Fatalf("RefundsRepositoryMock.AddRefund mock is already set by Set")
+	}
+
+	if mmAddRefund.defaultExpectation == nil {
+		mmAddRefund.defaultExpectation = &RefundsRepositoryMockAddRefundExpectation{}
+	}
+
+	if mmAddRefund.defaultExpectation.params != nil {
+		mmAddRefund.mock.t.Fatalf("RefundsRepositoryMock.AddRefund mock is already set by Expect")
+	}
+
+	if mmAddRefund.defaultExpectation.paramPtrs == nil {
+		mmAddRefund.defaultExpectation.paramPtrs = &RefundsRepositoryMockAddRefundParamPtrs{}
+	}
+	mmAddRefund.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddRefund.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddRefund
+}
+
+// ExpectUserIDParam2 sets up expected param userID for RefundsRepository.AddRefund
+func (mmAddRefund *mRefundsRepositoryMockAddRefund) ExpectUserIDParam2(userID uint64) *mRefundsRepositoryMockAddRefund {
 	if mmAddRefund.mock.funcAddRefund != nil {
 		mmAddRefund.mock.t.Fatalf("RefundsRepositoryMock.AddRefund mock is already set by Set")
 	}
@@ -171,8 +208,8 @@ func (mmAddRefund *mRefundsRepositoryMockAddRefund) ExpectUserIDParam1(userID ui
 	return mmAddRefund
 }
 
-// ExpectOrderIDParam2 sets up expected param orderID for RefundsRepository.AddRefund
-func (mmAddRefund *mRefundsRepositoryMockAddRefund) ExpectOrderIDParam2(orderID uint64) *mRefundsRepositoryMockAddRefund {
+// ExpectOrderIDParam3 sets up expected param orderID for RefundsRepository.AddRefund
+func (mmAddRefund *mRefundsRepositoryMockAddRefund) ExpectOrderIDParam3(orderID uint64) *mRefundsRepositoryMockAddRefund {
 	if mmAddRefund.mock.funcAddRefund != nil {
 		mmAddRefund.mock.t.Fatalf("RefundsRepositoryMock.AddRefund mock is already set by Set")
 	}
@@ -194,8 +231,8 @@ func (mmAddRefund *mRefundsRepositoryMockAddRefund) ExpectOrderIDParam2(orderID
 	return mmAddRefund
 }
 
-// ExpectOrderParam3 sets up expected param order for RefundsRepository.AddRefund
-func (mmAddRefund *mRefundsRepositoryMockAddRefund) ExpectOrderParam3(order *domain.Order) *mRefundsRepositoryMockAddRefund {
+// ExpectOrderParam4 sets up expected param order for RefundsRepository.AddRefund
+func (mmAddRefund *mRefundsRepositoryMockAddRefund) ExpectOrderParam4(order *domain.Order) *mRefundsRepositoryMockAddRefund {
 	if mmAddRefund.mock.funcAddRefund != nil {
 		mmAddRefund.mock.t.Fatalf("RefundsRepositoryMock.AddRefund mock is already set by Set")
 	}
@@ -218,7 +255,7 @@ func (mmAddRefund *mRefundsRepositoryMockAddRefund) ExpectOrderParam3(order *dom
 }
 
 // Inspect accepts an inspector function that has same arguments as the RefundsRepository.AddRefund
-func (mmAddRefund *mRefundsRepositoryMockAddRefund) Inspect(f func(userID uint64, orderID uint64, order *domain.Order)) *mRefundsRepositoryMockAddRefund {
+func (mmAddRefund *mRefundsRepositoryMockAddRefund) Inspect(f func(ctx context.Context, userID uint64, orderID uint64, order *domain.Order)) *mRefundsRepositoryMockAddRefund {
 	if mmAddRefund.mock.inspectFuncAddRefund != nil {
 		mmAddRefund.mock.t.Fatalf("Inspect function is already set for RefundsRepositoryMock.AddRefund")
 	}
@@ -243,7 +280,7 @@ func (mmAddRefund *mRefundsRepositoryMockAddRefund) Return(err error) *RefundsRe
 }
 
 // Set uses given function f to mock the RefundsRepository.AddRefund method
-func (mmAddRefund *mRefundsRepositoryMockAddRefund) Set(f func(userID uint64, orderID uint64, order *domain.Order) (err error)) *RefundsRepositoryMock {
+func (mmAddRefund *mRefundsRepositoryMockAddRefund) Set(f func(ctx context.Context, userID uint64, orderID uint64, order *domain.Order) (err error)) *RefundsRepositoryMock {
 	if mmAddRefund.defaultExpectation != nil {
 		mmAddRefund.mock.t.Fatalf("Default expectation is already set for the RefundsRepository.AddRefund method")
 	}
@@ -259,14 +296,14 @@ func (mmAddRefund *mRefundsRepositoryMockAddRefund) Set(f func(userID uint64, or
 
 // When sets expectation for the RefundsRepository.AddRefund which will trigger the result defined by the following
 // Then helper
-func (mmAddRefund *mRefundsRepositoryMockAddRefund) When(userID uint64, orderID uint64, order *domain.Order) *RefundsRepositoryMockAddRefundExpectation {
+func (mmAddRefund *mRefundsRepositoryMockAddRefund) When(ctx context.Context, userID uint64, orderID uint64, order *domain.Order) *RefundsRepositoryMockAddRefundExpectation {
 	if mmAddRefund.mock.funcAddRefund != nil {
 		mmAddRefund.mock.t.Fatalf("RefundsRepositoryMock.AddRefund mock is already set by Set")
 	}
 
 	expectation := &RefundsRepositoryMockAddRefundExpectation{
 		mock:               mmAddRefund.mock,
-		params:             &RefundsRepositoryMockAddRefundParams{userID, orderID, order},
+		params:             &RefundsRepositoryMockAddRefundParams{ctx, userID, orderID, order},
 		expectationOrigins: RefundsRepositoryMockAddRefundExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmAddRefund.expectations = append(mmAddRefund.expectations, expectation)
@@ -300,18 +337,18 @@ func (mmAddRefund *mRefundsRepositoryMockAddRefund) invocationsDone() bool {
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// AddRefund implements mm_storage.RefundsRepository
-func (mmAddRefund *RefundsRepositoryMock) AddRefund(userID uint64, orderID uint64, order *domain.Order) (err error) {
+// AddRefund implements mm_storage_json.RefundsRepository
+func (mmAddRefund *RefundsRepositoryMock) AddRefund(ctx context.Context, userID uint64, orderID uint64, order *domain.Order) (err error) {
 	mm_atomic.AddUint64(&mmAddRefund.beforeAddRefundCounter, 1)
 	defer mm_atomic.AddUint64(&mmAddRefund.afterAddRefundCounter, 1)
 
 	mmAddRefund.t.Helper()
 
 	if mmAddRefund.inspectFuncAddRefund != nil {
-		mmAddRefund.inspectFuncAddRefund(userID, orderID, order)
+		mmAddRefund.inspectFuncAddRefund(ctx, userID, orderID, order)
 	}
 
-	mm_params := RefundsRepositoryMockAddRefundParams{userID, orderID, order}
+	mm_params := RefundsRepositoryMockAddRefundParams{ctx, userID, orderID, order}
 
 	// Record call args
 	mmAddRefund.AddRefundMock.mutex.Lock()
@@ -330,10 +367,15 @@ func (mmAddRefund *RefundsRepositoryMock) AddRefund(userID uint64, orderID uint6
 		mm_want := mmAddRefund.AddRefundMock.defaultExpectation.params
 		mm_want_ptrs := mmAddRefund.AddRefundMock.defaultExpectation.paramPtrs
 
-		mm_got := RefundsRepositoryMockAddRefundParams{userID, orderID, order}
+		mm_got := RefundsRepositoryMockAddRefundParams{ctx, userID, orderID, order}
 
 		if mm_want_ptrs != nil {
 
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddRefund.t.Errorf("RefundsRepositoryMock.AddRefund got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddRefund.AddRefundMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
 			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
 				mmAddRefund.t.Errorf("RefundsRepositoryMock.AddRefund got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 					mmAddRefund.AddRefundMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
@@ -361,9 +403,9 @@ func (mmAddRefund *RefundsRepositoryMock) AddRefund(userID uint64, orderID uint6
 		return (*mm_results).err
 	}
 	if mmAddRefund.funcAddRefund != nil {
-		return mmAddRefund.funcAddRefund(userID, orderID, order)
+		return mmAddRefund.funcAddRefund(ctx, userID, orderID, order)
 	}
-	mmAddRefund.t.Fatalf("Unexpected call to RefundsRepositoryMock.AddRefund. %v %v %v", userID, orderID, order)
+	mmAddRefund.t.Fatalf("Unexpected call to RefundsRepositoryMock.AddRefund. %v %v %v %v", ctx, userID, orderID, order)
 	return
 }
 
@@ -461,12 +503,14 @@ type RefundsRepositoryMockGetRefundsExpectation struct {
 
 // RefundsRepositoryMockGetRefundsParams contains parameters of the RefundsRepository.GetRefunds
 type RefundsRepositoryMockGetRefundsParams struct {
+	ctx           context.Context
 	pageID        uint64
 	ordersPerPage uint64
 }
 
 // RefundsRepositoryMockGetRefundsParamPtrs contains pointers to parameters of the RefundsRepository.GetRefunds
 type RefundsRepositoryMockGetRefundsParamPtrs struct {
+	ctx           *context.Context
 	pageID        *uint64
 	ordersPerPage *uint64
 }
@@ -480,6 +524,7 @@ type RefundsRepositoryMockGetRefundsResults struct {
 // RefundsRepositoryMockGetRefundsOrigins contains origins of expectations of the RefundsRepository.GetRefunds
 type RefundsRepositoryMockGetRefundsExpectationOrigins struct {
 	origin              string
+	originCtx           string
 	originPageID        string
 	originOrdersPerPage string
 }
@@ -495,7 +540,7 @@ func (mmGetRefunds *mRefundsRepositoryMockGetRefunds) Optional() *mRefundsReposi
 }
 
 // Expect sets up expected params for RefundsRepository.GetRefunds
-func (mmGetRefunds *mRefundsRepositoryMockGetRefunds) Expect(pageID uint64, ordersPerPage uint64) *mRefundsRepositoryMockGetRefunds {
+func (mmGetRefunds *mRefundsRepositoryMockGetRefunds) Expect(ctx context.Context, pageID uint64, ordersPerPage uint64) *mRefundsRepositoryMockGetRefunds {
 	if mmGetRefunds.mock.funcGetRefunds != nil {
 		mmGetRefunds.mock.t.Fatalf("RefundsRepositoryMock.GetRefunds mock is already set by Set")
 	}
@@ -508,7 +553,7 @@ func (mmGetRefunds *mRefundsRepositoryMockGetRefunds) Expect(pageID uint64, orde
 		mmGetRefunds.mock.t.Fatalf("RefundsRepositoryMock.GetRefunds mock is already set by ExpectParams functions")
 	}
 
-	mmGetRefunds.defaultExpectation.params = &RefundsRepositoryMockGetRefundsParams{pageID, ordersPerPage}
+	mmGetRefunds.defaultExpectation.params = &RefundsRepositoryMockGetRefundsParams{ctx, pageID, ordersPerPage}
 	mmGetRefunds.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmGetRefunds.expectations {
 		if minimock.Equal(e.params, mmGetRefunds.defaultExpectation.params) {
@@ -519,8 +564,31 @@ func (mmGetRefunds *mRefundsRepositoryMockGetRefunds) Expect(pageID uint64, orde
 	return mmGetRefunds
 }
 
-// ExpectPageIDParam1 sets up expected param pageID for RefundsRepository.GetRefunds
-func (mmGetRefunds *mRefundsRepositoryMockGetRefunds) ExpectPageIDParam1(pageID uint64) *mRefundsRepositoryMockGetRefunds {
+// ExpectCtxParam1 sets up expected param ctx for RefundsRepository.GetRefunds
+func (mmGetRefunds *mRefundsRepositoryMockGetRefunds) ExpectCtxParam1(ctx context.Context) *mRefundsRepositoryMockGetRefunds {
+	if mmGetRefunds.mock.funcGetRefunds != nil {
+		mmGetRefunds.mock.t.Fatalf("RefundsRepositoryMock.GetRefunds mock is already set by Set")
+	}
+
+	if mmGetRefunds.defaultExpectation == nil {
+		mmGetRefunds.defaultExpectation = &RefundsRepositoryMockGetRefundsExpectation{}
+	}
+
+	if mmGetRefunds.defaultExpectation.params != nil {
+		mmGetRefunds.mock.t.Fatalf("RefundsRepositoryMock.GetRefunds mock is already set by Expect")
+	}
+
+	if mmGetRefunds.defaultExpectation.paramPtrs == nil {
+		mmGetRefunds.defaultExpectation.paramPtrs = &RefundsRepositoryMockGetRefundsParamPtrs{}
+	}
+	mmGetRefunds.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetRefunds.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetRefunds
+}
+
+// ExpectPageIDParam2 sets up expected param pageID for RefundsRepository.GetRefunds
+func (mmGetRefunds *mRefundsRepositoryMockGetRefunds) ExpectPageIDParam2(pageID uint64) *mRefundsRepositoryMockGetRefunds {
 	if mmGetRefunds.mock.funcGetRefunds != nil {
 		mmGetRefunds.mock.t.Fatalf("RefundsRepositoryMock.GetRefunds mock is already set by Set")
 	}
@@ -542,8 +610,8 @@ func (mmGetRefunds *mRefundsRepositoryMockGetRefunds) ExpectPageIDParam1(pageID
 	return mmGetRefunds
 }
 
-// ExpectOrdersPerPageParam2 sets up expected param ordersPerPage for RefundsRepository.GetRefunds
-func (mmGetRefunds *mRefundsRepositoryMockGetRefunds) ExpectOrdersPerPageParam2(ordersPerPage uint64) *mRefundsRepositoryMockGetRefunds {
+// ExpectOrdersPerPageParam3 sets up expected param ordersPerPage for RefundsRepository.GetRefunds
+func (mmGetRefunds *mRefundsRepositoryMockGetRefunds) ExpectOrdersPerPageParam3(ordersPerPage uint64) *mRefundsRepositoryMockGetRefunds {
 	if mmGetRefunds.mock.funcGetRefunds != nil {
 		mmGetRefunds.mock.t.Fatalf("RefundsRepositoryMock.GetRefunds mock is already set by Set")
 	}
@@ -566,7 +634,7 @@ func (mmGetRefunds *mRefundsRepositoryMockGetRefunds) ExpectOrdersPerPageParam2(
 }
 
 // Inspect accepts an inspector function that has same arguments as the RefundsRepository.GetRefunds
-func (mmGetRefunds *mRefundsRepositoryMockGetRefunds) Inspect(f func(pageID uint64, ordersPerPage uint64)) *mRefundsRepositoryMockGetRefunds {
+func (mmGetRefunds *mRefundsRepositoryMockGetRefunds) Inspect(f func(ctx context.Context, pageID uint64, ordersPerPage uint64)) *mRefundsRepositoryMockGetRefunds {
 	if mmGetRefunds.mock.inspectFuncGetRefunds != nil {
 		mmGetRefunds.mock.t.Fatalf("Inspect function is already set for RefundsRepositoryMock.GetRefunds")
 	}
@@ -591,7 +659,7 @@ func (mmGetRefunds *mRefundsRepositoryMockGetRefunds) Return(oa1 []domain.OrderV
 }
 
 // Set uses given function f to mock the RefundsRepository.GetRefunds method
-func (mmGetRefunds *mRefundsRepositoryMockGetRefunds) Set(f func(pageID uint64, ordersPerPage uint64) (oa1 []domain.OrderView, err error)) *RefundsRepositoryMock {
+func (mmGetRefunds *mRefundsRepositoryMockGetRefunds) Set(f func(ctx context.Context, pageID uint64, ordersPerPage uint64) (oa1 []domain.OrderView, err error)) *RefundsRepositoryMock {
 	if mmGetRefunds.defaultExpectation != nil {
 		mmGetRefunds.mock.t.Fatalf("Default expectation is already set for the RefundsRepository.GetRefunds method")
 	}
@@ -607,14 +675,14 @@ func (mmGetRefunds *mRefundsRepositoryMockGetRefunds) Set(f func(pageID uint64,
 
 // When sets expectation for the RefundsRepository.GetRefunds which will trigger the result defined by the following
 // Then helper
-func (mmGetRefunds *mRefundsRepositoryMockGetRefunds) When(pageID uint64, ordersPerPage uint64) *RefundsRepositoryMockGetRefundsExpectation {
+func (mmGetRefunds *mRefundsRepositoryMockGetRefunds) When(ctx context.Context, pageID uint64, ordersPerPage uint64) *RefundsRepositoryMockGetRefundsExpectation {
 	if mmGetRefunds.mock.funcGetRefunds != nil {
 		mmGetRefunds.mock.t.Fatalf("RefundsRepositoryMock.GetRefunds mock is already set by Set")
 	}
 
 	expectation := &RefundsRepositoryMockGetRefundsExpectation{
 		mock:               mmGetRefunds.mock,
-		params:             &RefundsRepositoryMockGetRefundsParams{pageID, ordersPerPage},
+		params:             &RefundsRepositoryMockGetRefundsParams{ctx, pageID, ordersPerPage},
 		expectationOrigins: RefundsRepositoryMockGetRefundsExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmGetRefunds.expectations = append(mmGetRefunds.expectations, expectation)
@@ -648,18 +716,18 @@ func (mmGetRefunds *mRefundsRepositoryMockGetRefunds) invocationsDone() bool {
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetRefunds implements mm_storage.RefundsRepository
-func (mmGetRefunds *RefundsRepositoryMock) GetRefunds(pageID uint64, ordersPerPage uint64) (oa1 []domain.OrderView, err error) {
+// GetRefunds implements mm_storage_json.RefundsRepository
+func (mmGetRefunds *RefundsRepositoryMock) GetRefunds(ctx context.Context, pageID uint64, ordersPerPage uint64) (oa1 []domain.OrderView, err error) {
 	mm_atomic.AddUint64(&mmGetRefunds.beforeGetRefundsCounter, 1)
 	defer mm_atomic.AddUint64(&mmGetRefunds.afterGetRefundsCounter, 1)
 
 	mmGetRefunds.t.Helper()
 
 	if mmGetRefunds.inspectFuncGetRefunds != nil {
-		mmGetRefunds.inspectFuncGetRefunds(pageID, ordersPerPage)
+		mmGetRefunds.inspectFuncGetRefunds(ctx, pageID, ordersPerPage)
 	}
 
-	mm_params := RefundsRepositoryMockGetRefundsParams{pageID, ordersPerPage}
+	mm_params := RefundsRepositoryMockGetRefundsParams{ctx, pageID, ordersPerPage}
 
 	// Record call args
 	mmGetRefunds.GetRefundsMock.mutex.Lock()
@@ -678,10 +746,15 @@ func (mmGetRefunds *RefundsRepositoryMock) GetRefunds(pageID uint64, ordersPerPa
 		mm_want := mmGetRefunds.GetRefundsMock.defaultExpectation.params
 		mm_want_ptrs := mmGetRefunds.GetRefundsMock.defaultExpectation.paramPtrs
 
-		mm_got := RefundsRepositoryMockGetRefundsParams{pageID, ordersPerPage}
+		mm_got := RefundsRepositoryMockGetRefundsParams{ctx, pageID, ordersPerPage}
 
 		if mm_want_ptrs != nil {
 
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetRefunds.t.Errorf("RefundsRepositoryMock.GetRefunds got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetRefunds.GetRefundsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
 			if mm_want_ptrs.pageID != nil && !minimock.Equal(*mm_want_ptrs.pageID, mm_got.pageID) {
 				mmGetRefunds.t.Errorf("RefundsRepositoryMock.GetRefunds got unexpected parameter pageID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 					mmGetRefunds.GetRefundsMock.defaultExpectation.expectationOrigins.originPageID, *mm_want_ptrs.pageID, mm_got.pageID, minimock.Diff(*mm_want_ptrs.pageID, mm_got.pageID))
@@ -704,9 +777,9 @@ func (mmGetRefunds *RefundsRepositoryMock) GetRefunds(pageID uint64, ordersPerPa
 		return (*mm_results).oa1, (*mm_results).err
 	}
 	if mmGetRefunds.funcGetRefunds != nil {
-		return mmGetRefunds.funcGetRefunds(pageID, ordersPerPage)
+		return mmGetRefunds.funcGetRefunds(ctx, pageID, ordersPerPage)
 	}
-	mmGetRefunds.t.Fatalf("Unexpected call to RefundsRepositoryMock.GetRefunds. %v %v", pageID, ordersPerPage)
+	mmGetRefunds.t.Fatalf("Unexpected call to RefundsRepositoryMock.GetRefunds. %v %v %v", ctx, pageID, ordersPerPage)
 	return
 }
 
@@ -778,6 +851,317 @@ func (m *RefundsRepositoryMock) MinimockGetRefundsInspect() {
 	}
 }
 
+type mRefundsRepositoryMockHasRefund struct {
+	optional           bool
+	mock               *RefundsRepositoryMock
+	defaultExpectation *RefundsRepositoryMockHasRefundExpectation
+	expectations       []*RefundsRepositoryMockHasRefundExpectation
+
+	callArgs []*RefundsRepositoryMockHasRefundParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RefundsRepositoryMockHasRefundExpectation specifies expectation struct of the RefundsRepository.HasRefund
+type RefundsRepositoryMockHasRefundExpectation struct {
+	mock               *RefundsRepositoryMock
+	params             *RefundsRepositoryMockHasRefundParams
+	paramPtrs          *RefundsRepositoryMockHasRefundParamPtrs
+	expectationOrigins RefundsRepositoryMockHasRefundExpectationOrigins
+	results            *RefundsRepositoryMockHasRefundResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RefundsRepositoryMockHasRefundParams contains parameters of the RefundsRepository.HasRefund
+type RefundsRepositoryMockHasRefundParams struct {
+	orderID uint64
+}
+
+// RefundsRepositoryMockHasRefundParamPtrs contains pointers to parameters of the RefundsRepository.HasRefund
+type RefundsRepositoryMockHasRefundParamPtrs struct {
+	orderID *uint64
+}
+
+// RefundsRepositoryMockHasRefundResults contains results of the RefundsRepository.HasRefund
+type RefundsRepositoryMockHasRefundResults struct {
+	b1 bool
+}
+
+// RefundsRepositoryMockHasRefundOrigins contains origins of expectations of the RefundsRepository.HasRefund
+type RefundsRepositoryMockHasRefundExpectationOrigins struct {
+	origin        string
+	originOrderID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmHasRefund *mRefundsRepositoryMockHasRefund) Optional() *mRefundsRepositoryMockHasRefund {
+	mmHasRefund.optional = true
+	return mmHasRefund
+}
+
+// Expect sets up expected params for RefundsRepository.HasRefund
+func (mmHasRefund *mRefundsRepositoryMockHasRefund) Expect(orderID uint64) *mRefundsRepositoryMockHasRefund {
+	if mmHasRefund.mock.funcHasRefund != nil {
+		mmHasRefund.mock.t.Fatalf("RefundsRepositoryMock.HasRefund mock is already set by Set")
+	}
+
+	if mmHasRefund.defaultExpectation == nil {
+		mmHasRefund.defaultExpectation = &RefundsRepositoryMockHasRefundExpectation{}
+	}
+
+	if mmHasRefund.defaultExpectation.paramPtrs != nil {
+		mmHasRefund.mock.t.Fatalf("RefundsRepositoryMock.HasRefund mock is already set by ExpectParams functions")
+	}
+
+	mmHasRefund.defaultExpectation.params = &RefundsRepositoryMockHasRefundParams{orderID}
+	mmHasRefund.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmHasRefund.expectations {
+		if minimock.Equal(e.params, mmHasRefund.defaultExpectation.params) {
+			mmHasRefund.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmHasRefund.defaultExpectation.params)
+		}
+	}
+
+	return mmHasRefund
+}
+
+// ExpectOrderIDParam1 sets up expected param orderID for RefundsRepository.HasRefund
+func (mmHasRefund *mRefundsRepositoryMockHasRefund) ExpectOrderIDParam1(orderID uint64) *mRefundsRepositoryMockHasRefund {
+	if mmHasRefund.mock.funcHasRefund != nil {
+		mmHasRefund.mock.t.Fatalf("RefundsRepositoryMock.HasRefund mock is already set by Set")
+	}
+
+	if mmHasRefund.defaultExpectation == nil {
+		mmHasRefund.defaultExpectation = &RefundsRepositoryMockHasRefundExpectation{}
+	}
+
+	if mmHasRefund.defaultExpectation.params != nil {
+		mmHasRefund.mock.t.Fatalf("RefundsRepositoryMock.HasRefund mock is already set by Expect")
+	}
+
+	if mmHasRefund.defaultExpectation.paramPtrs == nil {
+		mmHasRefund.defaultExpectation.paramPtrs = &RefundsRepositoryMockHasRefundParamPtrs{}
+	}
+	mmHasRefund.defaultExpectation.paramPtrs.orderID = &orderID
+	mmHasRefund.defaultExpectation.expectationOrigins.originOrderID = minimock.CallerInfo(1)
+
+	return mmHasRefund
+}
+
+// Inspect accepts an inspector function that has same arguments as the RefundsRepository.HasRefund
+func (mmHasRefund *mRefundsRepositoryMockHasRefund) Inspect(f func(orderID uint64)) *mRefundsRepositoryMockHasRefund {
+	if mmHasRefund.mock.inspectFuncHasRefund != nil {
+		mmHasRefund.mock.t.Fatalf("Inspect function is already set for RefundsRepositoryMock.HasRefund")
+	}
+
+	mmHasRefund.mock.inspectFuncHasRefund = f
+
+	return mmHasRefund
+}
+
+// Return sets up results that will be returned by RefundsRepository.HasRefund
+func (mmHasRefund *mRefundsRepositoryMockHasRefund) Return(b1 bool) *RefundsRepositoryMock {
+	if mmHasRefund.mock.funcHasRefund != nil {
+		mmHasRefund.mock.t.Fatalf("RefundsRepositoryMock.HasRefund mock is already set by Set")
+	}
+
+	if mmHasRefund.defaultExpectation == nil {
+		mmHasRefund.defaultExpectation = &RefundsRepositoryMockHasRefundExpectation{mock: mmHasRefund.mock}
+	}
+	mmHasRefund.defaultExpectation.results = &RefundsRepositoryMockHasRefundResults{b1}
+	mmHasRefund.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmHasRefund.mock
+}
+
+// Set uses given function f to mock the RefundsRepository.HasRefund method
+func (mmHasRefund *mRefundsRepositoryMockHasRefund) Set(f func(orderID uint64) (b1 bool)) *RefundsRepositoryMock {
+	if mmHasRefund.defaultExpectation != nil {
+		mmHasRefund.mock.t.Fatalf("Default expectation is already set for the RefundsRepository.HasRefund method")
+	}
+
+	if len(mmHasRefund.expectations) > 0 {
+		mmHasRefund.mock.t.Fatalf("Some expectations are already set for the RefundsRepository.HasRefund method")
+	}
+
+	mmHasRefund.mock.funcHasRefund = f
+	mmHasRefund.mock.funcHasRefundOrigin = minimock.CallerInfo(1)
+	return mmHasRefund.mock
+}
+
+// When sets expectation for the RefundsRepository.HasRefund which will trigger the result defined by the following
+// Then helper
+func (mmHasRefund *mRefundsRepositoryMockHasRefund) When(orderID uint64) *RefundsRepositoryMockHasRefundExpectation {
+	if mmHasRefund.mock.funcHasRefund != nil {
+		mmHasRefund.mock.t.Fatalf("RefundsRepositoryMock.HasRefund mock is already set by Set")
+	}
+
+	expectation := &RefundsRepositoryMockHasRefundExpectation{
+		mock:               mmHasRefund.mock,
+		params:             &RefundsRepositoryMockHasRefundParams{orderID},
+		expectationOrigins: RefundsRepositoryMockHasRefundExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmHasRefund.expectations = append(mmHasRefund.expectations, expectation)
+	return expectation
+}
+
+// Then sets up RefundsRepository.HasRefund return parameters for the expectation previously defined by the When method
+func (e *RefundsRepositoryMockHasRefundExpectation) Then(b1 bool) *RefundsRepositoryMock {
+	e.results = &RefundsRepositoryMockHasRefundResults{b1}
+	return e.mock
+}
+
+// Times sets number of times RefundsRepository.HasRefund should be invoked
+func (mmHasRefund *mRefundsRepositoryMockHasRefund) Times(n uint64) *mRefundsRepositoryMockHasRefund {
+	if n == 0 {
+		mmHasRefund.mock.t.Fatalf("Times of RefundsRepositoryMock.HasRefund mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmHasRefund.expectedInvocations, n)
+	mmHasRefund.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmHasRefund
+}
+
+func (mmHasRefund *mRefundsRepositoryMockHasRefund) invocationsDone() bool {
+	if len(mmHasRefund.expectations) == 0 && mmHasRefund.defaultExpectation == nil && mmHasRefund.mock.funcHasRefund == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmHasRefund.mock.afterHasRefundCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmHasRefund.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// HasRefund implements mm_storage_json.RefundsRepository
+func (mmHasRefund *RefundsRepositoryMock) HasRefund(orderID uint64) (b1 bool) {
+	mm_atomic.AddUint64(&mmHasRefund.beforeHasRefundCounter, 1)
+	defer mm_atomic.AddUint64(&mmHasRefund.afterHasRefundCounter, 1)
+
+	mmHasRefund.t.Helper()
+
+	if mmHasRefund.inspectFuncHasRefund != nil {
+		mmHasRefund.inspectFuncHasRefund(orderID)
+	}
+
+	mm_params := RefundsRepositoryMockHasRefundParams{orderID}
+
+	// Record call args
+	mmHasRefund.HasRefundMock.mutex.Lock()
+	mmHasRefund.HasRefundMock.callArgs = append(mmHasRefund.HasRefundMock.callArgs, &mm_params)
+	mmHasRefund.HasRefundMock.mutex.Unlock()
+
+	for _, e := range mmHasRefund.HasRefundMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.b1
+		}
+	}
+
+	if mmHasRefund.HasRefundMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmHasRefund.HasRefundMock.defaultExpectation.Counter, 1)
+		mm_want := mmHasRefund.HasRefundMock.defaultExpectation.params
+		mm_want_ptrs := mmHasRefund.HasRefundMock.defaultExpectation.paramPtrs
+
+		mm_got := RefundsRepositoryMockHasRefundParams{orderID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmHasRefund.t.Errorf("RefundsRepositoryMock.HasRefund got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmHasRefund.HasRefundMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmHasRefund.t.Errorf("RefundsRepositoryMock.HasRefund got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmHasRefund.HasRefundMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmHasRefund.HasRefundMock.defaultExpectation.results
+		if mm_results == nil {
+			mmHasRefund.t.Fatal("No results are set for the RefundsRepositoryMock.HasRefund")
+		}
+		return (*mm_results).b1
+	}
+	if mmHasRefund.funcHasRefund != nil {
+		return mmHasRefund.funcHasRefund(orderID)
+	}
+	mmHasRefund.t.Fatalf("Unexpected call to RefundsRepositoryMock.HasRefund. %v", orderID)
+	return
+}
+
+// HasRefundAfterCounter returns a count of finished RefundsRepositoryMock.HasRefund invocations
+func (mmHasRefund *RefundsRepositoryMock) HasRefundAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHasRefund.afterHasRefundCounter)
+}
+
+// HasRefundBeforeCounter returns a count of RefundsRepositoryMock.HasRefund invocations
+func (mmHasRefund *RefundsRepositoryMock) HasRefundBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmHasRefund.beforeHasRefundCounter)
+}
+
+// Calls returns a list of arguments used in each call to RefundsRepositoryMock.HasRefund.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmHasRefund *mRefundsRepositoryMockHasRefund) Calls() []*RefundsRepositoryMockHasRefundParams {
+	mmHasRefund.mutex.RLock()
+
+	argCopy := make([]*RefundsRepositoryMockHasRefundParams, len(mmHasRefund.callArgs))
+	copy(argCopy, mmHasRefund.callArgs)
+
+	mmHasRefund.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockHasRefundDone returns true if the count of the HasRefund invocations corresponds
+// the number of defined expectations
+func (m *RefundsRepositoryMock) MinimockHasRefundDone() bool {
+	if m.HasRefundMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.HasRefundMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.HasRefundMock.invocationsDone()
+}
+
+// MinimockHasRefundInspect logs each unmet expectation
+func (m *RefundsRepositoryMock) MinimockHasRefundInspect() {
+	for _, e := range m.HasRefundMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RefundsRepositoryMock.HasRefund at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterHasRefundCounter := mm_atomic.LoadUint64(&m.afterHasRefundCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.HasRefundMock.defaultExpectation != nil && afterHasRefundCounter < 1 {
+		if m.HasRefundMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RefundsRepositoryMock.HasRefund at\n%s", m.HasRefundMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RefundsRepositoryMock.HasRefund at\n%s with params: %#v", m.HasRefundMock.defaultExpectation.expectationOrigins.origin, *m.HasRefundMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcHasRefund != nil && afterHasRefundCounter < 1 {
+		m.t.Errorf("Expected call to RefundsRepositoryMock.HasRefund at\n%s", m.funcHasRefundOrigin)
+	}
+
+	if !m.HasRefundMock.invocationsDone() && afterHasRefundCounter > 0 {
+		m.t.Errorf("Expected %d calls to RefundsRepositoryMock.HasRefund at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.HasRefundMock.expectedInvocations), m.HasRefundMock.expectedInvocationsOrigin, afterHasRefundCounter)
+	}
+}
+
 type mRefundsRepositoryMockRemoveRefund struct {
 	optional           bool
 	mock               *RefundsRepositoryMock
@@ -804,11 +1188,13 @@ type RefundsRepositoryMockRemoveRefundExpectation struct {
 
 // RefundsRepositoryMockRemoveRefundParams contains parameters of the RefundsRepository.RemoveRefund
 type RefundsRepositoryMockRemoveRefundParams struct {
+	ctx     context.Context
 	orderID uint64
 }
 
 // RefundsRepositoryMockRemoveRefundParamPtrs contains pointers to parameters of the RefundsRepository.RemoveRefund
 type RefundsRepositoryMockRemoveRefundParamPtrs struct {
+	ctx     *context.Context
 	orderID *uint64
 }
 
@@ -820,6 +1206,7 @@ type RefundsRepositoryMockRemoveRefundResults struct {
 // RefundsRepositoryMockRemoveRefundOrigins contains origins of expectations of the RefundsRepository.RemoveRefund
 type RefundsRepositoryMockRemoveRefundExpectationOrigins struct {
 	origin        string
+	originCtx     string
 	originOrderID string
 }
 
@@ -834,7 +1221,7 @@ func (mmRemoveRefund *mRefundsRepositoryMockRemoveRefund) Optional() *mRefundsRe
 }
 
 // Expect sets up expected params for RefundsRepository.RemoveRefund
-func (mmRemoveRefund *mRefundsRepositoryMockRemoveRefund) Expect(orderID uint64) *mRefundsRepositoryMockRemoveRefund {
+func (mmRemoveRefund *mRefundsRepositoryMockRemoveRefund) Expect(ctx context.Context, orderID uint64) *mRefundsRepositoryMockRemoveRefund {
 	if mmRemoveRefund.mock.funcRemoveRefund != nil {
 		mmRemoveRefund.mock.t.Fatalf("RefundsRepositoryMock.RemoveRefund mock is already set by Set")
 	}
@@ -847,7 +1234,7 @@ func (mmRemoveRefund *mRefundsRepositoryMockRemoveRefund) Expect(orderID uint64)
 		mmRemoveRefund.mock.t.Fatalf("RefundsRepositoryMock.RemoveRefund mock is already set by ExpectParams functions")
 	}
 
-	mmRemoveRefund.defaultExpectation.params = &RefundsRepositoryMockRemoveRefundParams{orderID}
+	mmRemoveRefund.defaultExpectation.params = &RefundsRepositoryMockRemoveRefundParams{ctx, orderID}
 	mmRemoveRefund.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmRemoveRefund.expectations {
 		if minimock.Equal(e.params, mmRemoveRefund.defaultExpectation.params) {
@@ -858,8 +1245,31 @@ func (mmRemoveRefund *mRefundsRepositoryMockRemoveRefund) Expect(orderID uint64)
 	return mmRemoveRefund
 }
 
-// ExpectOrderIDParam1 sets up expected param orderID for RefundsRepository.RemoveRefund
-func (mmRemoveRefund *mRefundsRepositoryMockRemoveRefund) ExpectOrderIDParam1(orderID uint64) *mRefundsRepositoryMockRemoveRefund {
+// ExpectCtxParam1 sets up expected param ctx for RefundsRepository.RemoveRefund
+func (mmRemoveRefund *mRefundsRepositoryMockRemoveRefund) ExpectCtxParam1(ctx context.Context) *mRefundsRepositoryMockRemoveRefund {
+	if mmRemoveRefund.mock.funcRemoveRefund != nil {
+		mmRemoveRefund.mock.t.Fatalf("RefundsRepositoryMock.RemoveRefund mock is already set by Set")
+	}
+
+	if mmRemoveRefund.defaultExpectation == nil {
+		mmRemoveRefund.defaultExpectation = &RefundsRepositoryMockRemoveRefundExpectation{}
+	}
+
+	if mmRemoveRefund.defaultExpectation.params != nil {
+		mmRemoveRefund.mock.t.Fatalf("RefundsRepositoryMock.RemoveRefund mock is already set by Expect")
+	}
+
+	if mmRemoveRefund.defaultExpectation.paramPtrs == nil {
+		mmRemoveRefund.defaultExpectation.paramPtrs = &RefundsRepositoryMockRemoveRefundParamPtrs{}
+	}
+	mmRemoveRefund.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveRefund.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveRefund
+}
+
+// ExpectOrderIDParam2 sets up expected param orderID for RefundsRepository.RemoveRefund
+func (mmRemoveRefund *mRefundsRepositoryMockRemoveRefund) ExpectOrderIDParam2(orderID uint64) *mRefundsRepositoryMockRemoveRefund {
 	if mmRemoveRefund.mock.funcRemoveRefund != nil {
 		mmRemoveRefund.mock.t.Fatalf("RefundsRepositoryMock.RemoveRefund mock is already set by Set")
 	}
@@ -882,7 +1292,7 @@ func (mmRemoveRefund *mRefundsRepositoryMockRemoveRefund) ExpectOrderIDParam1(or
 }
 
 // Inspect accepts an inspector function that has same arguments as the RefundsRepository.RemoveRefund
-func (mmRemoveRefund *mRefundsRepositoryMockRemoveRefund) Inspect(f func(orderID uint64)) *mRefundsRepositoryMockRemoveRefund {
+func (mmRemoveRefund *mRefundsRepositoryMockRemoveRefund) Inspect(f func(ctx context.Context, orderID uint64)) *mRefundsRepositoryMockRemoveRefund {
 	if mmRemoveRefund.mock.inspectFuncRemoveRefund != nil {
 		mmRemoveRefund.mock.t.Fatalf("Inspect function is already set for RefundsRepositoryMock.RemoveRefund")
 	}
@@ -907,7 +1317,7 @@ func (mmRemoveRefund *mRefundsRepositoryMockRemoveRefund) Return(err error) *Ref
 }
 
 // Set uses given function f to mock the RefundsRepository.RemoveRefund method
-func (mmRemoveRefund *mRefundsRepositoryMockRemoveRefund) Set(f func(orderID uint64) (err error)) *RefundsRepositoryMock {
+func (mmRemoveRefund *mRefundsRepositoryMockRemoveRefund) Set(f func(ctx context.Context, orderID uint64) (err error)) *RefundsRepositoryMock {
 	if mmRemoveRefund.defaultExpectation != nil {
 		mmRemoveRefund.mock.t.Fatalf("Default expectation is already set for the RefundsRepository.RemoveRefund method")
 	}
@@ -923,14 +1333,14 @@ func (mmRemoveRefund *mRefundsRepositoryMockRemoveRefund) Set(f func(orderID uin
 
 // When sets expectation for the RefundsRepository.RemoveRefund which will trigger the result defined by the following
 // Then helper
-func (mmRemoveRefund *mRefundsRepositoryMockRemoveRefund) When(orderID uint64) *RefundsRepositoryMockRemoveRefundExpectation {
+func (mmRemoveRefund *mRefundsRepositoryMockRemoveRefund) When(ctx context.Context, orderID uint64) *RefundsRepositoryMockRemoveRefundExpectation {
 	if mmRemoveRefund.mock.funcRemoveRefund != nil {
 		mmRemoveRefund.mock.t.Fatalf("RefundsRepositoryMock.RemoveRefund mock is already set by Set")
 	}
 
 	expectation := &RefundsRepositoryMockRemoveRefundExpectation{
 		mock:               mmRemoveRefund.mock,
-		params:             &RefundsRepositoryMockRemoveRefundParams{orderID},
+		params:             &RefundsRepositoryMockRemoveRefundParams{ctx, orderID},
 		expectationOrigins: RefundsRepositoryMockRemoveRefundExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmRemoveRefund.expectations = append(mmRemoveRefund.expectations, expectation)
@@ -964,18 +1374,18 @@ func (mmRemoveRefund *mRefundsRepositoryMockRemoveRefund) invocationsDone() bool
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// RemoveRefund implements mm_storage.RefundsRepository
-func (mmRemoveRefund *RefundsRepositoryMock) RemoveRefund(orderID uint64) (err error) {
+// RemoveRefund implements mm_storage_json.RefundsRepository
+func (mmRemoveRefund *RefundsRepositoryMock) RemoveRefund(ctx context.Context, orderID uint64) (err error) {
 	mm_atomic.AddUint64(&mmRemoveRefund.beforeRemoveRefundCounter, 1)
 	defer mm_atomic.AddUint64(&mmRemoveRefund.afterRemoveRefundCounter, 1)
 
 	mmRemoveRefund.t.Helper()
 
 	if mmRemoveRefund.inspectFuncRemoveRefund != nil {
-		mmRemoveRefund.inspectFuncRemoveRefund(orderID)
+		mmRemoveRefund.inspectFuncRemoveRefund(ctx, orderID)
 	}
 
-	mm_params := RefundsRepositoryMockRemoveRefundParams{orderID}
+	mm_params := RefundsRepositoryMockRemoveRefundParams{ctx, orderID}
 
 	// Record call args
 	mmRemoveRefund.RemoveRefundMock.mutex.Lock()
@@ -994,10 +1404,15 @@ func (mmRemoveRefund *RefundsRepositoryMock) RemoveRefund(orderID uint64) (err e
 		mm_want := mmRemoveRefund.RemoveRefundMock.defaultExpectation.params
 		mm_want_ptrs := mmRemoveRefund.RemoveRefundMock.defaultExpectation.paramPtrs
 
-		mm_got := RefundsRepositoryMockRemoveRefundParams{orderID}
+		mm_got := RefundsRepositoryMockRemoveRefundParams{ctx, orderID}
 
 		if mm_want_ptrs != nil {
 
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveRefund.t.Errorf("RefundsRepositoryMock.RemoveRefund got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveRefund.RemoveRefundMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
 			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
 				mmRemoveRefund.t.Errorf("RefundsRepositoryMock.RemoveRefund got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 					mmRemoveRefund.RemoveRefundMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
@@ -1015,9 +1430,9 @@ func (mmRemoveRefund *RefundsRepositoryMock) RemoveRefund(orderID uint64) (err e
 		return (*mm_results).err
 	}
 	if mmRemoveRefund.funcRemoveRefund != nil {
-		return mmRemoveRefund.funcRemoveRefund(orderID)
+		return mmRemoveRefund.funcRemoveRefund(ctx, orderID)
 	}
-	mmRemoveRefund.t.Fatalf("Unexpected call to RefundsRepositoryMock.RemoveRefund. %v", orderID)
+	mmRemoveRefund.t.Fatalf("Unexpected call to RefundsRepositoryMock.RemoveRefund. %v %v", ctx, orderID)
 	return
 }
 
@@ -1097,6 +1512,8 @@ func (m *RefundsRepositoryMock) MinimockFinish() {
 
 			m.MinimockGetRefundsInspect()
 
+			m.MinimockHasRefundInspect()
+
 			m.MinimockRemoveRefundInspect()
 		}
 	})
@@ -1123,5 +1540,6 @@ func (m *RefundsRepositoryMock) minimockDone() bool {
 	return done &&
 		m.MinimockAddRefundDone() &&
 		m.MinimockGetRefundsDone() &&
+		m.MinimockHasRefundDone() &&
 		m.MinimockRemoveRefundDone()
 }
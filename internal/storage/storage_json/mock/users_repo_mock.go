@@ -5,6 +5,7 @@ package mock
 //go:generate minimock -i gitlab.ozon.dev/chppppr/homework/internal/storage.UsersRepository -o users_repo_mock.go -n UsersRepositoryMock -p mock
 
 import (
+	"context"
 	"sync"
 	mm_atomic "sync/atomic"
 	"time"
@@ -19,44 +20,44 @@ type UsersRepositoryMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
-	funcAddOrder          func(userID uint64, orderID uint64, order *domain.Order) (err error)
+	funcAddOrder          func(ctx context.Context, userID uint64, orderID uint64, order *domain.Order) (err error)
 	funcAddOrderOrigin    string
-	inspectFuncAddOrder   func(userID uint64, orderID uint64, order *domain.Order)
+	inspectFuncAddOrder   func(ctx context.Context, userID uint64, orderID uint64, order *domain.Order)
 	afterAddOrderCounter  uint64
 	beforeAddOrderCounter uint64
 	AddOrderMock          mUsersRepositoryMockAddOrder
 
-	funcCanRemove          func(userID uint64, orderID uint64) (err error)
+	funcCanRemove          func(ctx context.Context, userID uint64, orderID uint64) (err error)
 	funcCanRemoveOrigin    string
-	inspectFuncCanRemove   func(userID uint64, orderID uint64)
+	inspectFuncCanRemove   func(ctx context.Context, userID uint64, orderID uint64)
 	afterCanRemoveCounter  uint64
 	beforeCanRemoveCounter uint64
 	CanRemoveMock          mUsersRepositoryMockCanRemove
 
-	funcGetExpirationDate          func(userID uint64, orderID uint64) (t1 time.Time, err error)
+	funcGetExpirationDate          func(ctx context.Context, userID uint64, orderID uint64) (t1 time.Time, err error)
 	funcGetExpirationDateOrigin    string
-	inspectFuncGetExpirationDate   func(userID uint64, orderID uint64)
+	inspectFuncGetExpirationDate   func(ctx context.Context, userID uint64, orderID uint64)
 	afterGetExpirationDateCounter  uint64
 	beforeGetExpirationDateCounter uint64
 	GetExpirationDateMock          mUsersRepositoryMockGetExpirationDate
 
-	funcGetOrder          func(userID uint64, orderID uint64) (op1 *domain.Order, err error)
+	funcGetOrder          func(ctx context.Context, userID uint64, orderID uint64) (op1 *domain.Order, err error)
 	funcGetOrderOrigin    string
-	inspectFuncGetOrder   func(userID uint64, orderID uint64)
+	inspectFuncGetOrder   func(ctx context.Context, userID uint64, orderID uint64)
 	afterGetOrderCounter  uint64
 	beforeGetOrderCounter uint64
 	GetOrderMock          mUsersRepositoryMockGetOrder
 
-	funcGetOrders          func(userID uint64, firstOrderID uint64, limit uint64) (oa1 []domain.OrderView, err error)
+	funcGetOrders          func(ctx context.Context, userID uint64, firstOrderID uint64, limit uint64) (oa1 []domain.OrderView, err error)
 	funcGetOrdersOrigin    string
-	inspectFuncGetOrders   func(userID uint64, firstOrderID uint64, limit uint64)
+	inspectFuncGetOrders   func(ctx context.Context, userID uint64, firstOrderID uint64, limit uint64)
 	afterGetOrdersCounter  uint64
 	beforeGetOrdersCounter uint64
 	GetOrdersMock          mUsersRepositoryMockGetOrders
 
-	funcRemoveOrder          func(userID uint64, orderID uint64) (err error)
+	funcRemoveOrder          func(ctx context.Context, userID uint64, orderID uint64) (err error)
 	funcRemoveOrderOrigin    string
-	inspectFuncRemoveOrder   func(userID uint64, orderID uint64)
+	inspectFuncRemoveOrder   func(ctx context.Context, userID uint64, orderID uint64)
 	afterRemoveOrderCounter  uint64
 	beforeRemoveOrderCounter uint64
 	RemoveOrderMock          mUsersRepositoryMockRemoveOrder
@@ -119,6 +120,7 @@ type UsersRepositoryMockAddOrderExpectation struct {
 
 // UsersRepositoryMockAddOrderParams contains parameters of the UsersRepository.AddOrder
 type UsersRepositoryMockAddOrderParams struct {
+	ctx     context.Context
 	userID  uint64
 	orderID uint64
 	order   *domain.Order
@@ -126,6 +128,7 @@ type UsersRepositoryMockAddOrderParams struct {
 
 // UsersRepositoryMockAddOrderParamPtrs contains pointers to parameters of the UsersRepository.AddOrder
 type UsersRepositoryMockAddOrderParamPtrs struct {
+	ctx     *context.Context
 	userID  *uint64
 	orderID *uint64
 	order   **domain.Order
@@ -139,6 +142,7 @@ type UsersRepositoryMockAddOrderResults struct {
 // UsersRepositoryMockAddOrderOrigins contains origins of expectations of the UsersRepository.AddOrder
 type UsersRepositoryMockAddOrderExpectationOrigins struct {
 	origin        string
+	originCtx     string
 	originUserID  string
 	originOrderID string
 	originOrder   string
@@ -155,7 +159,7 @@ func (mmAddOrder *mUsersRepositoryMockAddOrder) Optional() *mUsersRepositoryMock
 }
 
 // Expect sets up expected params for UsersRepository.AddOrder
-func (mmAddOrder *mUsersRepositoryMockAddOrder) Expect(userID uint64, orderID uint64, order *domain.Order) *mUsersRepositoryMockAddOrder {
+func (mmAddOrder *mUsersRepositoryMockAddOrder) Expect(ctx context.Context, userID uint64, orderID uint64, order *domain.Order) *mUsersRepositoryMockAddOrder {
 	if mmAddOrder.mock.funcAddOrder != nil {
 		mmAddOrder.mock.t.Fatalf("UsersRepositoryMock.AddOrder mock is already set by Set")
 	}
@@ -168,7 +172,7 @@ func (mmAddOrder *mUsersRepositoryMockAddOrder) Expect(userID uint64, orderID ui
 		mmAddOrder.mock.t.Fatalf("UsersRepositoryMock.AddOrder mock is already set by ExpectParams functions")
 	}
 
-	mmAddOrder.defaultExpectation.params = &UsersRepositoryMockAddOrderParams{userID, orderID, order}
+	mmAddOrder.defaultExpectation.params = &UsersRepositoryMockAddOrderParams{ctx, userID, orderID, order}
 	mmAddOrder.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmAddOrder.expectations {
 		if minimock.Equal(e.params, mmAddOrder.defaultExpectation.params) {
@@ -179,8 +183,31 @@ func (mmAddOrder *mUsersRepositoryMockAddOrder) Expect(userID uint64, orderID ui
 	return mmAddOrder
 }
 
-// ExpectUserIDParam1 sets up expected param userID for UsersRepository.AddOrder
-func (mmAddOrder *mUsersRepositoryMockAddOrder) ExpectUserIDParam1(userID uint64) *mUsersRepositoryMockAddOrder {
+// ExpectCtxParam1 sets up expected param ctx for UsersRepository.AddOrder
+func (mmAddOrder *mUsersRepositoryMockAddOrder) ExpectCtxParam1(ctx context.Context) *mUsersRepositoryMockAddOrder {
+	if mmAddOrder.mock.funcAddOrder != nil {
+		mmAddOrder.mock.t.Fatalf("UsersRepositoryMock.AddOrder mock is already set by Set")
+	}
+
+	if mmAddOrder.defaultExpectation == nil {
+		mmAddOrder.defaultExpectation = &UsersRepositoryMockAddOrderExpectation{}
+	}
+
+	if mmAddOrder.defaultExpectation.params != nil {
+		mmAddOrder.mock.t.Fatalf("UsersRepositoryMock.AddOrder mock is already set by Expect")
+	}
+
+	if mmAddOrder.defaultExpectation.paramPtrs == nil {
+		mmAddOrder.defaultExpectation.paramPtrs = &UsersRepositoryMockAddOrderParamPtrs{}
+	}
+	mmAddOrder.defaultExpectation.paramPtrs.ctx = &ctx
+	mmAddOrder.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmAddOrder
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UsersRepository.AddOrder
+func (mmAddOrder *mUsersRepositoryMockAddOrder) ExpectUserIDParam2(userID uint64) *mUsersRepositoryMockAddOrder {
 	if mmAddOrder.mock.funcAddOrder != nil {
 		mmAddOrder.mock.t.Fatalf("UsersRepositoryMock.AddOrder mock is already set by Set")
 	}
@@ -202,8 +229,8 @@ func (mmAddOrder *mUsersRepositoryMockAddOrder) ExpectUserIDParam1(userID uint64
 	return mmAddOrder
 }
 
-// ExpectOrderIDParam2 sets up expected param orderID for UsersRepository.AddOrder
-func (mmAddOrder *mUsersRepositoryMockAddOrder) ExpectOrderIDParam2(orderID uint64) *mUsersRepositoryMockAddOrder {
+// ExpectOrderIDParam3 sets up expected param orderID for UsersRepository.AddOrder
+func (mmAddOrder *mUsersRepositoryMockAddOrder) ExpectOrderIDParam3(orderID uint64) *mUsersRepositoryMockAddOrder {
 	if mmAddOrder.mock.funcAddOrder != nil {
 		mmAddOrder.mock.t.Fatalf("UsersRepositoryMock.AddOrder mock is already set by Set")
 	}
@@ -225,8 +252,8 @@ func (mmAddOrder *mUsersRepositoryMockAddOrder) ExpectOrderIDParam2(orderID uint
 	return mmAddOrder
 }
 
-// ExpectOrderParam3 sets up expected param order for UsersRepository.AddOrder
-func (mmAddOrder *mUsersRepositoryMockAddOrder) ExpectOrderParam3(order *domain.Order) *mUsersRepositoryMockAddOrder {
+// ExpectOrderParam4 sets up expected param order for UsersRepository.AddOrder
+func (mmAddOrder *mUsersRepositoryMockAddOrder) ExpectOrderParam4(order *domain.Order) *mUsersRepositoryMockAddOrder {
 	if mmAddOrder.mock.funcAddOrder != nil {
 		mmAddOrder.mock.t.Fatalf("UsersRepositoryMock.AddOrder mock is already set by Set")
 	}
@@ -249,7 +276,7 @@ func (mmAddOrder *mUsersRepositoryMockAddOrder) ExpectOrderParam3(order *domain.
 }
 
 // Inspect accepts an inspector function that has same arguments as the UsersRepository.AddOrder
-func (mmAddOrder *mUsersRepositoryMockAddOrder) Inspect(f func(userID uint64, orderID uint64, order *domain.Order)) *mUsersRepositoryMockAddOrder {
+func (mmAddOrder *mUsersRepositoryMockAddOrder) Inspect(f func(ctx context.Context, userID uint64, orderID uint64, order *domain.Order)) *mUsersRepositoryMockAddOrder {
 	if mmAddOrder.mock.inspectFuncAddOrder != nil {
 		mmAddOrder.mock.t.Fatalf("Inspect function is already set for UsersRepositoryMock.AddOrder")
 	}
@@ -274,7 +301,7 @@ func (mmAddOrder *mUsersRepositoryMockAddOrder) Return(err error) *UsersReposito
 }
 
 // Set uses given function f to mock the UsersRepository.AddOrder method
-func (mmAddOrder *mUsersRepositoryMockAddOrder) Set(f func(userID uint64, orderID uint64, order *domain.Order) (err error)) *UsersRepositoryMock {
+func (mmAddOrder *mUsersRepositoryMockAddOrder) Set(f func(ctx context.Context, userID uint64, orderID uint64, order *domain.Order) (err error)) *UsersRepositoryMock {
 	if mmAddOrder.defaultExpectation != nil {
 		mmAddOrder.mock.t.Fatalf("Default expectation is already set for the UsersRepository.AddOrder method")
 	}
@@ -290,14 +317,14 @@ func (mmAddOrder *mUsersRepositoryMockAddOrder) Set(f func(userID uint64, orderI
 
 // When sets expectation for the UsersRepository.AddOrder which will trigger the result defined by the following
 // Then helper
-func (mmAddOrder *mUsersRepositoryMockAddOrder) When(userID uint64, orderID uint64, order *domain.Order) *UsersRepositoryMockAddOrderExpectation {
+func (mmAddOrder *mUsersRepositoryMockAddOrder) When(ctx context.Context, userID uint64, orderID uint64, order *domain.Order) *UsersRepositoryMockAddOrderExpectation {
 	if mmAddOrder.mock.funcAddOrder != nil {
 		mmAddOrder.mock.t.Fatalf("UsersRepositoryMock.AddOrder mock is already set by Set")
 	}
 
 	expectation := &UsersRepositoryMockAddOrderExpectation{
 		mock:               mmAddOrder.mock,
-		params:             &UsersRepositoryMockAddOrderParams{userID, orderID, order},
+		params:             &UsersRepositoryMockAddOrderParams{ctx, userID, orderID, order},
 		expectationOrigins: UsersRepositoryMockAddOrderExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmAddOrder.expectations = append(mmAddOrder.expectations, expectation)
@@ -332,17 +359,17 @@ func (mmAddOrder *mUsersRepositoryMockAddOrder) invocationsDone() bool {
 }
 
 // AddOrder implements mm_storage.UsersRepository
-func (mmAddOrder *UsersRepositoryMock) AddOrder(userID uint64, orderID uint64, order *domain.Order) (err error) {
+func (mmAddOrder *UsersRepositoryMock) AddOrder(ctx context.Context, userID uint64, orderID uint64, order *domain.Order) (err error) {
 	mm_atomic.AddUint64(&mmAddOrder.beforeAddOrderCounter, 1)
 	defer mm_atomic.AddUint64(&mmAddOrder.afterAddOrderCounter, 1)
 
 	mmAddOrder.t.Helper()
 
 	if mmAddOrder.inspectFuncAddOrder != nil {
-		mmAddOrder.inspectFuncAddOrder(userID, orderID, order)
+		mmAddOrder.inspectFuncAddOrder(ctx, userID, orderID, order)
 	}
 
-	mm_params := UsersRepositoryMockAddOrderParams{userID, orderID, order}
+	mm_params := UsersRepositoryMockAddOrderParams{ctx, userID, orderID, order}
 
 	// Record call args
 	mmAddOrder.AddOrderMock.mutex.Lock()
@@ -361,10 +388,15 @@ func (mmAddOrder *UsersRepositoryMock) AddOrder(userID uint64, orderID uint64, o
 		mm_want := mmAddOrder.AddOrderMock.defaultExpectation.params
 		mm_want_ptrs := mmAddOrder.AddOrderMock.defaultExpectation.paramPtrs
 
-		mm_got := UsersRepositoryMockAddOrderParams{userID, orderID, order}
+		mm_got := UsersRepositoryMockAddOrderParams{ctx, userID, orderID, order}
 
 		if mm_want_ptrs != nil {
 
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmAddOrder.t.Errorf("UsersRepositoryMock.AddOrder got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmAddOrder.AddOrderMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
 			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
 				mmAddOrder.t.Errorf("UsersRepositoryMock.AddOrder got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 					mmAddOrder.AddOrderMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
@@ -392,9 +424,9 @@ func (mmAddOrder *UsersRepositoryMock) AddOrder(userID uint64, orderID uint64, o
 		return (*mm_results).err
 	}
 	if mmAddOrder.funcAddOrder != nil {
-		return mmAddOrder.funcAddOrder(userID, orderID, order)
+		return mmAddOrder.funcAddOrder(ctx, userID, orderID, order)
 	}
-	mmAddOrder.t.Fatalf("Unexpected call to UsersRepositoryMock.AddOrder. %v %v %v", userID, orderID, order)
+	mmAddOrder.t.Fatalf("Unexpected call to UsersRepositoryMock.AddOrder. %v %v %v %v", ctx, userID, orderID, order)
 	return
 }
 
@@ -492,12 +524,14 @@ type UsersRepositoryMockCanRemoveExpectation struct {
 
 // UsersRepositoryMockCanRemoveParams contains parameters of the UsersRepository.CanRemove
 type UsersRepositoryMockCanRemoveParams struct {
+	ctx     context.Context
 	userID  uint64
 	orderID uint64
 }
 
 // UsersRepositoryMockCanRemoveParamPtrs contains pointers to parameters of the UsersRepository.CanRemove
 type UsersRepositoryMockCanRemoveParamPtrs struct {
+	ctx     *context.Context
 	userID  *uint64
 	orderID *uint64
 }
@@ -510,6 +544,7 @@ type UsersRepositoryMockCanRemoveResults struct {
 // UsersRepositoryMockCanRemoveOrigins contains origins of expectations of the UsersRepository.CanRemove
 type UsersRepositoryMockCanRemoveExpectationOrigins struct {
 	origin        string
+	originCtx     string
 	originUserID  string
 	originOrderID string
 }
@@ -525,7 +560,7 @@ func (mmCanRemove *mUsersRepositoryMockCanRemove) Optional() *mUsersRepositoryMo
 }
 
 // Expect sets up expected params for UsersRepository.CanRemove
-func (mmCanRemove *mUsersRepositoryMockCanRemove) Expect(userID uint64, orderID uint64) *mUsersRepositoryMockCanRemove {
+func (mmCanRemove *mUsersRepositoryMockCanRemove) Expect(ctx context.Context, userID uint64, orderID uint64) *mUsersRepositoryMockCanRemove {
 	if mmCanRemove.mock.funcCanRemove != nil {
 		mmCanRemove.mock.t.Fatalf("UsersRepositoryMock.CanRemove mock is already set by Set")
 	}
@@ -538,7 +573,7 @@ func (mmCanRemove *mUsersRepositoryMockCanRemove) Expect(userID uint64, orderID
 		mmCanRemove.mock.t.Fatalf("UsersRepositoryMock.CanRemove mock is already set by ExpectParams functions")
 	}
 
-	mmCanRemove.defaultExpectation.params = &UsersRepositoryMockCanRemoveParams{userID, orderID}
+	mmCanRemove.defaultExpectation.params = &UsersRepositoryMockCanRemoveParams{ctx, userID, orderID}
 	mmCanRemove.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmCanRemove.expectations {
 		if minimock.Equal(e.params, mmCanRemove.defaultExpectation.params) {
@@ -549,8 +584,31 @@ func (mmCanRemove *mUsersRepositoryMockCanRemove) Expect(userID uint64, orderID
 	return mmCanRemove
 }
 
-// ExpectUserIDParam1 sets up expected param userID for UsersRepository.CanRemove
-func (mmCanRemove *mUsersRepositoryMockCanRemove) ExpectUserIDParam1(userID uint64) *mUsersRepositoryMockCanRemove {
+// ExpectCtxParam1 sets up expected param ctx for UsersRepository.CanRemove
+func (mmCanRemove *mUsersRepositoryMockCanRemove) ExpectCtxParam1(ctx context.Context) *mUsersRepositoryMockCanRemove {
+	if mmCanRemove.mock.funcCanRemove != nil {
+		mmCanRemove.mock.t.Fatalf("UsersRepositoryMock.CanRemove mock is already set by Set")
+	}
+
+	if mmCanRemove.defaultExpectation == nil {
+		mmCanRemove.defaultExpectation = &UsersRepositoryMockCanRemoveExpectation{}
+	}
+
+	if mmCanRemove.defaultExpectation.params != nil {
+		mmCanRemove.mock.t.Fatalf("UsersRepositoryMock.CanRemove mock is already set by Expect")
+	}
+
+	if mmCanRemove.defaultExpectation.paramPtrs == nil {
+		mmCanRemove.defaultExpectation.paramPtrs = &UsersRepositoryMockCanRemoveParamPtrs{}
+	}
+	mmCanRemove.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCanRemove.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCanRemove
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UsersRepository.CanRemove
+func (mmCanRemove *mUsersRepositoryMockCanRemove) ExpectUserIDParam2(userID uint64) *mUsersRepositoryMockCanRemove {
 	if mmCanRemove.mock.funcCanRemove != nil {
 		mmCanRemove.mock.t.Fatalf("UsersRepositoryMock.CanRemove mock is already set by Set")
 	}
@@ -572,8 +630,8 @@ func (mmCanRemove *mUsersRepositoryMockCanRemove) ExpectUserIDParam1(userID uint
 	return mmCanRemove
 }
 
-// ExpectOrderIDParam2 sets up expected param orderID for UsersRepository.CanRemove
-func (mmCanRemove *mUsersRepositoryMockCanRemove) ExpectOrderIDParam2(orderID uint64) *mUsersRepositoryMockCanRemove {
+// ExpectOrderIDParam3 sets up expected param orderID for UsersRepository.CanRemove
+func (mmCanRemove *mUsersRepositoryMockCanRemove) ExpectOrderIDParam3(orderID uint64) *mUsersRepositoryMockCanRemove {
 	if mmCanRemove.mock.funcCanRemove != nil {
 		mmCanRemove.mock.t.Fatalf("UsersRepositoryMock.CanRemove mock is already set by Set")
 	}
@@ -596,7 +654,7 @@ func (mmCanRemove *mUsersRepositoryMockCanRemove) ExpectOrderIDParam2(orderID ui
 }
 
 // Inspect accepts an inspector function that has same arguments as the UsersRepository.CanRemove
-func (mmCanRemove *mUsersRepositoryMockCanRemove) Inspect(f func(userID uint64, orderID uint64)) *mUsersRepositoryMockCanRemove {
+func (mmCanRemove *mUsersRepositoryMockCanRemove) Inspect(f func(ctx context.Context, userID uint64, orderID uint64)) *mUsersRepositoryMockCanRemove {
 	if mmCanRemove.mock.inspectFuncCanRemove != nil {
 		mmCanRemove.mock.t.Fatalf("Inspect function is already set for UsersRepositoryMock.CanRemove")
 	}
@@ -621,7 +679,7 @@ func (mmCanRemove *mUsersRepositoryMockCanRemove) Return(err error) *UsersReposi
 }
 
 // Set uses given function f to mock the UsersRepository.CanRemove method
-func (mmCanRemove *mUsersRepositoryMockCanRemove) Set(f func(userID uint64, orderID uint64) (err error)) *UsersRepositoryMock {
+func (mmCanRemove *mUsersRepositoryMockCanRemove) Set(f func(ctx context.Context, userID uint64, orderID uint64) (err error)) *UsersRepositoryMock {
 	if mmCanRemove.defaultExpectation != nil {
 		mmCanRemove.mock.t.Fatalf("Default expectation is already set for the UsersRepository.CanRemove method")
 	}
@@ -637,14 +695,14 @@ func (mmCanRemove *mUsersRepositoryMockCanRemove) Set(f func(userID uint64, orde
 
 // When sets expectation for the UsersRepository.CanRemove which will trigger the result defined by the following
 // Then helper
-func (mmCanRemove *mUsersRepositoryMockCanRemove) When(userID uint64, orderID uint64) *UsersRepositoryMockCanRemoveExpectation {
+func (mmCanRemove *mUsersRepositoryMockCanRemove) When(ctx context.Context, userID uint64, orderID uint64) *UsersRepositoryMockCanRemoveExpectation {
 	if mmCanRemove.mock.funcCanRemove != nil {
 		mmCanRemove.mock.t.Fatalf("UsersRepositoryMock.CanRemove mock is already set by Set")
 	}
 
 	expectation := &UsersRepositoryMockCanRemoveExpectation{
 		mock:               mmCanRemove.mock,
-		params:             &UsersRepositoryMockCanRemoveParams{userID, orderID},
+		params:             &UsersRepositoryMockCanRemoveParams{ctx, userID, orderID},
 		expectationOrigins: UsersRepositoryMockCanRemoveExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmCanRemove.expectations = append(mmCanRemove.expectations, expectation)
@@ -679,17 +737,17 @@ func (mmCanRemove *mUsersRepositoryMockCanRemove) invocationsDone() bool {
 }
 
 // CanRemove implements mm_storage.UsersRepository
-func (mmCanRemove *UsersRepositoryMock) CanRemove(userID uint64, orderID uint64) (err error) {
+func (mmCanRemove *UsersRepositoryMock) CanRemove(ctx context.Context, userID uint64, orderID uint64) (err error) {
 	mm_atomic.AddUint64(&mmCanRemove.beforeCanRemoveCounter, 1)
 	defer mm_atomic.AddUint64(&mmCanRemove.afterCanRemoveCounter, 1)
 
 	mmCanRemove.t.Helper()
 
 	if mmCanRemove.inspectFuncCanRemove != nil {
-		mmCanRemove.inspectFuncCanRemove(userID, orderID)
+		mmCanRemove.inspectFuncCanRemove(ctx, userID, orderID)
 	}
 
-	mm_params := UsersRepositoryMockCanRemoveParams{userID, orderID}
+	mm_params := UsersRepositoryMockCanRemoveParams{ctx, userID, orderID}
 
 	// Record call args
 	mmCanRemove.CanRemoveMock.mutex.Lock()
@@ -708,10 +766,15 @@ func (mmCanRemove *UsersRepositoryMock) CanRemove(userID uint64, orderID uint64)
 		mm_want := mmCanRemove.CanRemoveMock.defaultExpectation.params
 		mm_want_ptrs := mmCanRemove.CanRemoveMock.defaultExpectation.paramPtrs
 
-		mm_got := UsersRepositoryMockCanRemoveParams{userID, orderID}
+		mm_got := UsersRepositoryMockCanRemoveParams{ctx, userID, orderID}
 
 		if mm_want_ptrs != nil {
 
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCanRemove.t.Errorf("UsersRepositoryMock.CanRemove got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCanRemove.CanRemoveMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
 			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
 				mmCanRemove.t.Errorf("UsersRepositoryMock.CanRemove got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 					mmCanRemove.CanRemoveMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
@@ -734,9 +797,9 @@ func (mmCanRemove *UsersRepositoryMock) CanRemove(userID uint64, orderID uint64)
 		return (*mm_results).err
 	}
 	if mmCanRemove.funcCanRemove != nil {
-		return mmCanRemove.funcCanRemove(userID, orderID)
+		return mmCanRemove.funcCanRemove(ctx, userID, orderID)
 	}
-	mmCanRemove.t.Fatalf("Unexpected call to UsersRepositoryMock.CanRemove. %v %v", userID, orderID)
+	mmCanRemove.t.Fatalf("Unexpected call to UsersRepositoryMock.CanRemove. %v %v %v", ctx, userID, orderID)
 	return
 }
 
@@ -834,12 +897,14 @@ type UsersRepositoryMockGetExpirationDateExpectation struct {
 
 // UsersRepositoryMockGetExpirationDateParams contains parameters of the UsersRepository.GetExpirationDate
 type UsersRepositoryMockGetExpirationDateParams struct {
+	ctx     context.Context
 	userID  uint64
 	orderID uint64
 }
 
 // UsersRepositoryMockGetExpirationDateParamPtrs contains pointers to parameters of the UsersRepository.GetExpirationDate
 type UsersRepositoryMockGetExpirationDateParamPtrs struct {
+	ctx     *context.Context
 	userID  *uint64
 	orderID *uint64
 }
@@ -853,6 +918,7 @@ type UsersRepositoryMockGetExpirationDateResults struct {
 // UsersRepositoryMockGetExpirationDateOrigins contains origins of expectations of the UsersRepository.GetExpirationDate
 type UsersRepositoryMockGetExpirationDateExpectationOrigins struct {
 	origin        string
+	originCtx     string
 	originUserID  string
 	originOrderID string
 }
@@ -868,7 +934,7 @@ func (mmGetExpirationDate *mUsersRepositoryMockGetExpirationDate) Optional() *mU
 }
 
 // Expect sets up expected params for UsersRepository.GetExpirationDate
-func (mmGetExpirationDate *mUsersRepositoryMockGetExpirationDate) Expect(userID uint64, orderID uint64) *mUsersRepositoryMockGetExpirationDate {
+func (mmGetExpirationDate *mUsersRepositoryMockGetExpirationDate) Expect(ctx context.Context, userID uint64, orderID uint64) *mUsersRepositoryMockGetExpirationDate {
 	if mmGetExpirationDate.mock.funcGetExpirationDate != nil {
 		mmGetExpirationDate.mock.t.Fatalf("UsersRepositoryMock.GetExpirationDate mock is already set by Set")
 	}
@@ -881,7 +947,7 @@ func (mmGetExpirationDate *mUsersRepositoryMockGetExpirationDate) Expect(userID
 		mmGetExpirationDate.mock.t.Fatalf("UsersRepositoryMock.GetExpirationDate mock is already set by ExpectParams functions")
 	}
 
-	mmGetExpirationDate.defaultExpectation.params = &UsersRepositoryMockGetExpirationDateParams{userID, orderID}
+	mmGetExpirationDate.defaultExpectation.params = &UsersRepositoryMockGetExpirationDateParams{ctx, userID, orderID}
 	mmGetExpirationDate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmGetExpirationDate.expectations {
 		if minimock.Equal(e.params, mmGetExpirationDate.defaultExpectation.params) {
@@ -892,8 +958,31 @@ func (mmGetExpirationDate *mUsersRepositoryMockGetExpirationDate) Expect(userID
 	return mmGetExpirationDate
 }
 
-// ExpectUserIDParam1 sets up expected param userID for UsersRepository.GetExpirationDate
-func (mmGetExpirationDate *mUsersRepositoryMockGetExpirationDate) ExpectUserIDParam1(userID uint64) *mUsersRepositoryMockGetExpirationDate {
+// ExpectCtxParam1 sets up expected param ctx for UsersRepository.GetExpirationDate
+func (mmGetExpirationDate *mUsersRepositoryMockGetExpirationDate) ExpectCtxParam1(ctx context.Context) *mUsersRepositoryMockGetExpirationDate {
+	if mmGetExpirationDate.mock.funcGetExpirationDate != nil {
+		mmGetExpirationDate.mock.t.Fatalf("UsersRepositoryMock.GetExpirationDate mock is already set by Set")
+	}
+
+	if mmGetExpirationDate.defaultExpectation == nil {
+		mmGetExpirationDate.defaultExpectation = &UsersRepositoryMockGetExpirationDateExpectation{}
+	}
+
+	if mmGetExpirationDate.defaultExpectation.params != nil {
+		mmGetExpirationDate.mock.t.Fatalf("UsersRepositoryMock.GetExpirationDate mock is already set by Expect")
+	}
+
+	if mmGetExpirationDate.defaultExpectation.paramPtrs == nil {
+		mmGetExpirationDate.defaultExpectation.paramPtrs = &UsersRepositoryMockGetExpirationDateParamPtrs{}
+	}
+	mmGetExpirationDate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetExpirationDate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetExpirationDate
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UsersRepository.GetExpirationDate
+func (mmGetExpirationDate *mUsersRepositoryMockGetExpirationDate) ExpectUserIDParam2(userID uint64) *mUsersRepositoryMockGetExpirationDate {
 	if mmGetExpirationDate.mock.funcGetExpirationDate != nil {
 		mmGetExpirationDate.mock.t.Fatalf("UsersRepositoryMock.GetExpirationDate mock is already set by Set")
 	}
@@ -915,8 +1004,8 @@ func (mmGetExpirationDate *mUsersRepositoryMockGetExpirationDate) ExpectUserIDPa
 	return mmGetExpirationDate
 }
 
-// ExpectOrderIDParam2 sets up expected param orderID for UsersRepository.GetExpirationDate
-func (mmGetExpirationDate *mUsersRepositoryMockGetExpirationDate) ExpectOrderIDParam2(orderID uint64) *mUsersRepositoryMockGetExpirationDate {
+// ExpectOrderIDParam3 sets up expected param orderID for UsersRepository.GetExpirationDate
+func (mmGetExpirationDate *mUsersRepositoryMockGetExpirationDate) ExpectOrderIDParam3(orderID uint64) *mUsersRepositoryMockGetExpirationDate {
 	if mmGetExpirationDate.mock.funcGetExpirationDate != nil {
 		mmGetExpirationDate.mock.t.Fatalf("UsersRepositoryMock.GetExpirationDate mock is already set by Set")
 	}
@@ -939,7 +1028,7 @@ func (mmGetExpirationDate *mUsersRepositoryMockGetExpirationDate) ExpectOrderIDP
 }
 
 // Inspect accepts an inspector function that has same arguments as the UsersRepository.GetExpirationDate
-func (mmGetExpirationDate *mUsersRepositoryMockGetExpirationDate) Inspect(f func(userID uint64, orderID uint64)) *mUsersRepositoryMockGetExpirationDate {
+func (mmGetExpirationDate *mUsersRepositoryMockGetExpirationDate) Inspect(f func(ctx context.Context, userID uint64, orderID uint64)) *mUsersRepositoryMockGetExpirationDate {
 	if mmGetExpirationDate.mock.inspectFuncGetExpirationDate != nil {
 		mmGetExpirationDate.mock.t.Fatalf("Inspect function is already set for UsersRepositoryMock.GetExpirationDate")
 	}
@@ -964,7 +1053,7 @@ func (mmGetExpirationDate *mUsersRepositoryMockGetExpirationDate) Return(t1 time
 }
 
 // Set uses given function f to mock the UsersRepository.GetExpirationDate method
-func (mmGetExpirationDate *mUsersRepositoryMockGetExpirationDate) Set(f func(userID uint64, orderID uint64) (t1 time.Time, err error)) *UsersRepositoryMock {
+func (mmGetExpirationDate *mUsersRepositoryMockGetExpirationDate) Set(f func(ctx context.Context, userID uint64, orderID uint64) (t1 time.Time, err error)) *UsersRepositoryMock {
 	if mmGetExpirationDate.defaultExpectation != nil {
 		mmGetExpirationDate.mock.t.Fatalf("Default expectation is already set for the UsersRepository.GetExpirationDate method")
 	}
@@ -980,14 +1069,14 @@ func (mmGetExpirationDate *mUsersRepositoryMockGetExpirationDate) Set(f func(use
 
 // When sets expectation for the UsersRepository.GetExpirationDate which will trigger the result defined by the following
 // Then helper
-func (mmGetExpirationDate *mUsersRepositoryMockGetExpirationDate) When(userID uint64, orderID uint64) *UsersRepositoryMockGetExpirationDateExpectation {
+func (mmGetExpirationDate *mUsersRepositoryMockGetExpirationDate) When(ctx context.Context, userID uint64, orderID uint64) *UsersRepositoryMockGetExpirationDateExpectation {
 	if mmGetExpirationDate.mock.funcGetExpirationDate != nil {
 		mmGetExpirationDate.mock.t.Fatalf("UsersRepositoryMock.GetExpirationDate mock is already set by Set")
 	}
 
 	expectation := &UsersRepositoryMockGetExpirationDateExpectation{
 		mock:               mmGetExpirationDate.mock,
-		params:             &UsersRepositoryMockGetExpirationDateParams{userID, orderID},
+		params:             &UsersRepositoryMockGetExpirationDateParams{ctx, userID, orderID},
 		expectationOrigins: UsersRepositoryMockGetExpirationDateExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmGetExpirationDate.expectations = append(mmGetExpirationDate.expectations, expectation)
@@ -1022,17 +1111,17 @@ func (mmGetExpirationDate *mUsersRepositoryMockGetExpirationDate) invocationsDon
 }
 
 // GetExpirationDate implements mm_storage.UsersRepository
-func (mmGetExpirationDate *UsersRepositoryMock) GetExpirationDate(userID uint64, orderID uint64) (t1 time.Time, err error) {
+func (mmGetExpirationDate *UsersRepositoryMock) GetExpirationDate(ctx context.Context, userID uint64, orderID uint64) (t1 time.Time, err error) {
 	mm_atomic.AddUint64(&mmGetExpirationDate.beforeGetExpirationDateCounter, 1)
 	defer mm_atomic.AddUint64(&mmGetExpirationDate.afterGetExpirationDateCounter, 1)
 
 	mmGetExpirationDate.t.Helper()
 
 	if mmGetExpirationDate.inspectFuncGetExpirationDate != nil {
-		mmGetExpirationDate.inspectFuncGetExpirationDate(userID, orderID)
+		mmGetExpirationDate.inspectFuncGetExpirationDate(ctx, userID, orderID)
 	}
 
-	mm_params := UsersRepositoryMockGetExpirationDateParams{userID, orderID}
+	mm_params := UsersRepositoryMockGetExpirationDateParams{ctx, userID, orderID}
 
 	// Record call args
 	mmGetExpirationDate.GetExpirationDateMock.mutex.Lock()
@@ -1051,10 +1140,15 @@ func (mmGetExpirationDate *UsersRepositoryMock) GetExpirationDate(userID uint64,
 		mm_want := mmGetExpirationDate.GetExpirationDateMock.defaultExpectation.params
 		mm_want_ptrs := mmGetExpirationDate.GetExpirationDateMock.defaultExpectation.paramPtrs
 
-		mm_got := UsersRepositoryMockGetExpirationDateParams{userID, orderID}
+		mm_got := UsersRepositoryMockGetExpirationDateParams{ctx, userID, orderID}
 
 		if mm_want_ptrs != nil {
 
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetExpirationDate.t.Errorf("UsersRepositoryMock.GetExpirationDate got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetExpirationDate.GetExpirationDateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
 			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
 				mmGetExpirationDate.t.Errorf("UsersRepositoryMock.GetExpirationDate got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 					mmGetExpirationDate.GetExpirationDateMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
@@ -1077,9 +1171,9 @@ func (mmGetExpirationDate *UsersRepositoryMock) GetExpirationDate(userID uint64,
 		return (*mm_results).t1, (*mm_results).err
 	}
 	if mmGetExpirationDate.funcGetExpirationDate != nil {
-		return mmGetExpirationDate.funcGetExpirationDate(userID, orderID)
+		return mmGetExpirationDate.funcGetExpirationDate(ctx, userID, orderID)
 	}
-	mmGetExpirationDate.t.Fatalf("Unexpected call to UsersRepositoryMock.GetExpirationDate. %v %v", userID, orderID)
+	mmGetExpirationDate.t.Fatalf("Unexpected call to UsersRepositoryMock.GetExpirationDate. %v %v %v", ctx, userID, orderID)
 	return
 }
 
@@ -1177,12 +1271,14 @@ type UsersRepositoryMockGetOrderExpectation struct {
 
 // UsersRepositoryMockGetOrderParams contains parameters of the UsersRepository.GetOrder
 type UsersRepositoryMockGetOrderParams struct {
+	ctx     context.Context
 	userID  uint64
 	orderID uint64
 }
 
 // UsersRepositoryMockGetOrderParamPtrs contains pointers to parameters of the UsersRepository.GetOrder
 type UsersRepositoryMockGetOrderParamPtrs struct {
+	ctx     *context.Context
 	userID  *uint64
 	orderID *uint64
 }
@@ -1196,6 +1292,7 @@ type UsersRepositoryMockGetOrderResults struct {
 // UsersRepositoryMockGetOrderOrigins contains origins of expectations of the UsersRepository.GetOrder
 type UsersRepositoryMockGetOrderExpectationOrigins struct {
 	origin        string
+	originCtx     string
 	originUserID  string
 	originOrderID string
 }
@@ -1211,7 +1308,7 @@ func (mmGetOrder *mUsersRepositoryMockGetOrder) Optional() *mUsersRepositoryMock
 }
 
 // Expect sets up expected params for UsersRepository.GetOrder
-func (mmGetOrder *mUsersRepositoryMockGetOrder) Expect(userID uint64, orderID uint64) *mUsersRepositoryMockGetOrder {
+func (mmGetOrder *mUsersRepositoryMockGetOrder) Expect(ctx context.Context, userID uint64, orderID uint64) *mUsersRepositoryMockGetOrder {
 	if mmGetOrder.mock.funcGetOrder != nil {
 		mmGetOrder.mock.t.Fatalf("UsersRepositoryMock.GetOrder mock is already set by Set")
 	}
@@ -1224,7 +1321,7 @@ func (mmGetOrder *mUsersRepositoryMockGetOrder) Expect(userID uint64, orderID ui
 		mmGetOrder.mock.t.Fatalf("UsersRepositoryMock.GetOrder mock is already set by ExpectParams functions")
 	}
 
-	mmGetOrder.defaultExpectation.params = &UsersRepositoryMockGetOrderParams{userID, orderID}
+	mmGetOrder.defaultExpectation.params = &UsersRepositoryMockGetOrderParams{ctx, userID, orderID}
 	mmGetOrder.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmGetOrder.expectations {
 		if minimock.Equal(e.params, mmGetOrder.defaultExpectation.params) {
@@ -1235,8 +1332,31 @@ func (mmGetOrder *mUsersRepositoryMockGetOrder) Expect(userID uint64, orderID ui
 	return mmGetOrder
 }
 
-// ExpectUserIDParam1 sets up expected param userID for UsersRepository.GetOrder
-func (mmGetOrder *mUsersRepositoryMockGetOrder) ExpectUserIDParam1(userID uint64) *mUsersRepositoryMockGetOrder {
+// ExpectCtxParam1 sets up expected param ctx for UsersRepository.GetOrder
+func (mmGetOrder *mUsersRepositoryMockGetOrder) ExpectCtxParam1(ctx context.Context) *mUsersRepositoryMockGetOrder {
+	if mmGetOrder.mock.funcGetOrder != nil {
+		mmGetOrder.mock.t.Fatalf("UsersRepositoryMock.GetOrder mock is already set by Set")
+	}
+
+	if mmGetOrder.defaultExpectation == nil {
+		mmGetOrder.defaultExpectation = &UsersRepositoryMockGetOrderExpectation{}
+	}
+
+	if mmGetOrder.defaultExpectation.params != nil {
+		mmGetOrder.mock.t.Fatalf("UsersRepositoryMock.GetOrder mock is already set by Expect")
+	}
+
+	if mmGetOrder.defaultExpectation.paramPtrs == nil {
+		mmGetOrder.defaultExpectation.paramPtrs = &UsersRepositoryMockGetOrderParamPtrs{}
+	}
+	mmGetOrder.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOrder.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetOrder
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UsersRepository.GetOrder
+func (mmGetOrder *mUsersRepositoryMockGetOrder) ExpectUserIDParam2(userID uint64) *mUsersRepositoryMockGetOrder {
 	if mmGetOrder.mock.funcGetOrder != nil {
 		mmGetOrder.mock.t.Fatalf("UsersRepositoryMock.GetOrder mock is already set by Set")
 	}
@@ -1258,8 +1378,8 @@ func (mmGetOrder *mUsersRepositoryMockGetOrder) ExpectUserIDParam1(userID uint64
 	return mmGetOrder
 }
 
-// ExpectOrderIDParam2 sets up expected param orderID for UsersRepository.GetOrder
-func (mmGetOrder *mUsersRepositoryMockGetOrder) ExpectOrderIDParam2(orderID uint64) *mUsersRepositoryMockGetOrder {
+// ExpectOrderIDParam3 sets up expected param orderID for UsersRepository.GetOrder
+func (mmGetOrder *mUsersRepositoryMockGetOrder) ExpectOrderIDParam3(orderID uint64) *mUsersRepositoryMockGetOrder {
 	if mmGetOrder.mock.funcGetOrder != nil {
 		mmGetOrder.mock.t.Fatalf("UsersRepositoryMock.GetOrder mock is already set by Set")
 	}
@@ -1282,7 +1402,7 @@ func (mmGetOrder *mUsersRepositoryMockGetOrder) ExpectOrderIDParam2(orderID uint
 }
 
 // Inspect accepts an inspector function that has same arguments as the UsersRepository.GetOrder
-func (mmGetOrder *mUsersRepositoryMockGetOrder) Inspect(f func(userID uint64, orderID uint64)) *mUsersRepositoryMockGetOrder {
+func (mmGetOrder *mUsersRepositoryMockGetOrder) Inspect(f func(ctx context.Context, userID uint64, orderID uint64)) *mUsersRepositoryMockGetOrder {
 	if mmGetOrder.mock.inspectFuncGetOrder != nil {
 		mmGetOrder.mock.t.Fatalf("Inspect function is already set for UsersRepositoryMock.GetOrder")
 	}
@@ -1307,7 +1427,7 @@ func (mmGetOrder *mUsersRepositoryMockGetOrder) Return(op1 *domain.Order, err er
 }
 
 // Set uses given function f to mock the UsersRepository.GetOrder method
-func (mmGetOrder *mUsersRepositoryMockGetOrder) Set(f func(userID uint64, orderID uint64) (op1 *domain.Order, err error)) *UsersRepositoryMock {
+func (mmGetOrder *mUsersRepositoryMockGetOrder) Set(f func(ctx context.Context, userID uint64, orderID uint64) (op1 *domain.Order, err error)) *UsersRepositoryMock {
 	if mmGetOrder.defaultExpectation != nil {
 		mmGetOrder.mock.t.Fatalf("Default expectation is already set for the UsersRepository.GetOrder method")
 	}
@@ -1323,14 +1443,14 @@ func (mmGetOrder *mUsersRepositoryMockGetOrder) Set(f func(userID uint64, orderI
 
 // When sets expectation for the UsersRepository.GetOrder which will trigger the result defined by the following
 // Then helper
-func (mmGetOrder *mUsersRepositoryMockGetOrder) When(userID uint64, orderID uint64) *UsersRepositoryMockGetOrderExpectation {
+func (mmGetOrder *mUsersRepositoryMockGetOrder) When(ctx context.Context, userID uint64, orderID uint64) *UsersRepositoryMockGetOrderExpectation {
 	if mmGetOrder.mock.funcGetOrder != nil {
 		mmGetOrder.mock.t.Fatalf("UsersRepositoryMock.GetOrder mock is already set by Set")
 	}
 
 	expectation := &UsersRepositoryMockGetOrderExpectation{
 		mock:               mmGetOrder.mock,
-		params:             &UsersRepositoryMockGetOrderParams{userID, orderID},
+		params:             &UsersRepositoryMockGetOrderParams{ctx, userID, orderID},
 		expectationOrigins: UsersRepositoryMockGetOrderExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmGetOrder.expectations = append(mmGetOrder.expectations, expectation)
@@ -1365,17 +1485,17 @@ func (mmGetOrder *mUsersRepositoryMockGetOrder) invocationsDone() bool {
 }
 
 // GetOrder implements mm_storage.UsersRepository
-func (mmGetOrder *UsersRepositoryMock) GetOrder(userID uint64, orderID uint64) (op1 *domain.Order, err error) {
+func (mmGetOrder *UsersRepositoryMock) GetOrder(ctx context.Context, userID uint64, orderID uint64) (op1 *domain.Order, err error) {
 	mm_atomic.AddUint64(&mmGetOrder.beforeGetOrderCounter, 1)
 	defer mm_atomic.AddUint64(&mmGetOrder.afterGetOrderCounter, 1)
 
 	mmGetOrder.t.Helper()
 
 	if mmGetOrder.inspectFuncGetOrder != nil {
-		mmGetOrder.inspectFuncGetOrder(userID, orderID)
+		mmGetOrder.inspectFuncGetOrder(ctx, userID, orderID)
 	}
 
-	mm_params := UsersRepositoryMockGetOrderParams{userID, orderID}
+	mm_params := UsersRepositoryMockGetOrderParams{ctx, userID, orderID}
 
 	// Record call args
 	mmGetOrder.GetOrderMock.mutex.Lock()
@@ -1394,10 +1514,15 @@ func (mmGetOrder *UsersRepositoryMock) GetOrder(userID uint64, orderID uint64) (
 		mm_want := mmGetOrder.GetOrderMock.defaultExpectation.params
 		mm_want_ptrs := mmGetOrder.GetOrderMock.defaultExpectation.paramPtrs
 
-		mm_got := UsersRepositoryMockGetOrderParams{userID, orderID}
+		mm_got := UsersRepositoryMockGetOrderParams{ctx, userID, orderID}
 
 		if mm_want_ptrs != nil {
 
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetOrder.t.Errorf("UsersRepositoryMock.GetOrder got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrder.GetOrderMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
 			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
 				mmGetOrder.t.Errorf("UsersRepositoryMock.GetOrder got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 					mmGetOrder.GetOrderMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
@@ -1420,9 +1545,9 @@ func (mmGetOrder *UsersRepositoryMock) GetOrder(userID uint64, orderID uint64) (
 		return (*mm_results).op1, (*mm_results).err
 	}
 	if mmGetOrder.funcGetOrder != nil {
-		return mmGetOrder.funcGetOrder(userID, orderID)
+		return mmGetOrder.funcGetOrder(ctx, userID, orderID)
 	}
-	mmGetOrder.t.Fatalf("Unexpected call to UsersRepositoryMock.GetOrder. %v %v", userID, orderID)
+	mmGetOrder.t.Fatalf("Unexpected call to UsersRepositoryMock.GetOrder. %v %v %v", ctx, userID, orderID)
 	return
 }
 
@@ -1520,6 +1645,7 @@ type UsersRepositoryMockGetOrdersExpectation struct {
 
 // UsersRepositoryMockGetOrdersParams contains parameters of the UsersRepository.GetOrders
 type UsersRepositoryMockGetOrdersParams struct {
+	ctx          context.Context
 	userID       uint64
 	firstOrderID uint64
 	limit        uint64
@@ -1527,6 +1653,7 @@ type UsersRepositoryMockGetOrdersParams struct {
 
 // UsersRepositoryMockGetOrdersParamPtrs contains pointers to parameters of the UsersRepository.GetOrders
 type UsersRepositoryMockGetOrdersParamPtrs struct {
+	ctx          *context.Context
 	userID       *uint64
 	firstOrderID *uint64
 	limit        *uint64
@@ -1541,6 +1668,7 @@ type UsersRepositoryMockGetOrdersResults struct {
 // UsersRepositoryMockGetOrdersOrigins contains origins of expectations of the UsersRepository.GetOrders
 type UsersRepositoryMockGetOrdersExpectationOrigins struct {
 	origin             string
+	originCtx          string
 	originUserID       string
 	originFirstOrderID string
 	originLimit        string
@@ -1557,7 +1685,7 @@ func (mmGetOrders *mUsersRepositoryMockGetOrders) Optional() *mUsersRepositoryMo
 }
 
 // Expect sets up expected params for UsersRepository.GetOrders
-func (mmGetOrders *mUsersRepositoryMockGetOrders) Expect(userID uint64, firstOrderID uint64, limit uint64) *mUsersRepositoryMockGetOrders {
+func (mmGetOrders *mUsersRepositoryMockGetOrders) Expect(ctx context.Context, userID uint64, firstOrderID uint64, limit uint64) *mUsersRepositoryMockGetOrders {
 	if mmGetOrders.mock.funcGetOrders != nil {
 		mmGetOrders.mock.t.Fatalf("UsersRepositoryMock.GetOrders mock is already set by Set")
 	}
@@ -1570,7 +1698,7 @@ func (mmGetOrders *mUsersRepositoryMockGetOrders) Expect(userID uint64, firstOrd
 		mmGetOrders.mock.t.Fatalf("UsersRepositoryMock.GetOrders mock is already set by ExpectParams functions")
 	}
 
-	mmGetOrders.defaultExpectation.params = &UsersRepositoryMockGetOrdersParams{userID, firstOrderID, limit}
+	mmGetOrders.defaultExpectation.params = &UsersRepositoryMockGetOrdersParams{ctx, userID, firstOrderID, limit}
 	mmGetOrders.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmGetOrders.expectations {
 		if minimock.Equal(e.params, mmGetOrders.defaultExpectation.params) {
@@ -1581,8 +1709,31 @@ func (mmGetOrders *mUsersRepositoryMockGetOrders) Expect(userID uint64, firstOrd
 	return mmGetOrders
 }
 
-// ExpectUserIDParam1 sets up expected param userID for UsersRepository.GetOrders
-func (mmGetOrders *mUsersRepositoryMockGetOrders) ExpectUserIDParam1(userID uint64) *mUsersRepositoryMockGetOrders {
+// ExpectCtxParam1 sets up expected param ctx for UsersRepository.GetOrders
+func (mmGetOrders *mUsersRepositoryMockGetOrders) ExpectCtxParam1(ctx context.Context) *mUsersRepositoryMockGetOrders {
+	if mmGetOrders.mock.funcGetOrders != nil {
+		mmGetOrders.mock.t.Fatalf("UsersRepositoryMock.GetOrders mock is already set by Set")
+	}
+
+	if mmGetOrders.defaultExpectation == nil {
+		mmGetOrders.defaultExpectation = &UsersRepositoryMockGetOrdersExpectation{}
+	}
+
+	if mmGetOrders.defaultExpectation.params != nil {
+		mmGetOrders.mock.t.Fatalf("UsersRepositoryMock.GetOrders mock is already set by Expect")
+	}
+
+	if mmGetOrders.defaultExpectation.paramPtrs == nil {
+		mmGetOrders.defaultExpectation.paramPtrs = &UsersRepositoryMockGetOrdersParamPtrs{}
+	}
+	mmGetOrders.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetOrders.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetOrders
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UsersRepository.GetOrders
+func (mmGetOrders *mUsersRepositoryMockGetOrders) ExpectUserIDParam2(userID uint64) *mUsersRepositoryMockGetOrders {
 	if mmGetOrders.mock.funcGetOrders != nil {
 		mmGetOrders.mock.t.Fatalf("UsersRepositoryMock.GetOrders mock is already set by Set")
 	}
@@ -1604,8 +1755,8 @@ func (mmGetOrders *mUsersRepositoryMockGetOrders) ExpectUserIDParam1(userID uint
 	return mmGetOrders
 }
 
-// ExpectFirstOrderIDParam2 sets up expected param firstOrderID for UsersRepository.GetOrders
-func (mmGetOrders *mUsersRepositoryMockGetOrders) ExpectFirstOrderIDParam2(firstOrderID uint64) *mUsersRepositoryMockGetOrders {
+// ExpectFirstOrderIDParam3 sets up expected param firstOrderID for UsersRepository.GetOrders
+func (mmGetOrders *mUsersRepositoryMockGetOrders) ExpectFirstOrderIDParam3(firstOrderID uint64) *mUsersRepositoryMockGetOrders {
 	if mmGetOrders.mock.funcGetOrders != nil {
 		mmGetOrders.mock.t.Fatalf("UsersRepositoryMock.GetOrders mock is already set by Set")
 	}
@@ -1627,8 +1778,8 @@ func (mmGetOrders *mUsersRepositoryMockGetOrders) ExpectFirstOrderIDParam2(first
 	return mmGetOrders
 }
 
-// ExpectLimitParam3 sets up expected param limit for UsersRepository.GetOrders
-func (mmGetOrders *mUsersRepositoryMockGetOrders) ExpectLimitParam3(limit uint64) *mUsersRepositoryMockGetOrders {
+// ExpectLimitParam4 sets up expected param limit for UsersRepository.GetOrders
+func (mmGetOrders *mUsersRepositoryMockGetOrders) ExpectLimitParam4(limit uint64) *mUsersRepositoryMockGetOrders {
 	if mmGetOrders.mock.funcGetOrders != nil {
 		mmGetOrders.mock.t.Fatalf("UsersRepositoryMock.GetOrders mock is already set by Set")
 	}
@@ -1651,7 +1802,7 @@ func (mmGetOrders *mUsersRepositoryMockGetOrders) ExpectLimitParam3(limit uint64
 }
 
 // Inspect accepts an inspector function that has same arguments as the UsersRepository.GetOrders
-func (mmGetOrders *mUsersRepositoryMockGetOrders) Inspect(f func(userID uint64, firstOrderID uint64, limit uint64)) *mUsersRepositoryMockGetOrders {
+func (mmGetOrders *mUsersRepositoryMockGetOrders) Inspect(f func(ctx context.Context, userID uint64, firstOrderID uint64, limit uint64)) *mUsersRepositoryMockGetOrders {
 	if mmGetOrders.mock.inspectFuncGetOrders != nil {
 		mmGetOrders.mock.t.Fatalf("Inspect function is already set for UsersRepositoryMock.GetOrders")
 	}
@@ -1676,7 +1827,7 @@ func (mmGetOrders *mUsersRepositoryMockGetOrders) Return(oa1 []domain.OrderView,
 }
 
 // Set uses given function f to mock the UsersRepository.GetOrders method
-func (mmGetOrders *mUsersRepositoryMockGetOrders) Set(f func(userID uint64, firstOrderID uint64, limit uint64) (oa1 []domain.OrderView, err error)) *UsersRepositoryMock {
+func (mmGetOrders *mUsersRepositoryMockGetOrders) Set(f func(ctx context.Context, userID uint64, firstOrderID uint64, limit uint64) (oa1 []domain.OrderView, err error)) *UsersRepositoryMock {
 	if mmGetOrders.defaultExpectation != nil {
 		mmGetOrders.mock.t.Fatalf("Default expectation is already set for the UsersRepository.GetOrders method")
 	}
@@ -1692,14 +1843,14 @@ func (mmGetOrders *mUsersRepositoryMockGetOrders) Set(f func(userID uint64, firs
 
 // When sets expectation for the UsersRepository.GetOrders which will trigger the result defined by the following
 // Then helper
-func (mmGetOrders *mUsersRepositoryMockGetOrders) When(userID uint64, firstOrderID uint64, limit uint64) *UsersRepositoryMockGetOrdersExpectation {
+func (mmGetOrders *mUsersRepositoryMockGetOrders) When(ctx context.Context, userID uint64, firstOrderID uint64, limit uint64) *UsersRepositoryMockGetOrdersExpectation {
 	if mmGetOrders.mock.funcGetOrders != nil {
 		mmGetOrders.mock.t.Fatalf("UsersRepositoryMock.GetOrders mock is already set by Set")
 	}
 
 	expectation := &UsersRepositoryMockGetOrdersExpectation{
 		mock:               mmGetOrders.mock,
-		params:             &UsersRepositoryMockGetOrdersParams{userID, firstOrderID, limit},
+		params:             &UsersRepositoryMockGetOrdersParams{ctx, userID, firstOrderID, limit},
 		expectationOrigins: UsersRepositoryMockGetOrdersExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmGetOrders.expectations = append(mmGetOrders.expectations, expectation)
@@ -1734,17 +1885,17 @@ func (mmGetOrders *mUsersRepositoryMockGetOrders) invocationsDone() bool {
 }
 
 // GetOrders implements mm_storage.UsersRepository
-func (mmGetOrders *UsersRepositoryMock) GetOrders(userID uint64, firstOrderID uint64, limit uint64) (oa1 []domain.OrderView, err error) {
+func (mmGetOrders *UsersRepositoryMock) GetOrders(ctx context.Context, userID uint64, firstOrderID uint64, limit uint64) (oa1 []domain.OrderView, err error) {
 	mm_atomic.AddUint64(&mmGetOrders.beforeGetOrdersCounter, 1)
 	defer mm_atomic.AddUint64(&mmGetOrders.afterGetOrdersCounter, 1)
 
 	mmGetOrders.t.Helper()
 
 	if mmGetOrders.inspectFuncGetOrders != nil {
-		mmGetOrders.inspectFuncGetOrders(userID, firstOrderID, limit)
+		mmGetOrders.inspectFuncGetOrders(ctx, userID, firstOrderID, limit)
 	}
 
-	mm_params := UsersRepositoryMockGetOrdersParams{userID, firstOrderID, limit}
+	mm_params := UsersRepositoryMockGetOrdersParams{ctx, userID, firstOrderID, limit}
 
 	// Record call args
 	mmGetOrders.GetOrdersMock.mutex.Lock()
@@ -1763,10 +1914,15 @@ func (mmGetOrders *UsersRepositoryMock) GetOrders(userID uint64, firstOrderID ui
 		mm_want := mmGetOrders.GetOrdersMock.defaultExpectation.params
 		mm_want_ptrs := mmGetOrders.GetOrdersMock.defaultExpectation.paramPtrs
 
-		mm_got := UsersRepositoryMockGetOrdersParams{userID, firstOrderID, limit}
+		mm_got := UsersRepositoryMockGetOrdersParams{ctx, userID, firstOrderID, limit}
 
 		if mm_want_ptrs != nil {
 
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetOrders.t.Errorf("UsersRepositoryMock.GetOrders got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetOrders.GetOrdersMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
 			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
 				mmGetOrders.t.Errorf("UsersRepositoryMock.GetOrders got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 					mmGetOrders.GetOrdersMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
@@ -1794,9 +1950,9 @@ func (mmGetOrders *UsersRepositoryMock) GetOrders(userID uint64, firstOrderID ui
 		return (*mm_results).oa1, (*mm_results).err
 	}
 	if mmGetOrders.funcGetOrders != nil {
-		return mmGetOrders.funcGetOrders(userID, firstOrderID, limit)
+		return mmGetOrders.funcGetOrders(ctx, userID, firstOrderID, limit)
 	}
-	mmGetOrders.t.Fatalf("Unexpected call to UsersRepositoryMock.GetOrders. %v %v %v", userID, firstOrderID, limit)
+	mmGetOrders.t.Fatalf("Unexpected call to UsersRepositoryMock.GetOrders. %v %v %v %v", ctx, userID, firstOrderID, limit)
 	return
 }
 
@@ -1894,12 +2050,14 @@ type UsersRepositoryMockRemoveOrderExpectation struct {
 
 // UsersRepositoryMockRemoveOrderParams contains parameters of the UsersRepository.RemoveOrder
 type UsersRepositoryMockRemoveOrderParams struct {
+	ctx     context.Context
 	userID  uint64
 	orderID uint64
 }
 
 // UsersRepositoryMockRemoveOrderParamPtrs contains pointers to parameters of the UsersRepository.RemoveOrder
 type UsersRepositoryMockRemoveOrderParamPtrs struct {
+	ctx     *context.Context
 	userID  *uint64
 	orderID *uint64
 }
@@ -1912,6 +2070,7 @@ type UsersRepositoryMockRemoveOrderResults struct {
 // UsersRepositoryMockRemoveOrderOrigins contains origins of expectations of the UsersRepository.RemoveOrder
 type UsersRepositoryMockRemoveOrderExpectationOrigins struct {
 	origin        string
+	originCtx     string
 	originUserID  string
 	originOrderID string
 }
@@ -1927,7 +2086,7 @@ func (mmRemoveOrder *mUsersRepositoryMockRemoveOrder) Optional() *mUsersReposito
 }
 
 // Expect sets up expected params for UsersRepository.RemoveOrder
-func (mmRemoveOrder *mUsersRepositoryMockRemoveOrder) Expect(userID uint64, orderID uint64) *mUsersRepositoryMockRemoveOrder {
+func (mmRemoveOrder *mUsersRepositoryMockRemoveOrder) Expect(ctx context.Context, userID uint64, orderID uint64) *mUsersRepositoryMockRemoveOrder {
 	if mmRemoveOrder.mock.funcRemoveOrder != nil {
 		mmRemoveOrder.mock.t.Fatalf("UsersRepositoryMock.RemoveOrder mock is already set by Set")
 	}
@@ -1940,7 +2099,7 @@ func (mmRemoveOrder *mUsersRepositoryMockRemoveOrder) Expect(userID uint64, orde
 		mmRemoveOrder.mock.t.Fatalf("UsersRepositoryMock.RemoveOrder mock is already set by ExpectParams functions")
 	}
 
-	mmRemoveOrder.defaultExpectation.params = &UsersRepositoryMockRemoveOrderParams{userID, orderID}
+	mmRemoveOrder.defaultExpectation.params = &UsersRepositoryMockRemoveOrderParams{ctx, userID, orderID}
 	mmRemoveOrder.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
 	for _, e := range mmRemoveOrder.expectations {
 		if minimock.Equal(e.params, mmRemoveOrder.defaultExpectation.params) {
@@ -1951,8 +2110,31 @@ func (mmRemoveOrder *mUsersRepositoryMockRemoveOrder) Expect(userID uint64, orde
 	return mmRemoveOrder
 }
 
-// ExpectUserIDParam1 sets up expected param userID for UsersRepository.RemoveOrder
-func (mmRemoveOrder *mUsersRepositoryMockRemoveOrder) ExpectUserIDParam1(userID uint64) *mUsersRepositoryMockRemoveOrder {
+// ExpectCtxParam1 sets up expected param ctx for UsersRepository.RemoveOrder
+func (mmRemoveOrder *mUsersRepositoryMockRemoveOrder) ExpectCtxParam1(ctx context.Context) *mUsersRepositoryMockRemoveOrder {
+	if mmRemoveOrder.mock.funcRemoveOrder != nil {
+		mmRemoveOrder.mock.t.Fatalf("UsersRepositoryMock.RemoveOrder mock is already set by Set")
+	}
+
+	if mmRemoveOrder.defaultExpectation == nil {
+		mmRemoveOrder.defaultExpectation = &UsersRepositoryMockRemoveOrderExpectation{}
+	}
+
+	if mmRemoveOrder.defaultExpectation.params != nil {
+		mmRemoveOrder.mock.t.Fatalf("UsersRepositoryMock.RemoveOrder mock is already set by Expect")
+	}
+
+	if mmRemoveOrder.defaultExpectation.paramPtrs == nil {
+		mmRemoveOrder.defaultExpectation.paramPtrs = &UsersRepositoryMockRemoveOrderParamPtrs{}
+	}
+	mmRemoveOrder.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemoveOrder.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemoveOrder
+}
+
+// ExpectUserIDParam2 sets up expected param userID for UsersRepository.RemoveOrder
+func (mmRemoveOrder *mUsersRepositoryMockRemoveOrder) ExpectUserIDParam2(userID uint64) *mUsersRepositoryMockRemoveOrder {
 	if mmRemoveOrder.mock.funcRemoveOrder != nil {
 		mmRemoveOrder.mock.t.Fatalf("UsersRepositoryMock.RemoveOrder mock is already set by Set")
 	}
@@ -1974,8 +2156,8 @@ func (mmRemoveOrder *mUsersRepositoryMockRemoveOrder) ExpectUserIDParam1(userID
 	return mmRemoveOrder
 }
 
-// ExpectOrderIDParam2 sets up expected param orderID for UsersRepository.RemoveOrder
-func (mmRemoveOrder *mUsersRepositoryMockRemoveOrder) ExpectOrderIDParam2(orderID uint64) *mUsersRepositoryMockRemoveOrder {
+// ExpectOrderIDParam3 sets up expected param orderID for UsersRepository.RemoveOrder
+func (mmRemoveOrder *mUsersRepositoryMockRemoveOrder) ExpectOrderIDParam3(orderID uint64) *mUsersRepositoryMockRemoveOrder {
 	if mmRemoveOrder.mock.funcRemoveOrder != nil {
 		mmRemoveOrder.mock.t.Fatalf("UsersRepositoryMock.RemoveOrder mock is already set by Set")
 	}
@@ -1998,7 +2180,7 @@ func (mmRemoveOrder *mUsersRepositoryMockRemoveOrder) ExpectOrderIDParam2(orderI
 }
 
 // Inspect accepts an inspector function that has same arguments as the UsersRepository.RemoveOrder
-func (mmRemoveOrder *mUsersRepositoryMockRemoveOrder) Inspect(f func(userID uint64, orderID uint64)) *mUsersRepositoryMockRemoveOrder {
+func (mmRemoveOrder *mUsersRepositoryMockRemoveOrder) Inspect(f func(ctx context.Context, userID uint64, orderID uint64)) *mUsersRepositoryMockRemoveOrder {
 	if mmRemoveOrder.mock.inspectFuncRemoveOrder != nil {
 		mmRemoveOrder.mock.t.Fatalf("Inspect function is already set for UsersRepositoryMock.RemoveOrder")
 	}
@@ -2023,7 +2205,7 @@ func (mmRemoveOrder *mUsersRepositoryMockRemoveOrder) Return(err error) *UsersRe
 }
 
 // Set uses given function f to mock the UsersRepository.RemoveOrder method
-func (mmRemoveOrder *mUsersRepositoryMockRemoveOrder) Set(f func(userID uint64, orderID uint64) (err error)) *UsersRepositoryMock {
+func (mmRemoveOrder *mUsersRepositoryMockRemoveOrder) Set(f func(ctx context.Context, userID uint64, orderID uint64) (err error)) *UsersRepositoryMock {
 	if mmRemoveOrder.defaultExpectation != nil {
 		mmRemoveOrder.mock.t.Fatalf("Default expectation is already set for the UsersRepository.RemoveOrder method")
 	}
@@ -2039,14 +2221,14 @@ func (mmRemoveOrder *mUsersRepositoryMockRemoveOrder) Set(f func(userID uint64,
 
 // When sets expectation for the UsersRepository.RemoveOrder which will trigger the result defined by the following
 // Then helper
-func (mmRemoveOrder *mUsersRepositoryMockRemoveOrder) When(userID uint64, orderID uint64) *UsersRepositoryMockRemoveOrderExpectation {
+func (mmRemoveOrder *mUsersRepositoryMockRemoveOrder) When(ctx context.Context, userID uint64, orderID uint64) *UsersRepositoryMockRemoveOrderExpectation {
 	if mmRemoveOrder.mock.funcRemoveOrder != nil {
 		mmRemoveOrder.mock.t.Fatalf("UsersRepositoryMock.RemoveOrder mock is already set by Set")
 	}
 
 	expectation := &UsersRepositoryMockRemoveOrderExpectation{
 		mock:               mmRemoveOrder.mock,
-		params:             &UsersRepositoryMockRemoveOrderParams{userID, orderID},
+		params:             &UsersRepositoryMockRemoveOrderParams{ctx, userID, orderID},
 		expectationOrigins: UsersRepositoryMockRemoveOrderExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
 	mmRemoveOrder.expectations = append(mmRemoveOrder.expectations, expectation)
@@ -2081,17 +2263,17 @@ func (mmRemoveOrder *mUsersRepositoryMockRemoveOrder) invocationsDone() bool {
 }
 
 // RemoveOrder implements mm_storage.UsersRepository
-func (mmRemoveOrder *UsersRepositoryMock) RemoveOrder(userID uint64, orderID uint64) (err error) {
+func (mmRemoveOrder *UsersRepositoryMock) RemoveOrder(ctx context.Context, userID uint64, orderID uint64) (err error) {
 	mm_atomic.AddUint64(&mmRemoveOrder.beforeRemoveOrderCounter, 1)
 	defer mm_atomic.AddUint64(&mmRemoveOrder.afterRemoveOrderCounter, 1)
 
 	mmRemoveOrder.t.Helper()
 
 	if mmRemoveOrder.inspectFuncRemoveOrder != nil {
-		mmRemoveOrder.inspectFuncRemoveOrder(userID, orderID)
+		mmRemoveOrder.inspectFuncRemoveOrder(ctx, userID, orderID)
 	}
 
-	mm_params := UsersRepositoryMockRemoveOrderParams{userID, orderID}
+	mm_params := UsersRepositoryMockRemoveOrderParams{ctx, userID, orderID}
 
 	// Record call args
 	mmRemoveOrder.RemoveOrderMock.mutex.Lock()
@@ -2110,10 +2292,15 @@ func (mmRemoveOrder *UsersRepositoryMock) RemoveOrder(userID uint64, orderID uin
 		mm_want := mmRemoveOrder.RemoveOrderMock.defaultExpectation.params
 		mm_want_ptrs := mmRemoveOrder.RemoveOrderMock.defaultExpectation.paramPtrs
 
-		mm_got := UsersRepositoryMockRemoveOrderParams{userID, orderID}
+		mm_got := UsersRepositoryMockRemoveOrderParams{ctx, userID, orderID}
 
 		if mm_want_ptrs != nil {
 
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmRemoveOrder.t.Errorf("UsersRepositoryMock.RemoveOrder got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemoveOrder.RemoveOrderMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
 			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
 				mmRemoveOrder.t.Errorf("UsersRepositoryMock.RemoveOrder got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
 					mmRemoveOrder.RemoveOrderMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
@@ -2136,9 +2323,9 @@ func (mmRemoveOrder *UsersRepositoryMock) RemoveOrder(userID uint64, orderID uin
 		return (*mm_results).err
 	}
 	if mmRemoveOrder.funcRemoveOrder != nil {
-		return mmRemoveOrder.funcRemoveOrder(userID, orderID)
+		return mmRemoveOrder.funcRemoveOrder(ctx, userID, orderID)
 	}
-	mmRemoveOrder.t.Fatalf("Unexpected call to UsersRepositoryMock.RemoveOrder. %v %v", userID, orderID)
+	mmRemoveOrder.t.Fatalf("Unexpected call to UsersRepositoryMock.RemoveOrder. %v %v %v", ctx, userID, orderID)
 	return
 }
 
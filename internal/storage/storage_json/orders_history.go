@@ -1,8 +1,13 @@
 package storage_json
 
 import (
+	"cmp"
+	"context"
+	"encoding/json"
 	"fmt"
+	"slices"
 	"sync"
+	"time"
 
 	"gitlab.ozon.dev/chppppr/homework/internal/domain"
 	"gitlab.ozon.dev/chppppr/homework/internal/utils"
@@ -10,65 +15,294 @@ import (
 
 type OrdersHistory struct {
 	Stat map[uint64]*domain.OrderStatus `json:"ordersHistory"`
-	mtx  sync.Mutex
+	// Reminders хранит для заказа минимальный этап записанного напоминания
+	Reminders map[uint64]uint64 `json:"reminders"`
+	// Pending - этап напоминания, которое записано, но ещё не отправлено
+	Pending map[uint64]uint64 `json:"pendingReminders"`
+	mtx     sync.Mutex
 }
 
 func NewOrdersHistory() *OrdersHistory {
-	return &OrdersHistory{Stat: make(map[uint64]*domain.OrderStatus)}
+	return &OrdersHistory{
+		Stat:      make(map[uint64]*domain.OrderStatus),
+		Reminders: make(map[uint64]uint64),
+		Pending:   make(map[uint64]uint64),
+	}
 }
 
-func (s *OrdersHistory) AddOrderStatus(orderID, userID uint64, status string, order *domain.Order) error {
+func (s *OrdersHistory) AddOrderStatus(ctx context.Context, orderID, userID uint64, status string, order *domain.Order) error {
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
 
 	stat, ok := s.Stat[orderID]
 	if ok {
-		return fmt.Errorf("order %d has already been %s", orderID, stat.Status)
+		return fmt.Errorf("order %d has already been %s: %w", orderID, stat.Status, domain.ErrAlreadyExist)
 	}
 
-	s.Stat[orderID] = &domain.OrderStatus{
-		Order:     order,
-		Status:    status,
-		UpdatedAt: utils.CurrentDateString(),
-		UserID:    userID,
+	s.Stat[orderID] = newOrderStatus(userID, status, order)
+	return nil
+}
+
+// UnmarshalJSON читает историю из снапшота. В записях старого формата нет версии,
+// им назначается начальная версия 1, как у новых заказов и в postgres
+func (s *OrdersHistory) UnmarshalJSON(data []byte) error {
+	type ordersHistory OrdersHistory
+	if err := json.Unmarshal(data, (*ordersHistory)(s)); err != nil {
+		return err
 	}
 
+	for _, stat := range s.Stat {
+		if stat.Version == 0 {
+			stat.Version = 1
+		}
+	}
 	return nil
 }
 
-func (s *OrdersHistory) GetOrderOnlyStatus(orderID uint64) (stat string, err error) {
+func newOrderStatus(userID uint64, status string, order *domain.Order) *domain.OrderStatus {
+	return &domain.OrderStatus{
+		Order:      order,
+		Status:     status,
+		AcceptedAt: utils.CurrentDateString(),
+		UpdatedAt:  utils.CurrentDateString(),
+		UserID:     userID,
+		Version:    1,
+	}
+}
+
+// withStatus возвращает копию статуса заказа после смены статуса. Прежняя запись не меняется,
+// поэтому её можно читать без мьютекса
+func withStatus(stat *domain.OrderStatus, status string) *domain.OrderStatus {
+	copied := *stat
+	copied.Status = status
+	copied.UpdatedAt = utils.CurrentDateString()
+	copied.Version++
+	return &copied
+}
+
+func (s *OrdersHistory) GetOrderOnlyStatus(ctx context.Context, orderID uint64) (stat string, err error) {
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
 
 	status, ok := s.Stat[orderID]
 	if !ok {
-		return "", fmt.Errorf("order %d not found", orderID)
+		return "", fmt.Errorf("order %d: %w", orderID, domain.ErrNotFound)
 	}
 
 	return status.Status, nil
 }
 
-func (s *OrdersHistory) GetOrderStatus(orderID uint64) (*domain.OrderStatus, error) {
+func (s *OrdersHistory) GetOrderStatus(ctx context.Context, orderID uint64) (*domain.OrderStatus, error) {
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
 
 	status, ok := s.Stat[orderID]
 	if !ok {
-		return nil, fmt.Errorf("order %d not found", orderID)
+		return nil, fmt.Errorf("order %d: %w", orderID, domain.ErrNotFound)
 	}
 
 	return status, nil
 }
 
-func (s *OrdersHistory) SetOrderStatus(orderID uint64, status string) error {
+func (s *OrdersHistory) SetOrderStatus(ctx context.Context, orderID uint64, status string) error {
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
 
 	order, ok := s.Stat[orderID]
 	if !ok {
-		return fmt.Errorf("order %d not found", orderID)
+		return fmt.Errorf("order %d: %w", orderID, domain.ErrNotFound)
+	}
+
+	s.Stat[orderID] = withStatus(order, status)
+	return nil
+}
+
+func (s *OrdersHistory) RestoreOrderStatus(orderID uint64, stat *domain.OrderStatus) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	s.Stat[orderID] = stat
+}
+
+func isExpired(order *domain.OrderStatus, expiredBefore time.Time) (bool, error) {
+	if order.Status != domain.StatusAccepted {
+		return false, nil
+	}
+
+	expDate, err := utils.StringToTime(order.ExpirationDate)
+	if err != nil {
+		return false, fmt.Errorf("error while parsing Expiration Date: %w", err)
+	}
+
+	return expDate.Before(expiredBefore), nil
+}
+
+func (s *OrdersHistory) ExpireOrders(ctx context.Context, expiredBefore time.Time) ([]uint64, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	orders := make([]uint64, 0)
+	for orderID, order := range s.Stat {
+		expired, err := isExpired(order, expiredBefore)
+		if err != nil {
+			return nil, err
+		}
+
+		if expired {
+			s.Stat[orderID] = withStatus(order, domain.StatusExpired)
+			orders = append(orders, orderID)
+		}
+	}
+
+	slices.Sort(orders)
+	return orders, nil
+}
+
+func (s *OrdersHistory) GetOrdersCountByStatus(ctx context.Context, status string) (uint64, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	count := uint64(0)
+	for _, order := range s.Stat {
+		if order.Status == status {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (s *OrdersHistory) isReminded(orderID, stage uint64) bool {
+	sent, ok := s.Reminders[orderID]
+	return ok && sent <= stage
+}
+
+func (s *OrdersHistory) needRemind(orderID, stage uint64, expiresFrom, expiresTo time.Time) (bool, error) {
+	order := s.Stat[orderID]
+	if order.Status != domain.StatusAccepted || s.isReminded(orderID, stage) {
+		return false, nil
+	}
+
+	expDate, err := utils.StringToTime(order.ExpirationDate)
+	if err != nil {
+		return false, fmt.Errorf("error while parsing Expiration Date: %w", err)
+	}
+
+	return !expDate.Before(expiresFrom) && !expDate.After(expiresTo), nil
+}
+
+func (s *OrdersHistory) GetOrdersToRemind(ctx context.Context, stage uint64, expiresFrom, expiresTo time.Time) ([]domain.OrderView, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	orders := make([]domain.OrderView, 0)
+	for orderID, order := range s.Stat {
+		remind, err := s.needRemind(orderID, stage, expiresFrom, expiresTo)
+		if err != nil {
+			return nil, err
+		}
+
+		if remind {
+			orders = append(orders, domain.OrderView{
+				Order:   order.Order,
+				UserID:  order.UserID,
+				OrderID: orderID,
+				Version: order.Version,
+			})
+		}
+	}
+
+	slices.SortFunc(orders, func(a, b domain.OrderView) int {
+		return cmp.Compare(a.OrderID, b.OrderID)
+	})
+	return orders, nil
+}
+
+func (s *OrdersHistory) AddReminders(ctx context.Context, stage uint64, ordersID []uint64) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	if s.Reminders == nil {
+		s.Reminders = make(map[uint64]uint64)
+	}
+	if s.Pending == nil {
+		s.Pending = make(map[uint64]uint64)
+	}
+
+	for _, orderID := range ordersID {
+		if !s.isReminded(orderID, stage) {
+			s.Reminders[orderID] = stage
+			s.Pending[orderID] = stage
+		}
+	}
+
+	return nil
+}
+
+// GetPendingReminders возвращает принятые заказы, напоминание этапа stage по которым записано, но не отправлено
+func (s *OrdersHistory) GetPendingReminders(ctx context.Context, stage uint64) ([]domain.OrderView, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	orders := make([]domain.OrderView, 0)
+	for orderID, pending := range s.Pending {
+		order, ok := s.Stat[orderID]
+		if pending != stage || !ok || order.Status != domain.StatusAccepted {
+			continue
+		}
+
+		orders = append(orders, domain.OrderView{
+			Order:   order.Order,
+			UserID:  order.UserID,
+			OrderID: orderID,
+			Version: order.Version,
+		})
+	}
+
+	slices.SortFunc(orders, func(a, b domain.OrderView) int {
+		return cmp.Compare(a.OrderID, b.OrderID)
+	})
+	return orders, nil
+}
+
+func (s *OrdersHistory) MarkRemindersSent(ctx context.Context, stage uint64, ordersID []uint64) error {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for _, orderID := range ordersID {
+		if pending, ok := s.Pending[orderID]; ok && pending == stage {
+			delete(s.Pending, orderID)
+		}
 	}
 
-	order.Status = status
 	return nil
 }
+
+func (s *OrdersHistory) GetReminders(ordersID []uint64) []domain.Reminder {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	reminders := make([]domain.Reminder, 0)
+	for _, orderID := range ordersID {
+		if stage, ok := s.Reminders[orderID]; ok {
+			reminders = append(reminders, domain.Reminder{OrderID: orderID, Stage: stage})
+		}
+	}
+	return reminders
+}
+
+func (s *OrdersHistory) PseudonymizeUser(userID, pseudonym uint64) []uint64 {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	orders := make([]uint64, 0)
+	for orderID, stat := range s.Stat {
+		if stat.UserID == userID {
+			stat.UserID = pseudonym
+			orders = append(orders, orderID)
+		}
+	}
+
+	slices.Sort(orders)
+	return orders
+}
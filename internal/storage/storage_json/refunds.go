@@ -1,7 +1,10 @@
 package storage_json
 
 import (
+	"cmp"
+	"context"
 	"fmt"
+	"slices"
 	"sync"
 
 	"gitlab.ozon.dev/chppppr/homework/internal/domain"
@@ -21,10 +24,14 @@ func NewRefunds() *Refunds {
 	}
 }
 
-func (r *Refunds) AddRefund(userID, orderID uint64, order *domain.Order) (err error) {
+func (r *Refunds) AddRefund(ctx context.Context, userID, orderID uint64, order *domain.Order) (err error) {
 	r.mtx.Lock()
 	defer r.mtx.Unlock()
 
+	if id, ok := r.OrdersIDatArray[orderID]; ok && r.Orders[id].Exist {
+		return fmt.Errorf("refund %d: %w", orderID, domain.ErrAlreadyExist)
+	}
+
 	r.Orders = append(r.Orders, domain.OrderView{
 		Order:   order,
 		UserID:  userID,
@@ -36,54 +43,78 @@ func (r *Refunds) AddRefund(userID, orderID uint64, order *domain.Order) (err er
 	return nil
 }
 
-func (r *Refunds) RemoveRefund(orderID uint64) error {
+func (r *Refunds) RemoveRefund(ctx context.Context, orderID uint64) error {
 	r.mtx.Lock()
 	defer r.mtx.Unlock()
 
 	id, ok := r.OrdersIDatArray[orderID]
-	if !ok {
-		return fmt.Errorf("not found order %d at refunded", orderID)
+	if !ok || !r.Orders[id].Exist {
+		return fmt.Errorf("refund %d: %w", orderID, domain.ErrNotFound)
 	}
 
 	r.Orders[id].Exist = false
 	return nil
 }
 
-func (r *Refunds) GetRefunds(pageID, ordersPerPage uint64) (res []domain.OrderView, err error) {
+func (r *Refunds) HasRefund(orderID uint64) bool {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	id, ok := r.OrdersIDatArray[orderID]
+	return ok && r.Orders[id].Exist
+}
+
+func (r *Refunds) GetRefunds(ctx context.Context, pageID, ordersPerPage uint64) (res []domain.OrderView, err error) {
 	if err := r.getRefundsCheckErr(pageID, ordersPerPage); err != nil {
 		return nil, err
 	}
 
-	firstOrderID := int((pageID - 1) * ordersPerPage)
-	return r.getRefundsSlice(firstOrderID, ordersPerPage), nil
+	refunds := r.existing()
+	first := min(uint64(len(refunds)), (pageID-1)*ordersPerPage)
+	last := min(uint64(len(refunds)), first+ordersPerPage)
+	return refunds[first:last], nil
 }
 
-func (r *Refunds) getRefundsSlice(firstOrderID int, ordersPerPage uint64) []domain.OrderView {
-	res := make([]domain.OrderView, 0)
-	ordersCount := 0
-
-	for i := firstOrderID; i < len(r.Orders); i++ {
-		if r.Orders[i].Exist {
-			res = append(res, r.Orders[i])
-			ordersCount++
-		}
+// existing возвращает текущие возвраты в порядке номеров заказов, как в refunds из postgres
+func (r *Refunds) existing() []domain.OrderView {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
 
-		if ordersCount == int(ordersPerPage) {
-			break
+	res := make([]domain.OrderView, 0, len(r.OrdersIDatArray))
+	for _, id := range r.OrdersIDatArray {
+		if r.Orders[id].Exist {
+			res = append(res, r.Orders[id])
 		}
 	}
 
+	slices.SortFunc(res, func(a, b domain.OrderView) int {
+		return cmp.Compare(a.OrderID, b.OrderID)
+	})
 	return res
 }
 
 func (r *Refunds) getRefundsCheckErr(pageID, ordersPerPage uint64) error {
 	if ordersPerPage == 0 {
-		return fmt.Errorf("orders per page must be greater than 0")
+		return fmt.Errorf("orders per page must be greater than 0: %w", domain.ErrWrongInput)
 	}
 
 	if pageID == 0 {
-		return fmt.Errorf("pageID myst be greater than 0")
+		return fmt.Errorf("pageID must be greater than 0: %w", domain.ErrWrongInput)
 	}
 
 	return nil
 }
+
+func (r *Refunds) PseudonymizeUser(userID, pseudonym uint64) []uint64 {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	orders := make([]uint64, 0)
+	for i := range r.Orders {
+		if r.Orders[i].UserID == userID {
+			r.Orders[i].UserID = pseudonym
+			orders = append(orders, r.Orders[i].OrderID)
+		}
+	}
+	return orders
+}
@@ -0,0 +1,79 @@
+package storage_json
+
+import (
+	"context"
+	"slices"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+)
+
+type searchItem struct {
+	record domain.OrderRecord
+	pos    domain.SearchCursor
+}
+
+func newRecord(orderID uint64, stat *domain.OrderStatus) domain.OrderRecord {
+	return domain.OrderRecord{
+		Order:      stat.Order,
+		OrderID:    orderID,
+		UserID:     stat.UserID,
+		Status:     stat.Status,
+		AcceptedAt: stat.AcceptedAt,
+		UpdatedAt:  stat.UpdatedAt,
+		Version:    stat.Version,
+	}
+}
+
+// match возвращает запись заказа, если она подходит под фильтр и идёт после курсора
+func match(orderID uint64, stat *domain.OrderStatus, filter *domain.OrderFilter) (*searchItem, error) {
+	item := &searchItem{record: newRecord(orderID, stat)}
+	if !filter.Match(&item.record) {
+		return nil, nil
+	}
+
+	key, err := item.record.SortKey(filter.SortBy)
+	if err != nil {
+		return nil, err
+	}
+
+	item.pos = domain.SearchCursor{Value: key, OrderID: orderID}
+	if !filter.IsAfter(item.pos) {
+		return nil, nil
+	}
+	return item, nil
+}
+
+func (s *OrdersHistory) collect(filter *domain.OrderFilter) ([]searchItem, error) {
+	items := make([]searchItem, 0)
+	for orderID, stat := range s.Stat {
+		item, err := match(orderID, stat, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		if item != nil {
+			items = append(items, *item)
+		}
+	}
+	return items, nil
+}
+
+func (s *OrdersHistory) SearchOrders(ctx context.Context, filter *domain.OrderFilter) ([]domain.OrderRecord, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	items, err := s.collect(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(items, func(a, b searchItem) int {
+		return filter.Compare(a.pos, b.pos)
+	})
+
+	orders := make([]domain.OrderRecord, 0, min(uint64(len(items)), filter.Limit))
+	for i := 0; i < len(items) && uint64(i) < filter.Limit; i++ {
+		orders = append(orders, items[i].record)
+	}
+	return orders, nil
+}
@@ -1,35 +1,60 @@
 package storage_json
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"math"
 	"os"
+	"sync"
 	"time"
 
 	"gitlab.ozon.dev/chppppr/homework/internal/domain"
 	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
 )
 
 type UsersRepository interface {
-	AddOrder(userID, orderID uint64, order *domain.Order) error
-	GetOrder(userID, orderID uint64) (*domain.Order, error)
-	CanRemove(userID, orderID uint64) error
-	RemoveOrder(userID, orderID uint64) error
-	GetExpirationDate(userID, orderID uint64) (time.Time, error)
-	GetOrders(userID, firstOrderID, limit uint64) ([]domain.OrderView, error)
+	AddOrder(ctx context.Context, userID, orderID uint64, order *domain.Order) error
+	GetOrder(ctx context.Context, userID, orderID uint64) (*domain.Order, error)
+	CanRemove(ctx context.Context, userID, orderID uint64) error
+	RemoveOrder(ctx context.Context, userID, orderID uint64) error
+	GetExpirationDate(ctx context.Context, userID, orderID uint64) (time.Time, error)
+	GetOrders(ctx context.Context, userID, firstOrderID, limit uint64) ([]domain.OrderView, error)
 }
 
-type Storage struct {
-	Ohp   storage.OrdersHistoryRepository `json:"historyRepository"`
-	Rp    storage.RefundsRepository       `json:"refundsRepository"`
-	Users UsersRepository                 `json:"usersRepository"`
+// OrdersHistoryRepository - история статусов, в которую журнал записывает статусы как есть
+type OrdersHistoryRepository interface {
+	storage.OrdersHistoryRepository
+	RestoreOrderStatus(orderID uint64, stat *domain.OrderStatus)
+}
 
-	path string `json:"-"`
+// RefundsRepository позволяет проверить возврат до записи в журнал
+type RefundsRepository interface {
+	storage.RefundsRepository
+	HasRefund(orderID uint64) bool
+}
+
+// Storage хранит данные в памяти. Каждое изменение дописывается в журнал path.wal,
+// а журнал периодически сжимается в снапшот path
+type Storage struct {
+	Ohp   OrdersHistoryRepository `json:"historyRepository"`
+	Rp    RefundsRepository       `json:"refundsRepository"`
+	Users UsersRepository         `json:"usersRepository"`
+	// LastSeq - номер последней записи журнала, вошедшей в снапшот
+	LastSeq uint64 `json:"lastSeq"`
+
+	path string     `json:"-"`
+	mu   sync.Mutex `json:"-"`
+	// wmu упорядочивает изменения и снапшоты
+	wmu sync.Mutex `json:"-"`
+	wal *wal       `json:"-"`
 }
 
 func NewStorage(
-	ohp storage.OrdersHistoryRepository,
-	rp storage.RefundsRepository,
+	ohp OrdersHistoryRepository,
+	rp RefundsRepository,
 	up UsersRepository,
 	path string,
 ) (*Storage, error) {
@@ -45,107 +70,328 @@ func NewStorage(
 		return nil, err
 	}
 
+	if err = storage.recover(); err != nil {
+		return nil, err
+	}
+
 	return storage, nil
 }
 
-func (s *Storage) readDataFromFile() (err error) {
-	file, err := os.OpenFile(s.path, os.O_RDWR, 0666)
-	if err != nil {
-		file, err = os.Create(s.path)
-		file.WriteString("{}")
-		return
+func (s *Storage) readDataFromFile() error {
+	file, err := os.Open(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return writeFileAtomic(s.path, s)
+	} else if err != nil {
+		return err
 	}
 	defer file.Close()
 
-	decoder := json.NewDecoder(file)
-	err = decoder.Decode(&s)
-	return
+	return json.NewDecoder(file).Decode(&s)
 }
 
-func (s *Storage) Save() (err error) {
-	file, err := os.OpenFile(s.path, os.O_RDWR|os.O_TRUNC, 0666)
-	if err != nil {
-		return
+// Save атомарно записывает снапшот и очищает журнал
+func (s *Storage) Save() error {
+	s.wmu.Lock()
+	defer s.wmu.Unlock()
+
+	return s.save()
+}
+
+// save вызывается под s.wmu: изменений в памяти нет, поэтому репозитории
+// кодируются без их мьютексов и снапшот не застаёт изменение наполовину
+func (s *Storage) save() error {
+	if s.wal == nil {
+		return writeFileAtomic(s.path, s)
 	}
-	defer file.Close()
 
-	encoder := json.NewEncoder(file)
-	err = encoder.Encode(s)
-	return
+	s.wal.mtx.Lock()
+	defer s.wal.mtx.Unlock()
+
+	s.LastSeq = s.wal.seq
+	if err := writeFileAtomic(s.path, s); err != nil {
+		return err
+	}
+
+	return s.wal.reset()
+}
+
+func (s *Storage) Close() error {
+	if s.wal == nil {
+		return nil
+	}
+
+	return s.wal.file.Close()
 }
 
-func (s *Storage) AddOrderStatus(orderID, userID uint64, status string, order *domain.Order) error {
-	return s.Ohp.AddOrderStatus(orderID, userID, status, order)
+// RunInTx сериализует транзакции: хранилище однопроцессное, поэтому мьютекса достаточно.
+// Отката нет: каждое изменение сразу пишется в журнал и остаётся, даже если fn вернула ошибку
+func (s *Storage) RunInTx(ctx context.Context, fn func(ctxTx context.Context) error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return fn(ctx)
 }
 
-func (s *Storage) GetOrderOnlyStatus(orderID uint64) (stat string, err error) {
-	return s.Ohp.GetOrderOnlyStatus(orderID)
+// RunInSnapshot выполняет fn под мьютексом транзакций: изменения через RunInTx ждут её окончания
+func (s *Storage) RunInSnapshot(ctx context.Context, fn func(ctxTx context.Context) error) error {
+	return s.RunInTx(ctx, fn)
 }
 
-func (s *Storage) GetOrderStatus(orderID uint64) (*domain.OrderStatus, error) {
-	return s.Ohp.GetOrderStatus(orderID)
+func (s *Storage) GetOrderStatusForUpdate(ctx context.Context, orderID uint64) (*domain.OrderStatus, error) {
+	return s.Ohp.GetOrderStatus(ctx, orderID)
 }
 
-func (s *Storage) SetOrderStatus(orderID uint64, status string) error {
-	return s.Ohp.SetOrderStatus(orderID, status)
+func (s *Storage) AddOrderStatus(ctx context.Context, orderID, userID uint64, status string, order *domain.Order) error {
+	return s.change(ctx, func() ([]walRecord, error) {
+		rec, err := s.newStatus(ctx, orderID, userID, status, order)
+		return []walRecord{rec}, err
+	})
 }
 
-func (s *Storage) AddRefund(userID, orderID uint64, order *domain.Order) error {
-	if err := s.Rp.AddRefund(userID, orderID, order); err != nil {
-		return err
+func (s *Storage) newStatus(ctx context.Context, orderID, userID uint64, status string, order *domain.Order) (walRecord, error) {
+	if stat, err := s.Ohp.GetOrderStatus(ctx, orderID); err == nil {
+		return walRecord{}, fmt.Errorf("order %d has already been %s: %w", orderID, stat.Status, domain.ErrAlreadyExist)
 	}
 
-	return s.SetOrderStatus(orderID, domain.StatusReturned)
+	return statusRecord(orderID, newOrderStatus(userID, status, order)), nil
 }
 
-func (s *Storage) RemoveRefund(orderID uint64) error {
-	if err := s.Rp.RemoveRefund(orderID); err != nil {
-		return err
+func (s *Storage) GetOrderOnlyStatus(ctx context.Context, orderID uint64) (stat string, err error) {
+	return s.Ohp.GetOrderOnlyStatus(ctx, orderID)
+}
+
+func (s *Storage) GetOrderStatus(ctx context.Context, orderID uint64) (*domain.OrderStatus, error) {
+	return s.Ohp.GetOrderStatus(ctx, orderID)
+}
+
+func (s *Storage) SetOrderStatus(ctx context.Context, orderID uint64, status string) error {
+	return s.change(ctx, func() ([]walRecord, error) {
+		rec, err := s.changedStatus(ctx, orderID, status)
+		return []walRecord{rec}, err
+	})
+}
+
+func (s *Storage) changedStatus(ctx context.Context, orderID uint64, status string) (walRecord, error) {
+	stat, err := s.Ohp.GetOrderStatus(ctx, orderID)
+	if err != nil {
+		return walRecord{}, err
 	}
 
-	return s.SetOrderStatus(orderID, domain.StatusGiveCourier)
+	return statusRecord(orderID, withStatus(stat, status)), nil
 }
 
-func (s *Storage) GetRefunds(pageID, ordersPerPage uint64) ([]domain.OrderView, error) {
-	return s.Rp.GetRefunds(pageID, ordersPerPage)
+func (s *Storage) ExpireOrders(ctx context.Context, expiredBefore time.Time) ([]uint64, error) {
+	orders := make([]uint64, 0)
+	err := s.change(ctx, func() ([]walRecord, error) {
+		records, err := s.expired(ctx, expiredBefore)
+		for _, rec := range records {
+			orders = append(orders, rec.OrderID)
+		}
+		return records, err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return orders, nil
 }
 
-func (s *Storage) AddOrder(userID, orderID uint64, order *domain.Order) error {
-	stat, err := s.GetOrderStatus(orderID)
-	if err == nil {
-		return fmt.Errorf("order %d has already been %s", orderID, stat.Status)
+// expired собирает статусы просроченных заказов по возрастанию номера
+func (s *Storage) expired(ctx context.Context, expiredBefore time.Time) ([]walRecord, error) {
+	accepted, err := s.Ohp.SearchOrders(ctx, &domain.OrderFilter{
+		Statuses: []string{domain.StatusAccepted},
+		SortBy:   domain.SortByOrderID,
+		Limit:    math.MaxUint64,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	records := make([]walRecord, 0)
+	for i := range accepted {
+		rec, err := expiredRecord(&accepted[i], expiredBefore)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, rec...)
 	}
 
-	err = s.Users.AddOrder(userID, orderID, order)
+	return records, nil
+}
+
+func expiredRecord(r *domain.OrderRecord, expiredBefore time.Time) ([]walRecord, error) {
+	stat := r.OrderStatus()
+	if expired, err := isExpired(stat, expiredBefore); err != nil || !expired {
+		return nil, err
+	}
+
+	return []walRecord{statusRecord(r.OrderID, withStatus(stat, domain.StatusExpired))}, nil
+}
+
+func (s *Storage) GetOrdersCountByStatus(ctx context.Context, status string) (uint64, error) {
+	return s.Ohp.GetOrdersCountByStatus(ctx, status)
+}
+
+func (s *Storage) GetOrdersToRemind(ctx context.Context, stage uint64, expiresFrom, expiresTo time.Time) ([]domain.OrderView, error) {
+	return s.Ohp.GetOrdersToRemind(ctx, stage, expiresFrom, expiresTo)
+}
+
+func (s *Storage) AddReminders(ctx context.Context, stage uint64, ordersID []uint64) error {
+	return s.change(ctx, func() ([]walRecord, error) {
+		return []walRecord{{Op: opAddReminders, Stage: stage, OrdersID: ordersID}}, nil
+	})
+}
+
+func (s *Storage) GetPendingReminders(ctx context.Context, stage uint64) ([]domain.OrderView, error) {
+	return s.Ohp.GetPendingReminders(ctx, stage)
+}
+
+func (s *Storage) MarkRemindersSent(ctx context.Context, stage uint64, ordersID []uint64) error {
+	return s.change(ctx, func() ([]walRecord, error) {
+		return []walRecord{{Op: opRemindersSent, Stage: stage, OrdersID: ordersID}}, nil
+	})
+}
+
+func (s *Storage) SearchOrders(ctx context.Context, filter *domain.OrderFilter) ([]domain.OrderRecord, error) {
+	return s.Ohp.SearchOrders(ctx, filter)
+}
+
+func (s *Storage) AddRefund(ctx context.Context, userID, orderID uint64, order *domain.Order) error {
+	return s.change(ctx, func() ([]walRecord, error) {
+		rec, err := s.refundRecord(orderID, false)
+		if err != nil {
+			return nil, err
+		}
+
+		stat, err := s.changedStatus(ctx, orderID, domain.StatusReturned)
+		rec.UserID, rec.Order = userID, order
+		return []walRecord{rec, stat}, err
+	})
+}
+
+func (s *Storage) RemoveRefund(ctx context.Context, orderID uint64) error {
+	return s.change(ctx, func() ([]walRecord, error) {
+		rec, err := s.refundRecord(orderID, true)
+		if err != nil {
+			return nil, err
+		}
+
+		stat, err := s.changedStatus(ctx, orderID, domain.StatusGiveCourier)
+		return []walRecord{rec, stat}, err
+	})
+}
+
+// refundRecord проверяет, что возврат есть (remove) или его нет, до записи в журнал
+func (s *Storage) refundRecord(orderID uint64, remove bool) (walRecord, error) {
+	switch exist := s.Rp.HasRefund(orderID); {
+	case remove && !exist:
+		return walRecord{}, fmt.Errorf("refund %d: %w", orderID, domain.ErrNotFound)
+	case remove:
+		return walRecord{Op: opRemoveRefund, OrderID: orderID}, nil
+	case exist:
+		return walRecord{}, fmt.Errorf("refund %d: %w", orderID, domain.ErrAlreadyExist)
+	default:
+		return walRecord{Op: opAddRefund, OrderID: orderID}, nil
+	}
+}
+
+// withVersions дополняет заказы версиями из истории статусов
+func (s *Storage) withVersions(ctx context.Context, orders []domain.OrderView) []domain.OrderView {
+	for i := range orders {
+		if stat, err := s.Ohp.GetOrderStatus(ctx, orders[i].OrderID); err == nil {
+			orders[i].Version = stat.Version
+		}
+	}
+	return orders
+}
+
+func (s *Storage) GetRefunds(ctx context.Context, pageID, ordersPerPage uint64) ([]domain.OrderView, error) {
+	orders, err := s.Rp.GetRefunds(ctx, pageID, ordersPerPage)
 	if err != nil {
-		return err
+		return nil, err
 	}
+	return s.withVersions(ctx, orders), nil
+}
+
+func (s *Storage) AddOrder(ctx context.Context, userID, orderID uint64, order *domain.Order) error {
+	return s.change(ctx, func() ([]walRecord, error) {
+		stat, err := s.newStatus(ctx, orderID, userID, domain.StatusAccepted, order)
+		if err != nil {
+			return nil, err
+		}
 
-	return s.AddOrderStatus(orderID, userID, domain.StatusAccepted, order)
+		held, err := s.heldRecord(ctx, userID, orderID, order)
+		return []walRecord{held, stat}, err
+	})
 }
 
-func (s *Storage) GetOrder(userID, orderID uint64) (*domain.Order, error) {
-	return s.Users.GetOrder(userID, orderID)
+// heldRecord собирает запись заказа, который кладётся на хранение в ПВЗ
+func (s *Storage) heldRecord(ctx context.Context, userID, orderID uint64, order *domain.Order) (walRecord, error) {
+	if _, err := s.Users.GetOrder(ctx, userID, orderID); err == nil {
+		return walRecord{}, fmt.Errorf("order %d has already accepted: %w", orderID, domain.ErrAlreadyExist)
+	}
+
+	return walRecord{Op: opAddUserOrder, UserID: userID, OrderID: orderID, Order: order}, nil
 }
 
-func (s *Storage) GetExpirationDate(userID, orderID uint64) (time.Time, error) {
-	return s.Users.GetExpirationDate(userID, orderID)
+// userOrder ищет заказ клиента в истории статусов, поэтому выданные заказы тоже находятся
+func (s *Storage) userOrder(ctx context.Context, userID, orderID uint64) (*domain.OrderStatus, error) {
+	stat, err := s.Ohp.GetOrderStatus(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if stat.UserID != userID {
+		return nil, fmt.Errorf("user %d doesn't have order %d: %w", userID, orderID, domain.ErrNotFound)
+	}
+	return stat, nil
 }
 
-func (s *Storage) GetOrdersByUserID(userID, firstOrderID, limit uint64) ([]domain.OrderView, error) {
-	return s.Users.GetOrders(userID, firstOrderID, limit)
+func (s *Storage) GetOrder(ctx context.Context, userID, orderID uint64) (*domain.Order, error) {
+	stat, err := s.userOrder(ctx, userID, orderID)
+	if err != nil {
+		return nil, err
+	}
+	return stat.Order, nil
+}
+
+func (s *Storage) GetExpirationDate(ctx context.Context, userID, orderID uint64) (time.Time, error) {
+	stat, err := s.userOrder(ctx, userID, orderID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return utils.StringToTime(stat.ExpirationDate)
+}
+
+// GetOrdersByUserID возвращает заказы клиента с номером не меньше firstOrderID по возрастанию номера
+func (s *Storage) GetOrdersByUserID(ctx context.Context, userID, firstOrderID, limit uint64) ([]domain.OrderView, error) {
+	filter := &domain.OrderFilter{UserID: userID, SortBy: domain.SortByOrderID, Limit: limit}
+	if firstOrderID > 0 {
+		filter.After = &domain.SearchCursor{Value: firstOrderID - 1, OrderID: firstOrderID - 1}
+	}
+
+	records, err := s.Ohp.SearchOrders(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]domain.OrderView, 0, len(records))
+	for _, r := range records {
+		orders = append(orders, domain.OrderView{Order: r.Order, UserID: r.UserID, OrderID: r.OrderID, Version: r.Version})
+	}
+	return orders, nil
 }
 
 func canRemoveOrderCheckStatus(status string, orderID uint64) error {
 	if status == domain.StatusGiveClient || status == domain.StatusGiveCourier {
-		return fmt.Errorf("order %d has already been %s", orderID, domain.StatusGiveClient)
+		return fmt.Errorf("order %d has already been %s: %w", orderID, domain.StatusGiveClient, domain.ErrAlreadyExist)
 	}
 
 	return nil
 }
 
-func (s *Storage) CanRemoveOrder(orderID uint64) error {
-	stat, err := s.GetOrderStatus(orderID)
+func (s *Storage) CanRemoveOrder(ctx context.Context, orderID uint64) error {
+	stat, err := s.GetOrderStatus(ctx, orderID)
 	if err != nil {
 		return err
 	}
@@ -154,25 +400,105 @@ func (s *Storage) CanRemoveOrder(orderID uint64) error {
 		return err
 	}
 
-	return s.Users.CanRemove(stat.UserID, orderID)
+	return s.Users.CanRemove(ctx, stat.UserID, orderID)
 }
 
-// Использовать только перед вызовом CanRemoveOrder!!!
-func (s *Storage) RemoveOrder(orderID uint64, status string) error {
-	stat, _ := s.GetOrderStatus(orderID)
+func (s *Storage) RemoveOrder(ctx context.Context, orderID uint64, status string) error {
+	return s.change(ctx, func() ([]walRecord, error) {
+		return s.removeRecords(ctx, orderID, status)
+	})
+}
 
-	err := s.Users.RemoveOrder(stat.UserID, orderID)
-	if err != nil {
-		return err
+// RemoveOrders проверяет все заказы до записи в журнал, поэтому при ошибке ничего не меняется
+func (s *Storage) RemoveOrders(ctx context.Context, ordersID []uint64, status string) error {
+	return s.change(ctx, func() ([]walRecord, error) {
+		if err := checkUnique(ordersID); err != nil {
+			return nil, err
+		}
+		return s.removeAllRecords(ctx, ordersID, status)
+	})
+}
+
+func (s *Storage) removeAllRecords(ctx context.Context, ordersID []uint64, status string) ([]walRecord, error) {
+	records := make([]walRecord, 0, 2*len(ordersID))
+	for _, orderID := range ordersID {
+		recs, err := s.removeRecords(ctx, orderID, status)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, recs...)
 	}
+	return records, nil
+}
 
-	s.SetOrderStatus(orderID, status)
+// checkUnique не даёт записать в журнал второе удаление того же заказа: при восстановлении оно бы не применилось
+func checkUnique(ordersID []uint64) error {
+	seen := make(map[uint64]struct{}, len(ordersID))
+	for _, orderID := range ordersID {
+		if _, ok := seen[orderID]; ok {
+			return fmt.Errorf("order %d has already been removed: %w", orderID, domain.ErrNotFound)
+		}
+		seen[orderID] = struct{}{}
+	}
 	return nil
 }
 
-func (s *Storage) RemoveOrders(ordersID []uint64, status string) error {
-	for _, orderID := range ordersID {
-		s.RemoveOrder(orderID, status)
+func (s *Storage) removeRecords(ctx context.Context, orderID uint64, status string) ([]walRecord, error) {
+	stat, err := s.Ohp.GetOrderStatus(ctx, orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.Users.CanRemove(ctx, stat.UserID, orderID); err != nil {
+		return nil, err
+	}
+
+	return []walRecord{
+		{Op: opRemoveUserOrder, UserID: stat.UserID, OrderID: orderID},
+		statusRecord(orderID, withStatus(stat, status)),
+	}, nil
+}
+
+// RestoreOrders загружает заказы как есть. Журнал пишется так же, как при обычных изменениях
+func (s *Storage) RestoreOrders(ctx context.Context, orders []domain.OrderRecord) error {
+	for i := range orders {
+		err := s.change(ctx, func() ([]walRecord, error) {
+			return s.restoreRecords(ctx, &orders[i])
+		})
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
+
+func (s *Storage) restoreRecords(ctx context.Context, r *domain.OrderRecord) ([]walRecord, error) {
+	if _, err := s.Ohp.GetOrderStatus(ctx, r.OrderID); err == nil {
+		return nil, fmt.Errorf("order %d: %w", r.OrderID, domain.ErrAlreadyExist)
+	}
+
+	records := []walRecord{statusRecord(r.OrderID, r.OrderStatus())}
+	if domain.IsHeld(r.Status) {
+		rec, err := s.heldRecord(ctx, r.UserID, r.OrderID, r.Order)
+		if err != nil {
+			return nil, err
+		}
+		records = append(records, rec)
+	}
+
+	return s.restoreRefund(r, records)
+}
+
+func (s *Storage) restoreRefund(r *domain.OrderRecord, records []walRecord) ([]walRecord, error) {
+	if r.Status != domain.StatusReturned {
+		return records, nil
+	}
+
+	rec, err := s.refundRecord(r.OrderID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	rec.UserID, rec.Order = r.UserID, r.Order
+	return append(records, rec), nil
+}
@@ -33,7 +33,7 @@ func (u *User) Add(orderID uint64, order *domain.Order) error {
 	defer u.mtx.Unlock()
 
 	if _, ok := u.Orders[orderID]; ok {
-		return fmt.Errorf("order %d has already accepted", orderID)
+		return fmt.Errorf("order %d has already accepted: %w", orderID, domain.ErrAlreadyExist)
 	}
 
 	u.Orders[orderID] = order
@@ -53,34 +53,41 @@ func (u *User) Get(orderID uint64) (*domain.Order, error) {
 	order, ok := u.Orders[orderID]
 	u.mtx.Unlock()
 	if !ok {
-		return nil, fmt.Errorf("not found order %d", orderID)
+		return nil, fmt.Errorf("order %d: %w", orderID, domain.ErrNotFound)
 	}
 
 	return order, nil
 }
 
+// held возвращает позицию заказа, который ещё хранится в ПВЗ
+func (u *User) held(orderID uint64) (int, error) {
+	id, ok := u.OrdersIDatArray[orderID]
+	if !ok || !u.OrdersArray[id].Exist {
+		return 0, fmt.Errorf("order %d at orders array of user %d: %w", orderID, u.UserID, domain.ErrNotFound)
+	}
+
+	return id, nil
+}
+
 func (u *User) CanRemove(orderID uint64) error {
 	u.mtx.Lock()
-	_, ok := u.OrdersIDatArray[orderID]
-	u.mtx.Unlock()
-	if !ok {
-		return fmt.Errorf("not found order %d at orders array of user %d", orderID, u.UserID)
-	}
+	defer u.mtx.Unlock()
 
-	return nil
+	_, err := u.held(orderID)
+	return err
 }
 
 func (u *User) Remove(orderId uint64) error {
 	u.mtx.Lock()
-	id, ok := u.OrdersIDatArray[orderId]
-	if !ok {
-		return fmt.Errorf("not found order %d at orders array of user %d", orderId, u.UserID)
+	defer u.mtx.Unlock()
+
+	id, err := u.held(orderId)
+	if err != nil {
+		return err
 	}
 
 	u.OrdersArray[id].Exist = false
 	delete(u.Orders, orderId)
-	u.mtx.Unlock()
-
 	return nil
 }
 
@@ -89,7 +96,7 @@ func (u *User) GetExpirationDate(orderID uint64) (time.Time, error) {
 	order, ok := u.Orders[orderID]
 	u.mtx.Unlock()
 	if !ok {
-		return time.Time{}, fmt.Errorf("user %d doesn't have order %d", u.UserID, orderID)
+		return time.Time{}, fmt.Errorf("user %d doesn't have order %d: %w", u.UserID, orderID, domain.ErrNotFound)
 	}
 
 	expDate, err := time.Parse("02-01-2006", order.ExpirationDate)
@@ -109,7 +116,7 @@ func (u *User) findID(firstOrderID uint64) (int, error) {
 		id, ok = u.OrdersIDatArray[firstOrderID]
 		u.mtx.Unlock()
 		if !ok {
-			return 0, fmt.Errorf("not found order %d", firstOrderID)
+			return 0, fmt.Errorf("order %d: %w", firstOrderID, domain.ErrNotFound)
 		}
 	}
 
@@ -143,3 +150,16 @@ func (u *User) GetOrders(firstOrderID, limit uint64) ([]domain.OrderView, error)
 
 	return res, nil
 }
+
+func (u *User) pseudonymize(pseudonym uint64) []uint64 {
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	u.UserID = pseudonym
+	orders := make([]uint64, 0, len(u.OrdersArray))
+	for i := range u.OrdersArray {
+		u.OrdersArray[i].UserID = pseudonym
+		orders = append(orders, u.OrdersArray[i].OrderID)
+	}
+	return orders
+}
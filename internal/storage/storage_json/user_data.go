@@ -0,0 +1,113 @@
+package storage_json
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+)
+
+type (
+	// userPseudonymizer заменяет клиента псевдонимом во всех записях репозитория
+	userPseudonymizer interface {
+		PseudonymizeUser(userID, pseudonym uint64) []uint64
+	}
+
+	reminderReader interface {
+		GetReminders(ordersID []uint64) []domain.Reminder
+	}
+)
+
+func (s *Storage) userOrders(ctx context.Context, userID uint64) ([]domain.OrderRecord, error) {
+	return s.Ohp.SearchOrders(ctx, &domain.OrderFilter{
+		UserID: userID,
+		SortBy: domain.SortByOrderID,
+		Limit:  math.MaxUint64,
+	})
+}
+
+// ExportUserData собирает данные клиента из истории статусов. Возврат ожидает
+// курьера, пока заказ в статусе returned, поэтому возвраты берутся оттуда же
+func (s *Storage) ExportUserData(ctx context.Context, userID uint64) (*domain.UserData, error) {
+	reader, ok := s.Ohp.(reminderReader)
+	if !ok {
+		return nil, fmt.Errorf("orders history can't read reminders")
+	}
+
+	orders, err := s.userOrders(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &domain.UserData{UserID: userID, Orders: orders, Refunds: make([]uint64, 0)}
+	ordersID := make([]uint64, 0, len(orders))
+	for _, r := range orders {
+		ordersID = append(ordersID, r.OrderID)
+		if r.Status == domain.StatusReturned {
+			data.Refunds = append(data.Refunds, r.OrderID)
+		}
+	}
+
+	data.Reminders = reader.GetReminders(ordersID)
+	return data, nil
+}
+
+func (s *Storage) checkNotHeld(ctx context.Context, userID uint64) error {
+	orders, err := s.userOrders(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range orders {
+		if domain.IsHeld(r.Status) {
+			return fmt.Errorf("user %d has orders held in pickup point: %w", userID, domain.ErrWrongStatus)
+		}
+	}
+	return nil
+}
+
+// pseudonymizers возвращает репозитории с данными клиента, первой - историю статусов
+func (s *Storage) pseudonymizers() ([]userPseudonymizer, error) {
+	repos := make([]userPseudonymizer, 0, 3)
+	for _, repo := range []any{s.Ohp, s.Users, s.Rp} {
+		p, ok := repo.(userPseudonymizer)
+		if !ok {
+			return nil, fmt.Errorf("repository %T can't pseudonymize users", repo)
+		}
+		repos = append(repos, p)
+	}
+	return repos, nil
+}
+
+// EraseUserData заменяет клиента псевдонимом во всех репозиториях и сразу сжимает
+// журнал в снапшот, чтобы прежний идентификатор не остался в записях журнала
+func (s *Storage) EraseUserData(ctx context.Context, userID, pseudonym uint64) ([]uint64, error) {
+	repos, err := s.pseudonymizers()
+	if err != nil {
+		return nil, err
+	}
+
+	s.wmu.Lock()
+	defer s.wmu.Unlock()
+
+	if err = s.checkNotHeld(ctx, userID); err != nil {
+		return nil, err
+	}
+
+	// в истории статусов есть все заказы клиента
+	orders := repos[0].PseudonymizeUser(userID, pseudonym)
+	for _, repo := range repos[1:] {
+		repo.PseudonymizeUser(userID, pseudonym)
+	}
+
+	return orders, s.save()
+}
+
+func (s *Storage) GetReminders(ctx context.Context, ordersID []uint64) ([]domain.Reminder, error) {
+	reader, ok := s.Ohp.(reminderReader)
+	if !ok {
+		return nil, fmt.Errorf("orders history can't read reminders")
+	}
+	return reader.GetReminders(ordersID), nil
+}
@@ -1,6 +1,7 @@
 package storage_json
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"time"
@@ -17,7 +18,7 @@ func NewUsers() *Users {
 	return &Users{UsersMap: make(map[uint64]*User)}
 }
 
-func (u *Users) AddOrder(userID, orderID uint64, order *domain.Order) error {
+func (u *Users) AddOrder(ctx context.Context, userID, orderID uint64, order *domain.Order) error {
 
 	u.mtx.Lock()
 	if _, ok := u.UsersMap[userID]; !ok {
@@ -28,58 +29,76 @@ func (u *Users) AddOrder(userID, orderID uint64, order *domain.Order) error {
 	return u.UsersMap[userID].Add(orderID, order)
 }
 
-func (u *Users) GetOrder(userID, orderID uint64) (*domain.Order, error) {
+func (u *Users) GetOrder(ctx context.Context, userID, orderID uint64) (*domain.Order, error) {
 
 	u.mtx.Lock()
 	user, ok := u.UsersMap[userID]
 	u.mtx.Unlock()
 	if !ok {
-		return nil, fmt.Errorf("user %d not found", userID)
+		return nil, fmt.Errorf("user %d: %w", userID, domain.ErrNotFound)
 	}
 
 	return user.Get(orderID)
 }
 
-func (u *Users) CanRemove(userID, orderID uint64) error {
+func (u *Users) CanRemove(ctx context.Context, userID, orderID uint64) error {
 
 	u.mtx.Lock()
 	user, ok := u.UsersMap[userID]
 	u.mtx.Unlock()
 	if !ok {
-		return fmt.Errorf("user %d not found", userID)
+		return fmt.Errorf("user %d: %w", userID, domain.ErrNotFound)
 	}
 
 	return user.CanRemove(orderID)
 }
 
-func (u *Users) RemoveOrder(userID, orderID uint64) error {
+func (u *Users) RemoveOrder(ctx context.Context, userID, orderID uint64) error {
 	u.mtx.Lock()
-	user := u.UsersMap[userID]
+	user, ok := u.UsersMap[userID]
 	u.mtx.Unlock()
+	if !ok {
+		return fmt.Errorf("user %d: %w", userID, domain.ErrNotFound)
+	}
 
 	return user.Remove(orderID)
 }
 
-func (u *Users) GetExpirationDate(userID, orderID uint64) (time.Time, error) {
+func (u *Users) GetExpirationDate(ctx context.Context, userID, orderID uint64) (time.Time, error) {
 
 	u.mtx.Lock()
 	user, ok := u.UsersMap[userID]
 	u.mtx.Unlock()
 	if !ok {
-		return time.Time{}, fmt.Errorf("user %d not found", userID)
+		return time.Time{}, fmt.Errorf("user %d: %w", userID, domain.ErrNotFound)
 	}
 
 	return user.GetExpirationDate(orderID)
 }
 
-func (u *Users) GetOrders(userID, firstOrderID, limit uint64) ([]domain.OrderView, error) {
+func (u *Users) GetOrders(ctx context.Context, userID, firstOrderID, limit uint64) ([]domain.OrderView, error) {
 
 	u.mtx.Lock()
 	user, ok := u.UsersMap[userID]
 	u.mtx.Unlock()
 	if !ok {
-		return nil, fmt.Errorf("not found user %d", userID)
+		return nil, fmt.Errorf("user %d: %w", userID, domain.ErrNotFound)
 	}
 
 	return user.GetOrders(firstOrderID, limit)
 }
+
+// PseudonymizeUser переносит заказы клиента под псевдоним
+func (u *Users) PseudonymizeUser(userID, pseudonym uint64) []uint64 {
+	u.mtx.Lock()
+	defer u.mtx.Unlock()
+
+	user, ok := u.UsersMap[userID]
+	if !ok {
+		return nil
+	}
+
+	delete(u.UsersMap, userID)
+	u.UsersMap[pseudonym] = user
+	return user.pseudonymize(pseudonym)
+}
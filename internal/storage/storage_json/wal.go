@@ -0,0 +1,330 @@
+package storage_json
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+)
+
+const (
+	opPutStatus       = "put_status"
+	opAddUserOrder    = "add_user_order"
+	opRemoveUserOrder = "remove_user_order"
+	opAddRefund       = "add_refund"
+	opRemoveRefund    = "remove_refund"
+	opAddReminders    = "add_reminders"
+	opRemindersSent   = "reminders_sent"
+
+	walSuffix      = ".wal"
+	snapshotSuffix = ".tmp"
+
+	// SnapshotEvery - число записей журнала, после которого он сжимается в снапшот
+	SnapshotEvery = 1000
+)
+
+type (
+	// walRecord хранит результат изменения, а не вызов метода,
+	// поэтому повторное применение не зависит от текущей даты
+	walRecord struct {
+		Seq      uint64              `json:"seq"`
+		Op       string              `json:"op"`
+		OrderID  uint64              `json:"orderID,omitempty"`
+		UserID   uint64              `json:"userID,omitempty"`
+		Order    *domain.Order       `json:"order,omitempty"`
+		Stat     *domain.OrderStatus `json:"stat,omitempty"`
+		Stage    uint64              `json:"stage,omitempty"`
+		OrdersID []uint64            `json:"ordersID,omitempty"`
+	}
+
+	// walFile - файл журнала, *os.File
+	walFile interface {
+		io.WriteSeeker
+		Truncate(size int64) error
+		Sync() error
+		Close() error
+	}
+
+	wal struct {
+		file    walFile
+		seq     uint64
+		records int
+		// broken - ошибка, после которой в журнале мог остаться недописанный хвост:
+		// записи после него потерялись бы при восстановлении
+		broken error
+		mtx    sync.Mutex
+	}
+)
+
+// openWAL открывает журнал на дозапись, отрезая недописанный хвост после size
+func openWAL(path string, size int64, seq uint64) (*wal, error) {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+	if err != nil {
+		return nil, fmt.Errorf("openWAL: %w", err)
+	}
+
+	if err = file.Truncate(size); err != nil {
+		file.Close()
+		return nil, fmt.Errorf("openWAL Truncate: %w", err)
+	}
+
+	return &wal{file: file, seq: seq}, nil
+}
+
+// append пишет записи и делает fsync. Возвращает true, когда журнал пора сжать.
+// При ошибке журнал обрезается до прежнего размера, чтобы недописанные записи
+// не применились при восстановлении
+func (w *wal) append(records ...walRecord) (bool, error) {
+	w.mtx.Lock()
+	defer w.mtx.Unlock()
+
+	if w.broken != nil {
+		return false, fmt.Errorf("wal append: %w", w.broken)
+	}
+
+	buf, err := w.encode(records)
+	if err != nil {
+		return false, fmt.Errorf("wal append: %w", err)
+	}
+
+	offset, err := w.file.Seek(0, io.SeekEnd)
+	if err != nil {
+		return false, fmt.Errorf("wal append: %w", err)
+	}
+
+	if err = w.write(buf); err != nil {
+		return false, fmt.Errorf("wal append: %w", w.rollback(offset, err))
+	}
+
+	w.seq += uint64(len(records))
+	w.records += len(records)
+	return w.records >= SnapshotEvery, nil
+}
+
+func (w *wal) encode(records []walRecord) ([]byte, error) {
+	var buf bytes.Buffer
+	encoder := json.NewEncoder(&buf)
+	for i, rec := range records {
+		rec.Seq = w.seq + uint64(i) + 1
+		if err := encoder.Encode(rec); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func (w *wal) write(buf []byte) error {
+	n, err := w.file.Write(buf)
+	if err == nil && n < len(buf) {
+		err = io.ErrShortWrite
+	}
+	if err != nil {
+		return err
+	}
+	return w.file.Sync()
+}
+
+// rollback отрезает записанное после offset
+func (w *wal) rollback(offset int64, err error) error {
+	if truncErr := w.file.Truncate(offset); truncErr != nil {
+		w.broken = errors.Join(err, truncErr)
+		return w.broken
+	}
+	return err
+}
+
+func (w *wal) reset() error {
+	if err := w.file.Truncate(0); err != nil {
+		return fmt.Errorf("wal reset: %w", err)
+	}
+
+	w.records = 0
+	return w.file.Sync()
+}
+
+// readWAL читает целые записи журнала. Возвращает размер корректной части:
+// всё после первой недописанной или повреждённой записи отбрасывается
+func readWAL(path string) ([]walRecord, int64, error) {
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, 0, nil
+	} else if err != nil {
+		return nil, 0, fmt.Errorf("readWAL: %w", err)
+	}
+
+	records, size := decodeWAL(data)
+	return records, size, nil
+}
+
+func decodeWAL(data []byte) ([]walRecord, int64) {
+	records := make([]walRecord, 0)
+	size := int64(0)
+	reader := bufio.NewReader(bytes.NewReader(data))
+	for {
+		line, err := reader.ReadBytes('\n')
+		if err != nil {
+			return records, size
+		}
+
+		var rec walRecord
+		if json.Unmarshal(line, &rec) != nil {
+			return records, size
+		}
+
+		records = append(records, rec)
+		size += int64(len(line))
+	}
+}
+
+func writeTemp(path string, v any) error {
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0666)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err = json.NewEncoder(file).Encode(v); err != nil {
+		return err
+	}
+
+	return file.Sync()
+}
+
+func syncDir(path string) error {
+	dir, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer dir.Close()
+
+	return dir.Sync()
+}
+
+// writeFileAtomic заменяет файл целиком через временный файл и rename
+func writeFileAtomic(path string, v any) error {
+	tmp := path + snapshotSuffix
+	if err := writeTemp(tmp, v); err != nil {
+		return fmt.Errorf("writeFileAtomic: %w", err)
+	}
+
+	if err := os.Rename(tmp, path); err != nil {
+		return fmt.Errorf("writeFileAtomic Rename: %w", err)
+	}
+
+	return syncDir(filepath.Dir(path))
+}
+
+//gocyclo:ignore
+func (s *Storage) apply(ctx context.Context, rec walRecord) error {
+	switch rec.Op {
+	case opPutStatus:
+		s.Ohp.RestoreOrderStatus(rec.OrderID, rec.Stat)
+		return nil
+	case opAddUserOrder:
+		return s.Users.AddOrder(ctx, rec.UserID, rec.OrderID, rec.Order)
+	case opRemoveUserOrder:
+		return s.Users.RemoveOrder(ctx, rec.UserID, rec.OrderID)
+	case opAddRefund:
+		return s.Rp.AddRefund(ctx, rec.UserID, rec.OrderID, rec.Order)
+	case opRemoveRefund:
+		return s.Rp.RemoveRefund(ctx, rec.OrderID)
+	case opAddReminders:
+		return s.Ohp.AddReminders(ctx, rec.Stage, rec.OrdersID)
+	case opRemindersSent:
+		return s.Ohp.MarkRemindersSent(ctx, rec.Stage, rec.OrdersID)
+	default:
+		return fmt.Errorf("unknown operation %q", rec.Op)
+	}
+}
+
+func (s *Storage) replay(records []walRecord) error {
+	ctx := context.Background()
+	for _, rec := range records {
+		if rec.Seq <= s.LastSeq {
+			continue
+		}
+
+		if err := s.apply(ctx, rec); err != nil {
+			return fmt.Errorf("recover record %d: %w", rec.Seq, err)
+		}
+		s.LastSeq = rec.Seq
+	}
+
+	return nil
+}
+
+// recover применяет записи журнала, которых ещё нет в снапшоте, и открывает журнал на дозапись
+func (s *Storage) recover() error {
+	path := s.path + walSuffix
+	records, size, err := readWAL(path)
+	if err != nil {
+		return err
+	}
+
+	if err = s.replay(records); err != nil {
+		return err
+	}
+
+	if s.wal, err = openWAL(path, size, s.LastSeq); err != nil {
+		return err
+	}
+
+	if len(records) == 0 {
+		return nil
+	}
+	return s.Save()
+}
+
+// change собирает записи изменения под s.wmu, поэтому проверки в build
+// не устаревают до применения, а снапшот не застаёт изменение наполовину
+func (s *Storage) change(ctx context.Context, build func() ([]walRecord, error)) error {
+	s.wmu.Lock()
+	defer s.wmu.Unlock()
+
+	records, err := build()
+	if err != nil || len(records) == 0 {
+		return err
+	}
+
+	return s.commit(ctx, records)
+}
+
+// commit пишет записи в журнал и только после fsync применяет их в памяти тем же apply,
+// что и восстановление: при ошибке записи память не расходится с диском
+func (s *Storage) commit(ctx context.Context, records []walRecord) error {
+	compact, err := s.append(records)
+	if err != nil {
+		return err
+	}
+
+	for _, rec := range records {
+		if err = s.apply(ctx, rec); err != nil {
+			return fmt.Errorf("apply %s for order %d: %w", rec.Op, rec.OrderID, err)
+		}
+	}
+
+	if !compact {
+		return nil
+	}
+	return s.save()
+}
+
+func (s *Storage) append(records []walRecord) (bool, error) {
+	if s.wal == nil {
+		return false, nil
+	}
+
+	return s.wal.append(records...)
+}
+
+func statusRecord(orderID uint64, stat *domain.OrderStatus) walRecord {
+	return walRecord{Op: opPutStatus, OrderID: orderID, Stat: stat}
+}
@@ -0,0 +1,67 @@
+package storage_json
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+)
+
+// failingFile дописывает половину данных или не может сделать fsync
+type failingFile struct {
+	*os.File
+	short    bool
+	failSync bool
+}
+
+func (f *failingFile) Write(p []byte) (int, error) {
+	if f.short {
+		return f.File.Write(p[:len(p)/2])
+	}
+	return f.File.Write(p)
+}
+
+func (f *failingFile) Sync() error {
+	if f.failSync {
+		return errors.New("disk is gone")
+	}
+	return f.File.Sync()
+}
+
+func TestWALTruncatesFailedAppend(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		file failingFile
+	}{
+		{name: "short write", file: failingFile{short: true}},
+		{name: "sync error", file: failingFile{failSync: true}},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "storage.json.wal")
+			w, err := openWAL(path, 0, 0)
+			require.NoError(t, err)
+			defer w.file.Close()
+
+			_, err = w.append(walRecord{Op: opAddRefund, OrderID: 1})
+			require.NoError(t, err)
+
+			tt.file.File = w.file.(*os.File)
+			w.file = &tt.file
+			_, err = w.append(walRecord{Op: opPutStatus, OrderID: 2, Stat: &domain.OrderStatus{Status: domain.StatusAccepted}})
+			require.Error(t, err)
+
+			tt.file.short, tt.file.failSync = false, false
+			_, err = w.append(walRecord{Op: opAddRefund, OrderID: 3})
+			require.NoError(t, err)
+
+			records, _, err := readWAL(path)
+			require.NoError(t, err)
+			require.Len(t, records, 2)
+			require.Equal(t, uint64(3), records[1].OrderID)
+			require.Equal(t, uint64(2), records[1].Seq)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package usecase
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -44,7 +45,7 @@ func generateOrder(req *dto.AddOrderRequest) (*domain.Order, error) {
 	return domain.NewOrder(req.Cost, req.Weight, req.ExpirationDate, cs)
 }
 
-func (u *AcceptUsecase) AcceptOrder(req *dto.AddOrderRequest) error {
+func (u *AcceptUsecase) AcceptOrder(ctx context.Context, req *dto.AddOrderRequest) error {
 	expDate, err := time.Parse("02-01-2006", req.ExpirationDate)
 	if err != nil {
 		return fmt.Errorf("%w: %w", err, domain.ErrWrongInput)
@@ -61,7 +62,7 @@ func (u *AcceptUsecase) AcceptOrder(req *dto.AddOrderRequest) error {
 		return err
 	}
 
-	return u.st.AddOrder(req.UserID, req.OrderID, order)
+	return u.st.AddOrder(ctx, req.UserID, req.OrderID, order)
 }
 
 func acceptRefundCheckErr(req *dto.RefundRequest, order *domain.OrderStatus) error {
@@ -88,15 +89,25 @@ func acceptRefundCheckErr(req *dto.RefundRequest, order *domain.OrderStatus) err
 	return nil
 }
 
-func (u *AcceptUsecase) AcceptRefund(req *dto.RefundRequest) error {
-	order, err := u.st.GetOrderStatus(req.OrderID)
+func (u *AcceptUsecase) AcceptRefund(ctx context.Context, req *dto.RefundRequest) error {
+	return u.st.RunInTx(ctx, func(ctxTx context.Context) error {
+		return u.acceptRefund(ctxTx, req)
+	})
+}
+
+func (u *AcceptUsecase) acceptRefund(ctx context.Context, req *dto.RefundRequest) error {
+	order, err := u.st.GetOrderStatusForUpdate(ctx, req.OrderID)
 	if err != nil {
 		return err
 	}
 
+	if err = order.CheckVersion(req.OrderID, req.ExpectedVersion); err != nil {
+		return err
+	}
+
 	if err = acceptRefundCheckErr(req, order); err != nil {
 		return err
 	}
 
-	return u.st.AddRefund(req.UserID, req.OrderID, order.Order)
+	return u.st.AddRefund(ctx, req.UserID, req.OrderID, order.Order)
 }
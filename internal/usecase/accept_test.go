@@ -1,6 +1,7 @@
 package usecase
 
 import (
+	"context"
 	"fmt"
 	"testing"
 
@@ -27,6 +28,13 @@ func newMocks(ctrl *minimock.Controller) *mocks {
 	}
 }
 
+// expectStatuses проверяет статусы, которые хранилище записывает в историю
+func (m *mocks) expectStatuses(t *testing.T, statuses map[uint64]string) {
+	m.ohp.RestoreOrderStatusMock.Set(func(orderID uint64, stat *domain.OrderStatus) {
+		assert.Equal(t, statuses[orderID], stat.Status, "order %d", orderID)
+	})
+}
+
 func newAcceptUsecase(mocks *mocks) *AcceptUsecase {
 	st := &storage_json.Storage{
 		Ohp:   mocks.ohp,
@@ -123,9 +131,17 @@ func TestAcceptUsecase_AcceptOrder(t *testing.T) {
 				req := data.req
 				order := data.order
 
-				m.ohp.GetOrderStatusMock.When(req.UserID).Then(nil, fmt.Errorf("order %d not found", req.UserID))
-				m.up.AddOrderMock.When(req.UserID, req.OrderID, order).Then(nil)
-				m.ohp.AddOrderStatusMock.When(req.OrderID, req.UserID, domain.StatusAccepted, order).Then(nil)
+				m.ohp.GetOrderStatusMock.When(minimock.AnyContext, req.UserID).Then(nil, fmt.Errorf("order %d not found", req.UserID))
+				m.up.GetOrderMock.When(minimock.AnyContext, req.UserID, req.OrderID).Then(nil, domain.ErrNotFound)
+				m.up.AddOrderMock.When(minimock.AnyContext, req.UserID, req.OrderID, order).Then(nil)
+				m.ohp.RestoreOrderStatusMock.Expect(req.OrderID, &domain.OrderStatus{
+					Order:      order,
+					Status:     domain.StatusAccepted,
+					AcceptedAt: utils.CurrentDateString(),
+					UpdatedAt:  utils.CurrentDateString(),
+					UserID:     req.UserID,
+					Version:    1,
+				}).Return()
 			},
 			wantErr: assert.NoError,
 		},
@@ -174,7 +190,7 @@ func TestAcceptUsecase_AcceptOrder(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			err := u.AcceptOrder(tt.args.req)
+			err := u.AcceptOrder(context.Background(), tt.args.req)
 			tt.wantErr(t, err)
 		})
 	}
@@ -196,6 +212,7 @@ func TestAcceptUsecase_AcceptRefund(t *testing.T) {
 	m := newMocks(ctrl)
 	u := newAcceptUsecase(m)
 
+	expectedVersion := uint64(1)
 	td := map[string]TestData{
 		"SuccessRefund": {
 			req: &dto.RefundRequest{
@@ -242,6 +259,19 @@ func TestAcceptUsecase_AcceptRefund(t *testing.T) {
 				UpdatedAt: "01-09-2024",
 			},
 		},
+		"VersionMismatch": {
+			req: &dto.RefundRequest{
+				UserID:          6,
+				OrderID:         6,
+				ExpectedVersion: &expectedVersion,
+			},
+			order: &domain.OrderStatus{
+				Status:    domain.StatusGiveClient,
+				UserID:    6,
+				UpdatedAt: utils.CurrentDateString(),
+				Version:   2,
+			},
+		},
 	}
 
 	tests := []struct {
@@ -258,9 +288,14 @@ func TestAcceptUsecase_AcceptRefund(t *testing.T) {
 				req := data.req
 				orderStat := data.order
 
-				m.ohp.GetOrderStatusMock.When(req.OrderID).Then(orderStat, nil)
-				m.rp.AddRefundMock.When(req.UserID, req.OrderID, orderStat.Order).Then(nil)
-				m.ohp.SetOrderStatusMock.When(req.OrderID, domain.StatusReturned).Then(nil)
+				returned := *orderStat
+				returned.Status = domain.StatusReturned
+				returned.Version++
+
+				m.ohp.GetOrderStatusMock.When(minimock.AnyContext, req.OrderID).Then(orderStat, nil)
+				m.rp.HasRefundMock.When(req.OrderID).Then(false)
+				m.rp.AddRefundMock.When(minimock.AnyContext, req.UserID, req.OrderID, orderStat.Order).Then(nil)
+				m.ohp.RestoreOrderStatusMock.Expect(req.OrderID, &returned).Return()
 			},
 			wantErr: assert.NoError,
 		},
@@ -272,7 +307,7 @@ func TestAcceptUsecase_AcceptRefund(t *testing.T) {
 				req := data.req
 				orderStat := data.order
 
-				m.ohp.GetOrderStatusMock.When(req.OrderID).Then(orderStat, nil)
+				m.ohp.GetOrderStatusMock.When(minimock.AnyContext, req.OrderID).Then(orderStat, nil)
 			},
 			wantErr: assert.Error,
 		},
@@ -284,7 +319,7 @@ func TestAcceptUsecase_AcceptRefund(t *testing.T) {
 				req := data.req
 				orderStat := data.order
 
-				m.ohp.GetOrderStatusMock.When(req.OrderID).Then(orderStat, nil)
+				m.ohp.GetOrderStatusMock.When(minimock.AnyContext, req.OrderID).Then(orderStat, nil)
 			},
 			wantErr: assert.Error,
 		},
@@ -296,10 +331,24 @@ func TestAcceptUsecase_AcceptRefund(t *testing.T) {
 				req := data.req
 				orderStat := data.order
 
-				m.ohp.GetOrderStatusMock.When(req.OrderID).Then(orderStat, nil)
+				m.ohp.GetOrderStatusMock.When(minimock.AnyContext, req.OrderID).Then(orderStat, nil)
 			},
 			wantErr: assert.Error,
 		},
+		{
+			name: "VersionMismatch",
+			args: args{td["VersionMismatch"].req},
+			prepare: func() {
+				data := td["VersionMismatch"]
+				req := data.req
+				orderStat := data.order
+
+				m.ohp.GetOrderStatusMock.When(minimock.AnyContext, req.OrderID).Then(orderStat, nil)
+			},
+			wantErr: func(t assert.TestingT, err error, _ ...interface{}) bool {
+				return assert.ErrorIs(t, err, domain.ErrVersionMismatch)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -310,7 +359,7 @@ func TestAcceptUsecase_AcceptRefund(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			err := u.AcceptRefund(tt.args.req)
+			err := u.AcceptRefund(context.Background(), tt.args.req)
 			tt.wantErr(t, err)
 		})
 	}
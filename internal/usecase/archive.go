@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+type ArchiveUsecase struct {
+	ar storage.Archiver
+}
+
+func NewArchiveUsecase(ar storage.Archiver) *ArchiveUsecase {
+	return &ArchiveUsecase{ar}
+}
+
+// ArchiveOrders пачками по batchSize переносит в архив заказы,
+// завершённые больше чем retention назад, и возвращает их число
+func (u *ArchiveUsecase) ArchiveOrders(ctx context.Context, retention time.Duration, batchSize uint64) (uint64, error) {
+	before := utils.CurrentDate().Add(-retention)
+
+	archived := uint64(0)
+	for {
+		orders, err := u.ar.ArchiveOrders(ctx, before, batchSize)
+		archived += uint64(len(orders))
+		if err != nil || uint64(len(orders)) < batchSize {
+			return archived, err
+		}
+	}
+}
+
+// DropArchive удаляет части архива старше dropAfter. Нулевой dropAfter хранит архив бессрочно
+func (u *ArchiveUsecase) DropArchive(ctx context.Context, dropAfter time.Duration) ([]string, error) {
+	if dropAfter == 0 {
+		return nil, nil
+	}
+	dropped, _, err := u.ar.DropArchive(ctx, utils.CurrentDate().Add(-dropAfter))
+	return dropped, err
+}
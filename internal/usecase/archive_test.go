@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+// fakeArchiver отдаёт заранее заданные пачки и запоминает границы переноса
+type fakeArchiver struct {
+	batches [][]uint64
+	err     error
+	before  []time.Time
+}
+
+func (f *fakeArchiver) ArchiveOrders(ctx context.Context, before time.Time, limit uint64) ([]uint64, error) {
+	f.before = append(f.before, before)
+	if len(f.batches) == 0 {
+		return nil, f.err
+	}
+
+	batch := f.batches[0]
+	f.batches = f.batches[1:]
+	return batch, nil
+}
+
+func (f *fakeArchiver) DropArchive(ctx context.Context, before time.Time) ([]string, []uint64, error) {
+	f.before = append(f.before, before)
+	return []string{"orders_archive_2024_01"}, []uint64{1}, nil
+}
+
+func TestArchiveUsecase_ArchiveOrders(t *testing.T) {
+	ctx := context.Background()
+	retention := 30 * 24 * time.Hour
+
+	ar := &fakeArchiver{batches: [][]uint64{{1, 2}, {3, 4}, {5}}}
+	archived, err := NewArchiveUsecase(ar).ArchiveOrders(ctx, retention, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), archived)
+	require.Len(t, ar.before, 3)
+	require.Equal(t, utils.CurrentDate().Add(-retention), ar.before[0])
+
+	storageErr := errors.New("some storage error")
+	ar = &fakeArchiver{batches: [][]uint64{{1, 2}}, err: storageErr}
+	archived, err = NewArchiveUsecase(ar).ArchiveOrders(ctx, retention, 2)
+	require.ErrorIs(t, err, storageErr)
+	require.Equal(t, uint64(2), archived)
+}
+
+func TestArchiveUsecase_DropArchive(t *testing.T) {
+	ctx := context.Background()
+
+	ar := &fakeArchiver{}
+	dropped, err := NewArchiveUsecase(ar).DropArchive(ctx, 0)
+	require.NoError(t, err)
+	require.Empty(t, dropped)
+	require.Empty(t, ar.before)
+
+	dropped, err = NewArchiveUsecase(ar).DropArchive(ctx, 365*24*time.Hour)
+	require.NoError(t, err)
+	require.Equal(t, []string{"orders_archive_2024_01"}, dropped)
+}
@@ -0,0 +1,41 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/dto"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+)
+
+const defaultAuditPageSize = 100
+
+type AuditUsecase struct {
+	st storage.AuditLog
+}
+
+func NewAuditUsecase(st storage.AuditLog) *AuditUsecase {
+	return &AuditUsecase{st: st}
+}
+
+func (u *AuditUsecase) QueryAuditLog(ctx context.Context, req *dto.QueryAuditLogRequest) ([]domain.AuditRecord, error) {
+	limit := req.Limit
+	if limit == 0 {
+		limit = defaultAuditPageSize
+	}
+
+	records, err := u.st.QueryAuditLog(ctx, &domain.AuditFilter{
+		Actor:   req.Actor,
+		OrderID: req.OrderID,
+		From:    req.From,
+		To:      req.To,
+		AfterID: req.AfterID,
+		Limit:   limit,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error while query audit log: %w", err)
+	}
+
+	return records, nil
+}
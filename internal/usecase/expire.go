@@ -0,0 +1,29 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+type ExpireUsecase struct {
+	st storage.Storage
+}
+
+func NewExpireUsecase(st storage.Storage) *ExpireUsecase {
+	return &ExpireUsecase{st}
+}
+
+// ExpireOrders переводит в статус StatusExpired принятые заказы,
+// срок хранения которых истёк больше чем grace назад.
+func (u *ExpireUsecase) ExpireOrders(ctx context.Context, grace time.Duration) ([]uint64, error) {
+	expiredBefore := utils.CurrentDate().Add(-grace)
+	return u.st.ExpireOrders(ctx, expiredBefore)
+}
+
+func (u *ExpireUsecase) GetExpiredBacklog(ctx context.Context) (uint64, error) {
+	return u.st.GetOrdersCountByStatus(ctx, domain.StatusExpired)
+}
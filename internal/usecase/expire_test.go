@@ -0,0 +1,109 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage/storage_json"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+func newExpireUsecase(mocks *mocks) *ExpireUsecase {
+	st := &storage_json.Storage{
+		Ohp:   mocks.ohp,
+		Rp:    mocks.rp,
+		Users: mocks.up,
+	}
+	return NewExpireUsecase(st)
+}
+
+func TestExpireUsecase_ExpireOrders(t *testing.T) {
+	type args struct {
+		grace  time.Duration
+		expect []uint64
+	}
+
+	accepted := &domain.OrderFilter{
+		Statuses: []string{domain.StatusAccepted},
+		SortBy:   domain.SortByOrderID,
+		Limit:    math.MaxUint64,
+	}
+	record := func(orderID uint64, expiration time.Time) domain.OrderRecord {
+		return domain.OrderRecord{
+			Order:   &domain.Order{ExpirationDate: utils.TimeToString(expiration)},
+			OrderID: orderID,
+			Status:  domain.StatusAccepted,
+			Version: 1,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		prepare func(m *mocks)
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success",
+			args: args{
+				grace:  24 * time.Hour,
+				expect: []uint64{1, 2, 3},
+			},
+			prepare: func(m *mocks) {
+				expired := utils.CurrentDate().AddDate(0, 0, -2)
+				m.ohp.SearchOrdersMock.When(minimock.AnyContext, accepted).Then([]domain.OrderRecord{
+					record(1, expired), record(2, expired), record(3, expired), record(4, utils.CurrentDate()),
+				}, nil)
+				m.ohp.RestoreOrderStatusMock.Inspect(func(orderID uint64, stat *domain.OrderStatus) {
+					assert.Equal(t, domain.StatusExpired, stat.Status)
+					assert.EqualValues(t, 2, stat.Version)
+				}).Times(3).Return()
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "StorageError",
+			args: args{
+				grace: 48 * time.Hour,
+			},
+			prepare: func(m *mocks) {
+				m.ohp.SearchOrdersMock.When(minimock.AnyContext, accepted).Then(nil, errors.New("some storage error"))
+			},
+			wantErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctrl := minimock.NewController(t)
+			m := newMocks(ctrl)
+			u := newExpireUsecase(m)
+			tt.prepare(m)
+
+			orders, err := u.ExpireOrders(context.Background(), tt.args.grace)
+			tt.wantErr(t, err)
+			require.Equal(t, tt.args.expect, orders)
+		})
+	}
+}
+
+func TestExpireUsecase_GetExpiredBacklog(t *testing.T) {
+	ctrl := minimock.NewController(t)
+	m := newMocks(ctrl)
+	u := newExpireUsecase(m)
+
+	m.ohp.GetOrdersCountByStatusMock.When(minimock.AnyContext, domain.StatusExpired).Then(42, nil)
+
+	backlog, err := u.GetExpiredBacklog(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, uint64(42), backlog)
+}
@@ -1,6 +1,8 @@
 package usecase
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"slices"
 
@@ -18,7 +20,7 @@ func NewGiveUsecase(st storage.Storage) *GiveUsecase {
 	return &GiveUsecase{st}
 }
 
-func (u *GiveUsecase) giveCheckErr(userID, orderID uint64, status *domain.OrderStatus) error {
+func (u *GiveUsecase) giveCheckErr(ctx context.Context, userID, orderID uint64, status *domain.OrderStatus) error {
 	if status.UserID != userID {
 		return fmt.Errorf("can't give order %d: different userID: %w", orderID, domain.ErrWrongInput)
 	}
@@ -27,7 +29,7 @@ func (u *GiveUsecase) giveCheckErr(userID, orderID uint64, status *domain.OrderS
 		return fmt.Errorf("can't give order %d: status = %s: %w", orderID, status.Status, domain.ErrWrongStatus)
 	}
 
-	expDate, err := u.st.GetExpirationDate(status.UserID, uint64(orderID))
+	expDate, err := u.st.GetExpirationDate(ctx, status.UserID, uint64(orderID))
 	if err != nil {
 		return fmt.Errorf("can't give: %s", err)
 	}
@@ -36,33 +38,64 @@ func (u *GiveUsecase) giveCheckErr(userID, orderID uint64, status *domain.OrderS
 		return fmt.Errorf("can't give order %d: %w", orderID, domain.ErrExpirationDatePassed)
 	}
 
-	return u.st.CanRemoveOrder(orderID)
+	return u.st.CanRemoveOrder(ctx, orderID)
 }
 
-func (u *GiveUsecase) giveCheckOrder(orderID, userID uint64, knowUserID bool) (uint64, bool, error) {
-	status, err := u.st.GetOrderStatus(orderID)
+func expectedVersion(versions map[uint64]uint64, orderID uint64) *uint64 {
+	if v, ok := versions[orderID]; ok {
+		return &v
+	}
+	return nil
+}
+
+func (u *GiveUsecase) giveCheckOrder(ctx context.Context, orderID, userID uint64, knowUserID bool, expected *uint64) (uint64, bool, error) {
+	status, err := u.st.GetOrderStatusForUpdate(ctx, orderID)
 	if err != nil {
 		return userID, knowUserID, fmt.Errorf("can't give: %s", err)
 	}
 
+	if err = status.CheckVersion(orderID, expected); err != nil {
+		return userID, knowUserID, err
+	}
+
 	if !knowUserID {
 		userID = status.UserID
 		knowUserID = true
 	}
 
-	return userID, knowUserID, u.giveCheckErr(userID, orderID, status)
+	return userID, knowUserID, u.giveCheckErr(ctx, userID, orderID, status)
 }
 
-func (u *GiveUsecase) giveProcess(orders []uint64, isGoodResponse bool, errors []error) []error {
+func (u *GiveUsecase) giveProcess(ctx context.Context, orders []uint64, isGoodResponse bool, errors []error) []error {
 	if isGoodResponse {
-		u.st.RemoveOrders(orders, domain.StatusGiveClient)
+		if err := u.st.RemoveOrders(ctx, orders, domain.StatusGiveClient); err != nil {
+			return []error{err}
+		}
 		return nil
 	}
 
 	return errors
 }
 
-func (u *GiveUsecase) Give(req *dto.GiveOrdersRequest) []error {
+// Give проверяет и выдаёт заказы в одной транзакции, блокируя их в порядке возрастания orderID.
+// Любая ошибка откатывает выдачу всех заказов запроса, если хранилище откатывает транзакции
+func (u *GiveUsecase) Give(ctx context.Context, req *dto.GiveOrdersRequest) []error {
+	var errs []error
+	err := u.st.RunInTx(ctx, func(ctxTx context.Context) error {
+		errs = u.give(ctxTx, req)
+		return errors.Join(errs...)
+	})
+
+	if len(errs) > 0 {
+		return errs
+	}
+	if err != nil {
+		return []error{err}
+	}
+	return nil
+}
+
+func (u *GiveUsecase) give(ctx context.Context, req *dto.GiveOrdersRequest) []error {
 	var err error
 	userID := uint64(0)
 	knowUserID := false
@@ -74,7 +107,7 @@ func (u *GiveUsecase) Give(req *dto.GiveOrdersRequest) []error {
 	orders := make([]uint64, 0, len(req.Orders))
 
 	for _, orderID := range req.Orders {
-		userID, knowUserID, err = u.giveCheckOrder(uint64(orderID), userID, knowUserID)
+		userID, knowUserID, err = u.giveCheckOrder(ctx, uint64(orderID), userID, knowUserID, expectedVersion(req.ExpectedVersions, orderID))
 		if err != nil {
 			errors = append(errors, err)
 			isGoodResponse = false
@@ -82,5 +115,5 @@ func (u *GiveUsecase) Give(req *dto.GiveOrdersRequest) []error {
 		orders = append(orders, uint64(orderID))
 	}
 
-	return u.giveProcess(orders, isGoodResponse, errors)
+	return u.giveProcess(ctx, orders, isGoodResponse, errors)
 }
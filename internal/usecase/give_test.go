@@ -1,13 +1,19 @@
 package usecase
 
 import (
+	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain/strategy"
 	"gitlab.ozon.dev/chppppr/homework/internal/dto"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage/memory"
 	"gitlab.ozon.dev/chppppr/homework/internal/storage/storage_json"
 	"gitlab.ozon.dev/chppppr/homework/internal/utils"
 )
@@ -37,6 +43,9 @@ func TestGiveUsecase(t *testing.T) {
 	m := newMocks(ctrl)
 	u := newGiveUsecase(m)
 
+	statuses := make(map[uint64]string)
+	m.expectStatuses(t, statuses)
+
 	td := map[string]TestData{
 		"SuccessGive": {
 			req: &dto.GiveOrdersRequest{
@@ -126,11 +135,11 @@ func TestGiveUsecase(t *testing.T) {
 					stat := ordersStat[i]
 					orderID := uint64(order)
 
-					m.ohp.GetOrderStatusMock.When(orderID).Then(stat, nil)
-					m.up.GetExpirationDateMock.When(stat.UserID, orderID).Then(utils.CurrentDate(), nil)
-					m.up.CanRemoveMock.When(stat.UserID, orderID).Then(nil)
-					m.up.RemoveOrderMock.When(stat.UserID, orderID).Then(nil)
-					m.ohp.SetOrderStatusMock.When(orderID, domain.StatusGiveClient).Then(nil)
+					m.ohp.GetOrderStatusMock.When(minimock.AnyContext, orderID).Then(stat, nil)
+					m.up.GetExpirationDateMock.When(minimock.AnyContext, stat.UserID, orderID).Then(utils.CurrentDate(), nil)
+					m.up.CanRemoveMock.When(minimock.AnyContext, stat.UserID, orderID).Then(nil)
+					m.up.RemoveOrderMock.When(minimock.AnyContext, stat.UserID, orderID).Then(nil)
+					statuses[orderID] = domain.StatusGiveClient
 				}
 			},
 			wantErr: assert.NoError,
@@ -147,11 +156,11 @@ func TestGiveUsecase(t *testing.T) {
 					stat := ordersStat[i]
 					orderID := uint64(order)
 
-					m.ohp.GetOrderStatusMock.Optional().When(orderID).Then(stat, nil)
-					m.up.GetExpirationDateMock.Optional().When(stat.UserID, orderID).Then(utils.CurrentDate(), nil)
-					m.up.CanRemoveMock.Optional().When(stat.UserID, orderID).Then(nil)
-					m.up.RemoveOrderMock.Optional().When(stat.UserID, orderID).Then(nil)
-					m.ohp.SetOrderStatusMock.Optional().When(orderID, domain.StatusGiveClient).Then(nil)
+					m.ohp.GetOrderStatusMock.Optional().When(minimock.AnyContext, orderID).Then(stat, nil)
+					m.up.GetExpirationDateMock.Optional().When(minimock.AnyContext, stat.UserID, orderID).Then(utils.CurrentDate(), nil)
+					m.up.CanRemoveMock.Optional().When(minimock.AnyContext, stat.UserID, orderID).Then(nil)
+					m.up.RemoveOrderMock.Optional().When(minimock.AnyContext, stat.UserID, orderID).Then(nil)
+					statuses[orderID] = domain.StatusGiveClient
 				}
 			},
 			wantErr: assert.Error,
@@ -164,7 +173,7 @@ func TestGiveUsecase(t *testing.T) {
 				stat := data.orderStatus[0]
 				orderID := uint64(data.req.Orders[0])
 
-				m.ohp.GetOrderStatusMock.When(orderID).Then(stat, nil)
+				m.ohp.GetOrderStatusMock.When(minimock.AnyContext, orderID).Then(stat, nil)
 			},
 			wantErr: assert.Error,
 		},
@@ -177,8 +186,8 @@ func TestGiveUsecase(t *testing.T) {
 				orderID := uint64(data.req.Orders[0])
 
 				expData, _ := time.Parse("02-01-2006", stat.ExpirationDate)
-				m.ohp.GetOrderStatusMock.When(orderID).Then(stat, nil)
-				m.up.GetExpirationDateMock.When(stat.UserID, orderID).Then(expData, nil)
+				m.ohp.GetOrderStatusMock.When(minimock.AnyContext, orderID).Then(stat, nil)
+				m.up.GetExpirationDateMock.When(minimock.AnyContext, stat.UserID, orderID).Then(expData, nil)
 			},
 			wantErr: assert.Error,
 		},
@@ -193,7 +202,7 @@ func TestGiveUsecase(t *testing.T) {
 			t.Parallel()
 
 			var err error
-			errors := u.Give(tt.args.req)
+			errors := u.Give(context.Background(), tt.args.req)
 			if len(errors) > 0 {
 				err = errors[0]
 			}
@@ -202,3 +211,43 @@ func TestGiveUsecase(t *testing.T) {
 		})
 	}
 }
+
+// removeOneByOne выдаёт заказы по одному, как postgres, и обрывается на втором заказе
+type removeOneByOne struct {
+	storage.Storage
+	removed int
+}
+
+func (s *removeOneByOne) RemoveOrders(ctx context.Context, ordersID []uint64, status string) error {
+	for _, orderID := range ordersID {
+		s.removed++
+		if s.removed == 2 {
+			return fmt.Errorf("order %d: %w", orderID, domain.ErrNotFound)
+		}
+
+		if err := s.RemoveOrder(ctx, orderID, status); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func TestGiveRollsBackOnFailure(t *testing.T) {
+	ctx := context.Background()
+	st := &removeOneByOne{Storage: memory.NewStorage()}
+
+	order, err := domain.NewOrder(100, 10, utils.CurrentDateString(), strategy.ContainerTypeMap["box"])
+	require.NoError(t, err)
+	require.NoError(t, st.AddOrder(ctx, 1, 1, order))
+	require.NoError(t, st.AddOrder(ctx, 1, 2, order))
+
+	errs := NewGiveUsecase(st).Give(ctx, &dto.GiveOrdersRequest{Orders: []uint64{1, 2}})
+	require.Len(t, errs, 1)
+	require.ErrorIs(t, errs[0], domain.ErrNotFound)
+
+	for _, orderID := range []uint64{1, 2} {
+		stat, err := st.GetOrderStatus(ctx, orderID)
+		require.NoError(t, err)
+		require.Equal(t, domain.StatusAccepted, stat.Status, "order %d", orderID)
+	}
+}
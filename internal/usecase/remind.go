@@ -0,0 +1,47 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+type RemindUsecase struct {
+	st storage.Storage
+}
+
+func NewRemindUsecase(st storage.Storage) *RemindUsecase {
+	return &RemindUsecase{st}
+}
+
+// GetOrdersToRemind возвращает принятые заказы, срок хранения которых
+// истекает не позже чем через daysBefore дней и по которым ещё не было
+// напоминания этого или более позднего этапа.
+func (u *RemindUsecase) GetOrdersToRemind(ctx context.Context, daysBefore uint64) ([]domain.OrderView, error) {
+	today := utils.CurrentDate()
+	expiresTo := today.Add(time.Duration(daysBefore) * 24 * time.Hour)
+	return u.st.GetOrdersToRemind(ctx, daysBefore, today, expiresTo)
+}
+
+func (u *RemindUsecase) MarkReminded(ctx context.Context, daysBefore uint64, ordersID []uint64) error {
+	if len(ordersID) == 0 {
+		return nil
+	}
+	return u.st.AddReminders(ctx, daysBefore, ordersID)
+}
+
+// GetPendingReminders возвращает заказы, напоминание этапа daysBefore по которым
+// уже записано, но ещё не отправлено, например из-за сбоя kafka
+func (u *RemindUsecase) GetPendingReminders(ctx context.Context, daysBefore uint64) ([]domain.OrderView, error) {
+	return u.st.GetPendingReminders(ctx, daysBefore)
+}
+
+func (u *RemindUsecase) MarkSent(ctx context.Context, daysBefore uint64, ordersID []uint64) error {
+	if len(ordersID) == 0 {
+		return nil
+	}
+	return u.st.MarkRemindersSent(ctx, daysBefore, ordersID)
+}
@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage/storage_json"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+func newRemindUsecase(mocks *mocks) *RemindUsecase {
+	st := &storage_json.Storage{
+		Ohp:   mocks.ohp,
+		Rp:    mocks.rp,
+		Users: mocks.up,
+	}
+	return NewRemindUsecase(st)
+}
+
+func TestRemindUsecase_GetOrdersToRemind(t *testing.T) {
+	type args struct {
+		daysBefore uint64
+		expect     []domain.OrderView
+	}
+
+	ctrl := minimock.NewController(t)
+	m := newMocks(ctrl)
+	u := newRemindUsecase(m)
+
+	today := utils.CurrentDate()
+	orders := []domain.OrderView{{UserID: 1, OrderID: 10}}
+
+	tests := []struct {
+		name    string
+		args    args
+		prepare func()
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Success",
+			args: args{
+				daysBefore: 3,
+				expect:     orders,
+			},
+			prepare: func() {
+				m.ohp.GetOrdersToRemindMock.When(minimock.AnyContext, uint64(3), today, today.Add(3*24*time.Hour)).Then(orders, nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "StorageError",
+			args: args{
+				daysBefore: 1,
+			},
+			prepare: func() {
+				m.ohp.GetOrdersToRemindMock.When(minimock.AnyContext, uint64(1), today, today.Add(24*time.Hour)).Then(nil, errors.New("some storage error"))
+			},
+			wantErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		tt.prepare()
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			orders, err := u.GetOrdersToRemind(context.Background(), tt.args.daysBefore)
+			tt.wantErr(t, err)
+			require.Equal(t, tt.args.expect, orders)
+		})
+	}
+}
+
+func TestRemindUsecase_MarkReminded(t *testing.T) {
+	ctrl := minimock.NewController(t)
+	m := newMocks(ctrl)
+	u := newRemindUsecase(m)
+
+	m.ohp.AddRemindersMock.Expect(minimock.AnyContext, uint64(1), []uint64{1, 2}).Return(nil)
+
+	require.NoError(t, u.MarkReminded(context.Background(), 1, []uint64{1, 2}))
+	// пустой список не должен обращаться к хранилищу
+	require.NoError(t, u.MarkReminded(context.Background(), 3, nil))
+}
+
+func TestRemindUsecase_MarkSent(t *testing.T) {
+	ctrl := minimock.NewController(t)
+	m := newMocks(ctrl)
+	u := newRemindUsecase(m)
+
+	m.ohp.MarkRemindersSentMock.Expect(minimock.AnyContext, uint64(1), []uint64{1, 2}).Return(nil)
+
+	require.NoError(t, u.MarkSent(context.Background(), 1, []uint64{1, 2}))
+	require.NoError(t, u.MarkSent(context.Background(), 3, nil))
+}
@@ -1,6 +1,7 @@
 package usecase
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -18,8 +19,8 @@ func NewReturnUsecase(st storage.Storage) *ReturnUsecase {
 	return &ReturnUsecase{st}
 }
 
-func (u *ReturnUsecase) returnAccepted(orderID uint64, order *domain.OrderStatus) error {
-	expDate, err := u.st.GetExpirationDate(order.UserID, orderID)
+func (u *ReturnUsecase) returnAccepted(ctx context.Context, orderID uint64, order *domain.OrderStatus) error {
+	expDate, err := u.st.GetExpirationDate(ctx, order.UserID, orderID)
 	if err != nil {
 		return err
 	}
@@ -28,25 +29,38 @@ func (u *ReturnUsecase) returnAccepted(orderID uint64, order *domain.OrderStatus
 		return fmt.Errorf("can't return order %d: %w", orderID, domain.ErrNotExpirationDate)
 	}
 
-	return u.st.RemoveOrder(orderID, domain.StatusGiveCourier)
+	return u.st.RemoveOrder(ctx, orderID, domain.StatusGiveCourier)
 }
 
-func (u *ReturnUsecase) Return(req *dto.ReturnRequest) error {
-	order, err := u.st.GetOrderStatus(req.OrderID)
+func (u *ReturnUsecase) Return(ctx context.Context, req *dto.ReturnRequest) error {
+	return u.st.RunInTx(ctx, func(ctxTx context.Context) error {
+		return u.returnOrder(ctxTx, req)
+	})
+}
+
+func (u *ReturnUsecase) returnOrder(ctx context.Context, req *dto.ReturnRequest) error {
+	order, err := u.st.GetOrderStatusForUpdate(ctx, req.OrderID)
 	if err != nil {
 		return err
 	}
 
+	if err = order.CheckVersion(req.OrderID, req.ExpectedVersion); err != nil {
+		return err
+	}
+
+	return u.returnByStatus(ctx, req, order)
+}
+
+func (u *ReturnUsecase) returnByStatus(ctx context.Context, req *dto.ReturnRequest, order *domain.OrderStatus) error {
 	switch order.Status {
 	case domain.StatusReturned:
-		u.st.RemoveRefund(req.OrderID)
-		u.st.SetOrderStatus(req.OrderID, domain.StatusGiveCourier)
-
+		// RemoveRefund сам переводит заказ в StatusGiveCourier одним изменением версии
+		return u.st.RemoveRefund(ctx, req.OrderID)
 	case domain.StatusAccepted:
-		return u.returnAccepted(req.OrderID, order)
+		return u.returnAccepted(ctx, req.OrderID, order)
+	case domain.StatusExpired:
+		return u.st.RemoveOrder(ctx, req.OrderID, domain.StatusGiveCourier)
 	default:
 		return fmt.Errorf("can't return order %d: status = %s: %w", req.OrderID, order.Status, domain.ErrWrongStatus)
 	}
-
-	return nil
 }
@@ -1,13 +1,16 @@
 package usecase
 
 import (
+	"context"
 	"testing"
-	"time"
 
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain/strategy"
 	"gitlab.ozon.dev/chppppr/homework/internal/dto"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage/memory"
 	"gitlab.ozon.dev/chppppr/homework/internal/storage/storage_json"
 	"gitlab.ozon.dev/chppppr/homework/internal/utils"
 )
@@ -37,6 +40,9 @@ func TestReturnUsecase(t *testing.T) {
 	m := newMocks(ctrl)
 	u := newReturnUsecase(m)
 
+	statuses := make(map[uint64]string)
+	m.expectStatuses(t, statuses)
+
 	td := map[string]TestData{
 		"SuccessReturned": {
 			req: &dto.ReturnRequest{
@@ -70,6 +76,15 @@ func TestReturnUsecase(t *testing.T) {
 				},
 			},
 		},
+		"SuccessExpired": {
+			req: &dto.ReturnRequest{
+				OrderID: 5,
+			},
+			orderStatus: &domain.OrderStatus{
+				Status: domain.StatusExpired,
+				UserID: 5,
+			},
+		},
 		"WrongOrderStatus": {
 			req: &dto.ReturnRequest{
 				OrderID: 4,
@@ -94,9 +109,10 @@ func TestReturnUsecase(t *testing.T) {
 				req := data.req
 				stat := data.orderStatus
 
-				m.ohp.GetOrderStatusMock.When(req.OrderID).Then(stat, nil)
-				m.rp.RemoveRefundMock.When(req.OrderID).Then(nil)
-				m.ohp.SetOrderStatusMock.When(req.OrderID, domain.StatusGiveCourier).Then(nil)
+				m.ohp.GetOrderStatusMock.When(minimock.AnyContext, req.OrderID).Then(stat, nil)
+				m.rp.HasRefundMock.When(req.OrderID).Then(true)
+				m.rp.RemoveRefundMock.When(minimock.AnyContext, req.OrderID).Then(nil)
+				statuses[req.OrderID] = domain.StatusGiveCourier
 			},
 			wantErr: assert.NoError,
 		},
@@ -108,11 +124,10 @@ func TestReturnUsecase(t *testing.T) {
 				req := data.req
 				stat := data.orderStatus
 
-				expData, _ := time.Parse("02-01-2006", stat.ExpirationDate)
-				m.ohp.GetOrderStatusMock.When(req.OrderID).Then(stat, nil)
-				m.up.GetExpirationDateMock.When(stat.UserID, req.OrderID).Then(expData, nil)
-				m.up.RemoveOrderMock.When(stat.UserID, req.OrderID).Then(nil)
-				m.ohp.SetOrderStatusMock.When(req.OrderID, domain.StatusGiveCourier).Then(nil)
+				m.ohp.GetOrderStatusMock.When(minimock.AnyContext, req.OrderID).Then(stat, nil)
+				m.up.CanRemoveMock.When(minimock.AnyContext, stat.UserID, req.OrderID).Then(nil)
+				m.up.RemoveOrderMock.When(minimock.AnyContext, stat.UserID, req.OrderID).Then(nil)
+				statuses[req.OrderID] = domain.StatusGiveCourier
 			},
 			wantErr: assert.NoError,
 		},
@@ -124,12 +139,25 @@ func TestReturnUsecase(t *testing.T) {
 				req := data.req
 				stat := data.orderStatus
 
-				expData, _ := time.Parse("02-01-2006", stat.ExpirationDate)
-				m.ohp.GetOrderStatusMock.When(req.OrderID).Then(stat, nil)
-				m.up.GetExpirationDateMock.When(stat.UserID, req.OrderID).Then(expData, nil)
+				m.ohp.GetOrderStatusMock.When(minimock.AnyContext, req.OrderID).Then(stat, nil)
 			},
 			wantErr: assert.Error,
 		},
+		{
+			name: "SuccessExpired",
+			args: args{td["SuccessExpired"].req},
+			prepare: func() {
+				data := td["SuccessExpired"]
+				req := data.req
+				stat := data.orderStatus
+
+				m.ohp.GetOrderStatusMock.When(minimock.AnyContext, req.OrderID).Then(stat, nil)
+				m.up.CanRemoveMock.When(minimock.AnyContext, stat.UserID, req.OrderID).Then(nil)
+				m.up.RemoveOrderMock.When(minimock.AnyContext, stat.UserID, req.OrderID).Then(nil)
+				statuses[req.OrderID] = domain.StatusGiveCourier
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name: "WrongOrderStatus",
 			args: args{td["WrongOrderStatus"].req},
@@ -138,7 +166,7 @@ func TestReturnUsecase(t *testing.T) {
 				req := data.req
 				stat := data.orderStatus
 
-				m.ohp.GetOrderStatusMock.When(req.OrderID).Then(stat, nil)
+				m.ohp.GetOrderStatusMock.When(minimock.AnyContext, req.OrderID).Then(stat, nil)
 			},
 			wantErr: assert.Error,
 		},
@@ -152,8 +180,30 @@ func TestReturnUsecase(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			err := u.Return(tt.args.req)
+			err := u.Return(context.Background(), tt.args.req)
 			tt.wantErr(t, err)
 		})
 	}
 }
+
+func TestReturnRefundBumpsVersionOnce(t *testing.T) {
+	ctx := context.Background()
+	st := memory.NewStorage()
+
+	order, err := domain.NewOrder(100, 10, utils.CurrentDateString(), strategy.ContainerTypeMap["box"])
+	require.NoError(t, err)
+	require.NoError(t, st.AddOrder(ctx, 1, 1, order))
+	require.NoError(t, st.RemoveOrders(ctx, []uint64{1}, domain.StatusGiveClient))
+	require.NoError(t, st.AddRefund(ctx, 1, 1, order))
+
+	stat, err := st.GetOrderStatus(ctx, 1)
+	require.NoError(t, err)
+	version := stat.Version
+
+	require.NoError(t, NewReturnUsecase(st).Return(ctx, &dto.ReturnRequest{OrderID: 1, ExpectedVersion: &version}))
+
+	stat, err = st.GetOrderStatus(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, domain.StatusGiveCourier, stat.Status)
+	require.Equal(t, version+1, stat.Version)
+}
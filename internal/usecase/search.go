@@ -0,0 +1,130 @@
+package usecase
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/dto"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+)
+
+const defaultPageSize = 25
+
+type (
+	SearchUsecase struct {
+		st storage.Storage
+	}
+
+	// pageToken — содержимое непрозрачного токена страницы. Сортировка
+	// сохраняется в токене, чтобы его нельзя было применить к другому порядку.
+	pageToken struct {
+		SortBy     string              `json:"s"`
+		Descending bool                `json:"d"`
+		After      domain.SearchCursor `json:"a"`
+	}
+)
+
+func NewSearchUsecase(st storage.Storage) *SearchUsecase {
+	return &SearchUsecase{st}
+}
+
+func encodePageToken(t *pageToken) (string, error) {
+	data, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodePageToken(token string) (*pageToken, error) {
+	data, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page token: %w", domain.ErrWrongInput)
+	}
+
+	t := &pageToken{}
+	if err = json.Unmarshal(data, t); err != nil {
+		return nil, fmt.Errorf("invalid page token: %w", domain.ErrWrongInput)
+	}
+	return t, nil
+}
+
+func (u *SearchUsecase) applyPageToken(filter *domain.OrderFilter, token string) error {
+	if token == "" {
+		return nil
+	}
+
+	t, err := decodePageToken(token)
+	if err != nil {
+		return err
+	}
+
+	if t.SortBy != filter.SortBy || t.Descending != filter.Descending {
+		return fmt.Errorf("page token was issued for another sort order: %w", domain.ErrWrongInput)
+	}
+
+	filter.After = &t.After
+	return nil
+}
+
+func (u *SearchUsecase) nextPageToken(filter *domain.OrderFilter, last *domain.OrderRecord) (string, error) {
+	key, err := last.SortKey(filter.SortBy)
+	if err != nil {
+		return "", err
+	}
+
+	return encodePageToken(&pageToken{
+		SortBy:     filter.SortBy,
+		Descending: filter.Descending,
+		After: domain.SearchCursor{
+			Value:   key,
+			OrderID: last.OrderID,
+		},
+	})
+}
+
+func (u *SearchUsecase) newFilter(req *dto.SearchOrdersRequest) (*domain.OrderFilter, error) {
+	filter := req.OrderFilter
+	if filter.SortBy == "" {
+		filter.SortBy = domain.SortByOrderID
+	}
+
+	if !domain.IsSortField(filter.SortBy) {
+		return nil, fmt.Errorf("unknown sort field %q: %w", filter.SortBy, domain.ErrWrongInput)
+	}
+
+	if err := u.applyPageToken(&filter, req.PageToken); err != nil {
+		return nil, err
+	}
+	return &filter, nil
+}
+
+func (u *SearchUsecase) SearchOrders(ctx context.Context, req *dto.SearchOrdersRequest) (*dto.SearchOrdersResponse, error) {
+	filter, err := u.newFilter(req)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := req.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+	// лишняя запись показывает, есть ли следующая страница
+	filter.Limit = pageSize + 1
+
+	orders, err := u.st.SearchOrders(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("error while search orders: %w", err)
+	}
+
+	res := &dto.SearchOrdersResponse{Orders: orders}
+	if uint64(len(orders)) > pageSize {
+		res.Orders = orders[:pageSize]
+		res.NextPageToken, err = u.nextPageToken(filter, &res.Orders[pageSize-1])
+	}
+
+	return res, err
+}
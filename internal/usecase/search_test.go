@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/dto"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage/storage_json"
+)
+
+func newSearchUsecase(mocks *mocks) *SearchUsecase {
+	st := &storage_json.Storage{
+		Ohp:   mocks.ohp,
+		Rp:    mocks.rp,
+		Users: mocks.up,
+	}
+	return NewSearchUsecase(st)
+}
+
+func records(ordersID ...uint64) []domain.OrderRecord {
+	out := make([]domain.OrderRecord, len(ordersID))
+	for i, orderID := range ordersID {
+		out[i] = domain.OrderRecord{
+			Order:   &domain.Order{Cost: orderID * 10},
+			OrderID: orderID,
+		}
+	}
+	return out
+}
+
+func TestSearchUsecase_SearchOrders_Pages(t *testing.T) {
+	ctrl := minimock.NewController(t)
+	m := newMocks(ctrl)
+	u := newSearchUsecase(m)
+
+	m.ohp.SearchOrdersMock.Set(func(_ context.Context, filter *domain.OrderFilter) ([]domain.OrderRecord, error) {
+		require.Equal(t, domain.SortByCost, filter.SortBy)
+		require.Equal(t, uint64(3), filter.Limit)
+
+		if filter.After == nil {
+			return records(1, 2, 3), nil
+		}
+
+		require.Equal(t, domain.SearchCursor{Value: 20, OrderID: 2}, *filter.After)
+		return records(3), nil
+	})
+
+	req := &dto.SearchOrdersRequest{
+		OrderFilter: domain.OrderFilter{SortBy: domain.SortByCost},
+		PageSize:    2,
+	}
+
+	res, err := u.SearchOrders(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, records(1, 2), res.Orders)
+	require.NotEmpty(t, res.NextPageToken)
+
+	req.PageToken = res.NextPageToken
+	res, err = u.SearchOrders(context.Background(), req)
+	require.NoError(t, err)
+	require.Equal(t, records(3), res.Orders)
+	require.Empty(t, res.NextPageToken)
+}
+
+func TestSearchUsecase_SearchOrders_WrongInput(t *testing.T) {
+	ctrl := minimock.NewController(t)
+	m := newMocks(ctrl)
+	u := newSearchUsecase(m)
+
+	token, err := encodePageToken(&pageToken{SortBy: domain.SortByCost})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		req  *dto.SearchOrdersRequest
+	}{
+		{
+			name: "UnknownSortField",
+			req: &dto.SearchOrdersRequest{
+				OrderFilter: domain.OrderFilter{SortBy: "status"},
+			},
+		},
+		{
+			name: "InvalidToken",
+			req: &dto.SearchOrdersRequest{
+				PageToken: "???",
+			},
+		},
+		{
+			name: "AnotherSortOrder",
+			req: &dto.SearchOrdersRequest{
+				OrderFilter: domain.OrderFilter{SortBy: domain.SortByWeight},
+				PageToken:   token,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := u.SearchOrders(context.Background(), tt.req)
+			require.ErrorIs(t, err, domain.ErrWrongInput)
+		})
+	}
+}
+
+func TestSearchUsecase_SearchOrders_StorageError(t *testing.T) {
+	ctrl := minimock.NewController(t)
+	m := newMocks(ctrl)
+	u := newSearchUsecase(m)
+
+	m.ohp.SearchOrdersMock.Return(nil, errors.New("some storage error"))
+
+	_, err := u.SearchOrders(context.Background(), &dto.SearchOrdersRequest{})
+	require.Error(t, err)
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/dto"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+)
+
+var ErrNoPseudonymKey = errors.New("pseudonymization key isn't configured")
+
+// UserDataUsecase отвечает на запросы клиентов об их данных. Запросы записывает
+// в журнал действий AuditInterceptor: клиент там представлен только HMAC запроса,
+// поэтому стирание находится по идентификатору клиента и ключу, но не наоборот.
+//
+// Хранимые данные клиента - заказы, включая архивные, возвраты и напоминания,
+// в том числе ещё не отправленные. Историю событий подписки на заказы добавляет
+// broadcast.UserData. Отдельных таблиц событий и outbox в сервисе нет: сообщения
+// в Kafka отправляются сразу в топик и не хранятся, поэтому их нет в выгрузке,
+// а user_id в них не заменяется и остаётся до истечения срока хранения топика
+type UserDataUsecase struct {
+	st  storage.UserData
+	key []byte
+}
+
+// NewUserDataUsecase создаёт обработчик запросов клиентов об их данных.
+// key - секрет, из которого получаются псевдонимы стёртых клиентов
+func NewUserDataUsecase(st storage.UserData, key []byte) *UserDataUsecase {
+	return &UserDataUsecase{st: st, key: key}
+}
+
+func (u *UserDataUsecase) ExportUserData(ctx context.Context, req *dto.ExportUserDataRequest) (*domain.UserData, error) {
+	return u.st.ExportUserData(ctx, req.UserID)
+}
+
+func (u *UserDataUsecase) EraseUserData(ctx context.Context, req *dto.EraseUserDataRequest) (*dto.EraseUserDataResponse, error) {
+	if len(u.key) == 0 {
+		return nil, ErrNoPseudonymKey
+	}
+
+	orders, err := u.st.EraseUserData(ctx, req.UserID, domain.Pseudonymize(req.UserID, u.key))
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.EraseUserDataResponse{Orders: uint64(len(orders))}, nil
+}
@@ -0,0 +1,49 @@
+package usecase
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/dto"
+	"gitlab.ozon.dev/chppppr/homework/internal/usecase/mock"
+)
+
+func TestUserDataUsecase_EraseUserData(t *testing.T) {
+	ctx := context.Background()
+	st := mock.NewUserDataMock(minimock.NewController(t))
+
+	pseudonym := domain.Pseudonymize(10, []byte("key"))
+	st.EraseUserDataMock.Expect(minimock.AnyContext, 10, pseudonym).Return([]uint64{1, 2}, nil)
+
+	res, err := NewUserDataUsecase(st, []byte("key")).EraseUserData(ctx, &dto.EraseUserDataRequest{UserID: 10})
+	require.NoError(t, err)
+	require.EqualValues(t, 2, res.Orders)
+
+	// псевдоним воспроизводим только с тем же ключом
+	require.NotEqual(t, domain.Pseudonymize(10, []byte("other key")), pseudonym)
+	require.NotEqual(t, uint64(10), pseudonym)
+	require.Less(t, pseudonym, uint64(1)<<63)
+}
+
+func TestUserDataUsecase_EraseWithoutKey(t *testing.T) {
+	st := mock.NewUserDataMock(minimock.NewController(t))
+
+	_, err := NewUserDataUsecase(st, nil).EraseUserData(context.Background(), &dto.EraseUserDataRequest{UserID: 10})
+	require.ErrorIs(t, err, ErrNoPseudonymKey)
+}
+
+func TestUserDataUsecase_ExportUserData(t *testing.T) {
+	st := mock.NewUserDataMock(minimock.NewController(t))
+	st.ExportUserDataMock.Expect(minimock.AnyContext, 10).Return(&domain.UserData{
+		UserID: 10,
+		Orders: []domain.OrderRecord{{OrderID: 1, UserID: 10}},
+	}, nil)
+
+	data, err := NewUserDataUsecase(st, nil).ExportUserData(context.Background(), &dto.ExportUserDataRequest{UserID: 10})
+	require.NoError(t, err)
+	require.EqualValues(t, 10, data.UserID)
+	require.Len(t, data.Orders, 1)
+}
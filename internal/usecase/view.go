@@ -1,11 +1,13 @@
 package usecase
 
 import (
+	"context"
 	"fmt"
 
 	"gitlab.ozon.dev/chppppr/homework/internal/domain"
 	"gitlab.ozon.dev/chppppr/homework/internal/dto"
 	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
 )
 
 type ViewUsecase struct {
@@ -16,8 +18,8 @@ func NewViewUsecase(st storage.Storage) *ViewUsecase {
 	return &ViewUsecase{st}
 }
 
-func (u *ViewUsecase) GetRefunds(req *dto.ViewRefundsRequest) ([]domain.OrderView, error) {
-	refunds, err := u.st.GetRefunds(req.PageID, req.OrdersPerPage)
+func (u *ViewUsecase) GetRefunds(ctx context.Context, req *dto.ViewRefundsRequest) ([]domain.OrderView, error) {
+	refunds, err := u.st.GetRefunds(ctx, req.PageID, req.OrdersPerPage)
 	if err != nil {
 		return nil, fmt.Errorf("error while view refund: %s", err)
 	}
@@ -29,8 +31,8 @@ func (u *ViewUsecase) GetRefunds(req *dto.ViewRefundsRequest) ([]domain.OrderVie
 	return refunds, nil
 }
 
-func (u *ViewUsecase) GetOrders(req *dto.ViewOrdersRequest) ([]domain.OrderView, error) {
-	orders, err := u.st.GetOrdersByUserID(req.UserID, req.FirstOrderID, req.OrdersLimit)
+func (u *ViewUsecase) GetOrders(ctx context.Context, req *dto.ViewOrdersRequest) ([]domain.OrderView, error) {
+	orders, err := u.st.GetOrdersByUserID(ctx, req.UserID, req.FirstOrderID, req.OrdersLimit)
 	if err != nil {
 		return nil, err
 	}
@@ -41,3 +43,36 @@ func (u *ViewUsecase) GetOrders(req *dto.ViewOrdersRequest) ([]domain.OrderView,
 
 	return orders, nil
 }
+
+// GetOrder возвращает заказ вместе с вычисляемыми полями:
+// количеством дней до окончания хранения и возможностью вернуть его сейчас.
+func (u *ViewUsecase) GetOrder(ctx context.Context, req *dto.GetOrderRequest) (*dto.GetOrderResponse, error) {
+	order, err := u.st.GetOrderStatus(ctx, req.OrderID)
+	if err != nil {
+		return nil, err
+	}
+
+	expDate, err := utils.StringToTime(order.ExpirationDate)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing Expiration Date: %w", err)
+	}
+
+	refund_req := &dto.RefundRequest{
+		UserID:  order.UserID,
+		OrderID: req.OrderID,
+	}
+
+	return &dto.GetOrderResponse{
+		Order: domain.OrderRecord{
+			Order:      order.Order,
+			OrderID:    req.OrderID,
+			UserID:     order.UserID,
+			Status:     order.Status,
+			AcceptedAt: order.AcceptedAt,
+			UpdatedAt:  order.UpdatedAt,
+			Version:    order.Version,
+		},
+		DaysUntilExpiration: int64(expDate.Sub(utils.CurrentDate()).Hours() / 24),
+		Refundable:          acceptRefundCheckErr(refund_req, order) == nil,
+	}, nil
+}
@@ -1,6 +1,7 @@
 package usecase
 
 import (
+	"context"
 	"testing"
 
 	"github.com/gojuno/minimock/v3"
@@ -9,6 +10,7 @@ import (
 	"gitlab.ozon.dev/chppppr/homework/internal/domain"
 	"gitlab.ozon.dev/chppppr/homework/internal/dto"
 	"gitlab.ozon.dev/chppppr/homework/internal/storage/storage_json"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
 )
 
 func newViewUsecase(mocks *mocks) *ViewUsecase {
@@ -20,6 +22,24 @@ func newViewUsecase(mocks *mocks) *ViewUsecase {
 	return NewViewUsecase(st)
 }
 
+// userOrdersFilter - запрос, которым JSON-хранилище выбирает заказы клиента из истории
+func userOrdersFilter(req *dto.ViewOrdersRequest) *domain.OrderFilter {
+	return &domain.OrderFilter{
+		UserID: req.UserID,
+		SortBy: domain.SortByOrderID,
+		After:  &domain.SearchCursor{Value: req.FirstOrderID - 1, OrderID: req.FirstOrderID - 1},
+		Limit:  req.OrdersLimit,
+	}
+}
+
+func toRecords(orders []domain.OrderView) []domain.OrderRecord {
+	records := make([]domain.OrderRecord, 0, len(orders))
+	for _, order := range orders {
+		records = append(records, domain.OrderRecord{Order: order.Order, OrderID: order.OrderID, UserID: order.UserID, Version: order.Version})
+	}
+	return records
+}
+
 func TestViewUsecase_GetOrders(t *testing.T) {
 	type (
 		args struct {
@@ -47,12 +67,15 @@ func TestViewUsecase_GetOrders(t *testing.T) {
 			view: []domain.OrderView{
 				{
 					OrderID: 1,
+					Version: 1,
 				},
 				{
 					OrderID: 2,
+					Version: 2,
 				},
 				{
 					OrderID: 3,
+					Version: 3,
 				},
 			},
 		},
@@ -80,7 +103,7 @@ func TestViewUsecase_GetOrders(t *testing.T) {
 				req := data.req
 				orders := data.view
 
-				m.up.GetOrdersMock.When(req.UserID, req.FirstOrderID, req.OrdersLimit).Then(orders, nil)
+				m.ohp.SearchOrdersMock.When(minimock.AnyContext, userOrdersFilter(req)).Then(toRecords(orders), nil)
 			},
 			wantErr: assert.NoError,
 		},
@@ -92,7 +115,7 @@ func TestViewUsecase_GetOrders(t *testing.T) {
 				req := data.req
 				orders := data.view
 
-				m.up.GetOrdersMock.When(req.UserID, req.FirstOrderID, req.OrdersLimit).Then(orders, nil)
+				m.ohp.SearchOrdersMock.When(minimock.AnyContext, userOrdersFilter(req)).Then(toRecords(orders), nil)
 			},
 			wantErr: assert.Error,
 		},
@@ -106,7 +129,7 @@ func TestViewUsecase_GetOrders(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := u.GetOrders(tt.args.req)
+			got, err := u.GetOrders(context.Background(), tt.args.req)
 			tt.wantErr(t, err)
 
 			require.Equal(t, tt.args.expect, got)
@@ -140,12 +163,15 @@ func TestViewUsecase_GetRefunds(t *testing.T) {
 			view: []domain.OrderView{
 				{
 					OrderID: 1,
+					Version: 1,
 				},
 				{
 					OrderID: 2,
+					Version: 2,
 				},
 				{
 					OrderID: 3,
+					Version: 3,
 				},
 			},
 		},
@@ -172,7 +198,10 @@ func TestViewUsecase_GetRefunds(t *testing.T) {
 				req := data.req
 				orders := data.view
 
-				m.rp.GetRefundsMock.When(req.PageID, req.OrdersPerPage).Then(orders, nil)
+				m.rp.GetRefundsMock.When(minimock.AnyContext, req.PageID, req.OrdersPerPage).Then(orders, nil)
+				for _, order := range orders {
+					m.ohp.GetOrderStatusMock.When(minimock.AnyContext, order.OrderID).Then(&domain.OrderStatus{Version: order.Version}, nil)
+				}
 			},
 			wantErr: assert.NoError,
 		},
@@ -184,7 +213,7 @@ func TestViewUsecase_GetRefunds(t *testing.T) {
 				req := data.req
 				orders := data.view
 
-				m.rp.GetRefundsMock.When(req.PageID, req.OrdersPerPage).Then(orders, nil)
+				m.rp.GetRefundsMock.When(minimock.AnyContext, req.PageID, req.OrdersPerPage).Then(orders, nil)
 			},
 			wantErr: assert.Error,
 		},
@@ -198,10 +227,95 @@ func TestViewUsecase_GetRefunds(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
-			got, err := u.GetRefunds(tt.args.req)
+			got, err := u.GetRefunds(context.Background(), tt.args.req)
 			tt.wantErr(t, err)
 
 			require.Equal(t, tt.args.expect, got)
 		})
 	}
 }
+
+func TestViewUsecase_GetOrder(t *testing.T) {
+	type args struct {
+		req        *dto.GetOrderRequest
+		days       int64
+		refundable bool
+	}
+
+	ctrl := minimock.NewController(t)
+	m := newMocks(ctrl)
+	u := newViewUsecase(m)
+
+	today := utils.CurrentDate()
+	newStatus := func(status string, expDays int) *domain.OrderStatus {
+		return &domain.OrderStatus{
+			Order: &domain.Order{
+				ExpirationDate: utils.TimeToString(today.AddDate(0, 0, expDays)),
+			},
+			Status:     status,
+			AcceptedAt: utils.TimeToString(today),
+			UpdatedAt:  utils.TimeToString(today),
+			UserID:     1,
+		}
+	}
+
+	tests := []struct {
+		name    string
+		args    args
+		prepare func()
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "Accepted",
+			args: args{
+				req:  &dto.GetOrderRequest{OrderID: 1},
+				days: 3,
+			},
+			prepare: func() {
+				m.ohp.GetOrderStatusMock.When(minimock.AnyContext, uint64(1)).Then(newStatus(domain.StatusAccepted, 3), nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "IssuedRefundable",
+			args: args{
+				req:        &dto.GetOrderRequest{OrderID: 2},
+				days:       -1,
+				refundable: true,
+			},
+			prepare: func() {
+				m.ohp.GetOrderStatusMock.When(minimock.AnyContext, uint64(2)).Then(newStatus(domain.StatusGiveClient, -1), nil)
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "NotFound",
+			args: args{
+				req: &dto.GetOrderRequest{OrderID: 3},
+			},
+			prepare: func() {
+				m.ohp.GetOrderStatusMock.When(minimock.AnyContext, uint64(3)).Then(nil, domain.ErrNotFound)
+			},
+			wantErr: assert.Error,
+		},
+	}
+
+	for _, tt := range tests {
+		tt.prepare()
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := u.GetOrder(context.Background(), tt.args.req)
+			if !tt.wantErr(t, err) || err != nil {
+				return
+			}
+
+			require.Equal(t, tt.args.req.OrderID, res.Order.OrderID)
+			require.Equal(t, tt.args.days, res.DaysUntilExpiration)
+			require.Equal(t, tt.args.refundable, res.Refundable)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"gitlab.ozon.dev/chppppr/homework/internal/broadcast"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/dto"
+)
+
+var watchStatuses = map[string]struct{}{
+	"":                       {},
+	domain.StatusAccepted:    {},
+	domain.StatusGiveClient:  {},
+	domain.StatusGiveCourier: {},
+	domain.StatusReturned:    {},
+	domain.StatusExpired:     {},
+}
+
+type WatchUsecase struct {
+	b *broadcast.Broadcaster
+}
+
+func NewWatchUsecase(b *broadcast.Broadcaster) *WatchUsecase {
+	return &WatchUsecase{b}
+}
+
+func (u *WatchUsecase) Watch(ctx context.Context, req *dto.WatchOrdersRequest) (*broadcast.Subscription, error) {
+	if _, ok := watchStatuses[req.Status]; !ok {
+		return nil, fmt.Errorf("unknown status %q: %w", req.Status, domain.ErrWrongInput)
+	}
+
+	filter := domain.WatchFilter{
+		UserID: req.UserID,
+		Status: req.Status,
+	}
+	return u.b.Subscribe(filter, req.Cursor)
+}
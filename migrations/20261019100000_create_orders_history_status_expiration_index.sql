@@ -0,0 +1,5 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+create index concurrently if not exists orders_history_status_expiration_idx on orders_history (status, expiration_date);
+-- +goose Down
+drop index concurrently if exists orders_history_status_expiration_idx;
\ No newline at end of file
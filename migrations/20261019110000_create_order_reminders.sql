@@ -0,0 +1,9 @@
+-- +goose Up
+create table if not exists order_reminders (
+    order_id bigint not null,
+    stage bigint not null,
+    sent_at timestamp not null default now(),
+    primary key(order_id, stage)
+);
+-- +goose Down
+drop table if exists order_reminders;
\ No newline at end of file
@@ -0,0 +1,6 @@
+-- +goose Up
+alter table orders_history add column if not exists accepted_at date;
+update orders_history set accepted_at = updated_at where accepted_at is null;
+alter table orders_history alter column accepted_at set not null;
+-- +goose Down
+alter table orders_history drop column if exists accepted_at;
\ No newline at end of file
@@ -0,0 +1,15 @@
+-- +goose NO TRANSACTION
+-- +goose Up
+create index concurrently if not exists orders_history_expiration_order_idx on orders_history (expiration_date, order_id);
+create index concurrently if not exists orders_history_accepted_order_idx on orders_history (accepted_at, order_id);
+create index concurrently if not exists orders_history_updated_order_idx on orders_history (updated_at, order_id);
+create index concurrently if not exists orders_history_cost_order_idx on orders_history (cost, order_id);
+create index concurrently if not exists orders_history_weight_order_idx on orders_history (weight, order_id);
+create index concurrently if not exists orders_history_package_type_idx on orders_history (package_type);
+-- +goose Down
+drop index concurrently if exists orders_history_expiration_order_idx;
+drop index concurrently if exists orders_history_accepted_order_idx;
+drop index concurrently if exists orders_history_updated_order_idx;
+drop index concurrently if exists orders_history_cost_order_idx;
+drop index concurrently if exists orders_history_weight_order_idx;
+drop index concurrently if exists orders_history_package_type_idx;
\ No newline at end of file
@@ -0,0 +1,4 @@
+-- +goose Up
+alter table orders_history add column if not exists version bigint not null default 1;
+-- +goose Down
+alter table orders_history drop column if exists version;
\ No newline at end of file
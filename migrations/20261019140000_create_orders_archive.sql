@@ -0,0 +1,28 @@
+-- +goose Up
+create table if not exists orders_archive (
+    user_id bigint not null,
+    order_id bigint not null,
+    expiration_date date not null,
+    package_type text not null,
+    weight bigint not null,
+    cost bigint not null,
+    use_tape boolean not null,
+    status text not null,
+    accepted_at date not null,
+    updated_at date not null,
+    version bigint not null,
+    archived_at date not null default current_date,
+    primary key(order_id, updated_at)
+) partition by range (updated_at);
+
+create index if not exists orders_archive_user_order_idx on orders_archive (user_id, order_id);
+
+create or replace view orders_all as
+    select user_id, order_id, expiration_date, package_type, weight, cost, use_tape, status, accepted_at, updated_at, version
+    from orders_history
+    union all
+    select user_id, order_id, expiration_date, package_type, weight, cost, use_tape, status, accepted_at, updated_at, version
+    from orders_archive;
+-- +goose Down
+drop view if exists orders_all;
+drop table if exists orders_archive;
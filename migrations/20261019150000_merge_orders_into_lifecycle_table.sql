@@ -0,0 +1,76 @@
+-- +goose Up
+alter table orders_history add column if not exists issued_at date;
+alter table orders_history add column if not exists returned_at date;
+alter table orders_history add column if not exists deleted_at date;
+
+-- даты выводятся из статуса: при расхождении orders и refunds с orders_history верным считается статус.
+-- Дата выдачи известна только у выданных заказов: их последнее изменение и есть выдача.
+-- У возвращённых она не сохранилась и остаётся пустой
+update orders_history oh set returned_at = oh.updated_at
+    where oh.status = 'returned'
+    or (oh.status = 'issued to courier' and exists (select 1 from refunds r where r.order_id = oh.order_id));
+update orders_history set issued_at = updated_at
+    where status = 'issued to client';
+update orders_history set deleted_at = updated_at
+    where status = 'issued to courier';
+
+drop table if exists orders;
+drop table if exists refunds;
+
+alter table orders_history rename to orders;
+alter index orders_history_pkey rename to orders_pkey;
+alter index if exists orders_history_user_order_expiration_idx rename to orders_user_order_expiration_idx;
+alter index if exists orders_history_order_status_idx rename to orders_order_status_idx;
+alter index if exists orders_history_status_expiration_idx rename to orders_status_expiration_idx;
+alter index if exists orders_history_expiration_order_idx rename to orders_expiration_order_idx;
+alter index if exists orders_history_accepted_order_idx rename to orders_accepted_order_idx;
+alter index if exists orders_history_updated_order_idx rename to orders_updated_order_idx;
+alter index if exists orders_history_cost_order_idx rename to orders_cost_order_idx;
+alter index if exists orders_history_weight_order_idx rename to orders_weight_order_idx;
+alter index if exists orders_history_package_type_idx rename to orders_package_type_idx;
+
+-- заказы на хранении в ПВЗ и ожидающие курьера возвраты
+create index if not exists orders_held_user_order_idx on orders (user_id, order_id) where issued_at is null and returned_at is null and deleted_at is null;
+create index if not exists orders_refunds_order_idx on orders (order_id) where returned_at is not null and deleted_at is null;
+
+alter table orders_archive add column if not exists issued_at date;
+alter table orders_archive add column if not exists returned_at date;
+alter table orders_archive add column if not exists deleted_at date;
+-- +goose Down
+alter table orders_archive drop column if exists issued_at;
+alter table orders_archive drop column if exists returned_at;
+alter table orders_archive drop column if exists deleted_at;
+
+drop index if exists orders_held_user_order_idx;
+drop index if exists orders_refunds_order_idx;
+
+alter index orders_pkey rename to orders_history_pkey;
+alter index if exists orders_user_order_expiration_idx rename to orders_history_user_order_expiration_idx;
+alter index if exists orders_order_status_idx rename to orders_history_order_status_idx;
+alter index if exists orders_status_expiration_idx rename to orders_history_status_expiration_idx;
+alter index if exists orders_expiration_order_idx rename to orders_history_expiration_order_idx;
+alter index if exists orders_accepted_order_idx rename to orders_history_accepted_order_idx;
+alter index if exists orders_updated_order_idx rename to orders_history_updated_order_idx;
+alter index if exists orders_cost_order_idx rename to orders_history_cost_order_idx;
+alter index if exists orders_weight_order_idx rename to orders_history_weight_order_idx;
+alter index if exists orders_package_type_idx rename to orders_history_package_type_idx;
+alter table orders rename to orders_history;
+
+create table if not exists orders (
+    user_id bigint not null,
+    order_id bigint not null,
+    primary key(order_id)
+);
+insert into orders (user_id, order_id)
+    select user_id, order_id from orders_history where issued_at is null and returned_at is null and deleted_at is null;
+
+create table if not exists refunds (
+    order_id bigint not null,
+    primary key(order_id)
+);
+insert into refunds (order_id)
+    select order_id from orders_history where returned_at is not null and deleted_at is null;
+
+alter table orders_history drop column if exists issued_at;
+alter table orders_history drop column if exists returned_at;
+alter table orders_history drop column if exists deleted_at;
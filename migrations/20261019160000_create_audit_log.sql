@@ -0,0 +1,35 @@
+-- +goose Up
+create table if not exists audit_log (
+    id bigserial primary key,
+    created_at timestamptz not null,
+    actor text not null,
+    pickup_point text not null,
+    method text not null,
+    order_ids bigint[] not null default '{}',
+    payload_digest text not null,
+    outcome text not null,
+    code text not null,
+    prev_hash text not null,
+    hash text not null unique
+);
+
+create index if not exists audit_log_actor_idx on audit_log (actor, id);
+create index if not exists audit_log_order_ids_idx on audit_log using gin (order_ids);
+create index if not exists audit_log_created_at_idx on audit_log (created_at);
+
+-- +goose StatementBegin
+create or replace function audit_log_append_only() returns trigger as $$
+begin
+    raise exception 'audit_log is append-only';
+end;
+$$ language plpgsql;
+-- +goose StatementEnd
+
+create trigger audit_log_no_change before update or delete on audit_log
+    for each row execute function audit_log_append_only();
+
+create trigger audit_log_no_truncate before truncate on audit_log
+    for each statement execute function audit_log_append_only();
+-- +goose Down
+drop table if exists audit_log;
+drop function if exists audit_log_append_only();
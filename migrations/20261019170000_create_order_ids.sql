@@ -0,0 +1,13 @@
+-- +goose Up
+-- номера всех заказов, активных и архивных: первичный ключ не даёт занять номер
+-- архивного заказа, пока его секция не удалена
+create table if not exists order_ids (
+    order_id bigint not null,
+    primary key(order_id)
+);
+insert into order_ids (order_id)
+    select order_id from orders
+    union
+    select order_id from orders_archive;
+-- +goose Down
+drop table if exists order_ids;
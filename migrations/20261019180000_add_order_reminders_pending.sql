@@ -0,0 +1,10 @@
+-- +goose Up
+-- напоминание записывается до отправки в kafka с пустым sent_at и отмечается после неё
+alter table order_reminders alter column sent_at drop default;
+alter table order_reminders alter column sent_at drop not null;
+create index if not exists order_reminders_pending_idx on order_reminders (stage, order_id) where sent_at is null;
+-- +goose Down
+drop index if exists order_reminders_pending_idx;
+update order_reminders set sent_at = now() where sent_at is null;
+alter table order_reminders alter column sent_at set not null;
+alter table order_reminders alter column sent_at set default now();
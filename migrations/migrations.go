@@ -0,0 +1,7 @@
+// Package migrations встраивает миграции схемы postgres в бинарники сервиса
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
@@ -1,12 +1,34 @@
 package test
 
 import (
+	"context"
+	"os"
 	"testing"
 
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage/postgres"
 	storage_suite "gitlab.ozon.dev/chppppr/homework/tests/suite/storage"
 )
 
 func TestStorageDBSuite(t *testing.T) {
 	suite.Run(t, &storage_suite.StorageDBSuite{})
 }
+
+func TestStorageContract(t *testing.T) {
+	suite.Run(t, &storage_suite.StorageContractSuite{
+		NewStorage: func(t *testing.T) storage.Storage {
+			psqlDSN, ok := os.LookupEnv("POSTGRESQL_TEST_DSN")
+			require.True(t, ok, "Not found POSTGRESQL_TEST_DSN at .env")
+
+			pool, err := pgxpool.New(context.Background(), psqlDSN)
+			require.NoError(t, err)
+			t.Cleanup(pool.Close)
+
+			txManager := postgres.NewTxManager(pool)
+			return postgres.NewStorageDB(txManager, postgres.NewRepoPG(txManager))
+		},
+	})
+}
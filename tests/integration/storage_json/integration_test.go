@@ -1,13 +1,15 @@
 package test
 
 import (
-	"os"
+	"context"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	"gitlab.ozon.dev/chppppr/homework/internal/domain"
 	"gitlab.ozon.dev/chppppr/homework/internal/domain/strategy"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
 	"gitlab.ozon.dev/chppppr/homework/internal/storage/storage_json"
 	"gitlab.ozon.dev/chppppr/homework/internal/utils"
 	storage_suite "gitlab.ozon.dev/chppppr/homework/tests/suite/storage"
@@ -17,17 +19,25 @@ func TestSuite(t *testing.T) {
 	suite.Run(t, &storage_suite.StorageJSONSuite{})
 }
 
+func TestStorageContract(t *testing.T) {
+	suite.Run(t, &storage_suite.StorageContractSuite{
+		NewStorage: func(t *testing.T) storage.Storage {
+			return openStorage(t, filepath.Join(t.TempDir(), "storage_contract.json"))
+		},
+	})
+}
+
 func TestStorageSuccessAddOrder(t *testing.T) {
 	t.Parallel()
 
 	ohp := storage_json.NewOrdersHistory()
 	rp := storage_json.NewRefunds()
 	up := storage_json.NewUsers()
-	path := "storage_TestStorageSuccessAdd.json"
-	defer os.Remove(path)
+	path := filepath.Join(t.TempDir(), "storage_TestStorageSuccessAdd.json")
 
 	st, err := storage_json.NewStorage(ohp, rp, up, path)
 	require.NoError(t, err)
+	ctx := context.Background()
 
 	userID := uint64(1)
 	orderID := uint64(1)
@@ -48,14 +58,14 @@ func TestStorageSuccessAddOrder(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, expect_order, order)
 
-	err = st.AddOrder(userID, orderID, order)
+	err = st.AddOrder(ctx, userID, orderID, order)
 	require.NoError(t, err)
 
-	order, err = st.GetOrder(userID, orderID)
+	order, err = st.GetOrder(ctx, userID, orderID)
 	require.NoError(t, err)
 	require.Equal(t, expect_order, order)
 
-	expDate, err := st.GetExpirationDate(userID, orderID)
+	expDate, err := st.GetExpirationDate(ctx, userID, orderID)
 	require.NoError(t, err)
 	require.Equal(t, utils.CurrentDate(), expDate)
 }
@@ -66,11 +76,11 @@ func TestStorageSuccessRemoveOrder(t *testing.T) {
 	ohp := storage_json.NewOrdersHistory()
 	rp := storage_json.NewRefunds()
 	up := storage_json.NewUsers()
-	path := "storage_TestStorageSuccessRemoveOrder.json"
-	defer os.Remove(path)
+	path := filepath.Join(t.TempDir(), "storage_TestStorageSuccessRemoveOrder.json")
 
 	st, err := storage_json.NewStorage(ohp, rp, up, path)
 	require.NoError(t, err)
+	ctx := context.Background()
 
 	userID := uint64(1)
 	orderID := uint64(1)
@@ -85,15 +95,15 @@ func TestStorageSuccessRemoveOrder(t *testing.T) {
 	give_orders := []uint64{orderID, orderID + 1, orderID + 2}
 
 	for id := range give_orders {
-		err = st.AddOrder(userID, uint64(id), order)
+		err = st.AddOrder(ctx, userID, uint64(id), order)
 		require.NoError(t, err)
 	}
 
 	for id := range give_orders {
-		err = st.CanRemoveOrder(uint64(id))
+		err = st.CanRemoveOrder(ctx, uint64(id))
 		require.NoError(t, err)
 
-		err = st.RemoveOrder(uint64(id), domain.StatusGiveClient)
+		err = st.RemoveOrder(ctx, uint64(id), domain.StatusGiveClient)
 		require.NoError(t, err)
 	}
 }
@@ -104,11 +114,11 @@ func TestStorageSuccessReturn(t *testing.T) {
 	ohp := storage_json.NewOrdersHistory()
 	rp := storage_json.NewRefunds()
 	up := storage_json.NewUsers()
-	path := "storage_TestStorageSuccessReturn.json"
-	defer os.Remove(path)
+	path := filepath.Join(t.TempDir(), "storage_TestStorageSuccessReturn.json")
 
 	st, err := storage_json.NewStorage(ohp, rp, up, path)
 	require.NoError(t, err)
+	ctx := context.Background()
 
 	userID := uint64(1)
 	orderID := uint64(1)
@@ -121,20 +131,112 @@ func TestStorageSuccessReturn(t *testing.T) {
 	require.NoError(t, err)
 
 	// Заказ принят
-	err = st.AddOrder(userID, orderID, order)
+	err = st.AddOrder(ctx, userID, orderID, order)
 	require.NoError(t, err)
 
 	// Заказ забрали
-	err = st.CanRemoveOrder(orderID)
+	err = st.CanRemoveOrder(ctx, orderID)
 	require.NoError(t, err)
-	err = st.RemoveOrder(orderID, domain.StatusGiveClient)
+	err = st.RemoveOrder(ctx, orderID, domain.StatusGiveClient)
 	require.NoError(t, err)
 
 	// Заказ вернули на ПВЗ
-	err = st.AddRefund(userID, orderID, order)
+	err = st.AddRefund(ctx, userID, orderID, order)
 	require.NoError(t, err)
 
 	// Заказ вернули курьеру
-	err = st.RemoveRefund(orderID)
+	err = st.RemoveRefund(ctx, orderID)
+	require.NoError(t, err)
+}
+
+func TestStorageReminders(t *testing.T) {
+	t.Parallel()
+
+	ohp := storage_json.NewOrdersHistory()
+	rp := storage_json.NewRefunds()
+	up := storage_json.NewUsers()
+	path := filepath.Join(t.TempDir(), "storage_TestStorageReminders.json")
+
+	st, err := storage_json.NewStorage(ohp, rp, up, path)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	today := utils.CurrentDate()
+	cs := strategy.ContainerTypeMap["box"]
+
+	// Заказ 1 истекает через день, заказ 2 — через 3 дня, заказ 3 — через 5 дней
+	for orderID, days := range map[uint64]int{1: 1, 2: 3, 3: 5} {
+		expDate := utils.TimeToString(today.AddDate(0, 0, days))
+		order, err := domain.NewOrder(100, 10, expDate, cs)
+		require.NoError(t, err)
+		require.NoError(t, st.AddOrder(ctx, 1, orderID, order))
+	}
+
+	orders, err := st.GetOrdersToRemind(ctx, 1, today, today.AddDate(0, 0, 1))
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	require.Equal(t, uint64(1), orders[0].OrderID)
+	require.NoError(t, st.AddReminders(ctx, 1, []uint64{1}))
+
+	// Заказ 1 уже получил более позднее напоминание
+	orders, err = st.GetOrdersToRemind(ctx, 3, today, today.AddDate(0, 0, 3))
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	require.Equal(t, uint64(2), orders[0].OrderID)
+	require.NoError(t, st.AddReminders(ctx, 3, []uint64{2}))
+
+	// Повторный запуск ничего не отправляет
+	orders, err = st.GetOrdersToRemind(ctx, 3, today, today.AddDate(0, 0, 3))
+	require.NoError(t, err)
+	require.Empty(t, orders)
+
+	// Напоминание за 1 день для заказа 2 ещё не отправлялось
+	orders, err = st.GetOrdersToRemind(ctx, 1, today, today.AddDate(0, 0, 3))
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+	require.Equal(t, uint64(2), orders[0].OrderID)
+}
+
+func TestStorageSearchOrders(t *testing.T) {
+	t.Parallel()
+
+	ohp := storage_json.NewOrdersHistory()
+	rp := storage_json.NewRefunds()
+	up := storage_json.NewUsers()
+	path := filepath.Join(t.TempDir(), "storage_TestStorageSearchOrders.json")
+
+	st, err := storage_json.NewStorage(ohp, rp, up, path)
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	cs := strategy.ContainerTypeMap["box"]
+	for orderID := uint64(1); orderID <= 5; orderID++ {
+		// стоимость убывает с ростом номера заказа
+		order, err := domain.NewOrder(1000-orderID*100, 10, utils.CurrentDateString(), cs)
+		require.NoError(t, err)
+		require.NoError(t, st.AddOrder(ctx, orderID%2, orderID, order))
+	}
+	require.NoError(t, st.SetOrderStatus(ctx, 5, domain.StatusGiveClient))
+
+	minCost := uint64(600)
+	filter := &domain.OrderFilter{
+		Statuses: []string{domain.StatusAccepted},
+		Cost:     domain.UintRange{Min: &minCost},
+		SortBy:   domain.SortByCost,
+		Limit:    2,
+	}
+
+	orders, err := st.SearchOrders(ctx, filter)
+	require.NoError(t, err)
+	require.Len(t, orders, 2)
+	require.Equal(t, []uint64{4, 3}, []uint64{orders[0].OrderID, orders[1].OrderID})
+
+	key, err := orders[1].SortKey(filter.SortBy)
+	require.NoError(t, err)
+	filter.After = &domain.SearchCursor{Value: key, OrderID: orders[1].OrderID}
+
+	orders, err = st.SearchOrders(ctx, filter)
 	require.NoError(t, err)
+	require.Len(t, orders, 2)
+	require.Equal(t, []uint64{2, 1}, []uint64{orders[0].OrderID, orders[1].OrderID})
 }
@@ -0,0 +1,227 @@
+package test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain/strategy"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage/storage_json"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+func openStorage(t *testing.T, path string) *storage_json.Storage {
+	st, err := storage_json.NewStorage(
+		storage_json.NewOrdersHistory(),
+		storage_json.NewRefunds(),
+		storage_json.NewUsers(),
+		path,
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { st.Close() })
+	return st
+}
+
+// fillStorage принимает заказ 1 и выдаёт его, заказ 2 только принимает
+func fillStorage(t *testing.T, ctx context.Context, st *storage_json.Storage) {
+	expDate := utils.TimeToString(utils.CurrentDate().AddDate(0, 0, 3))
+	order, err := domain.NewOrder(100, 10, expDate, strategy.ContainerTypeMap["box"])
+	require.NoError(t, err)
+
+	require.NoError(t, st.AddOrder(ctx, 1, 1, order))
+	require.NoError(t, st.AddOrder(ctx, 1, 2, order))
+	require.NoError(t, st.RemoveOrders(ctx, []uint64{1}, domain.StatusGiveClient))
+}
+
+func requireFilled(t *testing.T, ctx context.Context, st *storage_json.Storage) {
+	stat, err := st.GetOrderStatus(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, domain.StatusGiveClient, stat.Status)
+	require.EqualValues(t, 2, stat.Version)
+
+	stat, err = st.GetOrderStatus(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, domain.StatusAccepted, stat.Status)
+
+	_, err = st.GetOrder(ctx, 1, 2)
+	require.NoError(t, err)
+	require.Error(t, st.CanRemoveOrder(ctx, 1))
+}
+
+func TestStorageRecoverFromWAL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "storage_TestStorageRecoverFromWAL.json")
+
+	st := openStorage(t, path)
+	fillStorage(t, ctx, st)
+	// без Save: данные есть только в журнале
+	require.NoError(t, st.Close())
+
+	requireFilled(t, ctx, openStorage(t, path))
+}
+
+func TestStorageRecoverTornWAL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "storage_TestStorageRecoverTornWAL.json")
+
+	st := openStorage(t, path)
+	fillStorage(t, ctx, st)
+	require.NoError(t, st.Close())
+
+	// обрыв записи посреди последней строки журнала
+	wal, err := os.OpenFile(path+".wal", os.O_WRONLY|os.O_APPEND, 0666)
+	require.NoError(t, err)
+	_, err = wal.WriteString(`{"seq":100,"op":"add_refund","orderID":`)
+	require.NoError(t, err)
+	require.NoError(t, wal.Close())
+
+	st = openStorage(t, path)
+	requireFilled(t, ctx, st)
+
+	refunds, err := st.GetRefunds(ctx, 1, 10)
+	require.NoError(t, err)
+	require.Empty(t, refunds)
+}
+
+func TestStorageRecoverAfterSnapshot(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "storage_TestStorageRecoverAfterSnapshot.json")
+
+	st := openStorage(t, path)
+	fillStorage(t, ctx, st)
+
+	// падение между записью снапшота и очисткой журнала
+	wal, err := os.ReadFile(path + ".wal")
+	require.NoError(t, err)
+	require.NoError(t, st.Save())
+	require.NoError(t, st.Close())
+	require.NoError(t, os.WriteFile(path+".wal", wal, 0666))
+
+	st = openStorage(t, path)
+	requireFilled(t, ctx, st)
+
+	info, err := os.Stat(path + ".wal")
+	require.NoError(t, err)
+	require.Zero(t, info.Size())
+}
+
+func TestStorageCompactsWAL(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "storage_TestStorageCompactsWAL.json")
+
+	st := openStorage(t, path)
+	order, err := domain.NewOrder(100, 10, utils.CurrentDateString(), strategy.ContainerTypeMap["box"])
+	require.NoError(t, err)
+
+	// каждый AddOrder пишет две записи, журнал должен сжаться хотя бы раз
+	orders := uint64(storage_json.SnapshotEvery)
+	for orderID := uint64(1); orderID <= orders; orderID++ {
+		require.NoError(t, st.AddOrder(ctx, 1, orderID, order))
+	}
+	require.NoError(t, st.Close())
+
+	wal, err := os.ReadFile(path + ".wal")
+	require.NoError(t, err)
+	require.Less(t, bytes.Count(wal, []byte("\n")), storage_json.SnapshotEvery)
+
+	st = openStorage(t, path)
+	count, err := st.GetOrdersCountByStatus(ctx, domain.StatusAccepted)
+	require.NoError(t, err)
+	require.Equal(t, orders, count)
+}
+
+func TestStorageCompactsWALWithParallelWriters(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "storage_TestStorageCompactsWALWithParallelWriters.json")
+
+	st := openStorage(t, path)
+	order, err := domain.NewOrder(100, 10, utils.CurrentDateString(), strategy.ContainerTypeMap["box"])
+	require.NoError(t, err)
+
+	// записи журнала пересекают SnapshotEvery, пока другие горутины пишут и читают
+	const writers = 8
+	perWriter := uint64(storage_json.SnapshotEvery/writers + 1)
+
+	var wg sync.WaitGroup
+	for w := uint64(0); w < writers; w++ {
+		wg.Add(2)
+		go func() {
+			defer wg.Done()
+			for i := uint64(1); i <= perWriter; i++ {
+				assert.NoError(t, st.AddOrder(ctx, w+1, w*perWriter+i, order))
+			}
+		}()
+		go func() {
+			defer wg.Done()
+			for i := uint64(1); i <= perWriter; i++ {
+				_, err := st.SearchOrders(ctx, &domain.OrderFilter{UserID: w + 1, SortBy: domain.SortByOrderID, Limit: 10})
+				assert.NoError(t, err)
+			}
+		}()
+	}
+	wg.Wait()
+	require.NoError(t, st.Close())
+
+	st = openStorage(t, path)
+	count, err := st.GetOrdersCountByStatus(ctx, domain.StatusAccepted)
+	require.NoError(t, err)
+	require.Equal(t, writers*perWriter, count)
+}
+
+func TestStorageKeepsMemoryWhenWALFails(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "storage_TestStorageKeepsMemoryWhenWALFails.json")
+
+	st := openStorage(t, path)
+	fillStorage(t, ctx, st)
+
+	// закрытый журнал не даёт дописать запись
+	require.NoError(t, st.Close())
+	require.Error(t, st.SetOrderStatus(ctx, 2, domain.StatusExpired))
+
+	stat, err := st.GetOrderStatus(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, domain.StatusAccepted, stat.Status)
+	require.EqualValues(t, 1, stat.Version)
+}
+
+func TestStorageLoadsLegacyOrdersWithInitialVersion(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "storage.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"historyRepository": {"ordersHistory": {"1": {
+			"expirationDate": "10-10-2024", "packageType": "box", "cost": 100, "weight": 10,
+			"status": "accepted", "updatedAt": "20-09-2024", "userID": 1
+		}}},
+		"refundsRepository": {},
+		"usersRepository": {}
+	}`), 0o644))
+
+	st := openStorage(t, path)
+	stat, err := st.GetOrderStatus(ctx, 1)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, stat.Version)
+
+	require.NoError(t, st.SetOrderStatus(ctx, 1, domain.StatusExpired))
+	stat, err = st.GetOrderStatus(ctx, 1)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, stat.Version)
+}
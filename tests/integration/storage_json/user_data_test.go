@@ -0,0 +1,80 @@
+package test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain/strategy"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+func TestStorageExportUserData(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	st := openStorage(t, filepath.Join(t.TempDir(), "storage_TestStorageExportUserData.json"))
+	fillStorage(t, ctx, st)
+	require.NoError(t, st.AddRefund(ctx, 1, 1, nil))
+	require.NoError(t, st.AddReminders(ctx, 2, []uint64{2}))
+
+	data, err := st.ExportUserData(ctx, 1)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, data.UserID)
+	require.Len(t, data.Orders, 2)
+	require.Equal(t, domain.StatusReturned, data.Orders[0].Status)
+	require.Equal(t, []uint64{1}, data.Refunds)
+	require.Equal(t, []domain.Reminder{{OrderID: 2, Stage: 2}}, data.Reminders)
+
+	data, err = st.ExportUserData(ctx, 2)
+	require.NoError(t, err)
+	require.Empty(t, data.Orders)
+}
+
+func TestStorageEraseUserData(t *testing.T) {
+	t.Parallel()
+
+	const (
+		userID    = 987654321
+		pseudonym = 123456789
+	)
+
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "storage_TestStorageEraseUserData.json")
+	st := openStorage(t, path)
+
+	expDate := utils.TimeToString(utils.CurrentDate().AddDate(0, 0, 3))
+	order, err := domain.NewOrder(100, 10, expDate, strategy.ContainerTypeMap["box"])
+	require.NoError(t, err)
+	require.NoError(t, st.AddOrder(ctx, userID, 1, order))
+
+	_, err = st.EraseUserData(ctx, userID, pseudonym)
+	require.ErrorIs(t, err, domain.ErrWrongStatus, "order 1 is held in pickup point")
+
+	require.NoError(t, st.RemoveOrders(ctx, []uint64{1}, domain.StatusGiveClient))
+	orders, err := st.EraseUserData(ctx, userID, pseudonym)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1}, orders)
+
+	stat, err := st.GetOrderStatus(ctx, 1)
+	require.NoError(t, err)
+	require.EqualValues(t, pseudonym, stat.UserID)
+	require.Equal(t, order.Cost, stat.Cost)
+	_, err = st.GetOrder(ctx, userID, 1)
+	require.ErrorIs(t, err, domain.ErrNotFound)
+
+	require.NoError(t, st.Close())
+	for _, file := range []string{path, path + ".wal"} {
+		raw, err := os.ReadFile(file)
+		require.NoError(t, err)
+		require.NotContains(t, string(raw), strconv.Itoa(userID), file)
+	}
+
+	stat, err = openStorage(t, path).GetOrderStatus(ctx, 1)
+	require.NoError(t, err)
+	require.EqualValues(t, pseudonym, stat.UserID)
+}
@@ -0,0 +1,32 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage/cache"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage/memory"
+	storage_suite "gitlab.ozon.dev/chppppr/homework/tests/suite/storage"
+)
+
+func TestStorageMemorySuite(t *testing.T) {
+	suite.Run(t, &storage_suite.StorageMemorySuite{})
+}
+
+func TestStorageContract(t *testing.T) {
+	suite.Run(t, &storage_suite.StorageContractSuite{
+		NewStorage: func(t *testing.T) storage.Storage {
+			return memory.NewStorage()
+		},
+	})
+}
+
+func TestCachedStorageContract(t *testing.T) {
+	suite.Run(t, &storage_suite.StorageContractSuite{
+		NewStorage: func(t *testing.T) storage.Storage {
+			return cache.NewStorage(memory.NewStorage(), cache.Config{Size: 100, TTL: time.Minute}, nil)
+		},
+	})
+}
@@ -0,0 +1,77 @@
+package storage_suite
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+
+	"github.com/stretchr/testify/suite"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/dto"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+	"gitlab.ozon.dev/chppppr/homework/internal/usecase"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+const ConcurrentWorkers = 32
+
+// hammer запускает fn из ConcurrentWorkers горутин одновременно и возвращает число успешных вызовов
+func hammer(fn func() error) int64 {
+	var (
+		wg        sync.WaitGroup
+		succeeded atomic.Int64
+	)
+
+	start := make(chan struct{})
+	for range ConcurrentWorkers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			<-start
+			if fn() == nil {
+				succeeded.Add(1)
+			}
+		}()
+	}
+
+	close(start)
+	wg.Wait()
+	return succeeded.Load()
+}
+
+// checkConcurrentTransitions проводит один заказ через выдачу, возврат и передачу курьеру,
+// выполняя каждый переход из многих горутин: успешным должен быть ровно один вызов
+func checkConcurrentTransitions(s *suite.Suite, ctx context.Context, st storage.Storage, userID, orderID uint64) {
+	au := usecase.NewAcceptUsecase(st)
+	gu := usecase.NewGiveUsecase(st)
+	ru := usecase.NewReturnUsecase(st)
+
+	err := au.AcceptOrder(ctx, &dto.AddOrderRequest{
+		ExpirationDate: utils.CurrentDate().AddDate(0, 0, 7).Format("02-01-2006"),
+		UserID:         userID,
+		OrderID:        orderID,
+		Cost:           100,
+		Weight:         1,
+	})
+	s.Require().NoError(err)
+
+	given := hammer(func() error {
+		return errors.Join(gu.Give(ctx, &dto.GiveOrdersRequest{Orders: []uint64{orderID}})...)
+	})
+	s.Require().EqualValues(1, given)
+
+	refunded := hammer(func() error {
+		return au.AcceptRefund(ctx, &dto.RefundRequest{UserID: userID, OrderID: orderID})
+	})
+	s.Require().EqualValues(1, refunded)
+
+	returned := hammer(func() error {
+		return ru.Return(ctx, &dto.ReturnRequest{OrderID: orderID})
+	})
+	s.Require().EqualValues(1, returned)
+
+	status, err := st.GetOrderOnlyStatus(ctx, orderID)
+	s.Require().NoError(err)
+	s.Equal(domain.StatusGiveCourier, status)
+}
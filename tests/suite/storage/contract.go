@@ -0,0 +1,365 @@
+package storage_suite
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain/strategy"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+// refundsPageSize - размер страницы, которой тесты вычитывают все возвраты
+const refundsPageSize = 1000
+
+// StorageContractSuite - контракт, который обязана выполнять любая реализация storage.Storage.
+// NewStorage вызывается перед каждым тестом. Хранилище может быть непустым
+// (postgres между запусками не очищается), поэтому тесты работают только со своими заказами
+type StorageContractSuite struct {
+	suite.Suite
+	NewStorage func(t *testing.T) storage.Storage
+
+	st  storage.Storage
+	ctx context.Context
+	ids atomic.Uint64
+}
+
+func (s *StorageContractSuite) SetupSuite() {
+	s.ctx = context.Background()
+	s.ids.Store(uint64(time.Now().UnixNano()))
+}
+
+func (s *StorageContractSuite) SetupTest() {
+	s.st = s.NewStorage(s.T())
+}
+
+// newID возвращает номер заказа или клиента, которого ещё нет в хранилище
+func (s *StorageContractSuite) newID() uint64 {
+	return s.ids.Add(1)
+}
+
+func (s *StorageContractSuite) newOrder(daysToExpire int) *domain.Order {
+	expiration := utils.TimeToString(utils.CurrentDate().AddDate(0, 0, daysToExpire))
+	order, err := domain.NewOrder(100, 80, expiration, strategy.ContainerTypeMap[""])
+	s.Require().NoError(err)
+	return order
+}
+
+func (s *StorageContractSuite) accept(userID uint64, daysToExpire int) uint64 {
+	orderID := s.newID()
+	s.Require().NoError(s.st.AddOrder(s.ctx, userID, orderID, s.newOrder(daysToExpire)))
+	return orderID
+}
+
+func (s *StorageContractSuite) requireStatus(orderID uint64, status string, version uint64) {
+	stat, err := s.st.GetOrderStatus(s.ctx, orderID)
+	s.Require().NoError(err)
+	s.Equal(status, stat.Status)
+	s.Equal(version, stat.Version)
+}
+
+func orderIDs(orders []domain.OrderView) []uint64 {
+	ids := make([]uint64, 0, len(orders))
+	for _, order := range orders {
+		ids = append(ids, order.OrderID)
+	}
+	return ids
+}
+
+func (s *StorageContractSuite) TestAcceptedOrder() {
+	userID := s.newID()
+	orderID := s.newID()
+	order := s.newOrder(3)
+	s.Require().NoError(s.st.AddOrder(s.ctx, userID, orderID, order))
+
+	stat, err := s.st.GetOrderStatus(s.ctx, orderID)
+	s.Require().NoError(err)
+	s.Equal(domain.StatusAccepted, stat.Status)
+	s.Equal(userID, stat.UserID)
+	s.Equal(uint64(1), stat.Version)
+
+	status, err := s.st.GetOrderOnlyStatus(s.ctx, orderID)
+	s.Require().NoError(err)
+	s.Equal(domain.StatusAccepted, status)
+
+	actual, err := s.st.GetOrder(s.ctx, userID, orderID)
+	s.Require().NoError(err)
+	s.Equal(order, actual)
+
+	expiration, err := s.st.GetExpirationDate(s.ctx, userID, orderID)
+	s.Require().NoError(err)
+	s.Equal(order.ExpirationDate, utils.TimeToString(expiration))
+
+	s.Require().NoError(s.st.CanRemoveOrder(s.ctx, orderID))
+}
+
+func (s *StorageContractSuite) TestAlreadyExist() {
+	userID := s.newID()
+	orderID := s.accept(userID, 1)
+
+	err := s.st.AddOrder(s.ctx, userID, orderID, s.newOrder(1))
+	s.Require().ErrorIs(err, domain.ErrAlreadyExist)
+
+	err = s.st.AddOrderStatus(s.ctx, orderID, userID, domain.StatusAccepted, s.newOrder(1))
+	s.Require().ErrorIs(err, domain.ErrAlreadyExist)
+}
+
+func (s *StorageContractSuite) TestNotFound() {
+	missing := s.newID()
+
+	_, err := s.st.GetOrderStatus(s.ctx, missing)
+	s.Require().ErrorIs(err, domain.ErrNotFound)
+
+	_, err = s.st.GetOrderOnlyStatus(s.ctx, missing)
+	s.Require().ErrorIs(err, domain.ErrNotFound)
+
+	s.Require().ErrorIs(s.st.SetOrderStatus(s.ctx, missing, domain.StatusAccepted), domain.ErrNotFound)
+	s.Require().ErrorIs(s.st.CanRemoveOrder(s.ctx, missing), domain.ErrNotFound)
+	s.Require().ErrorIs(s.st.RemoveOrder(s.ctx, missing, domain.StatusGiveClient), domain.ErrNotFound)
+	s.Require().ErrorIs(s.st.RemoveRefund(s.ctx, missing), domain.ErrNotFound)
+}
+
+func (s *StorageContractSuite) TestOtherUserOrderNotFound() {
+	orderID := s.accept(s.newID(), 1)
+	otherUserID := s.newID()
+
+	_, err := s.st.GetOrder(s.ctx, otherUserID, orderID)
+	s.Require().ErrorIs(err, domain.ErrNotFound)
+
+	_, err = s.st.GetExpirationDate(s.ctx, otherUserID, orderID)
+	s.Require().ErrorIs(err, domain.ErrNotFound)
+}
+
+func (s *StorageContractSuite) TestGiveTransition() {
+	userID := s.newID()
+	orderID := s.accept(userID, 1)
+
+	s.Require().NoError(s.st.RemoveOrder(s.ctx, orderID, domain.StatusGiveClient))
+	s.requireStatus(orderID, domain.StatusGiveClient, 2)
+
+	s.Require().ErrorIs(s.st.CanRemoveOrder(s.ctx, orderID), domain.ErrAlreadyExist)
+	s.Require().ErrorIs(s.st.RemoveOrder(s.ctx, orderID, domain.StatusGiveClient), domain.ErrNotFound)
+
+	// выданный заказ остаётся в истории клиента
+	_, err := s.st.GetOrder(s.ctx, userID, orderID)
+	s.Require().NoError(err)
+}
+
+func (s *StorageContractSuite) TestRefundTransition() {
+	userID := s.newID()
+	orderID := s.accept(userID, 1)
+	s.Require().NoError(s.st.RemoveOrder(s.ctx, orderID, domain.StatusGiveClient))
+
+	order, err := s.st.GetOrder(s.ctx, userID, orderID)
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.st.AddRefund(s.ctx, userID, orderID, order))
+	s.requireStatus(orderID, domain.StatusReturned, 3)
+	s.Require().ErrorIs(s.st.AddRefund(s.ctx, userID, orderID, order), domain.ErrAlreadyExist)
+
+	s.Require().NoError(s.st.RemoveRefund(s.ctx, orderID))
+	s.requireStatus(orderID, domain.StatusGiveCourier, 4)
+	s.Require().ErrorIs(s.st.RemoveRefund(s.ctx, orderID), domain.ErrNotFound)
+}
+
+func (s *StorageContractSuite) TestRemoveOrdersAllOrNothing() {
+	userID := s.newID()
+	first := s.accept(userID, 1)
+	second := s.accept(userID, 1)
+
+	err := s.st.RemoveOrders(s.ctx, []uint64{first, s.newID(), second}, domain.StatusGiveClient)
+	s.Require().ErrorIs(err, domain.ErrNotFound)
+
+	for _, orderID := range []uint64{first, second} {
+		s.requireStatus(orderID, domain.StatusAccepted, 1)
+		s.Require().NoError(s.st.CanRemoveOrder(s.ctx, orderID))
+	}
+
+	s.Require().NoError(s.st.RemoveOrders(s.ctx, []uint64{first, second}, domain.StatusGiveClient))
+	s.requireStatus(first, domain.StatusGiveClient, 2)
+	s.requireStatus(second, domain.StatusGiveClient, 2)
+}
+
+func (s *StorageContractSuite) TestCountByStatus() {
+	before, err := s.st.GetOrdersCountByStatus(s.ctx, domain.StatusAccepted)
+	s.Require().NoError(err)
+
+	userID := s.newID()
+	s.accept(userID, 1)
+	s.accept(userID, 1)
+
+	after, err := s.st.GetOrdersCountByStatus(s.ctx, domain.StatusAccepted)
+	s.Require().NoError(err)
+	s.Equal(before+2, after)
+}
+
+func (s *StorageContractSuite) TestGetOrdersByUserIDPagination() {
+	userID := s.newID()
+	ids := make([]uint64, 0, 5)
+	for range 5 {
+		ids = append(ids, s.accept(userID, 1))
+	}
+	s.Require().NoError(s.st.RemoveOrder(s.ctx, ids[0], domain.StatusGiveClient))
+
+	orders, err := s.st.GetOrdersByUserID(s.ctx, userID, 0, 10)
+	s.Require().NoError(err)
+	s.Equal(ids, orderIDs(orders))
+	s.Equal(uint64(2), orders[0].Version)
+
+	orders, err = s.st.GetOrdersByUserID(s.ctx, userID, ids[1], 2)
+	s.Require().NoError(err)
+	s.Equal(ids[1:3], orderIDs(orders))
+
+	orders, err = s.st.GetOrdersByUserID(s.ctx, userID, ids[4]+1, 10)
+	s.Require().NoError(err)
+	s.Empty(orders)
+
+	orders, err = s.st.GetOrdersByUserID(s.ctx, s.newID(), 0, 10)
+	s.Require().NoError(err)
+	s.Empty(orders)
+}
+
+// allRefunds вычитывает все возвраты постранично
+func (s *StorageContractSuite) allRefunds() []domain.OrderView {
+	all := make([]domain.OrderView, 0)
+	for pageID := uint64(1); ; pageID++ {
+		page, err := s.st.GetRefunds(s.ctx, pageID, refundsPageSize)
+		s.Require().NoError(err)
+
+		all = append(all, page...)
+		if len(page) < refundsPageSize {
+			return all
+		}
+	}
+}
+
+func (s *StorageContractSuite) TestGetRefundsPagination() {
+	_, err := s.st.GetRefunds(s.ctx, 0, 10)
+	s.Require().ErrorIs(err, domain.ErrWrongInput)
+
+	_, err = s.st.GetRefunds(s.ctx, 1, 0)
+	s.Require().ErrorIs(err, domain.ErrWrongInput)
+
+	userID := s.newID()
+	refunded := make([]uint64, 0, 3)
+	for range 3 {
+		orderID := s.accept(userID, 1)
+		s.Require().NoError(s.st.RemoveOrder(s.ctx, orderID, domain.StatusGiveClient))
+		s.Require().NoError(s.st.AddRefund(s.ctx, userID, orderID, s.newOrder(1)))
+		refunded = append(refunded, orderID)
+	}
+
+	all := s.allRefunds()
+	ids := orderIDs(all)
+	s.IsIncreasing(ids)
+	s.Subset(ids, refunded)
+
+	page, err := s.st.GetRefunds(s.ctx, 2, 1)
+	s.Require().NoError(err)
+	s.Equal(ids[1:2], orderIDs(page))
+
+	page, err = s.st.GetRefunds(s.ctx, 2, 2)
+	s.Require().NoError(err)
+	s.Equal(ids[2:min(4, len(ids))], orderIDs(page))
+
+	page, err = s.st.GetRefunds(s.ctx, uint64(len(all))+1, 1)
+	s.Require().NoError(err)
+	s.Empty(page)
+}
+
+func (s *StorageContractSuite) TestExpireOrders() {
+	userID := s.newID()
+	expired := s.accept(userID, -2)
+	fresh := s.accept(userID, 5)
+
+	orders, err := s.st.ExpireOrders(s.ctx, utils.CurrentDate())
+	s.Require().NoError(err)
+	s.Contains(orders, expired)
+	s.NotContains(orders, fresh)
+
+	s.requireStatus(expired, domain.StatusExpired, 2)
+	s.requireStatus(fresh, domain.StatusAccepted, 1)
+}
+
+func (s *StorageContractSuite) remindIDs(stage uint64, from, to time.Time) []uint64 {
+	orders, err := s.st.GetOrdersToRemind(s.ctx, stage, from, to)
+	s.Require().NoError(err)
+	return orderIDs(orders)
+}
+
+func (s *StorageContractSuite) TestOrdersToRemind() {
+	from := utils.CurrentDate()
+	to := from.AddDate(0, 0, 3)
+
+	orderID := s.accept(s.newID(), 3)
+	later := s.accept(s.newID(), 4)
+	s.Contains(s.remindIDs(3, from, to), orderID)
+	s.NotContains(s.remindIDs(3, from, to), later)
+
+	// напоминание этапа 3 закрывает этапы 3 и более ранние, но не этап 1
+	s.Require().NoError(s.st.AddReminders(s.ctx, 3, []uint64{orderID}))
+	s.NotContains(s.remindIDs(3, from, to), orderID)
+	s.NotContains(s.remindIDs(5, from, to), orderID)
+	s.Contains(s.remindIDs(1, from, to), orderID)
+}
+
+func (s *StorageContractSuite) pendingIDs(stage uint64) []uint64 {
+	orders, err := s.st.GetPendingReminders(s.ctx, stage)
+	s.Require().NoError(err)
+	return orderIDs(orders)
+}
+
+func (s *StorageContractSuite) TestPendingReminders() {
+	orderID := s.accept(s.newID(), 3)
+	given := s.accept(s.newID(), 3)
+
+	// записанное напоминание ждёт отправки, пока его не отметят отправленным
+	s.Require().NoError(s.st.AddReminders(s.ctx, 3, []uint64{orderID, given}))
+	s.Contains(s.pendingIDs(3), orderID)
+	s.NotContains(s.pendingIDs(1), orderID)
+
+	// выданный заказ больше не напоминается
+	s.Require().NoError(s.st.RemoveOrder(s.ctx, given, domain.StatusGiveClient))
+	s.NotContains(s.pendingIDs(3), given)
+
+	s.Require().NoError(s.st.MarkRemindersSent(s.ctx, 1, []uint64{orderID}))
+	s.Contains(s.pendingIDs(3), orderID)
+
+	s.Require().NoError(s.st.MarkRemindersSent(s.ctx, 3, []uint64{orderID}))
+	s.NotContains(s.pendingIDs(3), orderID)
+}
+
+func (s *StorageContractSuite) TestSearchOrders() {
+	userID := s.newID()
+	ids := []uint64{s.accept(userID, 1), s.accept(userID, 2), s.accept(userID, 3)}
+
+	filter := &domain.OrderFilter{
+		UserID:     userID,
+		SortBy:     domain.SortByOrderID,
+		Descending: true,
+		Limit:      2,
+	}
+	orders, err := s.st.SearchOrders(s.ctx, filter)
+	s.Require().NoError(err)
+	s.Require().Len(orders, 2)
+	s.Equal(ids[2], orders[0].OrderID)
+	s.Equal(ids[1], orders[1].OrderID)
+
+	filter.After = &domain.SearchCursor{Value: ids[1], OrderID: ids[1]}
+	orders, err = s.st.SearchOrders(s.ctx, filter)
+	s.Require().NoError(err)
+	s.Require().Len(orders, 1)
+	s.Equal(ids[0], orders[0].OrderID)
+}
+
+func (s *StorageContractSuite) TestConcurrentTransitions() {
+	checkConcurrentTransitions(&s.Suite, s.ctx, s.st, s.newID(), s.newID())
+}
+
+func (s *StorageContractSuite) TestOrderVersions() {
+	checkOrderVersions(&s.Suite, s.ctx, s.st, s.newID(), s.newID())
+}
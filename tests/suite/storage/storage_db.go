@@ -3,14 +3,18 @@ package storage_suite
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 	"runtime"
 	"sync/atomic"
+	"time"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/joho/godotenv"
 	"github.com/stretchr/testify/suite"
+	"gitlab.ozon.dev/chppppr/homework/internal/backup"
 	"gitlab.ozon.dev/chppppr/homework/internal/domain"
 	"gitlab.ozon.dev/chppppr/homework/internal/domain/strategy"
 	"gitlab.ozon.dev/chppppr/homework/internal/dto"
@@ -35,6 +39,7 @@ type StorageDBSuite struct {
 	suite.Suite
 	pool *pgxpool.Pool
 	st   *postgres.StorageDB
+	ctx  context.Context
 }
 
 func (s *StorageDBSuite) SetupSuite() {
@@ -44,13 +49,13 @@ func (s *StorageDBSuite) SetupSuite() {
 		s.FailNow("Not found POSTGRESQL_TEST_DSN at .env")
 	}
 
-	ctx := context.Background()
-	s.pool, err = pgxpool.New(ctx, psqlDSN)
+	s.ctx = context.Background()
+	s.pool, err = pgxpool.New(s.ctx, psqlDSN)
 	s.Require().NoError(err)
 
 	txManager := postgres.NewTxManager(s.pool)
 	pgPepo := postgres.NewRepoPG(txManager)
-	s.st = postgres.NewStorageDB(ctx, txManager, pgPepo)
+	s.st = postgres.NewStorageDB(txManager, pgPepo)
 
 	s.generateFakeData()
 }
@@ -85,7 +90,7 @@ func (s *StorageDBSuite) execAddRequests() []*dto.AddOrderRequest {
 
 		task := &workers.TaskRequest{
 			Func: func() error {
-				if err := s.st.AddOrder(req.UserID, req.OrderID, order); err != nil {
+				if err := s.st.AddOrder(s.ctx, req.UserID, req.OrderID, order); err != nil {
 					bad_requests.Add(1)
 				}
 				return nil
@@ -108,7 +113,7 @@ func (s *StorageDBSuite) execAddRequests() []*dto.AddOrderRequest {
 
 		task := &workers.TaskRequest{
 			Func: func() error {
-				if err := s.st.AddOrder(req.UserID, req.OrderID, order); err != nil {
+				if err := s.st.AddOrder(s.ctx, req.UserID, req.OrderID, order); err != nil {
 					bad_requests.Add(1)
 				}
 				return nil
@@ -134,7 +139,7 @@ func (s *StorageDBSuite) execGiveRequests(addRequests []*dto.AddOrderRequest) ([
 		for _, v := range giveRequests[i].Orders {
 			orders = append(orders, uint64(v))
 		}
-		if err := s.st.RemoveOrders(orders, domain.StatusGiveClient); err != nil {
+		if err := s.st.RemoveOrders(s.ctx, orders, domain.StatusGiveClient); err != nil {
 			bad_requests++
 		}
 	}
@@ -160,7 +165,7 @@ func (s *StorageDBSuite) execRefundRequests(giveRequests []*dto.GiveOrdersReques
 	for _, req := range refundRequests {
 		task := &workers.TaskRequest{
 			Func: func() error {
-				if err := s.st.AddRefund(req.UserID, req.OrderID, nil); err != nil {
+				if err := s.st.AddRefund(s.ctx, req.UserID, req.OrderID, nil); err != nil {
 					bad_requests.Add(1)
 				}
 				return nil
@@ -180,7 +185,7 @@ func (s *StorageDBSuite) execReturnRequests(refundRequests []*dto.RefundRequest)
 	log.Printf("Generating %d ReturnRequests\n", TotalReturnRequests)
 	returnRequests := scripts.GenerateReturnRequests(refundRequests, TotalReturnRequests)
 	for _, req := range returnRequests {
-		if err := s.st.RemoveRefund(req.OrderID); err != nil {
+		if err := s.st.RemoveRefund(s.ctx, req.OrderID); err != nil {
 			bad_requests++
 		}
 	}
@@ -206,42 +211,42 @@ func (s *StorageDBSuite) TestOrderAlreadyExist() {
 	order, err := domain.NewOrder(cost, weight, utils.CurrentDateString(), cs)
 	s.Require().NoError(err)
 
-	err = s.st.AddOrder(userID, orderID, order)
+	err = s.st.AddOrder(s.ctx, userID, orderID, order)
 	s.Require().Error(err)
 
-	err = s.st.AddOrderStatus(orderID, userID, domain.StatusAccepted, order)
+	err = s.st.AddOrderStatus(s.ctx, orderID, userID, domain.StatusAccepted, order)
 	s.Require().Error(err)
 }
 
 func (s *StorageDBSuite) TestSetOrderStatusNotFound() {
-	err := s.st.SetOrderStatus(123, domain.StatusAccepted)
+	err := s.st.SetOrderStatus(s.ctx, 123, domain.StatusAccepted)
 	s.Require().Error(err)
 }
 
 func (s *StorageDBSuite) TestRemoveOrderWrongStatus() {
-	err := s.st.CanRemoveOrder(2434254602525190348)
+	err := s.st.CanRemoveOrder(s.ctx, 2434254602525190348)
 	s.Require().Error(err)
 }
 
 func (s *StorageDBSuite) TestRemoveOrderWrongOrderID() {
-	err := s.st.CanRemoveOrder(123)
+	err := s.st.CanRemoveOrder(s.ctx, 123)
 	s.Require().Error(err)
 }
 
 func (s *StorageDBSuite) TestGetOrderUserNotFound() {
-	_, err := s.st.GetOrder(10, 10)
+	_, err := s.st.GetOrder(s.ctx, 10, 10)
 	s.Require().Error(err)
 }
 
 func (s *StorageDBSuite) TestGetExpirationDateUserNotFound() {
-	_, err := s.st.GetExpirationDate(10, 10)
+	_, err := s.st.GetExpirationDate(s.ctx, 10, 10)
 	s.Require().Error(err)
 }
 
 func (s *StorageDBSuite) TestSuccessGetOrders() {
 	var expected []domain.OrderView
 	userID := uint64(12345678)
-	actual, err := s.st.GetOrdersByUserID(userID, 0, 10)
+	actual, err := s.st.GetOrdersByUserID(s.ctx, userID, 0, 10)
 	s.Require().NoError(err)
 
 	// Для обновления файла актульными данными:
@@ -254,18 +259,18 @@ func (s *StorageDBSuite) TestSuccessGetOrders() {
 	s.Require().NoError(err)
 	s.Equal(expected, actual)
 
-	actual, err = s.st.GetOrdersByUserID(userID, expected[1].OrderID, 9)
+	actual, err = s.st.GetOrdersByUserID(s.ctx, userID, expected[1].OrderID, 9)
 	s.Require().NoError(err)
 	s.Equal(expected[1:], actual)
 
-	actual, err = s.st.GetOrdersByUserID(userID, expected[1].OrderID, 3)
+	actual, err = s.st.GetOrdersByUserID(s.ctx, userID, expected[1].OrderID, 3)
 	s.Require().NoError(err)
 	s.Equal(expected[1:4], actual)
 }
 
 func (s *StorageDBSuite) TestSuccessGetRefunds() {
 	var expected []domain.OrderView
-	actual, err := s.st.GetRefunds(1, 10)
+	actual, err := s.st.GetRefunds(s.ctx, 1, 10)
 	s.Require().NoError(err)
 
 	// Для обновления файла актульными данными:
@@ -278,25 +283,256 @@ func (s *StorageDBSuite) TestSuccessGetRefunds() {
 	s.Require().NoError(err)
 	s.Equal(expected, actual)
 
-	actual, err = s.st.GetRefunds(2, 1)
+	actual, err = s.st.GetRefunds(s.ctx, 2, 1)
 	s.Require().NoError(err)
 	s.Equal(expected[1:2], actual)
 
-	actual, err = s.st.GetRefunds(1, 4)
+	actual, err = s.st.GetRefunds(s.ctx, 1, 4)
 	s.Require().NoError(err)
 	s.Equal(expected[0:4], actual)
 
-	actual, err = s.st.GetRefunds(3, 1)
+	actual, err = s.st.GetRefunds(s.ctx, 3, 1)
 	s.Require().NoError(err)
 	s.Equal(expected[2:3], actual)
 }
 
 func (s *StorageDBSuite) TestRemoveRefundOrderNotFound() {
-	err := s.st.RemoveRefund(101)
+	err := s.st.RemoveRefund(s.ctx, 101)
 	s.Require().Error(err)
 }
 
+func (s *StorageDBSuite) TestAddRefundRequiresIssuedOrder() {
+	userID := uint64(time.Now().UnixNano())
+	order, err := domain.NewOrder(100, 10, utils.CurrentDateString(), strategy.ContainerTypeMap["box"])
+	s.Require().NoError(err)
+	s.Require().NoError(s.st.AddOrder(s.ctx, userID, userID, order))
+
+	s.Require().ErrorIs(s.st.AddRefund(s.ctx, userID, userID, order), domain.ErrWrongStatus)
+	s.Require().ErrorIs(s.st.AddRefund(s.ctx, userID, userID+1, order), domain.ErrNotFound)
+
+	status, err := s.st.GetOrderStatus(s.ctx, userID)
+	s.Require().NoError(err)
+	s.Require().Equal(domain.StatusAccepted, status.Status)
+}
+
+// возврат, отданный курьеру, нельзя принять снова: причина - статус, а не версия
+func (s *StorageDBSuite) TestAddRefundAfterCourierReportsStatus() {
+	userID := uint64(time.Now().UnixNano())
+	order, err := domain.NewOrder(100, 10, utils.CurrentDateString(), strategy.ContainerTypeMap["box"])
+	s.Require().NoError(err)
+	s.Require().NoError(s.st.AddOrder(s.ctx, userID, userID, order))
+	s.Require().NoError(s.st.RemoveOrder(s.ctx, userID, domain.StatusGiveClient))
+	s.Require().NoError(s.st.AddRefund(s.ctx, userID, userID, order))
+	s.Require().NoError(s.st.RemoveRefund(s.ctx, userID))
+
+	err = s.st.AddRefund(s.ctx, userID, userID, order)
+	s.Require().ErrorIs(err, domain.ErrWrongStatus)
+	s.Require().NotErrorIs(err, domain.ErrVersionMismatch)
+}
+
 func (s *StorageDBSuite) TestFailGetRefunds() {
-	_, err := s.st.GetRefunds(0, 10)
+	_, err := s.st.GetRefunds(s.ctx, 0, 10)
+	s.Require().Error(err)
+}
+
+func (s *StorageDBSuite) TestCanceledContext() {
+	ctx, cancel := context.WithCancel(s.ctx)
+	cancel()
+
+	_, err := s.st.GetOrderStatus(ctx, 221482238527448200)
+	s.Require().ErrorIs(err, context.Canceled)
+
+	err = s.st.SetOrderStatus(ctx, 221482238527448200, domain.StatusAccepted)
+	s.Require().ErrorIs(err, context.Canceled)
+}
+
+func (s *StorageDBSuite) TestDeadlineAbortsBlockedQuery() {
+	orderID := uint64(221482238527448200)
+
+	// держим блокировку строки, чтобы запрос хранилища повис на ней
+	tx, err := s.pool.Begin(s.ctx)
+	s.Require().NoError(err)
+	defer func() {
+		_ = tx.Rollback(s.ctx)
+	}()
+
+	_, err = tx.Exec(s.ctx, `select 1 from orders where order_id = $1 for update`, orderID)
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithTimeout(s.ctx, 200*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err = s.st.SetOrderStatus(ctx, orderID, domain.StatusAccepted)
+	s.Require().ErrorIs(err, context.DeadlineExceeded)
+	s.Less(time.Since(start), 2*time.Second)
+}
+
+// курсор по дате не зависит от часового пояса сессии
+func (s *StorageDBSuite) TestSearchDateCursorInSessionTimeZone() {
+	cfg, err := pgxpool.ParseConfig(os.Getenv("POSTGRESQL_TEST_DSN"))
+	s.Require().NoError(err)
+	cfg.ConnConfig.RuntimeParams["timezone"] = "Pacific/Kiritimati"
+	pool, err := pgxpool.NewWithConfig(s.ctx, cfg)
+	s.Require().NoError(err)
+	defer pool.Close()
+
+	txManager := postgres.NewTxManager(pool)
+	st := postgres.NewStorageDB(txManager, postgres.NewRepoPG(txManager))
+
+	userID := uint64(time.Now().UnixNano())
+	for i := range uint64(3) {
+		expDate := utils.TimeToString(utils.CurrentDate().AddDate(0, 0, int(i)+1))
+		order, err := domain.NewOrder(100, 10, expDate, strategy.ContainerTypeMap["box"])
+		s.Require().NoError(err)
+		s.Require().NoError(st.AddOrder(s.ctx, userID, userID+i, order))
+	}
+
+	filter := &domain.OrderFilter{UserID: userID, SortBy: domain.SortByExpirationDate, Limit: 1}
+	var got []uint64
+	for range 4 {
+		orders, err := st.SearchOrders(s.ctx, filter)
+		s.Require().NoError(err)
+		if len(orders) == 0 {
+			break
+		}
+
+		got = append(got, orders[0].OrderID)
+		key, err := orders[0].SortKey(filter.SortBy)
+		s.Require().NoError(err)
+		filter.After = &domain.SearchCursor{Value: key, OrderID: orders[0].OrderID}
+	}
+	s.Require().Equal([]uint64{userID, userID + 1, userID + 2}, got)
+}
+
+func (s *StorageDBSuite) TestArchiveOrders() {
+	userID := uint64(time.Now().UnixNano())
+	orderID := userID
+	order, err := domain.NewOrder(100, 10, utils.CurrentDateString(), strategy.ContainerTypeMap["box"])
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.st.AddOrder(s.ctx, userID, orderID, order))
+	s.Require().NoError(s.st.RemoveOrder(s.ctx, orderID, domain.StatusGiveClient))
+
+	// заказ выдан давно, остальные заказы тестовой базы в перенос не попадают
+	_, err = s.pool.Exec(s.ctx, `update orders set updated_at = '2000-01-15' where order_id = $1`, orderID)
+	s.Require().NoError(err)
+
+	orders, err := s.st.ArchiveOrders(s.ctx, time.Date(2000, 2, 1, 0, 0, 0, 0, time.UTC), 100)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{orderID}, orders)
+
+	// из архива заказ читается как раньше, а его номер не может быть занят повторно
+	stat, err := s.st.GetOrderStatus(s.ctx, orderID)
+	s.Require().NoError(err)
+	s.Require().Equal(domain.StatusGiveClient, stat.Status)
+
+	got, err := s.st.GetOrder(s.ctx, userID, orderID)
+	s.Require().NoError(err)
+	s.Require().Equal(order, got)
+
+	views, err := s.st.GetOrdersByUserID(s.ctx, userID, 0, 10)
+	s.Require().NoError(err)
+	s.Require().Len(views, 1)
+
+	s.Require().ErrorIs(s.st.AddOrder(s.ctx, userID, orderID, order), domain.ErrAlreadyExist)
+
+	dropped, orders, err := s.st.DropArchive(s.ctx, time.Date(2000, 2, 1, 0, 0, 0, 0, time.UTC))
+	s.Require().NoError(err)
+	s.Require().Contains(dropped, "orders_archive_2000_01")
+	s.Require().Contains(orders, orderID)
+
+	_, err = s.st.GetOrderStatus(s.ctx, orderID)
+	s.Require().ErrorIs(err, domain.ErrNotFound)
+
+	// номер удалённого из архива заказа снова свободен
+	s.Require().NoError(s.st.AddOrder(s.ctx, userID, orderID, order))
+}
+
+func (s *StorageDBSuite) TestExportAndEraseUserData() {
+	userID := uint64(time.Now().UnixNano())
+	pseudonym := domain.Pseudonymize(userID, []byte("test key"))
+	returned, held := userID, userID+1
+	order, err := domain.NewOrder(100, 10, utils.CurrentDateString(), strategy.ContainerTypeMap["box"])
+	s.Require().NoError(err)
+
+	s.Require().NoError(s.st.AddOrder(s.ctx, userID, returned, order))
+	s.Require().NoError(s.st.AddOrder(s.ctx, userID, held, order))
+	s.Require().NoError(s.st.RemoveOrder(s.ctx, returned, domain.StatusGiveClient))
+	s.Require().NoError(s.st.AddRefund(s.ctx, userID, returned, order))
+	s.Require().NoError(s.st.AddReminders(s.ctx, 1, []uint64{held}))
+
+	data, err := s.st.ExportUserData(s.ctx, userID)
+	s.Require().NoError(err)
+	s.Require().Len(data.Orders, 2)
+	s.Require().Equal([]uint64{returned}, data.Refunds)
+	s.Require().Len(data.Reminders, 1)
+
+	_, err = s.st.EraseUserData(s.ctx, userID, pseudonym)
+	s.Require().ErrorIs(err, domain.ErrWrongStatus)
+
+	s.Require().NoError(s.st.RemoveOrder(s.ctx, held, domain.StatusGiveClient))
+	orders, err := s.st.EraseUserData(s.ctx, userID, pseudonym)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{returned, held}, orders)
+
+	data, err = s.st.ExportUserData(s.ctx, userID)
+	s.Require().NoError(err)
+	s.Require().Empty(data.Orders)
+
+	// суммы и статусы заказов остаются для отчётов
+	stat, err := s.st.GetOrderStatus(s.ctx, returned)
+	s.Require().NoError(err)
+	s.Require().Equal(pseudonym, stat.UserID)
+	s.Require().Equal(domain.StatusReturned, stat.Status)
+	s.Require().Equal(order.Cost, stat.Cost)
+}
+
+func (s *StorageDBSuite) TestBackupWritesSchemaVersion() {
+	path := filepath.Join(s.T().TempDir(), "orders.backup")
+	trailer, err := backup.Write(s.ctx, s.st, path, backup.Options{Source: "postgres"})
+	s.Require().NoError(err)
+
+	header, verified, err := backup.Verify(path)
+	s.Require().NoError(err)
+	s.Require().Equal(trailer, verified)
+	s.Require().Positive(header.SchemaVersion)
+
+	version, err := s.st.SchemaVersion(s.ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(version, header.SchemaVersion)
+}
+
+func (s *StorageDBSuite) TestAuditLogIsChainedAndAppendOnly() {
+	actor := fmt.Sprintf("staff:audit-%d", time.Now().UnixNano())
+	for _, orderID := range []uint64{1, 2} {
+		s.Require().NoError(s.st.AppendAudit(s.ctx, &domain.AuditRecord{
+			CreatedAt: time.Now(),
+			Actor:     actor,
+			Method:    "/manager.ManagerService/Return",
+			OrderIDs:  []uint64{orderID},
+			Outcome:   domain.AuditOutcomeSuccess,
+			Code:      "OK",
+		}))
+	}
+
+	records, err := s.st.QueryAuditLog(s.ctx, &domain.AuditFilter{Actor: actor, Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Len(records, 2)
+	s.Require().Equal(records[0].Hash, records[1].PrevHash)
+
+	byOrder, err := s.st.QueryAuditLog(s.ctx, &domain.AuditFilter{Actor: actor, OrderID: 2, Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Equal(records[1:], byOrder)
+
+	// журнал проверяется целиком: записи других тестов тоже в цепочке
+	all, err := s.st.QueryAuditLog(s.ctx, &domain.AuditFilter{Limit: 100000})
+	s.Require().NoError(err)
+	_, err = domain.VerifyAuditChain("", all)
+	s.Require().NoError(err)
+
+	_, err = s.pool.Exec(s.ctx, "UPDATE audit_log SET outcome = 'failure' WHERE id = $1", records[0].ID)
+	s.Require().Error(err)
+	_, err = s.pool.Exec(s.ctx, "DELETE FROM audit_log WHERE id = $1", records[0].ID)
 	s.Require().Error(err)
 }
@@ -1,8 +1,10 @@
 package storage_suite
 
 import (
+	"context"
 	"encoding/json"
 	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -15,17 +17,29 @@ import (
 
 type StorageJSONSuite struct {
 	suite.Suite
-	st *storage_json.Storage
+	st  *storage_json.Storage
+	ctx context.Context
 }
 
 func (s *StorageJSONSuite) SetupSuite() {
 	var err error
+	s.ctx = context.Background()
 	ohp := storage_json.NewOrdersHistory()
 	rp := storage_json.NewRefunds()
 	up := storage_json.NewUsers()
 
-	s.st, err = storage_json.NewStorage(ohp, rp, up, "test_data/storage_test.json")
+	// журнал дописывается при каждом изменении, поэтому работаем с копией фикстуры
+	path := filepath.Join(s.T().TempDir(), "storage_test.json")
+	data, err := os.ReadFile("test_data/storage_test.json")
 	s.Require().NoError(err)
+	s.Require().NoError(os.WriteFile(path, data, 0666))
+
+	s.st, err = storage_json.NewStorage(ohp, rp, up, path)
+	s.Require().NoError(err)
+}
+
+func (s *StorageJSONSuite) TearDownSuite() {
+	s.Require().NoError(s.st.Close())
 }
 
 func (s *StorageJSONSuite) TestOrderAlreadyExist() {
@@ -39,35 +53,35 @@ func (s *StorageJSONSuite) TestOrderAlreadyExist() {
 	order, err := domain.NewOrder(cost, weight, utils.CurrentDateString(), cs)
 	s.Require().NoError(err)
 
-	err = s.st.AddOrder(userID, orderID, order)
+	err = s.st.AddOrder(s.ctx, userID, orderID, order)
 	s.Require().Error(err)
 
-	err = s.st.AddOrderStatus(orderID, userID, domain.StatusAccepted, order)
+	err = s.st.AddOrderStatus(s.ctx, orderID, userID, domain.StatusAccepted, order)
 	s.Require().Error(err)
 }
 
 func (s *StorageJSONSuite) TestSetOrderStatusNotFound() {
-	err := s.st.SetOrderStatus(123, domain.StatusAccepted)
+	err := s.st.SetOrderStatus(s.ctx, 123, domain.StatusAccepted)
 	s.Require().Error(err)
 }
 
 func (s *StorageJSONSuite) TestRemoveOrderWrongStatus() {
-	err := s.st.CanRemoveOrder(1)
+	err := s.st.CanRemoveOrder(s.ctx, 1)
 	s.Require().Error(err)
 }
 
 func (s *StorageJSONSuite) TestRemoveOrderWrongOrderID() {
-	err := s.st.CanRemoveOrder(10101)
+	err := s.st.CanRemoveOrder(s.ctx, 10101)
 	s.Require().Error(err)
 }
 
 func (s *StorageJSONSuite) TestGetOrderUserNotFound() {
-	_, err := s.st.GetOrder(10, 10)
+	_, err := s.st.GetOrder(s.ctx, 10, 10)
 	s.Require().Error(err)
 }
 
 func (s *StorageJSONSuite) TestGetExpirationDateUserNotFound() {
-	_, err := s.st.GetExpirationDate(10, 10)
+	_, err := s.st.GetExpirationDate(s.ctx, 10, 10)
 	s.Require().Error(err)
 }
 
@@ -90,7 +104,7 @@ func readFromGoldenFile(t *testing.T, path string, actual []byte, isUpdate bool)
 
 func (s *StorageJSONSuite) TestSuccessGetOrders() {
 	var expected []domain.OrderView
-	actual, err := s.st.GetOrdersByUserID(1, 2, 0)
+	actual, err := s.st.GetOrdersByUserID(s.ctx, 1, 2, 10)
 	s.Require().NoError(err)
 
 	// Для обновления файла актульными данными:
@@ -103,28 +117,30 @@ func (s *StorageJSONSuite) TestSuccessGetOrders() {
 	s.Require().NoError(err)
 	s.Equal(expected, actual)
 
-	actual, err = s.st.GetOrdersByUserID(1, 3, 0)
+	actual, err = s.st.GetOrdersByUserID(s.ctx, 1, 3, 10)
 	s.Require().NoError(err)
 	s.Equal(expected[1:], actual)
 
-	actual, err = s.st.GetOrdersByUserID(1, 3, 3)
+	actual, err = s.st.GetOrdersByUserID(s.ctx, 1, 3, 3)
 	s.Require().NoError(err)
 	s.Equal(expected[1:4], actual)
 }
 
 func (s *StorageJSONSuite) TestGetOrdersUserNotFound() {
-	_, err := s.st.GetOrdersByUserID(101, 2, 0)
-	s.Require().Error(err)
+	orders, err := s.st.GetOrdersByUserID(s.ctx, 101, 2, 10)
+	s.Require().NoError(err)
+	s.Empty(orders)
 }
 
 func (s *StorageJSONSuite) TestGetOrdersWrongFirstUserID() {
-	_, err := s.st.GetOrdersByUserID(1, 101, 0)
-	s.Require().Error(err)
+	orders, err := s.st.GetOrdersByUserID(s.ctx, 1, 101, 10)
+	s.Require().NoError(err)
+	s.Empty(orders)
 }
 
 func (s *StorageJSONSuite) TestSuccessGetRefunds() {
 	var expected []domain.OrderView
-	actual, err := s.st.GetRefunds(1, 10)
+	actual, err := s.st.GetRefunds(s.ctx, 1, 10)
 	s.Require().NoError(err)
 
 	// Для обновления файла актульными данными:
@@ -137,28 +153,28 @@ func (s *StorageJSONSuite) TestSuccessGetRefunds() {
 	s.Require().NoError(err)
 	s.Equal(expected, actual)
 
-	actual, err = s.st.GetRefunds(2, 1)
+	actual, err = s.st.GetRefunds(s.ctx, 2, 1)
 	s.Require().NoError(err)
 	s.Equal(expected[1:2], actual)
 
-	actual, err = s.st.GetRefunds(1, 4)
+	actual, err = s.st.GetRefunds(s.ctx, 1, 4)
 	s.Require().NoError(err)
 	s.Equal(expected[0:4], actual)
 
-	actual, err = s.st.GetRefunds(3, 1)
+	actual, err = s.st.GetRefunds(s.ctx, 3, 1)
 	s.Require().NoError(err)
 	s.Equal(expected[2:3], actual)
 }
 
 func (s *StorageJSONSuite) TestRemoveRefundOrderNotFound() {
-	err := s.st.RemoveRefund(101)
+	err := s.st.RemoveRefund(s.ctx, 101)
 	s.Require().Error(err)
 }
 
 func (s *StorageJSONSuite) TestFailGetRefunds() {
-	_, err := s.st.GetRefunds(0, 10)
+	_, err := s.st.GetRefunds(s.ctx, 0, 10)
 	s.Require().Error(err)
 
-	_, err = s.st.GetRefunds(1, 0)
+	_, err = s.st.GetRefunds(s.ctx, 1, 0)
 	s.Require().Error(err)
 }
@@ -0,0 +1,159 @@
+package storage_suite
+
+import (
+	"context"
+	"errors"
+
+	"github.com/stretchr/testify/suite"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain/strategy"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage/memory"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+const (
+	memoryUserID   = uint64(12345678)
+	memoryOrdersID = 10
+)
+
+type StorageMemorySuite struct {
+	suite.Suite
+	st  *memory.Storage
+	ctx context.Context
+}
+
+func (s *StorageMemorySuite) newOrder(daysToExpire int) *domain.Order {
+	cs := strategy.ContainerTypeMap[""]
+	expiration := utils.TimeToString(utils.CurrentDate().AddDate(0, 0, daysToExpire))
+
+	order, err := domain.NewOrder(100, 80, expiration, cs)
+	s.Require().NoError(err)
+	return order
+}
+
+// SetupTest принимает заказы 1..10, выдаёт 1..4 и оформляет возврат 1..3
+func (s *StorageMemorySuite) SetupTest() {
+	s.ctx = context.Background()
+	s.st = memory.NewStorage()
+
+	for orderID := uint64(1); orderID <= memoryOrdersID; orderID++ {
+		s.Require().NoError(s.st.AddOrder(s.ctx, memoryUserID, orderID, s.newOrder(int(orderID))))
+	}
+
+	s.Require().NoError(s.st.RemoveOrders(s.ctx, []uint64{1, 2, 3, 4}, domain.StatusGiveClient))
+	for orderID := uint64(1); orderID <= 3; orderID++ {
+		s.Require().NoError(s.st.AddRefund(s.ctx, memoryUserID, orderID, s.newOrder(0)))
+	}
+}
+
+func (s *StorageMemorySuite) TestRemoveOrderWrongStatus() {
+	err := s.st.CanRemoveOrder(s.ctx, 4)
+	s.Require().ErrorIs(err, domain.ErrAlreadyExist)
+}
+
+func (s *StorageMemorySuite) TestSuccessGetOrders() {
+	actual, err := s.st.GetOrdersByUserID(s.ctx, memoryUserID, 0, 3)
+	s.Require().NoError(err)
+	s.Require().Len(actual, 3)
+	s.Equal([]uint64{1, 2, 3}, []uint64{actual[0].OrderID, actual[1].OrderID, actual[2].OrderID})
+
+	actual, err = s.st.GetOrdersByUserID(s.ctx, memoryUserID, 9, 10)
+	s.Require().NoError(err)
+	s.Len(actual, 2)
+}
+
+func (s *StorageMemorySuite) TestSuccessGetRefunds() {
+	actual, err := s.st.GetRefunds(s.ctx, 1, 10)
+	s.Require().NoError(err)
+	s.Require().Len(actual, 3)
+
+	page, err := s.st.GetRefunds(s.ctx, 2, 1)
+	s.Require().NoError(err)
+	s.Equal(actual[1:2], page)
+
+	page, err = s.st.GetRefunds(s.ctx, 4, 1)
+	s.Require().NoError(err)
+	s.Empty(page)
+}
+
+func (s *StorageMemorySuite) TestCountByStatus() {
+	for status, expected := range map[string]uint64{
+		domain.StatusAccepted:   6,
+		domain.StatusGiveClient: 1,
+		domain.StatusReturned:   3,
+	} {
+		count, err := s.st.GetOrdersCountByStatus(s.ctx, status)
+		s.Require().NoError(err)
+		s.Equal(expected, count, status)
+	}
+}
+
+func (s *StorageMemorySuite) TestExpireAndRemind() {
+	from := utils.CurrentDate()
+	toRemind, err := s.st.GetOrdersToRemind(s.ctx, 1, from, from.AddDate(0, 0, 6))
+	s.Require().NoError(err)
+	s.Len(toRemind, 2)
+
+	s.Require().NoError(s.st.AddReminders(s.ctx, 1, []uint64{5}))
+	toRemind, err = s.st.GetOrdersToRemind(s.ctx, 1, from, from.AddDate(0, 0, 6))
+	s.Require().NoError(err)
+	s.Require().Len(toRemind, 1)
+	s.Equal(uint64(6), toRemind[0].OrderID)
+
+	expired, err := s.st.ExpireOrders(s.ctx, from.AddDate(0, 0, 7))
+	s.Require().NoError(err)
+	s.Equal([]uint64{5, 6}, expired)
+}
+
+func (s *StorageMemorySuite) TestSearchByUserIndex() {
+	orders, err := s.st.SearchOrders(s.ctx, &domain.OrderFilter{
+		UserID:     memoryUserID,
+		Statuses:   []string{domain.StatusAccepted},
+		SortBy:     domain.SortByExpirationDate,
+		Descending: true,
+		Limit:      2,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(orders, 2)
+	s.Equal(uint64(10), orders[0].OrderID)
+	s.Equal(uint64(9), orders[1].OrderID)
+}
+
+func (s *StorageMemorySuite) TestRollback() {
+	errAbort := errors.New("abort")
+	err := s.st.RunInTx(s.ctx, func(ctxTx context.Context) error {
+		s.Require().NoError(s.st.AddOrder(ctxTx, memoryUserID, 100, s.newOrder(1)))
+		s.Require().NoError(s.st.RemoveOrder(ctxTx, 5, domain.StatusGiveClient))
+		s.Require().NoError(s.st.RemoveRefund(ctxTx, 1))
+		return errAbort
+	})
+	s.Require().ErrorIs(err, errAbort)
+
+	_, err = s.st.GetOrderStatus(s.ctx, 100)
+	s.Require().ErrorIs(err, domain.ErrNotFound)
+
+	stat, err := s.st.GetOrderStatus(s.ctx, 5)
+	s.Require().NoError(err)
+	s.Equal(domain.StatusAccepted, stat.Status)
+	s.Equal(uint64(1), stat.Version)
+	s.Require().NoError(s.st.CanRemoveOrder(s.ctx, 5))
+
+	count, err := s.st.GetOrdersCountByStatus(s.ctx, domain.StatusGiveClient)
+	s.Require().NoError(err)
+	s.Equal(uint64(1), count)
+
+	refunds, err := s.st.GetRefunds(s.ctx, 1, 10)
+	s.Require().NoError(err)
+	s.Len(refunds, 3)
+}
+
+func (s *StorageMemorySuite) TestCanceledContext() {
+	ctx, cancel := context.WithCancel(s.ctx)
+	cancel()
+
+	_, err := s.st.GetOrderStatus(ctx, 5)
+	s.Require().ErrorIs(err, context.Canceled)
+
+	err = s.st.SetOrderStatus(ctx, 5, domain.StatusAccepted)
+	s.Require().ErrorIs(err, context.Canceled)
+}
@@ -0,0 +1,50 @@
+package storage_suite
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/suite"
+	"gitlab.ozon.dev/chppppr/homework/internal/domain"
+	"gitlab.ozon.dev/chppppr/homework/internal/dto"
+	"gitlab.ozon.dev/chppppr/homework/internal/storage"
+	"gitlab.ozon.dev/chppppr/homework/internal/usecase"
+	"gitlab.ozon.dev/chppppr/homework/internal/utils"
+)
+
+// checkOrderVersions проверяет, что версия растёт при смене статуса,
+// а переход с устаревшей expected_version отклоняется
+func checkOrderVersions(s *suite.Suite, ctx context.Context, st storage.Storage, userID, orderID uint64) {
+	au := usecase.NewAcceptUsecase(st)
+	gu := usecase.NewGiveUsecase(st)
+
+	err := au.AcceptOrder(ctx, &dto.AddOrderRequest{
+		ExpirationDate: utils.CurrentDate().AddDate(0, 0, 7).Format("02-01-2006"),
+		UserID:         userID,
+		OrderID:        orderID,
+		Cost:           100,
+		Weight:         1,
+	})
+	s.Require().NoError(err)
+
+	stat, err := st.GetOrderStatus(ctx, orderID)
+	s.Require().NoError(err)
+	s.Require().EqualValues(1, stat.Version)
+
+	errs := gu.Give(ctx, &dto.GiveOrdersRequest{
+		Orders:           []uint64{orderID},
+		ExpectedVersions: map[uint64]uint64{orderID: 1},
+	})
+	s.Require().Empty(errs)
+
+	stat, err = st.GetOrderStatus(ctx, orderID)
+	s.Require().NoError(err)
+	s.Require().EqualValues(2, stat.Version)
+
+	stale := uint64(1)
+	err = au.AcceptRefund(ctx, &dto.RefundRequest{UserID: userID, OrderID: orderID, ExpectedVersion: &stale})
+	s.Require().ErrorIs(err, domain.ErrVersionMismatch)
+
+	status, err := st.GetOrderOnlyStatus(ctx, orderID)
+	s.Require().NoError(err)
+	s.Equal(domain.StatusGiveClient, status)
+}